@@ -66,12 +66,20 @@ clickhouse client -n -h 127.0.0.1 <<-EOSQL
         throughputFromDestinationNode UInt64,
         reverseThroughputFromSourceNode UInt64,
         reverseThroughputFromDestinationNode UInt64,
+        destinationFQDN String,
+        tcpSmoothedRTT UInt32,
+        tcpRetransmissions UInt32,
         trusted UInt8 DEFAULT 0
     ) engine=MergeTree
     ORDER BY (timeInserted, flowEndSeconds)
     TTL timeInserted + INTERVAL 1 HOUR
     SETTINGS merge_with_ttl_timeout = 3600;
 
+    -- Add the columns which are missing from a table created by a previous version.
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS destinationFQDN String AFTER reverseThroughputFromDestinationNode;
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS tcpSmoothedRTT UInt32 AFTER destinationFQDN;
+    ALTER TABLE flows ADD COLUMN IF NOT EXISTS tcpRetransmissions UInt32 AFTER tcpSmoothedRTT;
+
     CREATE MATERIALIZED VIEW IF NOT EXISTS flows_pod_view
     ENGINE = SummingMergeTree
     ORDER BY (
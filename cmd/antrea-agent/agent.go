@@ -39,6 +39,7 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/noderoute"
 	"antrea.io/antrea/pkg/agent/controller/serviceexternalip"
 	"antrea.io/antrea/pkg/agent/controller/traceflow"
+	"antrea.io/antrea/pkg/agent/controller/trafficcontrol"
 	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
 	"antrea.io/antrea/pkg/agent/interfacestore"
@@ -61,6 +62,7 @@ import (
 	"antrea.io/antrea/pkg/monitor"
 	ofconfig "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
+	antreaquerier "antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/signals"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/cipher"
@@ -98,6 +100,8 @@ func run(o *Options) error {
 	serviceInformer := informerFactory.Core().V1().Services()
 	endpointsInformer := informerFactory.Core().V1().Endpoints()
 	externalIPPoolInformer := crdInformerFactory.Crd().V1alpha2().ExternalIPPools()
+	trafficControlInformer := crdInformerFactory.Crd().V1alpha2().TrafficControls()
+	namespaceInformer := informerFactory.Core().V1().Namespaces()
 
 	// Create Antrea Clientset for the given config.
 	antreaClientProvider := agent.NewAntreaClientProvider(o.config.AntreaClientConnection, k8sClient)
@@ -117,6 +121,9 @@ func run(o *Options) error {
 	defer ovsdbConnection.Close()
 
 	egressEnabled := features.DefaultFeatureGate.Enabled(features.Egress)
+	egressTrafficShapingEnabled := egressEnabled && features.DefaultFeatureGate.Enabled(features.EgressTrafficShaping)
+	l7NetworkPolicyEnabled := features.DefaultFeatureGate.Enabled(features.L7NetworkPolicy)
+	nodeNetworkPolicyEnabled := features.DefaultFeatureGate.Enabled(features.AntreaPolicy) && features.DefaultFeatureGate.Enabled(features.NodeNetworkPolicy)
 	enableAntreaIPAM := features.DefaultFeatureGate.Enabled(features.AntreaIPAM)
 	enableBridgingMode := enableAntreaIPAM && o.config.EnableBridgingMode
 	// Bridging mode will connect the uplink interface to the OVS bridge.
@@ -134,6 +141,9 @@ func run(o *Options) error {
 		connectUplinkToBridge,
 		features.DefaultFeatureGate.Enabled(features.Multicast),
 		features.DefaultFeatureGate.Enabled(features.TrafficControl),
+		egressTrafficShapingEnabled,
+		l7NetworkPolicyEnabled,
+		nodeNetworkPolicyEnabled,
 	)
 
 	_, serviceCIDRNet, _ := net.ParseCIDR(o.config.ServiceCIDR)
@@ -203,6 +213,12 @@ func run(o *Options) error {
 		NodePortAddressesIPv6: nodePortAddressesIPv6,
 	}
 
+	var l7NetworkPolicyConfig *config.L7NetworkPolicyConfig
+	if l7NetworkPolicyEnabled {
+		// The OFPorts are filled by the Initializer after the ports are created.
+		l7NetworkPolicyConfig = &config.L7NetworkPolicyConfig{}
+	}
+
 	// Initialize agent and node network.
 	agentInitializer := agent.NewInitializer(
 		k8sClient,
@@ -217,6 +233,7 @@ func run(o *Options) error {
 		wireguardConfig,
 		egressConfig,
 		serviceConfig,
+		l7NetworkPolicyConfig,
 		networkReadyCh,
 		stopCh,
 		features.DefaultFeatureGate.Enabled(features.AntreaProxy),
@@ -249,6 +266,11 @@ func run(o *Options) error {
 
 	v4Enabled := networkConfig.IPv4Enabled
 	v6Enabled := networkConfig.IPv6Enabled
+	// Only the IPv4 traffic between the uplink interface and the bridge local port goes through the OVS pipeline, so
+	// the policies applied to the Node could not be enforced on its IPv6 traffic.
+	if nodeNetworkPolicyEnabled && v6Enabled {
+		return fmt.Errorf("NodeNetworkPolicy is not supported when IPv6 is enabled")
+	}
 	var proxier proxy.Proxier
 	if features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
 		proxyAll := o.config.AntreaProxy.ProxyAll
@@ -271,8 +293,8 @@ func run(o *Options) error {
 	}
 
 	// podUpdateChannel is a channel for receiving Pod updates from CNIServer and
-	// notifying NetworkPolicyController and EgressController to reconcile rules
-	// related to the updated Pods.
+	// notifying NetworkPolicyController, EgressController and TrafficControlController
+	// to reconcile rules related to the updated Pods.
 	podUpdateChannel := channel.NewSubscribableChannel("PodUpdate", 100)
 	// We set flow poll interval as the time interval for rule deletion in the async
 	// rule cache, which is implemented as part of the idAllocator. This is to preserve
@@ -298,21 +320,28 @@ func run(o *Options) error {
 		antreaProxyEnabled,
 		statusManagerEnabled,
 		loggingEnabled,
+		networkpolicy.AuditLoggingConfig{
+			Format:           o.config.AuditLogging.Format,
+			SyslogEnable:     o.config.AuditLogging.Syslog.Enable,
+			SyslogAddress:    o.config.AuditLogging.Syslog.Address,
+			SyslogTransport:  o.config.AuditLogging.Syslog.Transport,
+			SyslogCACertFile: o.config.AuditLogging.Syslog.CACertFile,
+			SyslogBufferSize: o.config.AuditLogging.Syslog.BufferSize,
+		},
+		l7NetworkPolicyEnabled,
+		nodeNetworkPolicyEnabled,
 		asyncRuleDeleteInterval,
-		o.config.DNSServerOverride,
+		networkpolicy.FQDNConfig{
+			DNSServerOverride:       o.config.DNSServerOverride,
+			MinTTL:                  o.config.FQDNPolicy.MinTTL,
+			IPExpirationGracePeriod: o.fqdnIPExpirationGracePeriod,
+		},
 		v4Enabled,
 		v6Enabled)
 	if err != nil {
 		return fmt.Errorf("error creating new NetworkPolicy controller: %v", err)
 	}
 
-	// statsCollector collects stats and reports to the antrea-controller periodically. For now it's only used for
-	// NetworkPolicy stats.
-	var statsCollector *stats.Collector
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsCollector = stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController)
-	}
-
 	var egressController *egress.EgressController
 
 	var externalIPPoolController *externalippool.ExternalIPPoolController
@@ -339,14 +368,26 @@ func run(o *Options) error {
 		}
 	}
 	if egressEnabled {
+		eventRecorder := k8s.NewEventRecorder(k8sClient, "antrea-agent", nodeConfig.Name)
 		egressController, err = egress.NewEgressController(
 			ofClient, antreaClientProvider, crdClient, ifaceStore, routeClient, nodeConfig.Name, nodeConfig.NodeTransportInterfaceName,
-			memberlistCluster, egressInformer, podUpdateChannel,
+			memberlistCluster, egressInformer, podUpdateChannel, eventRecorder, egressTrafficShapingEnabled,
 		)
 		if err != nil {
 			return fmt.Errorf("error creating new Egress controller: %v", err)
 		}
 	}
+
+	// statsCollector collects stats and reports to the antrea-controller periodically. It's used for NetworkPolicy
+	// stats, and Egress stats when Egress is enabled.
+	var statsCollector *stats.Collector
+	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		if egressEnabled {
+			statsCollector = stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, egressController)
+		} else {
+			statsCollector = stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, nil)
+		}
+	}
 	if features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
 		externalIPController, err = serviceexternalip.NewServiceExternalIPController(
 			nodeConfig.Name,
@@ -392,6 +433,11 @@ func run(o *Options) error {
 
 	var traceflowController *traceflow.Controller
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
+		// Traceflow reports the Egress observations only when Egress is enabled.
+		var egressQuerier antreaquerier.AgentEgressQuerier
+		if egressEnabled {
+			egressQuerier = egressController
+		}
 		traceflowController = traceflow.NewTraceflowController(
 			k8sClient,
 			informerFactory,
@@ -399,6 +445,7 @@ func run(o *Options) error {
 			traceflowInformer,
 			ofClient,
 			networkPolicyController,
+			egressQuerier,
 			ovsBridgeClient,
 			ifaceStore,
 			networkConfig,
@@ -429,11 +476,14 @@ func run(o *Options) error {
 		flowExporterOptions := &flowexporter.FlowExporterOptions{
 			FlowCollectorAddr:      o.flowCollectorAddr,
 			FlowCollectorProto:     o.flowCollectorProto,
+			FlowCollectorSharding:  o.config.FlowCollectorSharding,
+			FlowCollectorService:   o.flowCollectorService,
 			ActiveFlowTimeout:      o.activeFlowTimeout,
 			IdleFlowTimeout:        o.idleFlowTimeout,
 			StaleConnectionTimeout: o.staleConnectionTimeout,
 			PollInterval:           o.pollInterval,
-			ConnectUplinkToBridge:  connectUplinkToBridge}
+			ConnectUplinkToBridge:  connectUplinkToBridge,
+			CollectTCPMetrics:      o.config.FlowExporterTCPMetrics}
 		flowExporter, err = exporter.NewFlowExporter(
 			ifaceStore,
 			proxier,
@@ -457,10 +507,12 @@ func run(o *Options) error {
 
 	enableNodePortLocal := features.DefaultFeatureGate.Enabled(features.NodePortLocal) && o.config.NodePortLocal.Enable
 
-	// Initialize localPodInformer for NPLAgent, AntreaIPAMController, and secondary network controller.
+	// Initialize localPodInformer for NPLAgent, AntreaIPAMController, secondary network controller, and
+	// TrafficControlController.
 	var localPodInformer cache.SharedIndexInformer
 	if enableNodePortLocal || enableBridgingMode ||
-		features.DefaultFeatureGate.Enabled(features.SecondaryNetwork) {
+		features.DefaultFeatureGate.Enabled(features.SecondaryNetwork) ||
+		features.DefaultFeatureGate.Enabled(features.TrafficControl) {
 		listOptions := func(options *metav1.ListOptions) {
 			options.FieldSelector = fields.OneTermEqualSelector("spec.nodeName", nodeConfig.Name).String()
 		}
@@ -473,6 +525,19 @@ func run(o *Options) error {
 		)
 	}
 
+	var tcController *trafficcontrol.Controller
+	if features.DefaultFeatureGate.Enabled(features.TrafficControl) {
+		tcController = trafficcontrol.NewTrafficControlController(nodeConfig.Name,
+			ofClient,
+			antreaClientProvider,
+			ifaceStore,
+			ovsBridgeClient,
+			trafficControlInformer,
+			localPodInformer,
+			namespaceInformer,
+			podUpdateChannel)
+	}
+
 	log.StartLogFileNumberMonitor(stopCh)
 
 	go podUpdateChannel.Run(stopCh)
@@ -559,6 +624,10 @@ func run(o *Options) error {
 		go traceflowController.Run(stopCh)
 	}
 
+	if features.DefaultFeatureGate.Enabled(features.TrafficControl) {
+		go tcController.Run(stopCh)
+	}
+
 	if features.DefaultFeatureGate.Enabled(features.AntreaProxy) {
 		go proxier.GetProxyProvider().Run(stopCh)
 
@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/pflag"
 	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
@@ -50,6 +51,10 @@ const (
 	defaultIGMPQueryInterval       = 125 * time.Second
 	defaultStaleConnectionTimeout  = 5 * time.Minute
 	defaultNPLPortRange            = "61000-62000"
+	defaultAuditLogFormat          = "text"
+	defaultAuditLogSyslogTransport = "udp"
+	defaultAuditLogSyslogBufSize   = 1024
+	defaultFQDNIPExpirationGrace   = 60 * time.Second
 )
 
 type Options struct {
@@ -61,6 +66,9 @@ type Options struct {
 	flowCollectorAddr string
 	// IPFIX flow collector protocol
 	flowCollectorProto string
+	// Name and Namespace of the Flow Aggregator Service, when the flow records are sharded
+	// across its Endpoints
+	flowCollectorService types.NamespacedName
 	// Flow exporter poll interval
 	pollInterval time.Duration
 	// Active flow timeout to export records of active flows
@@ -72,6 +80,9 @@ type Options struct {
 	igmpQueryInterval      time.Duration
 	nplStartPort           int
 	nplEndPort             int
+
+	// Grace period after the expiration of the IPs resolved for FQDNs.
+	fqdnIPExpirationGracePeriod time.Duration
 }
 
 func newOptions() *Options {
@@ -187,6 +198,19 @@ func (o *Options) validate(args []string) error {
 	if err := o.validateAntreaIPAMConfig(); err != nil {
 		return fmt.Errorf("failed to validate AntreaIPAM config: %v", err)
 	}
+	// The traffic between the Node and the external network only goes through OVS when the uplink interface is
+	// connected to the OVS bridge, otherwise the policies applied to the Node would not be enforced for it. Steering
+	// the host traffic to OVS in other modes is not supported, so NodeNetworkPolicy is limited to bridging mode, which
+	// in turn requires AntreaIPAM, noEncap and noSNAT.
+	if features.DefaultFeatureGate.Enabled(features.NodeNetworkPolicy) && !o.config.EnableBridgingMode {
+		return fmt.Errorf("NodeNetworkPolicy feature gate requires enableBridgingMode to be enabled")
+	}
+	if err := o.validateAuditLoggingConfig(); err != nil {
+		return fmt.Errorf("failed to validate audit logging config: %v", err)
+	}
+	if err := o.validateFQDNPolicyConfig(); err != nil {
+		return fmt.Errorf("failed to validate FQDN policy config: %v", err)
+	}
 	return nil
 }
 
@@ -282,6 +306,19 @@ func (o *Options) setDefaults() {
 			o.igmpQueryInterval = defaultIGMPQueryInterval
 		}
 	}
+
+	if o.config.AuditLogging.Format == "" {
+		o.config.AuditLogging.Format = defaultAuditLogFormat
+	}
+	if o.config.AuditLogging.Syslog.Transport == "" {
+		o.config.AuditLogging.Syslog.Transport = defaultAuditLogSyslogTransport
+	}
+	if o.config.AuditLogging.Syslog.BufferSize == 0 {
+		o.config.AuditLogging.Syslog.BufferSize = defaultAuditLogSyslogBufSize
+	}
+	if o.config.FQDNPolicy.IPExpirationGracePeriod == "" {
+		o.fqdnIPExpirationGracePeriod = defaultFQDNIPExpirationGrace
+	}
 }
 
 func (o *Options) validateAntreaProxyConfig() error {
@@ -318,6 +355,14 @@ func (o *Options) validateFlowExporterConfig() error {
 		}
 		o.flowCollectorAddr = net.JoinHostPort(host, port)
 		o.flowCollectorProto = proto
+		if o.config.FlowCollectorSharding {
+			// The flow records are sharded across the Endpoints of the Service.
+			labels := strings.Split(host, ".")
+			if len(labels) < 3 || labels[2] != "svc" {
+				return fmt.Errorf("FlowCollectorAddr must be the DNS name of a Service (<NAME>.<NAMESPACE>.svc) when FlowCollectorSharding is enabled")
+			}
+			o.flowCollectorService = types.NamespacedName{Namespace: labels[1], Name: labels[0]}
+		}
 
 		// Parse the given flowPollInterval config
 		if o.config.FlowPollInterval != "" {
@@ -375,6 +420,47 @@ func (o *Options) validateMulticastConfig() error {
 	return nil
 }
 
+func (o *Options) validateAuditLoggingConfig() error {
+	auditLogging := o.config.AuditLogging
+	if auditLogging.Format != "text" && auditLogging.Format != "json" {
+		return fmt.Errorf("audit log format %s is not supported", auditLogging.Format)
+	}
+	if !auditLogging.Syslog.Enable {
+		return nil
+	}
+	if !features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		klog.InfoS("The auditLogging.syslog.enable config option is set to true, but it will be ignored because the AntreaPolicy feature gate is disabled")
+	}
+	if _, _, err := net.SplitHostPort(auditLogging.Syslog.Address); err != nil {
+		return fmt.Errorf("syslog address %s is invalid: %v", auditLogging.Syslog.Address, err)
+	}
+	transport := auditLogging.Syslog.Transport
+	if transport != "udp" && transport != "tcp" && transport != "tls" {
+		return fmt.Errorf("connection over %s transport proto is not supported", transport)
+	}
+	if auditLogging.Syslog.CACertFile != "" && transport != "tls" {
+		return fmt.Errorf("caCertFile can only be set when the syslog transport is tls")
+	}
+	if auditLogging.Syslog.BufferSize < 0 {
+		return fmt.Errorf("syslog bufferSize must not be negative")
+	}
+	return nil
+}
+
+func (o *Options) validateFQDNPolicyConfig() error {
+	if o.config.FQDNPolicy.IPExpirationGracePeriod != "" {
+		var err error
+		o.fqdnIPExpirationGracePeriod, err = time.ParseDuration(o.config.FQDNPolicy.IPExpirationGracePeriod)
+		if err != nil {
+			return fmt.Errorf("ipExpirationGracePeriod is not provided in right format")
+		}
+		if o.fqdnIPExpirationGracePeriod < 0 {
+			return fmt.Errorf("ipExpirationGracePeriod must not be negative")
+		}
+	}
+	return nil
+}
+
 func (o *Options) validateAntreaIPAMConfig() error {
 	if !o.config.EnableBridgingMode {
 		return nil
@@ -27,6 +27,8 @@ import (
 	genericopenapi "k8s.io/apiserver/pkg/endpoints/openapi"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	genericoptions "k8s.io/apiserver/pkg/server/options"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
@@ -51,6 +53,7 @@ import (
 	"antrea.io/antrea/pkg/controller/serviceexternalip"
 	"antrea.io/antrea/pkg/controller/stats"
 	"antrea.io/antrea/pkg/controller/traceflow"
+	"antrea.io/antrea/pkg/controller/trafficcontrol"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/log"
 	"antrea.io/antrea/pkg/monitor"
@@ -124,6 +127,25 @@ func run(o *Options) error {
 	cgInformer := crdInformerFactory.Crd().V1alpha3().ClusterGroups()
 	egressInformer := crdInformerFactory.Crd().V1alpha2().Egresses()
 	externalIPPoolInformer := crdInformerFactory.Crd().V1alpha2().ExternalIPPools()
+	trafficControlInformer := crdInformerFactory.Crd().V1alpha2().TrafficControls()
+
+	// AdminNetworkPolicy and BaselineAdminNetworkPolicy are not Antrea APIs, there is no generated clientset for
+	// them. Use the dynamic client and informers to watch them and to update their status.
+	var dynamicClient dynamic.Interface
+	var dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	var adminNPInformer, banpInformer informers.GenericInformer
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		if err := networkpolicy.CheckAdminNetworkPolicyAPIs(client.Discovery()); err != nil {
+			return fmt.Errorf("AdminNetworkPolicy feature gate is enabled but the APIs are not available: %v", err)
+		}
+		dynamicClient, err = k8s.CreateDynamicClient(o.config.ClientConnection, "")
+		if err != nil {
+			return fmt.Errorf("error creating K8s dynamic client: %v", err)
+		}
+		dynamicInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, informerDefaultResync)
+		adminNPInformer = dynamicInformerFactory.ForResource(networkpolicy.AdminNetworkPolicyGVR)
+		banpInformer = dynamicInformerFactory.ForResource(networkpolicy.BaselineAdminNetworkPolicyGVR)
+	}
 
 	clusterIdentityAllocator := clusteridentity.NewClusterIdentityAllocator(
 		env.GetAntreaNamespace(),
@@ -151,6 +173,8 @@ func run(o *Options) error {
 		anpInformer,
 		tierInformer,
 		cgInformer,
+		adminNPInformer,
+		banpInformer,
 		addressGroupStore,
 		appliedToGroupStore,
 		networkPolicyStore,
@@ -158,7 +182,7 @@ func run(o *Options) error {
 
 	var networkPolicyStatusController *networkpolicy.StatusController
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, cnpInformer, anpInformer)
+		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, dynamicClient, networkPolicyStore, cnpInformer, anpInformer, adminNPInformer, banpInformer)
 	}
 
 	endpointQuerier := networkpolicy.NewEndpointQuerier(networkPolicyController)
@@ -177,13 +201,19 @@ func run(o *Options) error {
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) {
-		egressController = egress.NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPPoolController, egressGroupStore)
+		eventRecorder := k8s.NewEventRecorder(client, "antrea-controller", "")
+		egressController = egress.NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPPoolController, egressGroupStore, eventRecorder)
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
 		externalIPController = serviceexternalip.NewServiceExternalIPController(client, serviceInformer, externalIPPoolController)
 	}
 
+	var trafficControlStatusController *trafficcontrol.StatusController
+	if features.DefaultFeatureGate.Enabled(features.TrafficControl) {
+		trafficControlStatusController = trafficcontrol.NewStatusController(crdClient, trafficControlInformer, podInformer, namespaceInformer)
+	}
+
 	var traceflowController *traceflow.Controller
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
 		traceflowController = traceflow.NewTraceflowController(crdClient, podInformer, tfInformer)
@@ -193,7 +223,7 @@ func run(o *Options) error {
 	// aggregated data. For now it's only used for NetworkPolicy stats.
 	var statsAggregator *stats.Aggregator
 	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsAggregator = stats.NewAggregator(networkPolicyInformer, cnpInformer, anpInformer)
+		statsAggregator = stats.NewAggregator(networkPolicyInformer, cnpInformer, anpInformer, egressInformer, adminNPInformer, banpInformer)
 	}
 
 	cipherSuites, err := cipher.GenerateCipherSuitesList(o.config.TLSCipherSuites)
@@ -224,6 +254,7 @@ func run(o *Options) error {
 		networkPolicyController,
 		networkPolicyStatusController,
 		egressController,
+		trafficControlStatusController,
 		statsAggregator,
 		*o.config.EnablePrometheusMetrics,
 		cipherSuites,
@@ -255,6 +286,9 @@ func run(o *Options) error {
 
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		dynamicInformerFactory.Start(stopCh)
+	}
 
 	go clusterIdentityAllocator.Run(stopCh)
 
@@ -285,6 +319,10 @@ func run(o *Options) error {
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		go networkPolicyStatusController.Run(stopCh)
 	}
+
+	if features.DefaultFeatureGate.Enabled(features.TrafficControl) {
+		go trafficControlStatusController.Run(stopCh)
+	}
 	if features.DefaultFeatureGate.Enabled(features.NodeIPAM) && o.config.NodeIPAM.EnableNodeIPAM {
 		clusterCIDRs, _ := netutils.ParseCIDRs(o.config.NodeIPAM.ClusterCIDRs)
 		_, serviceCIDR, _ := net.ParseCIDR(o.config.NodeIPAM.ServiceCIDR)
@@ -379,6 +417,7 @@ func createAPIServerConfig(kubeconfig string,
 	npController *networkpolicy.NetworkPolicyController,
 	networkPolicyStatusController *networkpolicy.StatusController,
 	egressController *egress.EgressController,
+	trafficControlStatusController *trafficcontrol.StatusController,
 	statsAggregator *stats.Aggregator,
 	enableMetrics bool,
 	cipherSuites []uint16,
@@ -440,5 +479,6 @@ func createAPIServerConfig(kubeconfig string,
 		networkPolicyStatusController,
 		endpointQuerier,
 		npController,
-		egressController), nil
+		egressController,
+		trafficControlStatusController), nil
 }
@@ -53,11 +53,12 @@ function generate_mocks {
     "pkg/antctl AntctlClient ."
     "pkg/controller/networkpolicy EndpointQuerier testing"
     "pkg/controller/querier ControllerQuerier testing"
+    "pkg/flowaggregator/exporter Interface testing"
     "pkg/ipfix IPFIXExportingProcess,IPFIXRegistry,IPFIXCollectingProcess,IPFIXAggregationProcess testing"
     "pkg/ovs/openflow Bridge,Table,Flow,Action,CTAction,FlowBuilder testing"
     "pkg/ovs/ovsconfig OVSBridgeClient testing"
     "pkg/ovs/ovsctl OVSCtlClient testing"
-    "pkg/querier AgentNetworkPolicyInfoQuerier testing"
+    "pkg/querier AgentEgressQuerier,AgentNetworkPolicyInfoQuerier testing"
     "third_party/proxy Provider testing"
   )
 
@@ -108,6 +109,9 @@ fi
 # Generate protobuf code for CNI gRPC service with protoc.
 protoc --go_out=plugins=grpc:. pkg/apis/cni/v1beta1/cni.proto
 
+# Generate protobuf code for the flow records exported to Kafka by the Flow Aggregator.
+protoc --go_out=. pkg/flowaggregator/flowrecord/protobuf/flowrecord.proto
+
 # Generate clientset and apis code with K8s codegen tools.
 $GOPATH/bin/client-gen \
   --clientset-name versioned \
@@ -123,6 +127,9 @@ $GOPATH/bin/client-gen \
   --plural-exceptions "NetworkPolicyStats:NetworkPolicyStats" \
   --plural-exceptions "AntreaNetworkPolicyStats:AntreaNetworkPolicyStats" \
   --plural-exceptions "AntreaClusterNetworkPolicyStats:AntreaClusterNetworkPolicyStats" \
+  --plural-exceptions "AdminNetworkPolicyStats:AdminNetworkPolicyStats" \
+  --plural-exceptions "BaselineAdminNetworkPolicyStats:BaselineAdminNetworkPolicyStats" \
+  --plural-exceptions "EgressStats:EgressStats" \
   --plural-exceptions "ClusterGroupMembers:ClusterGroupMembers" \
   --go-header-file hack/boilerplate/license_header.go.txt
 
@@ -39,6 +39,18 @@ type ExternalEntityExport struct {
 	ExternalEntitySpec v1alpha2.ExternalEntitySpec `json:"externalentityspec,omitempty"`
 }
 
+// TraceflowExport exports a Traceflow to be run in peer member clusters, or
+// the results of such a Traceflow back to the member cluster it comes from.
+type TraceflowExport struct {
+	// ClusterIDs specifies the member clusters to import the Traceflow to.
+	ClusterIDs []string `json:"clusterIDs,omitempty"`
+	// Spec is the spec of the Traceflow to run in the peer member clusters.
+	Spec *v1alpha1.TraceflowSpec `json:"spec,omitempty"`
+	// Results are the observations of the Traceflow in the exporting
+	// member cluster.
+	Results []v1alpha1.NodeResult `json:"results,omitempty"`
+}
+
 // RawResourceExport exports opaque resources.
 type RawResourceExport struct {
 	Data []byte `json:"data,omitempty"`
@@ -65,6 +77,8 @@ type ResourceExportSpec struct {
 	ExternalEntity *ExternalEntityExport `json:"externalentity,omitempty"`
 	// If exported resource is AntreaClusterNetworkPolicy.
 	ClusterNetworkPolicy *v1alpha1.ClusterNetworkPolicySpec `json:"clusternetworkpolicy,omitempty"`
+	// If exported resource is Traceflow or TraceflowResult.
+	Traceflow *TraceflowExport `json:"traceflow,omitempty"`
 	// If exported resource kind is unknown.
 	Raw *RawResourceExport `json:"raw,omitempty"`
 }
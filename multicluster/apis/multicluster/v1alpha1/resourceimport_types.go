@@ -35,6 +35,20 @@ type ExternalEntityImport struct {
 	ExternalEntitySpec *v1alpha2.ExternalEntitySpec `json:"externalentityspec,omitempty"`
 }
 
+// TraceflowImport imports a Traceflow from, or the results of a Traceflow
+// to, the member cluster where the Traceflow is created.
+type TraceflowImport struct {
+	// SourceClusterID is the ID of the member cluster which exports the
+	// Traceflow or its results.
+	SourceClusterID string `json:"sourceClusterID,omitempty"`
+	// Spec is the spec of the Traceflow to run in the importing member
+	// cluster.
+	Spec *v1alpha1.TraceflowSpec `json:"spec,omitempty"`
+	// Results are the observations of the Traceflow in the exporting
+	// member cluster.
+	Results []v1alpha1.NodeResult `json:"results,omitempty"`
+}
+
 // RawResourceImport imports opaque resources.
 type RawResourceImport struct {
 	Data []byte `json:"data,omitempty"`
@@ -62,6 +76,8 @@ type ResourceImportSpec struct {
 	ExternalEntity *ExternalEntityImport `json:"externalentity,omitempty"`
 	// If imported resource is AntreaClusterNetworkPolicy.
 	ClusterNetworkPolicy *v1alpha1.ClusterNetworkPolicySpec `json:"clusternetworkpolicy,omitempty"`
+	// If imported resource is Traceflow or TraceflowResult.
+	Traceflow *TraceflowImport `json:"traceflow,omitempty"`
 	// If imported resource is ANP.
 	// TODO:
 	// ANP uses float64 as priority.  Type float64 is discouraged by k8s, and is not supported by controller-gen tools.
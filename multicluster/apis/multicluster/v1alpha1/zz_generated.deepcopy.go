@@ -851,6 +851,11 @@ func (in *ResourceExportSpec) DeepCopyInto(out *ResourceExportSpec) {
 		*out = new(crdv1alpha1.ClusterNetworkPolicySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Traceflow != nil {
+		in, out := &in.Traceflow, &out.Traceflow
+		*out = new(TraceflowExport)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Raw != nil {
 		in, out := &in.Raw, &out.Raw
 		*out = new(RawResourceExport)
@@ -1109,6 +1114,11 @@ func (in *ResourceImportSpec) DeepCopyInto(out *ResourceImportSpec) {
 		*out = new(crdv1alpha1.ClusterNetworkPolicySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Traceflow != nil {
+		in, out := &in.Traceflow, &out.Traceflow
+		*out = new(TraceflowImport)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Raw != nil {
 		in, out := &in.Raw, &out.Raw
 		*out = new(RawResourceImport)
@@ -1163,3 +1173,62 @@ func (in *ServiceExport) DeepCopy() *ServiceExport {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowExport) DeepCopyInto(out *TraceflowExport) {
+	*out = *in
+	if in.ClusterIDs != nil {
+		in, out := &in.ClusterIDs, &out.ClusterIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(crdv1alpha1.TraceflowSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]crdv1alpha1.NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowExport.
+func (in *TraceflowExport) DeepCopy() *TraceflowExport {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowImport) DeepCopyInto(out *TraceflowImport) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(crdv1alpha1.TraceflowSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]crdv1alpha1.NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowImport.
+func (in *TraceflowImport) DeepCopy() *TraceflowImport {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowImport)
+	in.DeepCopyInto(out)
+	return out
+}
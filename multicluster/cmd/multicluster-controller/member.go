@@ -81,6 +81,15 @@ func runMember(o *Options) error {
 		return fmt.Errorf("error creating Gateway controller: %v", err)
 	}
 
+	tfReconciler := multiclustercontrollers.NewTraceflowReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		env.GetPodNamespace(),
+		commonAreaGetter)
+	if err = tfReconciler.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("error creating Traceflow controller: %v", err)
+	}
+
 	nodeReconciler := multiclustercontrollers.NewNodeReconciler(
 		mgr.GetClient(),
 		mgr.GetScheme(),
@@ -18,6 +18,7 @@ import corev1 "k8s.io/api/core/v1"
 const (
 	AntreaMCServiceAnnotation   = "multicluster.antrea.io/imported-service"
 	AntreaMCACNPAnnotation      = "multicluster.antrea.io/imported-acnp"
+	AntreaMCTraceflowAnnotation = "multicluster.antrea.io/imported-traceflow"
 	AntreaMCClusterIDAnnotation = "multicluster.antrea.io/local-cluster-id"
 	GatewayAnnotation           = "multicluster.antrea.io/gateway"
 	GatewayIPAnnotation         = "multicluster.antrea.io/gateway-ip"
@@ -28,6 +29,8 @@ const (
 	AntreaClusterNetworkPolicyKind = "AntreaClusterNetworkPolicy"
 	ServiceImportKind              = "ServiceImport"
 	ClusterInfoKind                = "ClusterInfo"
+	TraceflowKind                  = "Traceflow"
+	TraceflowResultKind            = "TraceflowResult"
 
 	SourceName      = "sourceName"
 	SourceNamespace = "sourceNamespace"
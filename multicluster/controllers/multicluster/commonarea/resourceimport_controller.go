@@ -82,6 +82,8 @@ func NewResourceImportReconciler(client client.Client, scheme *runtime.Scheme, l
 
 //+kubebuilder:rbac:groups=crd.antrea.io,resources=clusternetworkpolicies,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=crd.antrea.io,resources=tiers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceimports,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceimports/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceimports/finalizers,verbs=update
@@ -145,6 +147,16 @@ func (r *ResourceImportReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			return r.handleResImpDeleteForClusterInfo(ctx, req, &resImp)
 		}
 		return r.handleResImpUpdateForClusterInfo(ctx, req, &resImp)
+	case common.TraceflowKind:
+		if isDeleted {
+			return r.handleResImpDeleteForTraceflow(ctx, &resImp)
+		}
+		return r.handleResImpUpdateForTraceflow(ctx, &resImp)
+	case common.TraceflowResultKind:
+		if isDeleted {
+			return ctrl.Result{}, nil
+		}
+		return r.handleResImpUpdateForTraceflowResult(ctx, &resImp)
 	}
 	return ctrl.Result{}, nil
 }
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commonarea
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+// handleResImpUpdateForTraceflow creates a Traceflow in the local cluster for a
+// Traceflow from another member cluster. The packet is injected from the active
+// Gateway of the local cluster, which is where the traffic from the peer member
+// cluster enters the local cluster.
+func (r *ResourceImportReconciler) handleResImpUpdateForTraceflow(ctx context.Context, resImp *mcsv1alpha1.ResourceImport) (ctrl.Result, error) {
+	if !common.StringExistsInSlice(resImp.Spec.ClusterIDs, r.localClusterID) {
+		klog.V(2).InfoS("Skip reconciling ResourceImport for Traceflow since it's not imported to local cluster", "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, nil
+	}
+	if resImp.Spec.Traceflow == nil || resImp.Spec.Traceflow.Spec == nil {
+		klog.InfoS("No Traceflow spec in ResourceImport, skip reconciling", "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, nil
+	}
+	tfName := getMCTraceflowName(resImp)
+	tf := &crdv1alpha1.Traceflow{}
+	err := r.localClusterClient.Get(ctx, types.NamespacedName{Name: tfName}, tf)
+	if err == nil {
+		// The spec of a Traceflow is not expected to change after it is started.
+		r.installedResImports.Update(*resImp)
+		return ctrl.Result{}, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	gwName, err := r.getActiveGatewayName(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	tf = &crdv1alpha1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: tfName,
+			Labels: map[string]string{
+				common.SourceClusterID: resImp.Spec.Traceflow.SourceClusterID,
+				common.SourceName:      resImp.Spec.Name,
+			},
+			Annotations: map[string]string{common.AntreaMCTraceflowAnnotation: "true"},
+		},
+		Spec: *resImp.Spec.Traceflow.Spec.DeepCopy(),
+	}
+	tf.Spec.Source.Node = gwName
+	klog.InfoS("Creating Traceflow corresponding to ResourceImport", "traceflow", tfName, "resourceimport", klog.KObj(resImp))
+	if err := r.localClusterClient.Create(ctx, tf, &client.CreateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to create imported Traceflow", "traceflow", tfName)
+		return ctrl.Result{}, err
+	}
+	r.installedResImports.Add(*resImp)
+	return ctrl.Result{}, nil
+}
+
+func (r *ResourceImportReconciler) handleResImpDeleteForTraceflow(ctx context.Context, resImp *mcsv1alpha1.ResourceImport) (ctrl.Result, error) {
+	tfName := getMCTraceflowName(resImp)
+	klog.InfoS("Deleting Traceflow corresponding to ResourceImport", "traceflow", tfName, "resourceimport", klog.KObj(resImp))
+	tf := &crdv1alpha1.Traceflow{ObjectMeta: metav1.ObjectMeta{Name: tfName}}
+	err := r.localClusterClient.Delete(ctx, tf, &client.DeleteOptions{})
+	if err == nil || apierrors.IsNotFound(err) {
+		r.installedResImports.Delete(*resImp)
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+// handleResImpUpdateForTraceflowResult merges the results reported by a peer
+// member cluster into the results of the local Traceflow, replacing the ones
+// previously reported by the same cluster.
+func (r *ResourceImportReconciler) handleResImpUpdateForTraceflowResult(ctx context.Context, resImp *mcsv1alpha1.ResourceImport) (ctrl.Result, error) {
+	if !common.StringExistsInSlice(resImp.Spec.ClusterIDs, r.localClusterID) || resImp.Spec.Traceflow == nil {
+		return ctrl.Result{}, nil
+	}
+	peerClusterID := resImp.Spec.Traceflow.SourceClusterID
+	tf := &crdv1alpha1.Traceflow{}
+	if err := r.localClusterClient.Get(ctx, types.NamespacedName{Name: resImp.Spec.Name}, tf); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	var results []crdv1alpha1.NodeResult
+	for _, result := range tf.Status.Results {
+		if result.ClusterID != peerClusterID {
+			results = append(results, result)
+		}
+	}
+	for _, result := range resImp.Spec.Traceflow.Results {
+		result.ClusterID = peerClusterID
+		results = append(results, result)
+	}
+	if reflect.DeepEqual(results, tf.Status.Results) {
+		return ctrl.Result{}, nil
+	}
+	klog.InfoS("Updating Traceflow with results from peer member cluster", "traceflow", tf.Name, "cluster", peerClusterID)
+	tf.Status.Results = results
+	if err := r.localClusterClient.Status().Update(ctx, tf, &client.UpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update Traceflow status", "traceflow", tf.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// getActiveGatewayName returns the name of the last created Gateway, which is
+// the one exported in the ClusterInfo of the local cluster.
+func (r *ResourceImportReconciler) getActiveGatewayName(ctx context.Context) (string, error) {
+	gws := &mcsv1alpha1.GatewayList{}
+	if err := r.localClusterClient.List(ctx, gws, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return "", err
+	}
+	if len(gws.Items) == 0 {
+		return "", errors.New("no Gateway found in local cluster")
+	}
+	sort.Slice(gws.Items, func(i, j int) bool {
+		return !gws.Items[i].CreationTimestamp.Before(&gws.Items[j].CreationTimestamp)
+	})
+	return gws.Items[0].Name, nil
+}
+
+func getMCTraceflowName(resImp *mcsv1alpha1.ResourceImport) string {
+	return common.AntreaMCSPrefix + resImp.Name
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commonarea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func TestResourceImportReconciler_handleTraceflow(t *testing.T) {
+	tfSpec := &v1alpha1.TraceflowSpec{
+		Source:      v1alpha1.Source{IP: "10.10.0.5"},
+		Destination: v1alpha1.Destination{IP: "192.168.17.11"},
+	}
+	tfResImport := &mcsv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: leaderNamespace,
+			Name:      "cluster-b-tf1-traceflow",
+		},
+		Spec: mcsv1alpha1.ResourceImportSpec{
+			ClusterIDs: []string{localClusterID},
+			Kind:       common.TraceflowKind,
+			Name:       "tf1",
+			Traceflow: &mcsv1alpha1.TraceflowImport{
+				SourceClusterID: "cluster-b",
+				Spec:            tfSpec,
+			},
+		},
+	}
+	gw := &mcsv1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "node-1",
+		},
+		GatewayIP: "10.10.10.10",
+	}
+
+	remoteMgr := NewRemoteCommonAreaManager("test-clusterset", common.ClusterID(localClusterID), "kube-system")
+	remoteMgr.Start()
+	defer remoteMgr.Stop()
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gw).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tfResImport).Build()
+	remoteCluster := NewFakeRemoteCommonArea(scheme, remoteMgr, fakeRemoteClient, "leader-cluster", "default")
+	r := NewResourceImportReconciler(fakeClient, scheme, fakeClient, localClusterID, "default", remoteCluster)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: leaderNamespace, Name: tfResImport.Name}}
+	_, err := r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	tf := &v1alpha1.Traceflow{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "antrea-mc-cluster-b-tf1-traceflow"}, tf))
+	expectedSpec := *tfSpec
+	expectedSpec.Source.Node = "node-1"
+	assert.Equal(t, expectedSpec, tf.Spec)
+	assert.Equal(t, "cluster-b", tf.Labels[common.SourceClusterID])
+	assert.Equal(t, "tf1", tf.Labels[common.SourceName])
+	assert.Equal(t, "true", tf.Annotations[common.AntreaMCTraceflowAnnotation])
+
+	require.NoError(t, fakeRemoteClient.Delete(ctx, tfResImport))
+	_, err = r.Reconcile(ctx, req)
+	require.NoError(t, err)
+	err = fakeClient.Get(ctx, types.NamespacedName{Name: "antrea-mc-cluster-b-tf1-traceflow"}, tf)
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestResourceImportReconciler_handleTraceflowResult(t *testing.T) {
+	localResults := []v1alpha1.NodeResult{
+		{
+			Node:      "node-2",
+			ClusterID: localClusterID,
+			Observations: []v1alpha1.Observation{
+				{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionForwardedOutOfOverlay},
+			},
+		},
+		{
+			Node:      "node-1",
+			ClusterID: localClusterID,
+			Observations: []v1alpha1.Observation{
+				{Component: v1alpha1.ComponentMulticlusterGateway, Action: v1alpha1.ActionForwardedToRemoteCluster},
+			},
+		},
+	}
+	peerResults := []v1alpha1.NodeResult{{
+		Node: "node-3",
+		Observations: []v1alpha1.Observation{
+			{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionDelivered},
+		},
+	}}
+	tf := &v1alpha1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+		Status: v1alpha1.TraceflowStatus{
+			Phase:   v1alpha1.Succeeded,
+			Results: localResults,
+		},
+	}
+	resultResImport := &mcsv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: leaderNamespace,
+			Name:      "cluster-b-antrea-mc-cluster-a-tf1-traceflow-traceflowresult",
+		},
+		Spec: mcsv1alpha1.ResourceImportSpec{
+			ClusterIDs: []string{localClusterID},
+			Kind:       common.TraceflowResultKind,
+			Name:       "tf1",
+			Traceflow: &mcsv1alpha1.TraceflowImport{
+				SourceClusterID: "cluster-b",
+				Results:         peerResults,
+			},
+		},
+	}
+
+	remoteMgr := NewRemoteCommonAreaManager("test-clusterset", common.ClusterID(localClusterID), "kube-system")
+	remoteMgr.Start()
+	defer remoteMgr.Stop()
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(resultResImport).Build()
+	remoteCluster := NewFakeRemoteCommonArea(scheme, remoteMgr, fakeRemoteClient, "leader-cluster", "default")
+	r := NewResourceImportReconciler(fakeClient, scheme, fakeClient, localClusterID, "default", remoteCluster)
+
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: leaderNamespace, Name: resultResImport.Name}}
+	// Reconciling the same results twice should not duplicate them.
+	for i := 0; i < 2; i++ {
+		_, err := r.Reconcile(ctx, req)
+		require.NoError(t, err)
+	}
+	updatedTf := &v1alpha1.Traceflow{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "tf1"}, updatedTf))
+	expectedPeerResult := peerResults[0]
+	expectedPeerResult.ClusterID = "cluster-b"
+	assert.Equal(t, append(localResults, expectedPeerResult), updatedTf.Status.Results)
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -579,3 +580,76 @@ func TestResourceExportReconciler_handleClusterInfoKind(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceExportReconciler_handleTraceflowKind(t *testing.T) {
+	tfSpec := &v1alpha1.TraceflowSpec{
+		Source:      v1alpha1.Source{IP: "10.10.0.5"},
+		Destination: v1alpha1.Destination{IP: "192.168.17.11"},
+	}
+	tfResExport := mcsv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cluster-a-tf1-traceflow",
+		},
+		Spec: mcsv1alpha1.ResourceExportSpec{
+			Kind:      common.TraceflowKind,
+			ClusterID: "cluster-a",
+			Name:      "tf1",
+			Traceflow: &mcsv1alpha1.TraceflowExport{
+				ClusterIDs: []string{"cluster-b"},
+				Spec:       tfSpec,
+			},
+		},
+	}
+	deletedTime := metav1.Now()
+	tfResExportToDel := mcsv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              "cluster-a-tf2-traceflow",
+			Finalizers:        []string{common.ResourceExportFinalizer},
+			DeletionTimestamp: &deletedTime,
+		},
+		Spec: mcsv1alpha1.ResourceExportSpec{
+			Kind:      common.TraceflowKind,
+			ClusterID: "cluster-a",
+			Name:      "tf2",
+			Traceflow: &mcsv1alpha1.TraceflowExport{
+				ClusterIDs: []string{"cluster-b"},
+				Spec:       tfSpec,
+			},
+		},
+	}
+	existResImportToDel := mcsv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cluster-a-tf2-traceflow",
+		},
+		Spec: mcsv1alpha1.ResourceImportSpec{
+			Kind: common.TraceflowKind,
+			Name: "tf2",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&tfResExport, &tfResExportToDel, &existResImportToDel).Build()
+	r := NewResourceExportReconciler(fakeClient, scheme)
+
+	namespacedName := types.NamespacedName{Namespace: "default", Name: "cluster-a-tf1-traceflow"}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	require.NoError(t, err)
+	resImport := mcsv1alpha1.ResourceImport{}
+	require.NoError(t, fakeClient.Get(ctx, namespacedName, &resImport))
+	assert.Equal(t, mcsv1alpha1.ResourceImportSpec{
+		ClusterIDs: []string{"cluster-b"},
+		Kind:       common.TraceflowKind,
+		Name:       "tf1",
+		Traceflow: &mcsv1alpha1.TraceflowImport{
+			SourceClusterID: "cluster-a",
+			Spec:            tfSpec,
+		},
+	}, resImport.Spec)
+
+	namespacedName = types.NamespacedName{Namespace: "default", Name: "cluster-a-tf2-traceflow"}
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	require.NoError(t, err)
+	err = fakeClient.Get(ctx, namespacedName, &resImport)
+	assert.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2022 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+type (
+	// TraceflowReconciler is for member cluster only. It extends a Traceflow
+	// whose destination is a multi-cluster Service to the peer member cluster
+	// where the Service Endpoint resides:
+	// - In the source member cluster, it reports the Gateway hop in the results
+	//   of the Traceflow, and exports the Traceflow to the peer member cluster.
+	// - In the peer member cluster, it exports the results of the imported
+	//   Traceflow back to the source member cluster.
+	TraceflowReconciler struct {
+		client.Client
+		Scheme           *runtime.Scheme
+		commonAreaGetter RemoteCommonAreaGetter
+		namespace        string
+		localClusterID   string
+	}
+)
+
+// NewTraceflowReconciler creates a TraceflowReconciler which will watch Traceflow
+// events and create Traceflow or TraceflowResult kind of ResourceExports in the
+// leader cluster.
+func NewTraceflowReconciler(
+	client client.Client,
+	scheme *runtime.Scheme,
+	namespace string,
+	commonAreaGetter RemoteCommonAreaGetter) *TraceflowReconciler {
+	reconciler := &TraceflowReconciler{
+		Client:           client,
+		Scheme:           scheme,
+		namespace:        namespace,
+		commonAreaGetter: commonAreaGetter,
+	}
+	return reconciler
+}
+
+//+kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+func (r *TraceflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	klog.V(2).InfoS("Reconciling Traceflow", "traceflow", req.Name)
+	var err error
+	var commonArea commonarea.RemoteCommonArea
+	commonArea, r.localClusterID, err = r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
+	if commonArea == nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	tf := &crdv1alpha1.Traceflow{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tf); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		// The Traceflow might be either a source or an imported one, remove
+		// the ResourceExports of both kinds.
+		for _, kind := range []string{common.TraceflowKind, common.TraceflowResultKind} {
+			resExport := &mcsv1alpha1.ResourceExport{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      newTraceflowResourceExportName(r.localClusterID, req.Name, kind),
+					Namespace: commonArea.GetNamespace(),
+				},
+			}
+			if err := commonArea.Delete(ctx, resExport, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+	if _, ok := tf.Annotations[common.AntreaMCTraceflowAnnotation]; ok {
+		return ctrl.Result{}, r.exportTraceflowResults(ctx, commonArea, tf)
+	}
+	return ctrl.Result{}, r.exportTraceflow(ctx, commonArea, tf)
+}
+
+// exportTraceflow reports the Gateway hop of a succeeded Traceflow whose packet
+// is load-balanced to an Endpoint of a multi-cluster Service in a peer member
+// cluster, and exports a Traceflow to the peer member cluster, which traces the
+// packet from the Gateway of the peer member cluster to the Endpoint.
+func (r *TraceflowReconciler) exportTraceflow(ctx context.Context, commonArea commonarea.RemoteCommonArea, tf *crdv1alpha1.Traceflow) error {
+	if tf.Spec.LiveTraffic || tf.Spec.Destination.Service == "" || tf.Status.Phase != crdv1alpha1.Succeeded {
+		return nil
+	}
+	if hasGatewayObservation(tf) {
+		return nil
+	}
+	svc := &corev1.Service{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: tf.Spec.Destination.Namespace, Name: tf.Spec.Destination.Service}, svc); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if _, ok := svc.Annotations[common.AntreaMCServiceAnnotation]; !ok {
+		return nil
+	}
+	endpointIP := getTranslatedDstIP(tf)
+	if endpointIP == "" {
+		return nil
+	}
+	peerClusterID, endpointPort, err := r.getEndpointCluster(ctx, commonArea, svc, endpointIP, getDstPort(tf))
+	if err != nil {
+		return err
+	}
+	if peerClusterID == "" {
+		klog.InfoS("No member cluster exports the Endpoint of multi-cluster Service", "traceflow", tf.Name,
+			"service", klog.KObj(svc), "endpoint", endpointIP)
+		return nil
+	}
+
+	clusterInfoImport := &mcsv1alpha1.ClusterInfoImport{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: newClusterInfoResourceExportName(peerClusterID)}, clusterInfoImport); err != nil {
+		return err
+	}
+	if len(clusterInfoImport.Spec.GatewayInfos) == 0 {
+		return fmt.Errorf("no Gateway found in ClusterInfoImport of member cluster %s", peerClusterID)
+	}
+	gws := &mcsv1alpha1.GatewayList{}
+	if err := r.Client.List(ctx, gws, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return err
+	}
+	if len(gws.Items) == 0 {
+		return fmt.Errorf("no Gateway found in member cluster %s", r.localClusterID)
+	}
+	// The last created Gateway is the active one.
+	sort.Slice(gws.Items, func(i, j int) bool {
+		return !gws.Items[i].CreationTimestamp.Before(&gws.Items[j].CreationTimestamp)
+	})
+	srcIP, err := r.getSourceIP(ctx, tf, endpointIP)
+	if err != nil {
+		return err
+	}
+
+	peerSpec := &crdv1alpha1.TraceflowSpec{
+		Source:      crdv1alpha1.Source{IP: srcIP},
+		Destination: crdv1alpha1.Destination{IP: endpointIP},
+		Packet:      *tf.Spec.Packet.DeepCopy(),
+		Timeout:     tf.Spec.Timeout,
+	}
+	if peerSpec.Packet.TransportHeader.TCP != nil {
+		peerSpec.Packet.TransportHeader.TCP.DstPort = endpointPort
+	} else if peerSpec.Packet.TransportHeader.UDP != nil {
+		peerSpec.Packet.TransportHeader.UDP.DstPort = endpointPort
+	}
+	resExport := &mcsv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      newTraceflowResourceExportName(r.localClusterID, tf.Name, common.TraceflowKind),
+			Namespace: commonArea.GetNamespace(),
+			Labels: map[string]string{
+				common.SourceName:      tf.Name,
+				common.SourceKind:      common.TraceflowKind,
+				common.SourceClusterID: r.localClusterID,
+			},
+		},
+		Spec: mcsv1alpha1.ResourceExportSpec{
+			ClusterID: r.localClusterID,
+			Name:      tf.Name,
+			Kind:      common.TraceflowKind,
+			Traceflow: &mcsv1alpha1.TraceflowExport{
+				ClusterIDs: []string{peerClusterID},
+				Spec:       peerSpec,
+			},
+		},
+	}
+	if err := r.createOrUpdateResourceExport(ctx, commonArea, resExport); err != nil {
+		return err
+	}
+
+	// Results without a cluster ID are all reported by the local cluster.
+	for i := range tf.Status.Results {
+		if tf.Status.Results[i].ClusterID == "" {
+			tf.Status.Results[i].ClusterID = r.localClusterID
+		}
+	}
+	tf.Status.Results = append(tf.Status.Results, crdv1alpha1.NodeResult{
+		Node:      gws.Items[0].Name,
+		ClusterID: r.localClusterID,
+		Timestamp: time.Now().Unix(),
+		Observations: []crdv1alpha1.Observation{{
+			Component:     crdv1alpha1.ComponentMulticlusterGateway,
+			ComponentInfo: peerClusterID,
+			Action:        crdv1alpha1.ActionForwardedToRemoteCluster,
+			TunnelDstIP:   clusterInfoImport.Spec.GatewayInfos[0].GatewayIP,
+		}},
+	})
+	if err := r.Client.Status().Update(ctx, tf, &client.UpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update Traceflow status", "traceflow", tf.Name)
+		return err
+	}
+	return nil
+}
+
+// exportTraceflowResults exports the results of a Traceflow imported from
+// another member cluster once the Traceflow is completed.
+func (r *TraceflowReconciler) exportTraceflowResults(ctx context.Context, commonArea commonarea.RemoteCommonArea, tf *crdv1alpha1.Traceflow) error {
+	if tf.Status.Phase != crdv1alpha1.Succeeded && tf.Status.Phase != crdv1alpha1.Failed {
+		return nil
+	}
+	resExport := &mcsv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      newTraceflowResourceExportName(r.localClusterID, tf.Name, common.TraceflowResultKind),
+			Namespace: commonArea.GetNamespace(),
+			Labels: map[string]string{
+				common.SourceName:      tf.Labels[common.SourceName],
+				common.SourceKind:      common.TraceflowResultKind,
+				common.SourceClusterID: r.localClusterID,
+			},
+		},
+		Spec: mcsv1alpha1.ResourceExportSpec{
+			ClusterID: r.localClusterID,
+			Name:      tf.Labels[common.SourceName],
+			Kind:      common.TraceflowResultKind,
+			Traceflow: &mcsv1alpha1.TraceflowExport{
+				ClusterIDs: []string{tf.Labels[common.SourceClusterID]},
+				Results:    tf.Status.Results,
+			},
+		},
+	}
+	return r.createOrUpdateResourceExport(ctx, commonArea, resExport)
+}
+
+func (r *TraceflowReconciler) createOrUpdateResourceExport(ctx context.Context, commonArea commonarea.RemoteCommonArea,
+	resExport *mcsv1alpha1.ResourceExport) error {
+	existingResExport := &mcsv1alpha1.ResourceExport{}
+	if err := commonArea.Get(ctx, types.NamespacedName{Namespace: resExport.Namespace, Name: resExport.Name}, existingResExport); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		resExport.Finalizers = []string{common.ResourceExportFinalizer}
+		if err := commonArea.Create(ctx, resExport, &client.CreateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to create ResourceExport in leader cluster", "resourceexport", klog.KObj(resExport))
+			return err
+		}
+		klog.InfoS("Created a Traceflow kind of ResourceExport", "resourceexport", klog.KObj(resExport), "kind", resExport.Spec.Kind)
+		return nil
+	}
+	if reflect.DeepEqual(existingResExport.Spec, resExport.Spec) {
+		return nil
+	}
+	existingResExport.Spec = resExport.Spec
+	if err := commonArea.Update(ctx, existingResExport, &client.UpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update ResourceExport", "resourceexport", klog.KObj(resExport))
+		return err
+	}
+	return nil
+}
+
+// getEndpointCluster returns the ID of the member cluster which exports the
+// Endpoint of the multi-cluster Service, and the port of the Endpoint the
+// Service port is mapped to.
+func (r *TraceflowReconciler) getEndpointCluster(ctx context.Context, commonArea commonarea.RemoteCommonArea,
+	svc *corev1.Service, endpointIP string, svcPort int32) (string, int32, error) {
+	var portName string
+	for _, port := range svc.Spec.Ports {
+		if port.Port == svcPort {
+			portName = port.Name
+			break
+		}
+	}
+	reList := &mcsv1alpha1.ResourceExportList{}
+	if err := commonArea.List(ctx, reList, &client.ListOptions{
+		Namespace: commonArea.GetNamespace(),
+		LabelSelector: getLabelSelector(&mcsv1alpha1.ResourceExport{Spec: mcsv1alpha1.ResourceExportSpec{
+			Namespace: svc.Namespace,
+			Name:      strings.TrimPrefix(svc.Name, common.AntreaMCSPrefix),
+			Kind:      common.EndpointsKind,
+		}}),
+	}); err != nil {
+		return "", 0, err
+	}
+	for _, re := range reList.Items {
+		if re.Spec.Endpoints == nil {
+			continue
+		}
+		for _, subset := range re.Spec.Endpoints.Subsets {
+			for _, addr := range subset.Addresses {
+				if addr.IP != endpointIP {
+					continue
+				}
+				endpointPort := svcPort
+				for _, port := range subset.Ports {
+					if port.Name == portName {
+						endpointPort = port.Port
+						break
+					}
+				}
+				return re.Spec.ClusterID, endpointPort, nil
+			}
+		}
+	}
+	return "", 0, nil
+}
+
+// getSourceIP returns the IP of the source Pod in the same family as the
+// Endpoint IP.
+func (r *TraceflowReconciler) getSourceIP(ctx context.Context, tf *crdv1alpha1.Traceflow, endpointIP string) (string, error) {
+	if tf.Spec.Source.IP != "" {
+		return tf.Spec.Source.IP, nil
+	}
+	pod := &corev1.Pod{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: tf.Spec.Source.Namespace, Name: tf.Spec.Source.Pod}, pod); err != nil {
+		return "", err
+	}
+	isIPv4 := net.ParseIP(endpointIP).To4() != nil
+	for _, podIP := range pod.Status.PodIPs {
+		if (net.ParseIP(podIP.IP).To4() != nil) == isIPv4 {
+			return podIP.IP, nil
+		}
+	}
+	return "", fmt.Errorf("no IP of Pod %s/%s in the same family as Endpoint %s", pod.Namespace, pod.Name, endpointIP)
+}
+
+func getTranslatedDstIP(tf *crdv1alpha1.Traceflow) string {
+	for _, result := range tf.Status.Results {
+		for _, ob := range result.Observations {
+			if ob.Component == crdv1alpha1.ComponentLB && ob.TranslatedDstIP != "" {
+				return ob.TranslatedDstIP
+			}
+		}
+	}
+	return ""
+}
+
+func getDstPort(tf *crdv1alpha1.Traceflow) int32 {
+	if tf.Spec.Packet.TransportHeader.TCP != nil {
+		return tf.Spec.Packet.TransportHeader.TCP.DstPort
+	}
+	if tf.Spec.Packet.TransportHeader.UDP != nil {
+		return tf.Spec.Packet.TransportHeader.UDP.DstPort
+	}
+	return 0
+}
+
+func hasGatewayObservation(tf *crdv1alpha1.Traceflow) bool {
+	for _, result := range tf.Status.Results {
+		for _, ob := range result.Observations {
+			if ob.Component == crdv1alpha1.ComponentMulticlusterGateway {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newTraceflowResourceExportName(clusterID, name, kind string) string {
+	return clusterID + "-" + name + "-" + strings.ToLower(kind)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TraceflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&crdv1alpha1.Traceflow{}).
+		WithOptions(controller.Options{
+			// TODO: add a lock for localClusterID if there is any plan to
+			// increase this concurrent number.
+			MaxConcurrentReconciles: 1,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+var tfReq = ctrl.Request{NamespacedName: types.NamespacedName{Name: "tf1"}}
+
+func TestTraceflowReconciler_exportTraceflow(t *testing.T) {
+	mcSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP}},
+		},
+	}
+	srcPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "client"},
+		Status: corev1.PodStatus{
+			PodIP:  "10.10.0.5",
+			PodIPs: []corev1.PodIP{{IP: "10.10.0.5"}},
+		},
+	}
+	gw := gwNode1.DeepCopy()
+	clusterInfoImport := &mcsv1alpha1.ClusterInfoImport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster-b-clusterinfo"},
+		Spec: mcsv1alpha1.ClusterInfo{
+			ClusterID:    "cluster-b",
+			GatewayInfos: []mcsv1alpha1.GatewayInfo{{GatewayIP: "10.20.20.20"}},
+		},
+	}
+	tf := &crdv1alpha1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+		Spec: crdv1alpha1.TraceflowSpec{
+			Source:      crdv1alpha1.Source{Namespace: "default", Pod: "client"},
+			Destination: crdv1alpha1.Destination{Namespace: "default", Service: "antrea-mc-nginx"},
+			Packet: crdv1alpha1.Packet{
+				IPHeader:        crdv1alpha1.IPHeader{Protocol: crdv1alpha1.TCPProtocolNumber},
+				TransportHeader: crdv1alpha1.TransportHeader{TCP: &crdv1alpha1.TCPHeader{DstPort: 80, Flags: 2}},
+			},
+		},
+		Status: crdv1alpha1.TraceflowStatus{
+			Phase: crdv1alpha1.Succeeded,
+			Results: []crdv1alpha1.NodeResult{{
+				Node: "node-2",
+				Role: "sender",
+				Observations: []crdv1alpha1.Observation{
+					{Component: crdv1alpha1.ComponentSpoofGuard, Action: crdv1alpha1.ActionForwarded},
+					{Component: crdv1alpha1.ComponentLB, Action: crdv1alpha1.ActionForwarded, TranslatedDstIP: "192.168.17.11"},
+					{Component: crdv1alpha1.ComponentForwarding, Action: crdv1alpha1.ActionForwardedOutOfOverlay},
+				},
+			}},
+		},
+	}
+	epResExport := &mcsv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: leaderNamespace,
+			Name:      "cluster-b-default-nginx-endpoints",
+			Labels: map[string]string{
+				common.SourceNamespace: "default",
+				common.SourceName:      "nginx",
+				common.SourceKind:      common.EndpointsKind,
+				common.SourceClusterID: "cluster-b",
+			},
+		},
+		Spec: mcsv1alpha1.ResourceExportSpec{
+			ClusterID: "cluster-b",
+			Namespace: "default",
+			Name:      "nginx",
+			Kind:      common.EndpointsKind,
+			Endpoints: &mcsv1alpha1.EndpointsExport{
+				Subsets: []corev1.EndpointSubset{{
+					Addresses: []corev1.EndpointAddress{{IP: "192.168.17.11"}},
+					Ports:     []corev1.EndpointPort{{Name: "http", Port: 8080, Protocol: corev1.ProtocolTCP}},
+				}},
+			},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(mcSvc, srcPod, gw, clusterInfoImport, tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(epResExport).Build()
+	remoteMgr := commonarea.NewRemoteCommonAreaManager("test-clusterset", common.ClusterID(localClusterID), "kube-system")
+	remoteMgr.Start()
+	defer remoteMgr.Stop()
+	_ = commonarea.NewFakeRemoteCommonArea(scheme, remoteMgr, fakeRemoteClient, "leader-cluster", leaderNamespace)
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, scheme, "default")
+	mcReconciler.SetRemoteCommonAreaManager(remoteMgr)
+
+	r := NewTraceflowReconciler(fakeClient, scheme, "default", mcReconciler)
+	_, err := r.Reconcile(ctx, tfReq)
+	require.NoError(t, err)
+
+	resExport := &mcsv1alpha1.ResourceExport{}
+	require.NoError(t, fakeRemoteClient.Get(ctx, types.NamespacedName{Namespace: leaderNamespace, Name: "cluster-a-tf1-traceflow"}, resExport))
+	assert.Equal(t, common.TraceflowKind, resExport.Spec.Kind)
+	assert.Equal(t, []string{"cluster-b"}, resExport.Spec.Traceflow.ClusterIDs)
+	expectedSpec := &crdv1alpha1.TraceflowSpec{
+		Source:      crdv1alpha1.Source{IP: "10.10.0.5"},
+		Destination: crdv1alpha1.Destination{IP: "192.168.17.11"},
+		Packet: crdv1alpha1.Packet{
+			IPHeader:        crdv1alpha1.IPHeader{Protocol: crdv1alpha1.TCPProtocolNumber},
+			TransportHeader: crdv1alpha1.TransportHeader{TCP: &crdv1alpha1.TCPHeader{DstPort: 8080, Flags: 2}},
+		},
+	}
+	assert.Equal(t, expectedSpec, resExport.Spec.Traceflow.Spec)
+
+	updatedTf := &crdv1alpha1.Traceflow{}
+	require.NoError(t, fakeClient.Get(ctx, tfReq.NamespacedName, updatedTf))
+	require.Len(t, updatedTf.Status.Results, 2)
+	assert.Equal(t, localClusterID, updatedTf.Status.Results[0].ClusterID)
+	gwResult := updatedTf.Status.Results[1]
+	assert.Equal(t, localClusterID, gwResult.ClusterID)
+	assert.Equal(t, "node-1", gwResult.Node)
+	assert.Equal(t, []crdv1alpha1.Observation{{
+		Component:     crdv1alpha1.ComponentMulticlusterGateway,
+		ComponentInfo: "cluster-b",
+		Action:        crdv1alpha1.ActionForwardedToRemoteCluster,
+		TunnelDstIP:   "10.20.20.20",
+	}}, gwResult.Observations)
+
+	// The Gateway hop should be reported only once.
+	_, err = r.Reconcile(ctx, tfReq)
+	require.NoError(t, err)
+	require.NoError(t, fakeClient.Get(ctx, tfReq.NamespacedName, updatedTf))
+	assert.Len(t, updatedTf.Status.Results, 2)
+
+	require.NoError(t, fakeClient.Delete(ctx, updatedTf))
+	_, err = r.Reconcile(ctx, tfReq)
+	require.NoError(t, err)
+	// The ResourceExport is kept by its finalizer until the leader cluster
+	// cleans up the ResourceImport.
+	require.NoError(t, fakeRemoteClient.Get(ctx, types.NamespacedName{Namespace: leaderNamespace, Name: "cluster-a-tf1-traceflow"}, resExport))
+	assert.False(t, resExport.DeletionTimestamp.IsZero())
+}
+
+func TestTraceflowReconciler_exportTraceflowResults(t *testing.T) {
+	results := []crdv1alpha1.NodeResult{{
+		Node: "node-3",
+		Role: "receiver",
+		Observations: []crdv1alpha1.Observation{
+			{Component: crdv1alpha1.ComponentForwarding, Action: crdv1alpha1.ActionDelivered},
+		},
+	}}
+	tf := &crdv1alpha1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tf1",
+			Labels: map[string]string{
+				common.SourceClusterID: "cluster-b",
+				common.SourceName:      "tf-b",
+			},
+			Annotations: map[string]string{common.AntreaMCTraceflowAnnotation: "true"},
+		},
+		Status: crdv1alpha1.TraceflowStatus{Phase: crdv1alpha1.Running},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tf).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	remoteMgr := commonarea.NewRemoteCommonAreaManager("test-clusterset", common.ClusterID(localClusterID), "kube-system")
+	remoteMgr.Start()
+	defer remoteMgr.Stop()
+	_ = commonarea.NewFakeRemoteCommonArea(scheme, remoteMgr, fakeRemoteClient, "leader-cluster", leaderNamespace)
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, scheme, "default")
+	mcReconciler.SetRemoteCommonAreaManager(remoteMgr)
+	r := NewTraceflowReconciler(fakeClient, scheme, "default", mcReconciler)
+
+	resExportName := types.NamespacedName{Namespace: leaderNamespace, Name: "cluster-a-tf1-traceflowresult"}
+	resExport := &mcsv1alpha1.ResourceExport{}
+	// Results are not exported before the Traceflow is completed.
+	_, err := r.Reconcile(ctx, tfReq)
+	require.NoError(t, err)
+	err = fakeRemoteClient.Get(ctx, resExportName, resExport)
+	assert.True(t, apierrors.IsNotFound(err))
+
+	tf.Status.Phase = crdv1alpha1.Succeeded
+	tf.Status.Results = results
+	require.NoError(t, fakeClient.Status().Update(ctx, tf))
+	_, err = r.Reconcile(ctx, tfReq)
+	require.NoError(t, err)
+	require.NoError(t, fakeRemoteClient.Get(ctx, resExportName, resExport))
+	assert.Equal(t, mcsv1alpha1.ResourceExportSpec{
+		ClusterID: localClusterID,
+		Name:      "tf-b",
+		Kind:      common.TraceflowResultKind,
+		Traceflow: &mcsv1alpha1.TraceflowExport{
+			ClusterIDs: []string{"cluster-b"},
+			Results:    results,
+		},
+	}, resExport.Spec)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+// handleTraceflow converts a Traceflow or TraceflowResult kind of ResourceExport
+// to a ResourceImport with the same name, which is only imported by the member
+// clusters specified in the ResourceExport.
+func (r *ResourceExportReconciler) handleTraceflow(ctx context.Context, req ctrl.Request, resExport mcsv1alpha1.ResourceExport) (ctrl.Result, error) {
+	resImport := &mcsv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+		},
+	}
+
+	if !resExport.DeletionTimestamp.IsZero() {
+		if common.StringExistsInSlice(resExport.Finalizers, common.ResourceExportFinalizer) {
+			err := r.Client.Delete(ctx, resImport, &client.DeleteOptions{})
+			if err == nil || apierrors.IsNotFound(err) {
+				return r.deleteResourceExport(&resExport)
+			}
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+	if resExport.Spec.Traceflow == nil {
+		klog.InfoS("No Traceflow in ResourceExport, skip reconciling", "resourceexport", klog.KObj(&resExport))
+		return ctrl.Result{}, nil
+	}
+
+	resImportSpec := mcsv1alpha1.ResourceImportSpec{
+		ClusterIDs: resExport.Spec.Traceflow.ClusterIDs,
+		Kind:       resExport.Spec.Kind,
+		Name:       resExport.Spec.Name,
+		Traceflow: &mcsv1alpha1.TraceflowImport{
+			SourceClusterID: resExport.Spec.ClusterID,
+			Spec:            resExport.Spec.Traceflow.Spec,
+			Results:         resExport.Spec.Traceflow.Results,
+		},
+	}
+	if err := r.Client.Get(ctx, req.NamespacedName, resImport); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		resImport.Spec = resImportSpec
+		if err = r.Client.Create(ctx, resImport, &client.CreateOptions{}); err != nil {
+			return ctrl.Result{}, err
+		}
+		r.updateResourceExportStatus(&resExport, succeed)
+		return ctrl.Result{}, nil
+	}
+	if reflect.DeepEqual(resImport.Spec, resImportSpec) {
+		klog.V(2).InfoS("No data change from ResourceExport, skip reconciling", "resourceexport", klog.KObj(&resExport))
+		return ctrl.Result{}, nil
+	}
+	resImport.Spec = resImportSpec
+	klog.InfoS("Updating ResourceImport", "resourceimport", klog.KObj(resImport))
+	if err := r.Client.Update(ctx, resImport, &client.UpdateOptions{}); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.updateResourceExportStatus(&resExport, succeed)
+	return ctrl.Result{}, nil
+}
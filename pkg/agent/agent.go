@@ -90,6 +90,7 @@ type Initializer struct {
 	wireGuardConfig       *config.WireGuardConfig
 	egressConfig          *config.EgressConfig
 	serviceConfig         *config.ServiceConfig
+	l7NetworkPolicyConfig *config.L7NetworkPolicyConfig
 	enableProxy           bool
 	connectUplinkToBridge bool
 	// networkReadyCh should be closed once the Node's network is ready.
@@ -112,6 +113,7 @@ func NewInitializer(
 	wireGuardConfig *config.WireGuardConfig,
 	egressConfig *config.EgressConfig,
 	serviceConfig *config.ServiceConfig,
+	l7NetworkPolicyConfig *config.L7NetworkPolicyConfig,
 	networkReadyCh chan<- struct{},
 	stopCh <-chan struct{},
 	enableProxy bool,
@@ -131,6 +133,7 @@ func NewInitializer(
 		wireGuardConfig:       wireGuardConfig,
 		egressConfig:          egressConfig,
 		serviceConfig:         serviceConfig,
+		l7NetworkPolicyConfig: l7NetworkPolicyConfig,
 		networkReadyCh:        networkReadyCh,
 		stopCh:                stopCh,
 		enableProxy:           enableProxy,
@@ -178,6 +181,60 @@ func (i *Initializer) setupOVSBridge() error {
 		return err
 	}
 
+	if i.l7NetworkPolicyConfig != nil {
+		if err := i.prepareL7NetworkPolicyInterfaces(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prepareL7NetworkPolicyInterfaces creates the OVS internal ports used to redirect the traffic to the
+// application-aware engine for layer 7 NetworkPolicy and to receive the traffic returned from the engine, if they
+// don't exist, and records their OFPorts in l7NetworkPolicyConfig.
+func (i *Initializer) prepareL7NetworkPolicyInterfaces() error {
+	for _, portName := range []string{config.L7NetworkPolicyTargetPortName, config.L7NetworkPolicyReturnPortName} {
+		itf, exists := i.ifaceStore.GetInterface(portName)
+		if !exists {
+			externalIDs := map[string]interface{}{
+				interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTrafficControl,
+			}
+			portUUID, err := i.ovsBridgeClient.CreateInternalPort(portName, config.AutoAssignedOFPort, externalIDs)
+			if err != nil {
+				return fmt.Errorf("failed to create L7 NetworkPolicy port %s: %v", portName, err)
+			}
+			ofPort, err := i.ovsBridgeClient.GetOFPort(portName, false)
+			if err != nil {
+				return fmt.Errorf("failed to get OFPort of L7 NetworkPolicy port %s: %v", portName, err)
+			}
+			itf = interfacestore.NewTrafficControlInterface(portName)
+			itf.OVSPortConfig = &interfacestore.OVSPortConfig{PortUUID: portUUID, OFPort: ofPort}
+			i.ifaceStore.AddInterface(itf)
+			klog.InfoS("Created L7 NetworkPolicy port", "port", portName, "ofPort", ofPort)
+		}
+		// The link must be up for the application-aware engine to capture and send the packets.
+		var err error
+		for retry := 0; retry < maxRetryForHostLink; retry++ {
+			if _, _, err = util.SetLinkUp(portName); err == nil {
+				break
+			}
+			if _, ok := err.(util.LinkNotFound); ok {
+				klog.V(2).InfoS("Not found host link for L7 NetworkPolicy port, retry after 1s", "port", portName)
+				time.Sleep(1 * time.Second)
+				continue
+			}
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to set link up for L7 NetworkPolicy port %s: %v", portName, err)
+		}
+		if portName == config.L7NetworkPolicyTargetPortName {
+			i.l7NetworkPolicyConfig.TargetOFPort = uint32(itf.OFPort)
+		} else {
+			i.l7NetworkPolicyConfig.ReturnOFPort = uint32(itf.OFPort)
+		}
+	}
 	return nil
 }
 
@@ -276,6 +333,9 @@ func (i *Initializer) initInterfaceStore() error {
 			case interfacestore.AntreaContainer:
 				// The port should be for a container interface.
 				intf = cniserver.ParseOVSPortInterfaceConfig(port, ovsPort, true)
+			case interfacestore.AntreaTrafficControl:
+				intf = interfacestore.NewTrafficControlInterface(port.Name)
+				intf.OVSPortConfig = ovsPort
 			default:
 				klog.InfoS("Unknown Antrea interface type", "type", interfaceType)
 			}
@@ -425,7 +485,7 @@ func (i *Initializer) initOpenFlowPipeline() error {
 	roundInfo := getRoundInfo(i.ovsBridgeClient)
 
 	// Set up all basic flows.
-	ofConnCh, err := i.ofClient.Initialize(roundInfo, i.nodeConfig, i.networkConfig, i.egressConfig, i.serviceConfig)
+	ofConnCh, err := i.ofClient.Initialize(roundInfo, i.nodeConfig, i.networkConfig, i.egressConfig, i.serviceConfig, i.l7NetworkPolicyConfig)
 	if err != nil {
 		klog.Errorf("Failed to initialize openflow client: %v", err)
 		return err
@@ -707,7 +767,7 @@ func (i *Initializer) setupDefaultTunnelInterface() error {
 		externalIDs := map[string]interface{}{
 			interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTunnel,
 		}
-		tunnelPortUUID, err := i.ovsBridgeClient.CreateTunnelPortExt(tunnelPortName, i.networkConfig.TunnelType, config.DefaultTunOFPort, shouldEnableCsum, localIPStr, "", "", externalIDs)
+		tunnelPortUUID, err := i.ovsBridgeClient.CreateTunnelPortExt(tunnelPortName, i.networkConfig.TunnelType, config.DefaultTunOFPort, shouldEnableCsum, localIPStr, "", "", nil, externalIDs)
 		if err != nil {
 			klog.Errorf("Failed to create tunnel port %s type %s on OVS bridge: %v", tunnelPortName, i.networkConfig.TunnelType, err)
 			return err
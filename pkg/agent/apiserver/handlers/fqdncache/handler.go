@@ -0,0 +1,102 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fqdncache
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// Response describes the response struct of fqdncache command.
+type Response struct {
+	querier.FQDNCacheEntry
+}
+
+// FlushResponse describes the response struct of flush-fqdncache command.
+type FlushResponse struct {
+	FlushedCount int `json:"flushedCount"`
+}
+
+// HandleFunc creates a http.HandlerFunc which uses an AgentNetworkPolicyInfoQuerier to query
+// the FQDNs tracked for FQDN policy rules. The HandlerFunc accepts an optional `domain`
+// parameter in URL, which can contain wildcards, to filter the FQDNs.
+func HandleFunc(npq querier.AgentNetworkPolicyInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+			http.Error(w, "AntreaPolicy is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		domain := r.URL.Query().Get("domain")
+		entries := npq.GetFQDNCache(domain)
+		response := make([]Response, 0, len(entries))
+		for _, entry := range entries {
+			response = append(response, Response{entry})
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// FlushHandleFunc creates a http.HandlerFunc which uses an AgentNetworkPolicyInfoQuerier to
+// flush the IPs cached for the FQDNs matching the optional `domain` parameter in URL.
+// Flushing modifies the state of the Agent, hence only POST requests are accepted.
+func FlushHandleFunc(npq querier.AgentNetworkPolicyInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+			http.Error(w, "AntreaPolicy is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "Method "+r.Method+" is not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		domain := r.URL.Query().Get("domain")
+		response := FlushResponse{FlushedCount: npq.FlushFQDNCache(domain)}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+var _ common.TableOutput = (*Response)(nil)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"FQDN", "IPS", "EXPIRATION-TIME", "SELECTORS", "RULES"}
+}
+
+func (r Response) GetTableRow(maxColumnLength int) []string {
+	var expirationTime string
+	if r.ExpirationTime != nil {
+		expirationTime = r.ExpirationTime.Format(time.RFC3339)
+	}
+	return []string{
+		r.FQDN,
+		common.GenerateTableElementWithSummary(r.IPs, maxColumnLength),
+		expirationTime,
+		common.GenerateTableElementWithSummary(r.Selectors, maxColumnLength),
+		common.GenerateTableElementWithSummary(r.Rules, maxColumnLength),
+	}
+}
+
+func (r Response) SortRows() bool {
+	return true
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fqdncache
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/querier"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+func TestFQDNCacheQuery(t *testing.T) {
+	expirationTime := time.Date(2022, 5, 2, 9, 0, 0, 0, time.UTC)
+	entries := []querier.FQDNCacheEntry{
+		{
+			FQDN:           "www.antrea.io",
+			IPs:            []string{"10.0.0.1", "10.0.0.2"},
+			ExpirationTime: &expirationTime,
+			Selectors:      []string{"matchName:www.antrea.io", "matchRegex:^.*[.]antrea[.]io$"},
+			Rules:          []string{"AntreaClusterNetworkPolicy:acnp-fqdn/allow-antrea"},
+		},
+		{
+			FQDN:      "docs.antrea.io",
+			Selectors: []string{"matchName:docs.antrea.io"},
+			Rules:     []string{"AntreaNetworkPolicy:ns1/anp-fqdn/allow-docs"},
+		},
+	}
+	testcases := map[string]struct {
+		query            string
+		domain           string
+		entries          []querier.FQDNCacheEntry
+		expectedResponse []Response
+	}{
+		"all FQDNs": {
+			query:            "",
+			domain:           "",
+			entries:          entries,
+			expectedResponse: []Response{{entries[0]}, {entries[1]}},
+		},
+		"FQDNs matching a domain": {
+			query:            "?domain=*.antrea.io",
+			domain:           "*.antrea.io",
+			entries:          entries[:1],
+			expectedResponse: []Response{{entries[0]}},
+		},
+		"no FQDN": {
+			query:            "?domain=www.example.com",
+			domain:           "www.example.com",
+			entries:          nil,
+			expectedResponse: []Response{},
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+			npq.EXPECT().GetFQDNCache(tc.domain).Return(tc.entries)
+			handler := HandleFunc(npq)
+
+			req, err := http.NewRequest(http.MethodGet, tc.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			var received []Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+			assert.Equal(t, tc.expectedResponse, received)
+		})
+	}
+}
+
+func TestFQDNCacheFlush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	npq.EXPECT().FlushFQDNCache("*.antrea.io").Return(2)
+	handler := FlushHandleFunc(npq)
+
+	req, err := http.NewRequest(http.MethodPost, "?domain=*.antrea.io", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+
+	var received FlushResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+	assert.Equal(t, FlushResponse{FlushedCount: 2}, received)
+}
+
+func TestFQDNCacheFlushMethodNotAllowed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	handler := FlushHandleFunc(npq)
+
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, "?domain=*.antrea.io", nil)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code, "Unexpected status code for method %s", method)
+		assert.Equal(t, http.MethodPost, recorder.Header().Get("Allow"))
+	}
+}
+
+func TestFQDNCacheAntreaPolicyDisabled(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, false)()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	npq := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+
+	for _, handler := range []http.HandlerFunc{HandleFunc(npq), FlushHandleFunc(npq)} {
+		req, err := http.NewRequest(http.MethodGet, "", nil)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	}
+}
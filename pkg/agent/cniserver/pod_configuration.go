@@ -51,6 +51,7 @@ const (
 	ovsExternalIDContainerID  = "container-id"
 	ovsExternalIDPodName      = "pod-name"
 	ovsExternalIDPodNamespace = "pod-namespace"
+	ovsExternalIDNetNS        = "netns"
 )
 
 const (
@@ -121,7 +122,7 @@ func buildContainerConfig(
 	}
 	// containerIface.Mac should be a valid MAC string, otherwise it should throw error before
 	containerMAC, _ := net.ParseMAC(containerIface.Mac)
-	return interfacestore.NewContainerInterface(
+	containerConfig := interfacestore.NewContainerInterface(
 		interfaceName,
 		containerID,
 		podName,
@@ -129,6 +130,8 @@ func buildContainerConfig(
 		containerMAC,
 		containerIPs,
 		vlanID)
+	containerConfig.NetNS = containerIface.Sandbox
+	return containerConfig
 }
 
 // BuildOVSPortExternalIDs parses OVS port external_ids from InterfaceConfig.
@@ -140,6 +143,9 @@ func BuildOVSPortExternalIDs(containerConfig *interfacestore.InterfaceConfig) ma
 	externalIDs[ovsExternalIDIP] = getContainerIPsString(containerConfig.IPs)
 	externalIDs[ovsExternalIDPodName] = containerConfig.PodName
 	externalIDs[ovsExternalIDPodNamespace] = containerConfig.PodNamespace
+	if containerConfig.NetNS != "" {
+		externalIDs[ovsExternalIDNetNS] = containerConfig.NetNS
+	}
 	externalIDs[interfacestore.AntreaInterfaceTypeKey] = interfacestore.AntreaContainer
 	return externalIDs
 }
@@ -182,6 +188,7 @@ func ParseOVSPortInterfaceConfig(portData *ovsconfig.OVSPortData, portConfig *in
 	}
 	podName, _ := portData.ExternalIDs[ovsExternalIDPodName]
 	podNamespace, _ := portData.ExternalIDs[ovsExternalIDPodNamespace]
+	netNS, _ := portData.ExternalIDs[ovsExternalIDNetNS]
 
 	interfaceConfig := interfacestore.NewContainerInterface(
 		portData.Name,
@@ -191,6 +198,7 @@ func ParseOVSPortInterfaceConfig(portData *ovsconfig.OVSPortData, portConfig *in
 		containerMAC,
 		containerIPs,
 		portData.VLANID)
+	interfaceConfig.NetNS = netNS
 	interfaceConfig.OVSPortConfig = portConfig
 	return interfaceConfig
 }
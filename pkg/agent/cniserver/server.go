@@ -668,6 +668,7 @@ func (s *CNIServer) Initialize(
 	if err := s.reconcile(); err != nil {
 		return fmt.Errorf("error during initial reconciliation for CNI server: %v", err)
 	}
+	s.backfillContainerNetNS()
 	return nil
 }
 
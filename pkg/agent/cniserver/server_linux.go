@@ -14,7 +14,16 @@
 
 package cniserver
 
-import "github.com/containernetworking/cni/pkg/types/current"
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/containernetworking/cni/pkg/types/current"
+	"github.com/vishvananda/netlink"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
 
 // updateResultDNSConfig updates the DNS config from CNIConfig.
 func updateResultDNSConfig(result *current.Result, cniConfig *CNIConfig) {
@@ -42,3 +51,74 @@ func isInfraContainer(netNS string) bool {
 func (c *CNIConfig) getInfraContainer() string {
 	return c.ContainerId
 }
+
+// backfillContainerNetNS sets the network namespace of the container interfaces which were created
+// by an Agent version which did not persist it in the OVS port external IDs, i.e. the interfaces of
+// the Pods created before an upgrade. The network namespace of such a Pod is found by matching the
+// ID of the peer network namespace of its host veth interface with the network namespaces created
+// by the container runtime: containerd bind-mounts them under /var/run/netns, while Docker only
+// exposes them through the processes of the containers.
+func (s *CNIServer) backfillContainerNetNS() {
+	var ifaces []*interfacestore.InterfaceConfig
+	for _, iface := range s.podConfigurator.ifaceStore.GetInterfacesByType(interfacestore.ContainerInterface) {
+		if iface.NetNS == "" {
+			ifaces = append(ifaces, iface)
+		}
+	}
+	if len(ifaces) == 0 {
+		return
+	}
+	netNSByID := s.getNetNSByID()
+	for _, iface := range ifaces {
+		podRef := klog.KRef(iface.PodNamespace, iface.PodName)
+		link, err := netlink.LinkByName(iface.InterfaceName)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the host interface of the Pod", "interface", iface.InterfaceName, "pod", podRef)
+			continue
+		}
+		netNS, ok := netNSByID[link.Attrs().NetNsID]
+		if !ok {
+			klog.InfoS("Network namespace of the Pod not found", "interface", iface.InterfaceName, "pod", podRef)
+			continue
+		}
+		containerConfig := *iface.ContainerInterfaceConfig
+		containerConfig.NetNS = netNS
+		newIface := *iface
+		newIface.ContainerInterfaceConfig = &containerConfig
+		if err := s.podConfigurator.ovsBridgeClient.SetPortExternalIDs(newIface.InterfaceName, BuildOVSPortExternalIDs(&newIface)); err != nil {
+			klog.ErrorS(err, "Failed to save the network namespace of the Pod on OVS port", "port", newIface.InterfaceName, "pod", podRef)
+		}
+		s.podConfigurator.ifaceStore.AddInterface(&newIface)
+		klog.V(2).InfoS("Found network namespace of the Pod", "pod", podRef, "netns", netNS)
+	}
+}
+
+// getNetNSByID returns the paths of the network namespaces created by the container runtime, as
+// seen by the Agent, indexed by their IDs in the network namespace of the Agent. The paths under
+// /var/run/netns are preferred over the ones of the processes, which are only valid while the
+// processes are running.
+func (s *CNIServer) getNetNSByID() map[int]string {
+	netNSByID := make(map[int]string)
+	netNSPaths, _ := filepath.Glob(s.hostNetNsPath("/var/run/netns/*"))
+	procNetNSPaths, _ := filepath.Glob(s.hostNetNsPath("/proc/[0-9]*/ns/net"))
+	for _, path := range append(netNSPaths, procNetNSPaths...) {
+		id, err := getNetNSID(path)
+		// A negative ID means that the network namespace is not peered with the one of the Agent.
+		if err != nil || id < 0 {
+			continue
+		}
+		if _, exists := netNSByID[id]; !exists {
+			netNSByID[id] = path
+		}
+	}
+	return netNSByID
+}
+
+func getNetNSID(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return -1, err
+	}
+	defer f.Close()
+	return netlink.GetNetNsIdByFd(int(f.Fd()))
+}
@@ -709,6 +709,7 @@ func TestBuildOVSPortExternalIDs(t *testing.T) {
 	containerIP2 := net.ParseIP("2001:fd1a::2")
 	containerIPs := []net.IP{containerIP1, containerIP2}
 	containerConfig := interfacestore.NewContainerInterface("pod1-abcd", containerID, "test-1", "t1", containerMAC, containerIPs, 0)
+	containerConfig.NetNS = "/var/run/netns/cni-1234"
 	externalIds := BuildOVSPortExternalIDs(containerConfig)
 	parsedIP, existed := externalIds[ovsExternalIDIP]
 	parsedIPStr := parsedIP.(string)
@@ -737,6 +738,7 @@ func TestBuildOVSPortExternalIDs(t *testing.T) {
 		OFPort:   int32(1),
 	}
 	ifaceConfig := ParseOVSPortInterfaceConfig(mockPort, portConfig, true)
+	assert.Equal(t, containerConfig.NetNS, ifaceConfig.NetNS)
 	assert.Equal(t, len(containerIPs), len(ifaceConfig.IPs))
 	for _, ip1 := range containerIPs {
 		existed := false
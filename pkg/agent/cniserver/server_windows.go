@@ -88,3 +88,7 @@ func getInfraContainer(containerID, netNS string) string {
 func (c *CNIConfig) getInfraContainer() string {
 	return getInfraContainer(c.ContainerId, c.Netns)
 }
+
+// backfillContainerNetNS is a no-op on Windows, as the network namespace of the containers is not
+// used.
+func (s *CNIServer) backfillContainerNetNS() {}
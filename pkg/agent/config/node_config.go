@@ -44,6 +44,15 @@ const (
 	IPv6ExtraOverhead = 20
 )
 
+const (
+	// L7NetworkPolicyTargetPortName is the name of the OVS internal port via which the traffic matching layer 7
+	// NetworkPolicy rules is redirected to the application-aware engine.
+	L7NetworkPolicyTargetPortName = "antrea-l7-tap0"
+	// L7NetworkPolicyReturnPortName is the name of the OVS internal port via which the traffic is returned from the
+	// application-aware engine.
+	L7NetworkPolicyReturnPortName = "antrea-l7-tap1"
+)
+
 var (
 	// VirtualServiceIPv4 / VirtualServiceIPv6 are used in the following situations:
 	// - Use the virtual IP to perform SNAT for packets of Service from Antrea gateway and the Endpoint is not on
@@ -190,3 +199,10 @@ type ServiceConfig struct {
 	NodePortAddressesIPv4 []net.IP
 	NodePortAddressesIPv6 []net.IP
 }
+
+// L7NetworkPolicyConfig includes the OVS ports used to redirect the traffic to the application-aware engine for layer 7
+// NetworkPolicy and to receive the traffic returned from the engine.
+type L7NetworkPolicyConfig struct {
+	TargetOFPort uint32 // Matched L7 NetworkPolicy traffic is forwarded to the application-aware engine via this port.
+	ReturnOFPort uint32 // Traffic from the application-aware engine is returned to OVS via this port.
+}
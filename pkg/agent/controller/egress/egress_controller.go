@@ -23,19 +23,24 @@ import (
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent"
+	"antrea.io/antrea/pkg/agent/consistenthash"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/ipassigner"
 	"antrea.io/antrea/pkg/agent/memberlist"
@@ -71,24 +76,54 @@ const (
 
 	// egressDummyDevice is the dummy device that holds the Egress IPs configured to the system by antrea-agent.
 	egressDummyDevice = "antrea-egress0"
+
+	// egressIPHashReplicas is the number of virtual replicas of each Egress IP in the consistent hash ring used to
+	// distribute the Pods of an Egress across its IPs.
+	egressIPHashReplicas = 50
+
+	// Reasons of the Assigned and NodeUnavailable conditions.
+	reasonAssigned        = "Assigned"
+	reasonFailover        = "Failover"
+	reasonUnassigned      = "Unassigned"
+	reasonNodeAvailable   = "NodeAvailable"
+	reasonNoNodeAvailable = "NoNodeAvailable"
 )
 
 var emptyWatch = watch.NewEmptyWatch()
 
 // egressState keeps the actual state of an Egress that has been realized.
 type egressState struct {
-	// The actual egress IP of the Egress. If it's different from the desired IP, there is an update to EgressIP, and we
-	// need to remove previously installed flows.
-	egressIP string
-	// The actual datapath mark of this Egress. Used to check if the mark changes since last process.
-	mark uint32
-	// The actual openflow ports for which we have installed SNAT rules. Used to identify stale openflow ports when
-	// updating or deleting an Egress.
-	ofPorts sets.Int32
+	// The actual egress IPs of the Egress. If they're different from the desired IPs, there is an update to EgressIP or
+	// EgressIPs, and we need to remove previously installed flows.
+	egressIPs []string
+	// The consistent hash ring of the egress IPs, used to select the egress IP of a Pod.
+	egressIPHash *consistenthash.Map
+	// The actual datapath marks of the egress IPs. Used to check if the marks change since last process.
+	marks map[string]uint32
+	// The actual openflow ports for which we have installed SNAT rules, mapped to the egress IPs used by the rules.
+	// Used to identify stale openflow ports when updating or deleting an Egress.
+	ofPorts map[int32]string
 	// The actual Pods of the Egress. Used to identify stale Pods when updating or deleting an Egress.
 	pods sets.String
 }
 
+// getEgressIP returns the egress IP the Pod's traffic should be SNAT'd to.
+func (s *egressState) getEgressIP(pod string) string {
+	return s.egressIPHash.Get(pod)
+}
+
+// getOFPorts returns the openflow ports whose SNAT rules use the provided egress IP. It returns all openflow ports if
+// the provided IP is empty.
+func (s *egressState) getOFPorts(egressIP string) sets.Int32 {
+	ofPorts := sets.NewInt32()
+	for ofPort, ip := range s.ofPorts {
+		if egressIP == "" || ip == egressIP {
+			ofPorts.Insert(ofPort)
+		}
+	}
+	return ofPorts
+}
+
 // egressIPState keeps the actual state of an Egress IP. It's maintained separately from egressState because
 // multiple Egresses can share an EgressIP.
 type egressIPState struct {
@@ -101,6 +136,8 @@ type egressIPState struct {
 	flowsInstalled bool
 	// Whether its iptables rule has been installed.
 	ruleInstalled bool
+	// The bandwidth limit applied to the traffic of this Egress IP. nil if no QoS flows have been installed.
+	bandwidth *crdv1a2.Bandwidth
 }
 
 // egressBinding keeps the Egresses applying to a Pod.
@@ -141,6 +178,16 @@ type EgressController struct {
 	egressIPStates      map[string]*egressIPState
 	egressIPStatesMutex sync.Mutex
 
+	// ofPortEgresses maps the openflow ports for which SNAT flows have been installed to the names of the Egresses.
+	// It's used to map the stats of the SNAT flows to Egresses.
+	ofPortEgresses      map[uint32]string
+	ofPortEgressesMutex sync.RWMutex
+
+	trafficShapingEnabled bool
+
+	// eventRecorder records the Events of Egress IP assignment.
+	eventRecorder record.EventRecorder
+
 	cluster    *memberlist.Cluster
 	ipAssigner ipassigner.IPAssigner
 }
@@ -156,6 +203,8 @@ func NewEgressController(
 	cluster *memberlist.Cluster,
 	egressInformer crdinformers.EgressInformer,
 	podUpdateSubscriber channel.Subscriber,
+	eventRecorder record.EventRecorder,
+	trafficShapingEnabled bool,
 ) (*EgressController, error) {
 	c := &EgressController{
 		ofClient:             ofClient,
@@ -172,9 +221,13 @@ func NewEgressController(
 		egressStates:         map[string]*egressState{},
 		egressIPStates:       map[string]*egressIPState{},
 		egressBindings:       map[string]*egressBinding{},
+		ofPortEgresses:       map[uint32]string{},
 		localIPDetector:      ipassigner.NewLocalIPDetector(),
 		idAllocator:          newIDAllocator(minEgressMark, maxEgressMark),
 		cluster:              cluster,
+		eventRecorder:        eventRecorder,
+
+		trafficShapingEnabled: trafficShapingEnabled,
 	}
 	ipAssigner, err := ipassigner.NewIPAssigner(nodeTransportInterface, egressDummyDevice)
 	if err != nil {
@@ -187,7 +240,7 @@ func NewEgressController(
 		if !ok {
 			return nil, fmt.Errorf("obj is not Egress: %+v", obj)
 		}
-		return []string{egress.Spec.EgressIP}, nil
+		return getEgressIPs(egress), nil
 	}})
 	// externalIPPoolIndex will be used to get all Egresses associated with a given ExternalIPPool.
 	c.egressInformer.AddIndexers(cache.Indexers{externalIPPoolIndex: func(obj interface{}) (strings []string, e error) {
@@ -233,7 +286,7 @@ func (c *EgressController) processPodUpdate(e interface{}) {
 // addEgress processes Egress ADD events.
 func (c *EgressController) addEgress(obj interface{}) {
 	egress := obj.(*crdv1a2.Egress)
-	if egress.Spec.EgressIP == "" {
+	if len(getEgressIPs(egress)) == 0 {
 		return
 	}
 	c.queue.Add(egress.Name)
@@ -331,9 +384,17 @@ func (c *EgressController) removeStaleEgressIPs() {
 	desiredLocalEgressIPs := sets.NewString()
 	egresses, _ := c.egressLister.List(labels.Everything())
 	for _, egress := range egresses {
-		if egress.Spec.EgressIP != "" && egress.Spec.ExternalIPPool != "" && egress.Status.EgressNode == c.nodeName {
+		if egress.Spec.ExternalIPPool == "" {
+			continue
+		}
+		if egress.Spec.EgressIP != "" && egress.Status.EgressNode == c.nodeName {
 			desiredLocalEgressIPs.Insert(egress.Spec.EgressIP)
 		}
+		for _, ipStatus := range egress.Status.EgressIPs {
+			if ipStatus.EgressNode == c.nodeName {
+				desiredLocalEgressIPs.Insert(ipStatus.EgressIP)
+			}
+		}
 	}
 	actualLocalEgressIPs := c.ipAssigner.AssignedIPs()
 	for ip := range actualLocalEgressIPs.Difference(desiredLocalEgressIPs) {
@@ -381,8 +442,9 @@ func (c *EgressController) processNextWorkItem() bool {
 // If it's called the first time for a local Egress IP, it allocates a locally-unique mark for the IP and installs flows
 // and iptables rule for this IP and the mark.
 // If the Egress IP is changed from local to non local, it uninstalls flows and iptables rule and releases the mark.
+// If traffic shaping is enabled, it also ensures the bandwidth limit of the Egress is applied to a local Egress IP.
 // The method returns the mark on success. Non local Egresses use 0 as the mark.
-func (c *EgressController) realizeEgressIP(egressName, egressIP string) (uint32, error) {
+func (c *EgressController) realizeEgressIP(egressName, egressIP string, bandwidth *crdv1a2.Bandwidth) (uint32, error) {
 	isLocalIP := c.localIPDetector.IsLocalIP(egressIP)
 
 	c.egressIPStatesMutex.Lock()
@@ -422,8 +484,14 @@ func (c *EgressController) realizeEgressIP(egressName, egressIP string) (uint32,
 			}
 			ipState.ruleInstalled = true
 		}
+		if err := c.syncEgressIPQoS(ipState, bandwidth); err != nil {
+			return 0, err
+		}
 	} else {
 		// Ensure datapath is uninstalled properly.
+		if err := c.syncEgressIPQoS(ipState, nil); err != nil {
+			return 0, err
+		}
 		if ipState.ruleInstalled {
 			if err := c.routeClient.DeleteSNATRule(ipState.mark); err != nil {
 				return 0, fmt.Errorf("error uninstalling SNAT rule for IP %s: %v", ipState.egressIP, err)
@@ -466,6 +534,9 @@ func (c *EgressController) unrealizeEgressIP(egressName, egressIP string) error
 		return nil
 	}
 	if ipState.mark != 0 {
+		if err := c.syncEgressIPQoS(ipState, nil); err != nil {
+			return err
+		}
 		if ipState.ruleInstalled {
 			if err := c.routeClient.DeleteSNATRule(ipState.mark); err != nil {
 				return err
@@ -484,6 +555,108 @@ func (c *EgressController) unrealizeEgressIP(egressName, egressIP string) error
 	return nil
 }
 
+// syncEgressIPQoS ensures the QoS flows of a local Egress IP limit its traffic to the provided bandwidth. It
+// uninstalls the QoS flows if the provided bandwidth is nil. As the QoS flows are installed per Egress IP, the
+// bandwidth of the Egress processed last takes effect if multiple Egresses share the Egress IP.
+func (c *EgressController) syncEgressIPQoS(ipState *egressIPState, bandwidth *crdv1a2.Bandwidth) error {
+	if !c.trafficShapingEnabled || reflect.DeepEqual(ipState.bandwidth, bandwidth) {
+		return nil
+	}
+	if bandwidth == nil {
+		if err := c.ofClient.UninstallEgressQoS(ipState.mark); err != nil {
+			return fmt.Errorf("error uninstalling QoS flows for IP %s: %v", ipState.egressIP, err)
+		}
+		ipState.bandwidth = nil
+		return nil
+	}
+	rate, burst, err := parseBandwidth(bandwidth)
+	if err != nil {
+		return fmt.Errorf("invalid bandwidth for IP %s: %v", ipState.egressIP, err)
+	}
+	if err := c.ofClient.InstallEgressQoS(ipState.mark, rate, burst); err != nil {
+		return fmt.Errorf("error installing QoS flows for IP %s: %v", ipState.egressIP, err)
+	}
+	ipState.bandwidth = bandwidth.DeepCopy()
+	return nil
+}
+
+// parseBandwidth converts the rate and burst of the provided bandwidth to kilobits per second and kilobits.
+func parseBandwidth(bandwidth *crdv1a2.Bandwidth) (uint32, uint32, error) {
+	rate, err := resource.ParseQuantity(bandwidth.Rate)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid rate %s: %v", bandwidth.Rate, err)
+	}
+	burst, err := resource.ParseQuantity(bandwidth.Burst)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid burst %s: %v", bandwidth.Burst, err)
+	}
+	return uint32(rate.Value() / 1000), uint32(burst.Value() / 1000), nil
+}
+
+// GetEgressByOFPort returns the name and UID of the Egress whose SNAT flow is installed for the provided openflow
+// port. It implements querier.AgentEgressQuerier.
+func (c *EgressController) GetEgressByOFPort(ofPort uint32) (string, apitypes.UID, bool) {
+	c.ofPortEgressesMutex.RLock()
+	egressName, exists := c.ofPortEgresses[ofPort]
+	c.ofPortEgressesMutex.RUnlock()
+	if !exists {
+		return "", "", false
+	}
+	egress, err := c.egressLister.Get(egressName)
+	if err != nil {
+		return "", "", false
+	}
+	return egress.Name, egress.UID, true
+}
+
+// GetEgress returns the name of the effective Egress of a local Pod, the Egress IP the Pod's traffic is SNAT'd to, and
+// the name of the Node that holds the Egress IP. It implements querier.AgentEgressQuerier.
+func (c *EgressController) GetEgress(podNamespace, podName string) (string, string, string, error) {
+	pod := k8s.NamespacedName(podNamespace, podName)
+	c.egressBindingsMutex.RLock()
+	binding, exists := c.egressBindings[pod]
+	c.egressBindingsMutex.RUnlock()
+	if !exists {
+		return "", "", "", fmt.Errorf("no Egress applied to Pod %s", pod)
+	}
+	egressName := binding.effectiveEgress
+	state, exists := c.getEgressState(egressName)
+	if !exists {
+		return "", "", "", fmt.Errorf("Egress %s is not realized", egressName)
+	}
+	egressIP := state.getEgressIP(pod)
+	if egressIP == "" {
+		return "", "", "", fmt.Errorf("Egress %s does not have an effective IP", egressName)
+	}
+	egress, err := c.egressLister.Get(egressName)
+	if err != nil {
+		return "", "", "", err
+	}
+	egressNode := egress.Status.EgressNode
+	for _, ipStatus := range egress.Status.EgressIPs {
+		if ipStatus.EgressIP == egressIP {
+			egressNode = ipStatus.EgressNode
+			break
+		}
+	}
+	return egressName, egressIP, egressNode, nil
+}
+
+func (c *EgressController) setOFPortEgress(ofPort uint32, egressName string) {
+	c.ofPortEgressesMutex.Lock()
+	defer c.ofPortEgressesMutex.Unlock()
+	c.ofPortEgresses[ofPort] = egressName
+}
+
+func (c *EgressController) deleteOFPortEgress(ofPort uint32, egressName string) {
+	c.ofPortEgressesMutex.Lock()
+	defer c.ofPortEgressesMutex.Unlock()
+	// Another Egress may have installed SNAT flows for the openflow port.
+	if c.ofPortEgresses[ofPort] == egressName {
+		delete(c.ofPortEgresses, ofPort)
+	}
+}
+
 func (c *EgressController) getEgressState(egressName string) (*egressState, bool) {
 	c.egressStatesMutex.RLock()
 	defer c.egressStatesMutex.RUnlock()
@@ -497,13 +670,17 @@ func (c *EgressController) deleteEgressState(egressName string) {
 	delete(c.egressStates, egressName)
 }
 
-func (c *EgressController) newEgressState(egressName string, egressIP string) *egressState {
+func (c *EgressController) newEgressState(egressName string, egressIPs []string) *egressState {
 	c.egressStatesMutex.Lock()
 	defer c.egressStatesMutex.Unlock()
+	egressIPHash := consistenthash.New(egressIPHashReplicas, nil)
+	egressIPHash.Add(egressIPs...)
 	state := &egressState{
-		egressIP: egressIP,
-		ofPorts:  sets.NewInt32(),
-		pods:     sets.NewString(),
+		egressIPs:    egressIPs,
+		egressIPHash: egressIPHash,
+		marks:        map[string]uint32{},
+		ofPorts:      map[int32]string{},
+		pods:         sets.NewString(),
 	}
 	c.egressStates[egressName] = state
 	return state
@@ -558,21 +735,127 @@ func (c *EgressController) unbindPodEgress(pod, egress string) (string, bool) {
 func (c *EgressController) updateEgressStatus(egress *crdv1a2.Egress, isLocal bool) error {
 	toUpdate := egress.DeepCopy()
 	var updateErr, getErr error
+	// The Node that held the Egress IP before this Node claims it. It's used to record the failover.
+	var prevNode string
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		status := toUpdate.Status.DeepCopy()
+		prevNode = status.EgressNode
 		if isLocal {
-			// Do nothing if the current EgressNode in status is already this Node.
-			if toUpdate.Status.EgressNode == c.nodeName {
-				return nil
+			status.EgressNode = c.nodeName
+			if prevNode != c.nodeName {
+				c.setAssignedCondition(status, egress.Spec.EgressIP, prevNode)
+			} else if !isConditionTrue(status, crdv1a2.EgressIPAssigned) {
+				c.setAssignedCondition(status, egress.Spec.EgressIP, "")
+			}
+			if egress.Spec.ExternalIPPool != "" {
+				setEgressCondition(status, newNodeAvailableCondition())
 			}
-			toUpdate.Status.EgressNode = c.nodeName
 		} else {
 			// Do nothing if the current EgressNode in status is not this Node.
-			if toUpdate.Status.EgressNode != c.nodeName {
+			if status.EgressNode != c.nodeName {
 				return nil
 			}
-			toUpdate.Status.EgressNode = ""
+			status.EgressNode = ""
+			setEgressCondition(status, crdv1a2.EgressCondition{
+				Type:    crdv1a2.EgressIPAssigned,
+				Status:  corev1.ConditionFalse,
+				Reason:  reasonUnassigned,
+				Message: fmt.Sprintf("EgressIP %s was released by Node %s", egress.Spec.EgressIP, c.nodeName),
+			})
+		}
+		// Do nothing if the status doesn't change.
+		if reflect.DeepEqual(status, &toUpdate.Status) {
+			return nil
+		}
+		toUpdate.Status = *status
+		klog.V(2).InfoS("Updating Egress status", "Egress", egress.Name, "oldNode", prevNode, "newNode", toUpdate.Status.EgressNode)
+		_, updateErr = c.crdClient.CrdV1alpha2().Egresses().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	if isLocal && prevNode != c.nodeName {
+		c.recordAssignment(egress, egress.Spec.EgressIP, prevNode)
+	}
+	klog.V(2).InfoS("Updated Egress status", "Egress", egress.Name)
+	metrics.AntreaEgressStatusUpdates.Inc()
+	return nil
+}
+
+// updateEgressIPsStatus is the counterpart of updateEgressStatus for Egresses using multiple IPs. It claims the
+// provided local IPs for this Node and releases the other IPs that were claimed by this Node.
+func (c *EgressController) updateEgressIPsStatus(egress *crdv1a2.Egress, localIPs sets.String) error {
+	toUpdate := egress.DeepCopy()
+	var updateErr, getErr error
+	// The Nodes that held the local IPs before this Node claims them. It's used to record the failovers.
+	var prevNodes map[string]string
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		status := toUpdate.Status.DeepCopy()
+		prevNodes = map[string]string{}
+		var ipStatuses []crdv1a2.EgressIPStatus
+		for _, egressIP := range toUpdate.Spec.EgressIPs {
+			var prevNode string
+			for _, ipStatus := range toUpdate.Status.EgressIPs {
+				if ipStatus.EgressIP == egressIP {
+					prevNode = ipStatus.EgressNode
+					break
+				}
+			}
+			if localIPs.Has(egressIP) {
+				ipStatuses = append(ipStatuses, crdv1a2.EgressIPStatus{EgressIP: egressIP, EgressNode: c.nodeName})
+				if prevNode != c.nodeName {
+					prevNodes[egressIP] = prevNode
+				}
+				continue
+			}
+			// Keep the IPs claimed by other Nodes.
+			if prevNode != "" && prevNode != c.nodeName {
+				ipStatuses = append(ipStatuses, crdv1a2.EgressIPStatus{EgressIP: egressIP, EgressNode: prevNode})
+			}
+		}
+		status.EgressIPs = ipStatuses
+		if len(ipStatuses) < len(toUpdate.Spec.EgressIPs) {
+			setEgressCondition(status, crdv1a2.EgressCondition{
+				Type:    crdv1a2.EgressIPAssigned,
+				Status:  corev1.ConditionFalse,
+				Reason:  reasonUnassigned,
+				Message: fmt.Sprintf("%d of %d EgressIPs are assigned to Nodes", len(ipStatuses), len(toUpdate.Spec.EgressIPs)),
+			})
+		} else {
+			// Record the first failover if there is any, otherwise keep the existing condition if it's already true.
+			var failoverIP, failoverFrom string
+			for _, egressIP := range toUpdate.Spec.EgressIPs {
+				if prevNode, ok := prevNodes[egressIP]; ok && prevNode != "" {
+					failoverIP, failoverFrom = egressIP, prevNode
+					break
+				}
+			}
+			if failoverIP != "" {
+				c.setAssignedCondition(status, failoverIP, failoverFrom)
+			} else if !isConditionTrue(status, crdv1a2.EgressIPAssigned) {
+				setEgressCondition(status, crdv1a2.EgressCondition{
+					Type:    crdv1a2.EgressIPAssigned,
+					Status:  corev1.ConditionTrue,
+					Reason:  reasonAssigned,
+					Message: "All EgressIPs are assigned to Nodes",
+				})
+			}
+		}
+		if egress.Spec.ExternalIPPool != "" {
+			setEgressCondition(status, newNodeAvailableCondition())
 		}
-		klog.V(2).InfoS("Updating Egress status", "Egress", egress.Name, "oldNode", egress.Status.EgressNode, "newNode", toUpdate.Status.EgressNode)
+		// Do nothing if the status doesn't change.
+		if reflect.DeepEqual(status, &toUpdate.Status) {
+			return nil
+		}
+		klog.V(2).InfoS("Updating Egress status", "Egress", egress.Name, "oldIPs", toUpdate.Status.EgressIPs, "newIPs", ipStatuses)
+		toUpdate.Status = *status
 		_, updateErr = c.crdClient.CrdV1alpha2().Egresses().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
 		if updateErr != nil && errors.IsConflict(updateErr) {
 			if toUpdate, getErr = c.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{}); getErr != nil {
@@ -584,11 +867,177 @@ func (c *EgressController) updateEgressStatus(egress *crdv1a2.Egress, isLocal bo
 	}); err != nil {
 		return err
 	}
+	for egressIP, prevNode := range prevNodes {
+		c.recordAssignment(egress, egressIP, prevNode)
+	}
 	klog.V(2).InfoS("Updated Egress status", "Egress", egress.Name)
 	metrics.AntreaEgressStatusUpdates.Inc()
 	return nil
 }
 
+// updateEgressNodeUnavailable sets the NodeUnavailable condition of the Egress when no Node selected by its
+// ExternalIPPool is available to hold its IPs, and records a warning Event when the condition becomes true.
+func (c *EgressController) updateEgressNodeUnavailable(egress *crdv1a2.Egress) error {
+	condition := crdv1a2.EgressCondition{
+		Type:    crdv1a2.EgressNodeUnavailable,
+		Status:  corev1.ConditionTrue,
+		Reason:  reasonNoNodeAvailable,
+		Message: fmt.Sprintf("No Node selected by ExternalIPPool %s is available", egress.Spec.ExternalIPPool),
+	}
+	toUpdate := egress.DeepCopy()
+	var updateErr, getErr error
+	updated := false
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		status := toUpdate.Status.DeepCopy()
+		if !setEgressCondition(status, condition) {
+			return nil
+		}
+		toUpdate.Status = *status
+		_, updateErr = c.crdClient.CrdV1alpha2().Egresses().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		updated = updateErr == nil
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	if updated {
+		c.eventRecorder.Event(egress, corev1.EventTypeWarning, "NodeUnavailable", condition.Message)
+		metrics.AntreaEgressStatusUpdates.Inc()
+	}
+	return nil
+}
+
+// setAssignedCondition sets the Assigned condition to true for the Egress IP claimed by this Node. prevNode is the
+// Node that held the IP before, the reason of the condition is "Failover" if it's not empty.
+func (c *EgressController) setAssignedCondition(status *crdv1a2.EgressStatus, egressIP, prevNode string) {
+	condition := crdv1a2.EgressCondition{
+		Type:    crdv1a2.EgressIPAssigned,
+		Status:  corev1.ConditionTrue,
+		Reason:  reasonAssigned,
+		Message: fmt.Sprintf("EgressIP %s is assigned to Node %s", egressIP, c.nodeName),
+	}
+	if prevNode != "" {
+		condition.Reason = reasonFailover
+		condition.Message = fmt.Sprintf("EgressIP %s failed over from Node %s to Node %s", egressIP, prevNode, c.nodeName)
+	}
+	setEgressCondition(status, condition)
+}
+
+// recordAssignment records an Event for the Egress IP claimed by this Node.
+func (c *EgressController) recordAssignment(egress *crdv1a2.Egress, egressIP, prevNode string) {
+	if prevNode != "" {
+		c.eventRecorder.Eventf(egress, corev1.EventTypeNormal, "IPFailover", "EgressIP %s failed over from Node %s to Node %s", egressIP, prevNode, c.nodeName)
+		return
+	}
+	c.eventRecorder.Eventf(egress, corev1.EventTypeNormal, "IPAssigned", "Assigned EgressIP %s to Node %s", egressIP, c.nodeName)
+}
+
+func newNodeAvailableCondition() crdv1a2.EgressCondition {
+	return crdv1a2.EgressCondition{
+		Type:   crdv1a2.EgressNodeUnavailable,
+		Status: corev1.ConditionFalse,
+		Reason: reasonNodeAvailable,
+	}
+}
+
+func isConditionTrue(status *crdv1a2.EgressStatus, conditionType crdv1a2.EgressConditionType) bool {
+	for _, condition := range status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// setEgressCondition sets the condition in the status and returns whether the status is changed. The
+// LastTransitionTime is only updated when the status, reason or message of the condition changes.
+func setEgressCondition(status *crdv1a2.EgressStatus, condition crdv1a2.EgressCondition) bool {
+	condition.LastTransitionTime = metav1.Now()
+	for i := range status.Conditions {
+		existing := &status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return false
+		}
+		*existing = condition
+		return true
+	}
+	status.Conditions = append(status.Conditions, condition)
+	return true
+}
+
+// selectLocalEgressIPs returns the Egress IPs that should be assigned to the local Node.
+// The IPs of an Egress using multiple IPs are spread across the Nodes of its ExternalIPPool: each IP prefers the
+// Nodes that haven't been selected by the Egress's previous IPs in the consistent hash ring. As all Nodes share the
+// same hash ring and the same order of IPs, they reach the same result without coordination.
+func (c *EgressController) selectLocalEgressIPs(egress *crdv1a2.Egress) (sets.String, error) {
+	localIPs := sets.NewString()
+	// The IPs must be assigned to Nodes manually if ExternalIPPool is not specified.
+	if egress.Spec.ExternalIPPool == "" {
+		return localIPs, nil
+	}
+	if len(egress.Spec.EgressIPs) == 0 {
+		node, err := c.cluster.SelectNodeForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool)
+		if err != nil {
+			return nil, err
+		}
+		if node == c.nodeName {
+			localIPs.Insert(egress.Spec.EgressIP)
+		}
+		return localIPs, nil
+	}
+	selectedNodes := sets.NewString()
+	notSelected := func(node string) bool {
+		return !selectedNodes.Has(node)
+	}
+	for _, egressIP := range egress.Spec.EgressIPs {
+		node, err := c.cluster.SelectNodeForIP(egressIP, egress.Spec.ExternalIPPool, notSelected)
+		if err == memberlist.ErrNoNodeAvailable {
+			// All Nodes have been selected, start another round.
+			selectedNodes = sets.NewString()
+			node, err = c.cluster.SelectNodeForIP(egressIP, egress.Spec.ExternalIPPool)
+		}
+		if err != nil {
+			return nil, err
+		}
+		selectedNodes.Insert(node)
+		if node == c.nodeName {
+			localIPs.Insert(egressIP)
+		}
+	}
+	return localIPs, nil
+}
+
+// getEgressIPs returns the IPs specified in the Egress, regardless of whether it uses EgressIP or EgressIPs.
+func getEgressIPs(egress *crdv1a2.Egress) []string {
+	if len(egress.Spec.EgressIPs) > 0 {
+		return egress.Spec.EgressIPs
+	}
+	if egress.Spec.EgressIP != "" {
+		return []string{egress.Spec.EgressIP}
+	}
+	return nil
+}
+
+func stringSliceEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *EgressController) syncEgress(egressName string) error {
 	startTime := time.Now()
 	defer func() {
@@ -612,60 +1061,84 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return err
 	}
 
+	egressIPs := getEgressIPs(egress)
 	eState, exist := c.getEgressState(egressName)
-	// If the EgressIP changes, uninstalls this Egress first.
-	if exist && eState.egressIP != egress.Spec.EgressIP {
+	// If the EgressIPs change, uninstalls this Egress first.
+	if exist && !stringSliceEqual(eState.egressIPs, egressIPs) {
 		if err := c.uninstallEgress(egressName, eState); err != nil {
 			return err
 		}
 		exist = false
 	}
-	// Do not proceed if EgressIP is empty.
-	if egress.Spec.EgressIP == "" {
+	// Do not proceed if EgressIPs are empty.
+	if len(egressIPs) == 0 {
 		return nil
 	}
 	if !exist {
-		eState = c.newEgressState(egressName, egress.Spec.EgressIP)
+		eState = c.newEgressState(egressName, egressIPs)
 	}
 
-	localNodeSelected, err := c.cluster.ShouldSelectIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool)
-	if err != nil {
-		return err
-	}
-	if localNodeSelected {
-		// Ensure the Egress IP is assigned to the system.
-		if err := c.ipAssigner.AssignIP(egress.Spec.EgressIP); err != nil {
-			return err
-		}
-	} else {
-		// Unassign the Egress IP from the local Node if it was assigned by the agent.
-		if err := c.ipAssigner.UnassignIP(egress.Spec.EgressIP); err != nil {
-			return err
+	localNodeSelectedIPs, err := c.selectLocalEgressIPs(egress)
+	if err == memberlist.ErrNoNodeAvailable {
+		if updateErr := c.updateEgressNodeUnavailable(egress); updateErr != nil {
+			return fmt.Errorf("update Egress %s status error: %v", egressName, updateErr)
 		}
 	}
-
-	// Realize the latest EgressIP and get the desired mark.
-	mark, err := c.realizeEgressIP(egressName, egress.Spec.EgressIP)
 	if err != nil {
 		return err
 	}
+	for _, egressIP := range egressIPs {
+		if localNodeSelectedIPs.Has(egressIP) {
+			// Ensure the Egress IP is assigned to the system.
+			if err := c.ipAssigner.AssignIP(egressIP); err != nil {
+				return err
+			}
+		} else {
+			// Unassign the Egress IP from the local Node if it was assigned by the agent.
+			if err := c.ipAssigner.UnassignIP(egressIP); err != nil {
+				return err
+			}
+		}
+	}
 
-	// If the mark changes, uninstall all of the Egress's Pod flows first, then installs them with new mark.
-	// It could happen when the Egress IP is added to or removed from the Node.
-	if eState.mark != mark {
-		// Uninstall all of its Pod flows.
-		if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
+	localIPs := sets.NewString()
+	for _, egressIP := range egressIPs {
+		// Realize the latest EgressIP and get the desired mark.
+		mark, err := c.realizeEgressIP(egressName, egressIP, egress.Spec.Bandwidth)
+		if err != nil {
 			return err
 		}
-		eState.mark = mark
+		// If the mark changes, uninstall the Pod flows using the Egress IP first, then installs them with new mark.
+		// It could happen when the Egress IP is added to or removed from the Node.
+		if prevMark, ok := eState.marks[egressIP]; ok && prevMark != mark {
+			pods := sets.NewString()
+			for pod := range eState.pods {
+				if eState.getEgressIP(pod) == egressIP {
+					pods.Insert(pod)
+				}
+			}
+			if err := c.uninstallPodFlows(egressName, eState, eState.getOFPorts(egressIP), pods); err != nil {
+				return err
+			}
+		}
+		eState.marks[egressIP] = mark
+		if c.localIPDetector.IsLocalIP(egressIP) {
+			localIPs.Insert(egressIP)
+		}
 	}
 
-	if err := c.updateEgressStatus(egress, c.localIPDetector.IsLocalIP(egress.Spec.EgressIP)); err != nil {
-		return fmt.Errorf("update Egress %s status error: %v", egressName, err)
+	if len(egress.Spec.EgressIPs) > 0 {
+		if err := c.updateEgressIPsStatus(egress, localIPs); err != nil {
+			return fmt.Errorf("update Egress %s status error: %v", egressName, err)
+		}
+	} else {
+		if err := c.updateEgressStatus(egress, localIPs.Has(egress.Spec.EgressIP)); err != nil {
+			return fmt.Errorf("update Egress %s status error: %v", egressName, err)
+		}
 	}
 
 	// Copy the previous ofPorts and Pods. They will be used to identify stale ofPorts and Pods.
-	staleOFPorts := eState.ofPorts.Union(nil)
+	staleOFPorts := eState.getOFPorts("")
 	stalePods := eState.pods.Union(nil)
 
 	// Get a copy of the desired Pods.
@@ -679,7 +1152,6 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return pods.Union(nil)
 	}()
 
-	egressIP := net.ParseIP(eState.egressIP)
 	// Install SNAT flows for desired Pods.
 	for pod := range pods {
 		eState.pods.Insert(pod)
@@ -700,14 +1172,17 @@ func (c *EgressController) syncEgress(egressName string) error {
 		}
 
 		ofPort := ifaces[0].OFPort
-		if eState.ofPorts.Has(ofPort) {
+		if _, installed := eState.ofPorts[ofPort]; installed {
 			staleOFPorts.Delete(ofPort)
 			continue
 		}
-		if err := c.ofClient.InstallPodSNATFlows(uint32(ofPort), egressIP, mark); err != nil {
+		// Spread the Pods across the Egress IPs.
+		egressIP := eState.getEgressIP(pod)
+		if err := c.ofClient.InstallPodSNATFlows(uint32(ofPort), net.ParseIP(egressIP), eState.marks[egressIP]); err != nil {
 			return err
 		}
-		eState.ofPorts.Insert(ofPort)
+		eState.ofPorts[ofPort] = egressIP
+		c.setOFPortEgress(uint32(ofPort), egressName)
 	}
 
 	// Uninstall SNAT flows for stale Pods.
@@ -719,16 +1194,18 @@ func (c *EgressController) syncEgress(egressName string) error {
 
 func (c *EgressController) uninstallEgress(egressName string, eState *egressState) error {
 	// Uninstall all of its Pod flows.
-	if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
-		return err
-	}
-	// Release the EgressIP's mark if the Egress is the last one referring to it.
-	if err := c.unrealizeEgressIP(egressName, eState.egressIP); err != nil {
+	if err := c.uninstallPodFlows(egressName, eState, eState.getOFPorts(""), eState.pods); err != nil {
 		return err
 	}
-	// Unassign the Egress IP from the local Node if it was assigned by the agent.
-	if err := c.ipAssigner.UnassignIP(eState.egressIP); err != nil {
-		return err
+	for _, egressIP := range eState.egressIPs {
+		// Release the EgressIP's mark if the Egress is the last one referring to it.
+		if err := c.unrealizeEgressIP(egressName, egressIP); err != nil {
+			return err
+		}
+		// Unassign the Egress IP from the local Node if it was assigned by the agent.
+		if err := c.ipAssigner.UnassignIP(egressIP); err != nil {
+			return err
+		}
 	}
 	// Remove the Egress's state.
 	c.deleteEgressState(egressName)
@@ -740,7 +1217,8 @@ func (c *EgressController) uninstallPodFlows(egressName string, egressState *egr
 		if err := c.ofClient.UninstallPodSNATFlows(uint32(ofPort)); err != nil {
 			return err
 		}
-		egressState.ofPorts.Delete(ofPort)
+		delete(egressState.ofPorts, ofPort)
+		c.deleteOFPortEgress(uint32(ofPort), egressName)
 	}
 
 	// Remove Pods from the Egress state after uninstalling Pod's flows to avoid overlapping. Otherwise another Egress
@@ -25,12 +25,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	"antrea.io/antrea/pkg/agent/interfacestore"
@@ -136,7 +138,11 @@ func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeControll
 		egressBindings:       map[string]*egressBinding{},
 		egressStates:         map[string]*egressState{},
 		egressIPStates:       map[string]*egressIPState{},
+		ofPortEgresses:       map[uint32]string{},
 		ipAssigner:           mockIPAssigner,
+		eventRecorder:        &record.FakeRecorder{},
+
+		trafficShapingEnabled: true,
 	}
 	podUpdateChannel.Subscribe(egressController.processPodUpdate)
 	return &fakeController{
@@ -211,6 +217,151 @@ func TestSyncEgress(t *testing.T) {
 				mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
 			},
 		},
+		{
+			name: "Bandwidth added to local Egress",
+			existingEgress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1},
+			},
+			newEgress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: crdv1a2.EgressSpec{
+					EgressIP:  fakeLocalEgressIP1,
+					Bandwidth: &crdv1a2.Bandwidth{Rate: "10M", Burst: "20M"},
+				},
+			},
+			existingEgressGroup: &cpv1b2.EgressGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				GroupMembers: []cpv1b2.GroupMember{
+					{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+				},
+			},
+			newEgressGroup: &cpv1b2.EgressGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				GroupMembers: []cpv1b2.GroupMember{
+					{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+				},
+			},
+			expectedEgresses: []*crdv1a2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: crdv1a2.EgressSpec{
+						EgressIP:  fakeLocalEgressIP1,
+						Bandwidth: &crdv1a2.Bandwidth{Rate: "10M", Burst: "20M"},
+					},
+					Status: crdv1a2.EgressStatus{EgressNode: fakeNode},
+				},
+			},
+			expectedCalls: func(mockOFClient *openflowtest.MockClient, mockRouteClient *routetest.MockInterface, mockIPAssigner *ipassignertest.MockIPAssigner) {
+				mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1).Times(3)
+
+				mockOFClient.EXPECT().InstallEgressQoS(uint32(1), uint32(10000), uint32(20000))
+			},
+		},
+		{
+			name: "Local IP with bandwidth becomes non local",
+			existingEgress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: crdv1a2.EgressSpec{
+					EgressIP:  fakeLocalEgressIP1,
+					Bandwidth: &crdv1a2.Bandwidth{Rate: "500k", Burst: "1M"},
+				},
+			},
+			newEgress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: crdv1a2.EgressSpec{
+					EgressIP:  fakeLocalEgressIP1,
+					Bandwidth: &crdv1a2.Bandwidth{Rate: "500k", Burst: "1M"},
+				},
+			},
+			existingEgressGroup: &cpv1b2.EgressGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				GroupMembers: []cpv1b2.GroupMember{
+					{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+				},
+			},
+			newEgressGroup: &cpv1b2.EgressGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				GroupMembers: []cpv1b2.GroupMember{
+					{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+				},
+			},
+			newLocalIPs: sets.NewString(),
+			expectedEgresses: []*crdv1a2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: crdv1a2.EgressSpec{
+						EgressIP:  fakeLocalEgressIP1,
+						Bandwidth: &crdv1a2.Bandwidth{Rate: "500k", Burst: "1M"},
+					},
+				},
+			},
+			expectedCalls: func(mockOFClient *openflowtest.MockClient, mockRouteClient *routetest.MockInterface, mockIPAssigner *ipassignertest.MockIPAssigner) {
+				mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockOFClient.EXPECT().InstallEgressQoS(uint32(1), uint32(500), uint32(1000))
+				mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1).Times(3)
+
+				mockOFClient.EXPECT().UninstallEgressQoS(uint32(1))
+				mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(1))
+				mockRouteClient.EXPECT().DeleteSNATRule(uint32(1))
+				mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(1))
+				mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(0))
+			},
+		},
+		{
+			name: "One of multiple IPs becomes non local",
+			existingEgress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       crdv1a2.EgressSpec{EgressIPs: []string{fakeLocalEgressIP1, fakeRemoteEgressIP1}},
+			},
+			newEgress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       crdv1a2.EgressSpec{EgressIPs: []string{fakeLocalEgressIP1, fakeRemoteEgressIP1}},
+			},
+			existingEgressGroup: &cpv1b2.EgressGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				GroupMembers: []cpv1b2.GroupMember{
+					{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+					{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+				},
+			},
+			newEgressGroup: &cpv1b2.EgressGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				GroupMembers: []cpv1b2.GroupMember{
+					{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+					{Pod: &cpv1b2.PodReference{Name: "pod2", Namespace: "ns2"}},
+				},
+			},
+			newLocalIPs: sets.NewString(),
+			expectedEgresses: []*crdv1a2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec:       crdv1a2.EgressSpec{EgressIPs: []string{fakeLocalEgressIP1, fakeRemoteEgressIP1}},
+				},
+			},
+			expectedCalls: func(mockOFClient *openflowtest.MockClient, mockRouteClient *routetest.MockInterface, mockIPAssigner *ipassignertest.MockIPAssigner) {
+				// pod1 is hashed to fakeLocalEgressIP1, pod2 is hashed to fakeRemoteEgressIP1.
+				mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockOFClient.EXPECT().InstallPodSNATFlows(uint32(2), net.ParseIP(fakeRemoteEgressIP1), uint32(0))
+				mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+				mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+				mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1)
+
+				// Only the flows of pod1 are reinstalled.
+				mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(1))
+				mockRouteClient.EXPECT().DeleteSNATRule(uint32(1))
+				mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(1))
+				mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(0))
+				mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1).Times(2)
+				mockIPAssigner.EXPECT().UnassignIP(fakeRemoteEgressIP1).Times(2)
+			},
+		},
 		{
 			name: "Non local IP becomes local",
 			existingEgress: &crdv1a2.Egress{
@@ -542,6 +693,8 @@ func TestSyncEgress(t *testing.T) {
 			for _, expectedEgress := range tt.expectedEgresses {
 				gotEgress, err := c.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), expectedEgress.Name, metav1.GetOptions{})
 				require.NoError(t, err)
+				// The conditions are verified in TestUpdateEgressStatusConditions.
+				gotEgress.Status.Conditions = nil
 				assert.Equal(t, expectedEgress, gotEgress)
 			}
 		})
@@ -597,6 +750,83 @@ func TestPodUpdateShouldSyncEgress(t *testing.T) {
 	c.queue.Done(item)
 }
 
+func TestGetEgressByOFPort(t *testing.T) {
+	egress := &crdv1a2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1},
+	}
+	c := newFakeController(t, []runtime.Object{egress})
+	defer c.mockController.Finish()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1).Times(2)
+	c.addEgressGroup(&cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+		},
+	})
+	require.NoError(t, c.syncEgress(egress.Name))
+
+	name, uid, exists := c.GetEgressByOFPort(1)
+	assert.True(t, exists)
+	assert.Equal(t, "egressA", name)
+	assert.Equal(t, egress.UID, uid)
+	_, _, exists = c.GetEgressByOFPort(2)
+	assert.False(t, exists)
+
+	c.mockOFClient.EXPECT().UninstallPodSNATFlows(uint32(1))
+	c.patchEgressGroup(&cpv1b2.EgressGroupPatch{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		RemovedGroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+		},
+	})
+	require.NoError(t, c.syncEgress(egress.Name))
+	_, _, exists = c.GetEgressByOFPort(1)
+	assert.False(t, exists)
+}
+
+func TestGetEgress(t *testing.T) {
+	egress := &crdv1a2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1},
+		Status:     crdv1a2.EgressStatus{EgressNode: fakeNode},
+	}
+	c := newFakeController(t, []runtime.Object{egress})
+	defer c.mockController.Finish()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallPodSNATFlows(uint32(1), net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockIPAssigner.EXPECT().UnassignIP(fakeLocalEgressIP1)
+	c.addEgressGroup(&cpv1b2.EgressGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		GroupMembers: []cpv1b2.GroupMember{
+			{Pod: &cpv1b2.PodReference{Name: "pod1", Namespace: "ns1"}},
+		},
+	})
+	require.NoError(t, c.syncEgress(egress.Name))
+
+	egressName, egressIP, egressNode, err := c.GetEgress("ns1", "pod1")
+	require.NoError(t, err)
+	assert.Equal(t, "egressA", egressName)
+	assert.Equal(t, fakeLocalEgressIP1, egressIP)
+	assert.Equal(t, fakeNode, egressNode)
+	_, _, _, err = c.GetEgress("ns1", "pod2")
+	assert.Error(t, err)
+}
+
 func TestSyncOverlappingEgress(t *testing.T) {
 	egress1 := &crdv1a2.Egress{
 		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
@@ -822,7 +1052,7 @@ func TestUpdateEgressStatus(t *testing.T) {
 				return true, &egress, nil
 			})
 
-			c := &EgressController{crdClient: fakeClient, nodeName: fakeNode}
+			c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, eventRecorder: &record.FakeRecorder{}}
 			_, err := c.crdClient.CrdV1alpha2().Egresses().Create(context.TODO(), &egress, metav1.CreateOptions{})
 			assert.NoError(t, err)
 			err = c.updateEgressStatus(&egress, true)
@@ -834,3 +1064,151 @@ func TestUpdateEgressStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateEgressIPsStatus(t *testing.T) {
+	egress := &crdv1a2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1a2.EgressSpec{EgressIPs: []string{fakeLocalEgressIP1, fakeLocalEgressIP2, fakeRemoteEgressIP1}},
+		Status: crdv1a2.EgressStatus{
+			EgressIPs: []crdv1a2.EgressIPStatus{
+				{EgressIP: fakeLocalEgressIP2, EgressNode: fakeNode},
+				{EgressIP: fakeRemoteEgressIP1, EgressNode: "node2"},
+				// The IP is no longer used by the Egress.
+				{EgressIP: "1.1.1.4", EgressNode: "node2"},
+			},
+		},
+	}
+	fakeClient := fakeversioned.NewSimpleClientset(egress)
+	eventRecorder := record.NewFakeRecorder(10)
+	c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, eventRecorder: eventRecorder}
+	require.NoError(t, c.updateEgressIPsStatus(egress, sets.NewString(fakeLocalEgressIP1)))
+	gotEgress, err := fakeClient.CrdV1alpha2().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	expectedStatus := crdv1a2.EgressStatus{
+		EgressIPs: []crdv1a2.EgressIPStatus{
+			{EgressIP: fakeLocalEgressIP1, EgressNode: fakeNode},
+			{EgressIP: fakeRemoteEgressIP1, EgressNode: "node2"},
+		},
+		Conditions: []crdv1a2.EgressCondition{
+			{Type: crdv1a2.EgressIPAssigned, Status: corev1.ConditionFalse, Reason: reasonUnassigned, Message: "2 of 3 EgressIPs are assigned to Nodes"},
+		},
+	}
+	assert.Equal(t, expectedStatus, withoutTransitionTime(gotEgress.Status))
+	assert.Equal(t, "Normal IPAssigned Assigned EgressIP 1.1.1.1 to Node node1", <-eventRecorder.Events)
+}
+
+func TestUpdateEgressStatusConditions(t *testing.T) {
+	tests := []struct {
+		name               string
+		egress             *crdv1a2.Egress
+		isLocal            bool
+		expectedEgressNode string
+		expectedConditions []crdv1a2.EgressCondition
+		expectedEvent      string
+	}{
+		{
+			name: "IP assigned",
+			egress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1, ExternalIPPool: "pool1"},
+				Status: crdv1a2.EgressStatus{
+					Conditions: []crdv1a2.EgressCondition{
+						{Type: crdv1a2.EgressNodeUnavailable, Status: corev1.ConditionTrue, Reason: reasonNoNodeAvailable},
+					},
+				},
+			},
+			isLocal:            true,
+			expectedEgressNode: fakeNode,
+			expectedConditions: []crdv1a2.EgressCondition{
+				{Type: crdv1a2.EgressNodeUnavailable, Status: corev1.ConditionFalse, Reason: reasonNodeAvailable},
+				{Type: crdv1a2.EgressIPAssigned, Status: corev1.ConditionTrue, Reason: reasonAssigned, Message: "EgressIP 1.1.1.1 is assigned to Node node1"},
+			},
+			expectedEvent: "Normal IPAssigned Assigned EgressIP 1.1.1.1 to Node node1",
+		},
+		{
+			name: "IP failed over",
+			egress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1, ExternalIPPool: "pool1"},
+				Status: crdv1a2.EgressStatus{
+					EgressNode: "node2",
+					Conditions: []crdv1a2.EgressCondition{
+						{Type: crdv1a2.EgressIPAssigned, Status: corev1.ConditionTrue, Reason: reasonAssigned, Message: "EgressIP 1.1.1.1 is assigned to Node node2"},
+					},
+				},
+			},
+			isLocal:            true,
+			expectedEgressNode: fakeNode,
+			expectedConditions: []crdv1a2.EgressCondition{
+				{Type: crdv1a2.EgressIPAssigned, Status: corev1.ConditionTrue, Reason: reasonFailover, Message: "EgressIP 1.1.1.1 failed over from Node node2 to Node node1"},
+				{Type: crdv1a2.EgressNodeUnavailable, Status: corev1.ConditionFalse, Reason: reasonNodeAvailable},
+			},
+			expectedEvent: "Normal IPFailover EgressIP 1.1.1.1 failed over from Node node2 to Node node1",
+		},
+		{
+			name: "IP released",
+			egress: &crdv1a2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1},
+				Status: crdv1a2.EgressStatus{
+					EgressNode: fakeNode,
+					Conditions: []crdv1a2.EgressCondition{
+						{Type: crdv1a2.EgressIPAssigned, Status: corev1.ConditionTrue, Reason: reasonAssigned, Message: "EgressIP 1.1.1.1 is assigned to Node node1"},
+					},
+				},
+			},
+			isLocal:            false,
+			expectedEgressNode: "",
+			expectedConditions: []crdv1a2.EgressCondition{
+				{Type: crdv1a2.EgressIPAssigned, Status: corev1.ConditionFalse, Reason: reasonUnassigned, Message: "EgressIP 1.1.1.1 was released by Node node1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fakeversioned.NewSimpleClientset(tt.egress)
+			eventRecorder := record.NewFakeRecorder(10)
+			c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, eventRecorder: eventRecorder}
+			require.NoError(t, c.updateEgressStatus(tt.egress, tt.isLocal))
+			gotEgress, err := fakeClient.CrdV1alpha2().Egresses().Get(context.TODO(), tt.egress.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			status := withoutTransitionTime(gotEgress.Status)
+			assert.Equal(t, tt.expectedEgressNode, status.EgressNode)
+			assert.ElementsMatch(t, tt.expectedConditions, status.Conditions)
+			if tt.expectedEvent != "" {
+				assert.Equal(t, tt.expectedEvent, <-eventRecorder.Events)
+			} else {
+				assert.Len(t, eventRecorder.Events, 0)
+			}
+		})
+	}
+}
+
+func TestUpdateEgressNodeUnavailable(t *testing.T) {
+	egress := &crdv1a2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+		Spec:       crdv1a2.EgressSpec{EgressIP: fakeLocalEgressIP1, ExternalIPPool: "pool1"},
+	}
+	fakeClient := fakeversioned.NewSimpleClientset(egress)
+	eventRecorder := record.NewFakeRecorder(10)
+	c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, eventRecorder: eventRecorder}
+	require.NoError(t, c.updateEgressNodeUnavailable(egress))
+	gotEgress, err := fakeClient.CrdV1alpha2().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	expectedConditions := []crdv1a2.EgressCondition{
+		{Type: crdv1a2.EgressNodeUnavailable, Status: corev1.ConditionTrue, Reason: reasonNoNodeAvailable, Message: "No Node selected by ExternalIPPool pool1 is available"},
+	}
+	assert.Equal(t, expectedConditions, withoutTransitionTime(gotEgress.Status).Conditions)
+	assert.Equal(t, "Warning NodeUnavailable No Node selected by ExternalIPPool pool1 is available", <-eventRecorder.Events)
+
+	// The Event should not be recorded again if the condition doesn't change.
+	require.NoError(t, c.updateEgressNodeUnavailable(gotEgress))
+	assert.Len(t, eventRecorder.Events, 0)
+}
+
+func withoutTransitionTime(status crdv1a2.EgressStatus) crdv1a2.EgressStatus {
+	for i := range status.Conditions {
+		status.Conditions[i].LastTransitionTime = metav1.Time{}
+	}
+	return status
+}
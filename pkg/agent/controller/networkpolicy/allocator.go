@@ -213,3 +213,77 @@ func (a *idAllocator) release(id uint32) error {
 	a.availableSlice = append(a.availableSlice, id)
 	return nil
 }
+
+const (
+	minL7RuleVlanID uint32 = 1
+	maxL7RuleVlanID uint32 = 4094
+)
+
+// l7VlanIDAllocator allocates VLAN IDs for the rules with layer 7 protocols. The VLAN ID is used to identify the rule
+// when the traffic is redirected to the layer 7 engine. It's thread-safe.
+type l7VlanIDAllocator struct {
+	sync.Mutex
+	// vlanIDs maps the rule ID to the VLAN ID allocated for it.
+	vlanIDs map[string]uint32
+	// ruleIDs maps the VLAN ID to the ID of the rule it's allocated for.
+	ruleIDs map[uint32]string
+	// nextVlanID is the VLAN ID from which the search for an available one starts.
+	nextVlanID uint32
+}
+
+func newL7VlanIDAllocator() *l7VlanIDAllocator {
+	return &l7VlanIDAllocator{
+		vlanIDs:    map[string]uint32{},
+		ruleIDs:    map[uint32]string{},
+		nextVlanID: minL7RuleVlanID,
+	}
+}
+
+// allocate returns the VLAN ID allocated for the rule, allocating one if there is none. It returns an error if all
+// VLAN IDs have been allocated.
+func (a *l7VlanIDAllocator) allocate(ruleID string) (uint32, error) {
+	a.Lock()
+	defer a.Unlock()
+	if vlanID, exists := a.vlanIDs[ruleID]; exists {
+		return vlanID, nil
+	}
+	for i := minL7RuleVlanID; i <= maxL7RuleVlanID; i++ {
+		vlanID := a.nextVlanID
+		a.nextVlanID++
+		if a.nextVlanID > maxL7RuleVlanID {
+			a.nextVlanID = minL7RuleVlanID
+		}
+		if _, exists := a.ruleIDs[vlanID]; !exists {
+			a.vlanIDs[ruleID] = vlanID
+			a.ruleIDs[vlanID] = ruleID
+			return vlanID, nil
+		}
+	}
+	return 0, fmt.Errorf("no VLAN ID available for L7 NetworkPolicy rule %s", ruleID)
+}
+
+// query returns the VLAN ID allocated for the rule.
+func (a *l7VlanIDAllocator) query(ruleID string) (uint32, bool) {
+	a.Lock()
+	defer a.Unlock()
+	vlanID, exists := a.vlanIDs[ruleID]
+	return vlanID, exists
+}
+
+// getRuleID returns the ID of the rule the VLAN ID is allocated for.
+func (a *l7VlanIDAllocator) getRuleID(vlanID uint32) (string, bool) {
+	a.Lock()
+	defer a.Unlock()
+	ruleID, exists := a.ruleIDs[vlanID]
+	return ruleID, exists
+}
+
+// release releases the VLAN ID allocated for the rule, if any.
+func (a *l7VlanIDAllocator) release(ruleID string) {
+	a.Lock()
+	defer a.Unlock()
+	if vlanID, exists := a.vlanIDs[ruleID]; exists {
+		delete(a.vlanIDs, ruleID)
+		delete(a.ruleIDs, vlanID)
+	}
+}
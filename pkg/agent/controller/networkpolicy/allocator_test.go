@@ -254,3 +254,41 @@ func TestIdAllocatorWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestL7VlanIDAllocator(t *testing.T) {
+	a := newL7VlanIDAllocator()
+	vlanID1, err := a.allocate("rule1")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), vlanID1)
+	// Allocating for the same rule again returns the same VLAN ID.
+	vlanID, err := a.allocate("rule1")
+	require.NoError(t, err)
+	assert.Equal(t, vlanID1, vlanID)
+	vlanID2, err := a.allocate("rule2")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), vlanID2)
+
+	vlanID, exists := a.query("rule2")
+	assert.True(t, exists)
+	assert.Equal(t, vlanID2, vlanID)
+	ruleID, exists := a.getRuleID(vlanID1)
+	assert.True(t, exists)
+	assert.Equal(t, "rule1", ruleID)
+
+	a.release("rule1")
+	_, exists = a.query("rule1")
+	assert.False(t, exists)
+	_, exists = a.getRuleID(vlanID1)
+	assert.False(t, exists)
+
+	// Exhaust the VLAN IDs, the released one is reused once the others have been allocated.
+	for i := 3; i <= int(maxL7RuleVlanID); i++ {
+		_, err := a.allocate(fmt.Sprintf("rule%d", i))
+		require.NoError(t, err)
+	}
+	vlanID, err = a.allocate("rule0")
+	require.NoError(t, err)
+	assert.Equal(t, vlanID1, vlanID)
+	_, err = a.allocate("rule-exhausted")
+	assert.Error(t, err)
+}
@@ -15,6 +15,7 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/logdir"
@@ -36,8 +38,34 @@ import (
 const (
 	logfileSubdir string = "networkpolicy"
 	logfileName   string = "np.log"
+	// auditDispositionPrefix is prepended to the disposition of the traffic matching the rules
+	// of policies in Audit mode.
+	auditDispositionPrefix = "Audit"
+
+	// AuditLogFormatText is the format of the audit log records consisting of space-separated
+	// fields.
+	AuditLogFormatText = "text"
+	// AuditLogFormatJSON is the format of the audit log records consisting of JSON objects.
+	AuditLogFormatJSON = "json"
 )
 
+// AuditLoggingConfig includes the options of Antrea policy audit logging.
+type AuditLoggingConfig struct {
+	// Format of the audit log records, AuditLogFormatText or AuditLogFormatJSON.
+	Format string
+	// SyslogEnable enables streaming the audit log records to a syslog server.
+	SyslogEnable bool
+	// SyslogAddress is the host:port address of the syslog server.
+	SyslogAddress string
+	// SyslogTransport is the transport used to send records to the syslog server: "udp",
+	// "tcp" or "tls".
+	SyslogTransport string
+	// SyslogCACertFile is the CA certificate used to verify the syslog server with "tls".
+	SyslogCACertFile string
+	// SyslogBufferSize is the number of records which can be queued for the syslog server.
+	SyslogBufferSize int
+}
+
 type Clock interface {
 	Now() time.Time
 	After(d time.Duration) <-chan time.Time
@@ -54,11 +82,14 @@ func (c realClock) After(d time.Duration) <-chan time.Time {
 }
 
 // AntreaPolicyLogger is used for Antrea policy audit logging.
-// Includes a lumberjack logger and a map used for log deduplication.
+// Includes a lumberjack logger, an optional syslog writer and a map used for log deduplication.
 type AntreaPolicyLogger struct {
 	bufferLength     time.Duration
 	clock            Clock // enable the use of a "virtual" clock for unit tests
 	anpLogger        *log.Logger
+	format           string // AuditLogFormatText if empty
+	nodeName         string
+	syslogWriter     *syslogWriter // nil if syslog is not enabled
 	logDeduplication logRecordDedupMap
 }
 
@@ -74,6 +105,48 @@ type logInfo struct {
 	destPort    string // destination port of the traffic logged
 	pktLength   uint16 // packet length of packetin
 	protocolStr string // protocol of the traffic logged
+	npType      string // type of the Network Policy
+	npNamespace string // namespace of the Network Policy, empty for cluster-scoped policies
+	npName      string // name of the Network Policy
+	ruleName    string // name of the rule sending packetin
+	tier        string // name of the Tier of the Network Policy
+	direction   string // Ingress/Egress of the rule sending packetin
+	srcPod      string // namespace/name of the source Pod if it's a local Pod
+	destPod     string // namespace/name of the destination Pod if it's a local Pod
+}
+
+// auditLogRecord is the audit log record in JSON format.
+type auditLogRecord struct {
+	Timestamp       string `json:"timestamp"`
+	NodeName        string `json:"nodeName,omitempty"`
+	TableName       string `json:"tableName"`
+	PolicyRef       string `json:"policyRef"`
+	PolicyType      string `json:"policyType,omitempty"`
+	PolicyNamespace string `json:"policyNamespace,omitempty"`
+	PolicyName      string `json:"policyName,omitempty"`
+	Tier            string `json:"tier,omitempty"`
+	RuleName        string `json:"ruleName,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	Disposition     string `json:"disposition"`
+	OFPriority      string `json:"ofPriority"`
+	SrcIP           string `json:"srcIP"`
+	SrcPort         string `json:"srcPort"`
+	SrcPod          string `json:"srcPod,omitempty"`
+	DestIP          string `json:"destIP"`
+	DestPort        string `json:"destPort"`
+	DestPod         string `json:"destPod,omitempty"`
+	Protocol        string `json:"protocol"`
+	PacketLength    uint16 `json:"packetLength"`
+	// Count is the number of packets deduplicated into this record.
+	Count int64 `json:"count"`
+	// Duration is the time between the first packet and the emission of this record. It's
+	// set only when multiple packets are deduplicated into this record.
+	Duration string `json:"duration,omitempty"`
+}
+
+// toText returns the log message of ob in text format.
+func (ob *logInfo) toText() string {
+	return fmt.Sprintf("%s %s %s %s %s %s %s %s %s %d", ob.tableName, ob.npRef, ob.disposition, ob.ofPriority, ob.srcIP, ob.srcPort, ob.destIP, ob.destPort, ob.protocolStr, ob.pktLength)
 }
 
 // logDedupRecord will be used as 1 sec buffer for log deduplication.
@@ -81,6 +154,7 @@ type logDedupRecord struct {
 	count         int64            // record count of duplicate log
 	initTime      time.Time        // initial time upon receiving packet log
 	bufferTimerCh <-chan time.Time // 1 sec buffer for each log
+	ob            *logInfo         // info of the first packet log
 }
 
 // logRecordDedupMap includes a map of log buffers and a r/w mutex for accessing the map.
@@ -108,23 +182,71 @@ func (l *AntreaPolicyLogger) terminateLogKey(logMsg string) {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	logRecord := l.logDeduplication.logMap[logMsg]
-	if logRecord.count == 1 {
-		l.anpLogger.Printf(logMsg)
-	} else {
-		l.anpLogger.Printf("%s [%d packets in %s]", logMsg, logRecord.count, time.Since(logRecord.initTime))
+	var duration time.Duration
+	if logRecord.count > 1 {
+		duration = time.Since(logRecord.initTime)
 	}
+	l.emit(logRecord.ob, logMsg, logRecord.count, duration)
 	delete(l.logDeduplication.logMap, logMsg)
 }
 
+// emit writes the log record of ob, which stands for count packets received in duration, to the
+// log file and the syslog server if enabled. logMsg is the message of ob in text format.
+func (l *AntreaPolicyLogger) emit(ob *logInfo, logMsg string, count int64, duration time.Duration) {
+	msg := logMsg
+	if l.format == AuditLogFormatJSON {
+		msg = l.toJSON(ob, count, duration)
+	} else if count > 1 {
+		msg = fmt.Sprintf("%s [%d packets in %s]", logMsg, count, duration)
+	}
+	l.anpLogger.Print(msg)
+	if l.syslogWriter != nil {
+		l.syslogWriter.enqueue(msg)
+	}
+}
+
+// toJSON returns the log record of ob in JSON format.
+func (l *AntreaPolicyLogger) toJSON(ob *logInfo, count int64, duration time.Duration) string {
+	record := auditLogRecord{
+		Timestamp:       l.clock.Now().Format(time.RFC3339Nano),
+		NodeName:        l.nodeName,
+		TableName:       ob.tableName,
+		PolicyRef:       ob.npRef,
+		PolicyType:      ob.npType,
+		PolicyNamespace: ob.npNamespace,
+		PolicyName:      ob.npName,
+		Tier:            ob.tier,
+		RuleName:        ob.ruleName,
+		Direction:       ob.direction,
+		Disposition:     ob.disposition,
+		OFPriority:      ob.ofPriority,
+		SrcIP:           ob.srcIP,
+		SrcPort:         ob.srcPort,
+		SrcPod:          ob.srcPod,
+		DestIP:          ob.destIP,
+		DestPort:        ob.destPort,
+		DestPod:         ob.destPod,
+		Protocol:        ob.protocolStr,
+		PacketLength:    ob.pktLength,
+		Count:           count,
+	}
+	if count > 1 {
+		record.Duration = duration.String()
+	}
+	// Marshal cannot fail as auditLogRecord only contains strings and integers.
+	data, _ := json.Marshal(record)
+	return string(data)
+}
+
 // updateLogKey initiates record or increases the count in logDeduplication corresponding to given logMsg.
-func (l *AntreaPolicyLogger) updateLogKey(logMsg string, bufferLength time.Duration) bool {
+func (l *AntreaPolicyLogger) updateLogKey(logMsg string, ob *logInfo, bufferLength time.Duration) bool {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	_, exists := l.logDeduplication.logMap[logMsg]
 	if exists {
 		l.logDeduplication.logMap[logMsg].count++
 	} else {
-		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength)}
+		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength), ob}
 		l.logDeduplication.logMap[logMsg] = &record
 	}
 	return exists
@@ -133,12 +255,12 @@ func (l *AntreaPolicyLogger) updateLogKey(logMsg string, bufferLength time.Durat
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
 func (l *AntreaPolicyLogger) LogDedupPacket(ob *logInfo) {
 	// Deduplicate non-Allow packet log.
-	logMsg := fmt.Sprintf("%s %s %s %s %s %s %s %s %s %d", ob.tableName, ob.npRef, ob.disposition, ob.ofPriority, ob.srcIP, ob.srcPort, ob.destIP, ob.destPort, ob.protocolStr, ob.pktLength)
+	logMsg := ob.toText()
 	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] {
-		l.anpLogger.Printf(logMsg)
+		l.emit(ob, logMsg, 1, 0)
 	} else {
 		// Increase count if duplicated within 1 sec, create buffer otherwise.
-		exists := l.updateLogKey(logMsg, l.bufferLength)
+		exists := l.updateLogKey(logMsg, ob, l.bufferLength)
 		if !exists {
 			// Go routine for logging when buffer timer stops.
 			go l.logAfterTimer(logMsg)
@@ -146,9 +268,17 @@ func (l *AntreaPolicyLogger) LogDedupPacket(ob *logInfo) {
 	}
 }
 
+// Run streams the audit log records to the syslog server if it's enabled, until stopCh is closed.
+func (l *AntreaPolicyLogger) Run(stopCh <-chan struct{}) {
+	if l.syslogWriter == nil {
+		return
+	}
+	l.syslogWriter.Run(stopCh)
+}
+
 // newAntreaPolicyLogger is called while newing Antrea network policy agent controller.
 // Customize AntreaPolicyLogger specifically for Antrea Policies audit logging.
-func newAntreaPolicyLogger() (*AntreaPolicyLogger, error) {
+func newAntreaPolicyLogger(nodeName string, config AuditLoggingConfig) (*AntreaPolicyLogger, error) {
 	logDir := filepath.Join(logdir.GetLogDir(), logfileSubdir)
 	logFile := filepath.Join(logDir, logfileName)
 	_, err := os.Stat(logDir)
@@ -167,16 +297,67 @@ func newAntreaPolicyLogger() (*AntreaPolicyLogger, error) {
 		Compress:   true, // compress the old log files for backup
 	}
 
+	// The JSON records have their own timestamp field.
+	logFlags := log.Ldate | log.Lmicroseconds
+	if config.Format == AuditLogFormatJSON {
+		logFlags = 0
+	}
 	antreaPolicyLogger := &AntreaPolicyLogger{
 		bufferLength:     time.Second,
 		clock:            &realClock{},
-		anpLogger:        log.New(logOutput, "", log.Ldate|log.Lmicroseconds),
+		anpLogger:        log.New(logOutput, "", logFlags),
+		format:           config.Format,
+		nodeName:         nodeName,
 		logDeduplication: logRecordDedupMap{logMap: make(map[string]*logDedupRecord)},
 	}
-	klog.InfoS("Initialized Antrea-native Policy Logger for audit logging", "logFile", logFile)
+	if config.SyslogEnable {
+		antreaPolicyLogger.syslogWriter, err = newSyslogWriter(config.SyslogAddress, config.SyslogTransport, config.SyslogCACertFile, nodeName, config.SyslogBufferSize)
+		if err != nil {
+			return nil, fmt.Errorf("error creating syslog writer for audit logging: %v", err)
+		}
+	}
+	klog.InfoS("Initialized Antrea-native Policy Logger for audit logging", "logFile", logFile, "format", config.Format, "syslog", config.SyslogEnable)
 	return antreaPolicyLogger, nil
 }
 
+// setPolicyRuleInfo fills in the Network Policy and rule info of logInfo ob.
+func (c *Controller) setPolicyRuleInfo(ob *logInfo, policyRef *v1beta2.NetworkPolicyReference, ruleName string, direction v1beta2.Direction) {
+	if policyRef == nil {
+		return
+	}
+	ob.npType = string(policyRef.Type)
+	ob.npNamespace = policyRef.Namespace
+	ob.npName = policyRef.Name
+	ob.ruleName = ruleName
+	if direction == v1beta2.DirectionIn {
+		ob.direction = "Ingress"
+	} else {
+		ob.direction = "Egress"
+	}
+	if c.ruleCache != nil {
+		if policy := c.ruleCache.getNetworkPolicy(string(policyRef.UID)); policy != nil {
+			ob.tier = policy.Tier
+		}
+	}
+}
+
+// setPodInfo fills in the names of the source and destination Pods of logInfo ob, if they
+// are local Pods.
+func (c *Controller) setPodInfo(ob *logInfo) {
+	if c.ifaceStore == nil {
+		return
+	}
+	getPodName := func(ip string) string {
+		iface, exists := c.ifaceStore.GetInterfaceByIP(ip)
+		if !exists || iface.ContainerInterfaceConfig == nil {
+			return ""
+		}
+		return iface.PodNamespace + "/" + iface.PodName
+	}
+	ob.srcPod = getPodName(ob.srcIP)
+	ob.destPod = getPodName(ob.destIP)
+}
+
 // getNetworkPolicyInfo fills in tableName, npName, ofPriority, disposition of logInfo ob.
 func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, c *Controller, ob *logInfo) error {
 	matchers := pktIn.GetMatches()
@@ -202,6 +383,14 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, c *Controller, ob *logInfo) er
 		return fmt.Errorf("received error while unloading conjunction id from reg: %v", err)
 	}
 	ob.npRef, ob.ofPriority = c.ofClient.GetPolicyInfoFromConjunction(info)
+	// For the rules of policies in Audit mode, log the action the rule would take in
+	// Enforce mode, e.g. "AuditDrop", to tell the traffic that would be blocked.
+	if rule := c.GetRuleByFlowID(info); rule != nil {
+		if rule.AuditAction != nil {
+			ob.disposition = auditDispositionPrefix + string(*rule.AuditAction)
+		}
+		c.setPolicyRuleInfo(ob, rule.PolicyRef, rule.Name, rule.Direction)
+	}
 
 	return nil
 }
@@ -232,6 +421,7 @@ func (c *Controller) logPacket(pktIn *ofctrl.PacketIn) error {
 		// Placeholders for ICMP packets without port numbers.
 		ob.srcPort, ob.destPort = "<nil>", "<nil>"
 	}
+	c.setPodInfo(ob)
 
 	// Log the ob info to corresponding file w/ deduplication.
 	c.antreaPolicyLogger.LogDedupPacket(ob)
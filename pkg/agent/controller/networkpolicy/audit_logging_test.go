@@ -15,6 +15,7 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -220,3 +221,65 @@ func TestDropPacketMultiDedupLog(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, c2)
 }
+
+func TestJSONPacketDedupLog(t *testing.T) {
+	clock := NewVirtualClock(time.Now())
+	defer clock.Stop()
+	antreaLogger, mockAnpLogger := newTestAntreaPolicyLogger(testBufferLength, clock)
+	antreaLogger.format = AuditLogFormatJSON
+	antreaLogger.anpLogger.SetFlags(0)
+	antreaLogger.nodeName = "node1"
+	ob, _ := newLogInfo("Drop")
+	ob.npType = "AntreaNetworkPolicy"
+	ob.npNamespace = "default"
+	ob.npName = "test"
+	ob.ruleName = "rule1"
+	ob.tier = "application"
+	ob.direction = "Ingress"
+	ob.destPod = "default/web"
+
+	antreaLogger.LogDedupPacket(ob)
+	clock.Advance(time.Millisecond)
+	antreaLogger.LogDedupPacket(ob)
+	clock.Advance(testBufferLength)
+	actual := <-mockAnpLogger.logged
+	var record auditLogRecord
+	require.NoError(t, json.Unmarshal([]byte(actual), &record))
+	assert.NotEmpty(t, record.Timestamp)
+	assert.NotEmpty(t, record.Duration)
+	record.Timestamp, record.Duration = "", ""
+	assert.Equal(t, auditLogRecord{
+		NodeName:        "node1",
+		TableName:       "AntreaPolicyIngressRule",
+		PolicyRef:       "AntreaNetworkPolicy:default/test",
+		PolicyType:      "AntreaNetworkPolicy",
+		PolicyNamespace: "default",
+		PolicyName:      "test",
+		Tier:            "application",
+		RuleName:        "rule1",
+		Direction:       "Ingress",
+		Disposition:     "Drop",
+		OFPriority:      "0",
+		SrcIP:           "0.0.0.0",
+		SrcPort:         "35402",
+		DestIP:          "1.1.1.1",
+		DestPort:        "80",
+		DestPod:         "default/web",
+		Protocol:        "TCP",
+		PacketLength:    60,
+		Count:           2,
+	}, record)
+}
+
+func TestSyslogPacketLog(t *testing.T) {
+	antreaLogger, mockAnpLogger := newTestAntreaPolicyLogger(testBufferLength, &realClock{})
+	writer, err := newSyslogWriter("127.0.0.1:514", syslogTransportUDP, "", "node1", 10)
+	require.NoError(t, err)
+	antreaLogger.syslogWriter = writer
+	ob, expected := newLogInfo("Allow")
+
+	antreaLogger.LogDedupPacket(ob)
+	assert.Contains(t, <-mockAnpLogger.logged, expected)
+	require.Len(t, writer.recordCh, 1)
+	assert.Equal(t, expected, <-writer.recordCh)
+}
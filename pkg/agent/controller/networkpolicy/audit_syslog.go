@@ -0,0 +1,189 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+const (
+	syslogTransportUDP = "udp"
+	syslogTransportTCP = "tcp"
+	syslogTransportTLS = "tls"
+
+	// syslogFacilityLogAudit is the "log audit" facility defined in RFC5424.
+	syslogFacilityLogAudit = 13
+	// syslogSeverityInfo is the "informational" severity defined in RFC5424.
+	syslogSeverityInfo = 6
+	syslogAppName      = "antrea-agent"
+	syslogMsgID        = "NetworkPolicy"
+	// syslogTimestampFormat is RFC3339 with microseconds, which is the maximum precision
+	// allowed by RFC5424.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	syslogDialTimeout  = 5 * time.Second
+	syslogWriteTimeout = 5 * time.Second
+	// syslogRetryInterval is the minimum interval between two attempts to connect to the
+	// syslog server. The records received in between are dropped.
+	syslogRetryInterval = 5 * time.Second
+
+	syslogDropReasonBufferFull = "buffer_full"
+	syslogDropReasonWriteError = "write_error"
+)
+
+// syslogWriter streams audit log records to a syslog server, using the RFC5424 format. The
+// records are queued in a buffered channel so that audit logging is never blocked by the syslog
+// server: when the channel is full, new records are dropped and counted in metrics.
+type syslogWriter struct {
+	transport string
+	address   string
+	tlsConfig *tls.Config
+	hostname  string
+	procID    string
+	recordCh  chan string
+	// dialFunc connects to the syslog server. It can be overridden in unit tests.
+	dialFunc     func() (net.Conn, error)
+	conn         net.Conn
+	nextDialTime time.Time
+}
+
+func newSyslogWriter(address, transport, caCertFile, hostname string, bufferSize int) (*syslogWriter, error) {
+	w := &syslogWriter{
+		transport: transport,
+		address:   address,
+		hostname:  hostname,
+		procID:    fmt.Sprint(os.Getpid()),
+		recordCh:  make(chan string, bufferSize),
+	}
+	if transport == syslogTransportTLS {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid syslog address %s: %v", address, err)
+		}
+		w.tlsConfig = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+		if caCertFile != "" {
+			caCert, err := os.ReadFile(caCertFile)
+			if err != nil {
+				return nil, fmt.Errorf("error reading syslog CA certificate: %v", err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid certificate found in %s", caCertFile)
+			}
+			w.tlsConfig.RootCAs = pool
+		}
+	}
+	w.dialFunc = w.dial
+	return w, nil
+}
+
+func (w *syslogWriter) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	switch w.transport {
+	case syslogTransportTLS:
+		return tls.DialWithDialer(dialer, "tcp", w.address, w.tlsConfig)
+	case syslogTransportTCP:
+		return dialer.Dial("tcp", w.address)
+	default:
+		return dialer.Dial("udp", w.address)
+	}
+}
+
+// enqueue adds a record to the queue of the writer without blocking. The record is dropped if
+// the queue is full.
+func (w *syslogWriter) enqueue(msg string) {
+	select {
+	case w.recordCh <- msg:
+		metrics.AuditLogSyslogQueueLength.Set(float64(len(w.recordCh)))
+	default:
+		metrics.AuditLogSyslogDroppedRecordCount.WithLabelValues(syslogDropReasonBufferFull).Inc()
+	}
+}
+
+// Run sends the queued records to the syslog server until stopCh is closed.
+func (w *syslogWriter) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting syslog writer for audit logging", "address", w.address, "transport", w.transport)
+	defer func() {
+		if w.conn != nil {
+			w.conn.Close()
+		}
+	}()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case msg := <-w.recordCh:
+			metrics.AuditLogSyslogQueueLength.Set(float64(len(w.recordCh)))
+			if err := w.send(msg, time.Now()); err != nil {
+				metrics.AuditLogSyslogDroppedRecordCount.WithLabelValues(syslogDropReasonWriteError).Inc()
+				continue
+			}
+			metrics.AuditLogSyslogSentRecordCount.Inc()
+		}
+	}
+}
+
+// send writes a record to the syslog server, connecting to it first if needed. The connection
+// is closed after a write error, and re-established for a subsequent record.
+func (w *syslogWriter) send(msg string, now time.Time) error {
+	if w.conn == nil {
+		if now.Before(w.nextDialTime) {
+			return fmt.Errorf("waiting to reconnect to syslog server")
+		}
+		conn, err := w.dialFunc()
+		if err != nil {
+			w.nextDialTime = now.Add(syslogRetryInterval)
+			klog.ErrorS(err, "Failed to connect to syslog server", "address", w.address, "transport", w.transport)
+			return err
+		}
+		w.conn = conn
+	}
+	w.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+	if _, err := w.conn.Write(w.frame(formatSyslogMessage(now, w.hostname, w.procID, msg))); err != nil {
+		klog.ErrorS(err, "Failed to send audit log record to syslog server", "address", w.address)
+		w.conn.Close()
+		w.conn = nil
+		return err
+	}
+	return nil
+}
+
+// frame returns the bytes to write for a syslog message. Each UDP datagram contains exactly
+// one message, while the octet-counting framing of RFC6587 and RFC5425 is used for TCP and TLS.
+func (w *syslogWriter) frame(message string) []byte {
+	if w.transport == syslogTransportUDP {
+		return []byte(message)
+	}
+	return []byte(fmt.Sprintf("%d %s", len(message), message))
+}
+
+// formatSyslogMessage formats msg as a RFC5424 syslog message without structured data, e.g.
+// "<110>1 2022-07-26T06:55:56.170456Z node1 antrea-agent 1 NetworkPolicy - msg".
+func formatSyslogMessage(timestamp time.Time, hostname, procID, msg string) string {
+	if hostname == "" {
+		hostname = "-"
+	}
+	return fmt.Sprintf("<%d>1 %s %s %s %s %s - %s", syslogFacilityLogAudit*8+syslogSeverityInfo,
+		timestamp.UTC().Format(syslogTimestampFormat), hostname, syslogAppName, procID, syslogMsgID, msg)
+}
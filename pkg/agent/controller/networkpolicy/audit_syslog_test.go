@@ -0,0 +1,100 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSyslogMessage(t *testing.T) {
+	timestamp := time.Date(2022, 7, 26, 6, 55, 56, 170456000, time.UTC)
+	assert.Equal(t, "<110>1 2022-07-26T06:55:56.170456Z node1 antrea-agent 10 NetworkPolicy - msg", formatSyslogMessage(timestamp, "node1", "10", "msg"))
+	assert.Equal(t, "<110>1 2022-07-26T06:55:56.170456Z - antrea-agent 10 NetworkPolicy - msg", formatSyslogMessage(timestamp, "", "10", "msg"))
+}
+
+func TestSyslogWriterEnqueue(t *testing.T) {
+	writer, err := newSyslogWriter("127.0.0.1:514", syslogTransportUDP, "", "node1", 1)
+	require.NoError(t, err)
+	writer.enqueue("msg1")
+	// The record is dropped as the queue is full.
+	writer.enqueue("msg2")
+	require.Len(t, writer.recordCh, 1)
+	assert.Equal(t, "msg1", <-writer.recordCh)
+}
+
+func TestSyslogWriterSend(t *testing.T) {
+	for _, transport := range []string{syslogTransportUDP, syslogTransportTCP} {
+		t.Run(transport, func(t *testing.T) {
+			writer, err := newSyslogWriter("127.0.0.1:514", transport, "", "node1", 10)
+			require.NoError(t, err)
+			now := time.Date(2022, 7, 26, 6, 55, 56, 0, time.UTC)
+			message := formatSyslogMessage(now, "node1", writer.procID, "msg")
+			expected := message
+			if transport != syslogTransportUDP {
+				expected = fmt.Sprintf("%d %s", len(message), message)
+			}
+			var serverConn net.Conn
+			receivedCh := make(chan string, 1)
+			dialCount := 0
+			writer.dialFunc = func() (net.Conn, error) {
+				dialCount++
+				var clientConn net.Conn
+				clientConn, serverConn = net.Pipe()
+				go func(conn net.Conn) {
+					buf := make([]byte, len(expected))
+					io.ReadFull(conn, buf)
+					receivedCh <- string(buf)
+				}(serverConn)
+				return clientConn, nil
+			}
+
+			require.NoError(t, writer.send("msg", now))
+			assert.Equal(t, expected, <-receivedCh)
+			assert.Equal(t, 1, dialCount)
+
+			// The connection is closed after a write error, and re-established for the next record.
+			serverConn.Close()
+			assert.Error(t, writer.send("msg", now))
+			assert.Nil(t, writer.conn)
+			require.NoError(t, writer.send("msg", now))
+			assert.Equal(t, expected, <-receivedCh)
+			assert.Equal(t, 2, dialCount)
+		})
+	}
+}
+
+func TestSyslogWriterDialError(t *testing.T) {
+	writer, err := newSyslogWriter("127.0.0.1:514", syslogTransportTCP, "", "node1", 10)
+	require.NoError(t, err)
+	dialCount := 0
+	writer.dialFunc = func() (net.Conn, error) {
+		dialCount++
+		return nil, fmt.Errorf("connection refused")
+	}
+	now := time.Now()
+	assert.Error(t, writer.send("msg", now))
+	// No new connection attempt is made until the retry interval has elapsed.
+	assert.Error(t, writer.send("msg", now.Add(time.Second)))
+	assert.Equal(t, 1, dialCount)
+	assert.Error(t, writer.send("msg", now.Add(syslogRetryInterval)))
+	assert.Equal(t, 2, dialCount)
+}
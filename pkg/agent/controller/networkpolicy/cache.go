@@ -93,6 +93,11 @@ type rule struct {
 	SourceRef *v1beta.NetworkPolicyReference
 	// EnableLogging is a boolean indicating whether logging is required for Antrea Policies. Always false for K8s NetworkPolicy.
 	EnableLogging bool
+	// Layer 7 protocols of this rule. It's omitted when empty to keep the ID of the rules without it unchanged.
+	L7Protocols []v1beta.L7Protocol `json:",omitempty"`
+	// EnforcementMode of the policy to which this rule belongs. It's omitted when empty to keep the ID of the
+	// rules of policies in Enforce mode unchanged.
+	EnforcementMode crdv1alpha1.EnforcementMode `json:",omitempty"`
 }
 
 // hashRule calculates a string based on the rule's content.
@@ -133,6 +138,25 @@ func (r *CompletedRule) isAntreaNetworkPolicyRule() bool {
 	return r.SourceRef.Type != v1beta.K8sNetworkPolicy
 }
 
+// isAuditedRule returns true if the rule is a Drop or Reject rule of a policy in Audit mode,
+// which only logs the traffic it would block.
+func (r *CompletedRule) isAuditedRule() bool {
+	if r.EnforcementMode != crdv1alpha1.EnforcementModeAudit || r.Action == nil {
+		return false
+	}
+	return *r.Action == crdv1alpha1.RuleActionDrop || *r.Action == crdv1alpha1.RuleActionReject
+}
+
+// isNodeNetworkPolicyRule returns true if the rule is applied to Nodes.
+func (r *CompletedRule) isNodeNetworkPolicyRule() bool {
+	for _, m := range r.TargetMembers {
+		if m.Node != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ruleCache caches Antrea AddressGroups, AppliedToGroups and NetworkPolicies,
 // can construct complete rules that can be used by reconciler to enforce.
 type ruleCache struct {
@@ -597,6 +621,8 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		PolicyUID:       policy.UID,
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
+		L7Protocols:     r.L7Protocols,
+		EnforcementMode: policy.EnforcementMode,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
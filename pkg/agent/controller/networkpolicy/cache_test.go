@@ -195,6 +195,14 @@ func newAddressGroupMember(ips ...string) *v1beta2.GroupMember {
 	return &v1beta2.GroupMember{IPs: ipAddrs}
 }
 
+func newAppliedToGroupNodeMember(name string, ips ...string) *v1beta2.GroupMember {
+	ipAddrs := make([]v1beta2.IPAddress, len(ips))
+	for idx, ip := range ips {
+		ipAddrs[idx] = v1beta2.IPAddress(net.ParseIP(ip))
+	}
+	return &v1beta2.GroupMember{Node: &v1beta2.NodeReference{Name: name}, IPs: ipAddrs}
+}
+
 func newAddressGroupPodMember(name, namespace string, ips ...string) *v1beta2.GroupMember {
 	ipAddrs := make([]v1beta2.IPAddress, len(ips))
 	for idx, ip := range ips {
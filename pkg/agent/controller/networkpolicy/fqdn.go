@@ -21,21 +21,26 @@ import (
 	"net"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"antrea.io/libOpenflow/protocol"
+	"antrea.io/libOpenflow/util"
 	"antrea.io/ofnet/ofctrl"
 	"github.com/miekg/dns"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/types"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/querier"
 	utilsets "antrea.io/antrea/pkg/util/sets"
 )
 
@@ -47,6 +52,19 @@ const (
 	dnsRequestTimeout      = 10 * time.Second
 )
 
+// FQDNConfig contains the options of FQDN policy rules.
+type FQDNConfig struct {
+	// DNSServerOverride is the address of the DNS server used to resolve FQDNs, overriding the
+	// kube-dns Service.
+	DNSServerOverride string
+	// MinTTL is the minimum TTL, in seconds, of the IPs resolved for FQDNs. Records with a lower
+	// TTL are considered valid for MinTTL seconds.
+	MinTTL uint32
+	// IPExpirationGracePeriod is the duration for which an IP which is no longer returned for a
+	// FQDN is still selected by the FQDN rules after its TTL expired.
+	IPExpirationGracePeriod time.Duration
+}
+
 // fqdnSelectorItem is a selector that selects FQDNs,
 // either by exact name match or by regex pattern.
 type fqdnSelectorItem struct {
@@ -72,14 +90,22 @@ func (fs *fqdnSelectorItem) matches(fqdn string) bool {
 
 // dnsMeta stores the name resolution results of a FQDN,
 // including the IP addresses resolved, as well as the
-// expirationTime of the records, which is the DNS response
-// receiving time plus lowest applicable TTL.
+// expirationTime of the records, which is the time at which
+// the FQDN must be queried again.
 type dnsMeta struct {
 	expirationTime time.Time
 	// Key for responseIPs is the string representation of the IP.
 	// It helps to quickly identify IP address updates when a
 	// new DNS response is received.
-	responseIPs map[string]net.IP
+	responseIPs map[string]ipWithExpiration
+}
+
+// ipWithExpiration stores an IP resolved for a FQDN, with the
+// expirationTime of the record, which is the DNS response
+// receiving time plus the applicable TTL.
+type ipWithExpiration struct {
+	ip             net.IP
+	expirationTime time.Time
 }
 
 // subscriber is a entity that subsribes for datapath rule realization
@@ -129,6 +155,9 @@ type fqdnController struct {
 	ruleSyncTracker *ruleSyncTracker
 	// FQDN names this controller is tracking, with their corresponding dnsMeta.
 	dnsEntryCache map[string]dnsMeta
+	// ipToFQDNs is a reversed map of dnsEntryCache. It stores the FQDNs which were resolved to
+	// each IP. It is protected by fqdnSelectorMutex, like dnsEntryCache.
+	ipToFQDNs map[string]sets.String
 	// FQDN names that needs to be re-queried after their respective TTLs.
 	dnsQueryQueue workqueue.RateLimitingInterface
 	// idAllocator provides interfaces to allocateForRule and release uint32 id.
@@ -150,31 +179,47 @@ type fqdnController struct {
 	selectorItemToRuleIDs map[fqdnSelectorItem]sets.String
 	ipv4Enabled           bool
 	ipv6Enabled           bool
+
+	// minTTL is the minimum TTL applied to the IPs resolved for FQDNs.
+	minTTL uint32
+	// ipExpirationGracePeriod is the duration for which an IP which is no longer returned for a
+	// FQDN is kept after its TTL expired. It avoids disrupting connections when the records of a
+	// FQDN rotate.
+	ipExpirationGracePeriod time.Duration
+	// tcpDNSReassembler reassembles the DNS responses intercepted from TCP connections.
+	tcpDNSReassembler *tcpDNSReassembler
+	clock             clock.Clock
 }
 
-func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool) (*fqdnController, error) {
+func newFQDNController(client openflow.Client, allocator *idAllocator, fqdnConfig FQDNConfig, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool) (*fqdnController, error) {
+	realClock := clock.RealClock{}
 	controller := &fqdnController{
-		ofClient:               client,
-		dirtyRuleHandler:       dirtyRuleHandler,
-		ruleSyncTracker:        &ruleSyncTracker{updateCh: make(chan ruleRealizationUpdate, 1), ruleToSubscribers: map[string][]*subscriber{}, dirtyRules: sets.NewString()},
-		idAllocator:            allocator,
-		dnsQueryQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "fqdn"),
-		dnsEntryCache:          map[string]dnsMeta{},
-		fqdnRuleToSelectedPods: map[string]sets.Int32{},
-		fqdnToSelectorItem:     map[string]map[fqdnSelectorItem]struct{}{},
-		selectorItemToFQDN:     map[fqdnSelectorItem]sets.String{},
-		selectorItemToRuleIDs:  map[fqdnSelectorItem]sets.String{},
-		ipv4Enabled:            v4Enabled,
-		ipv6Enabled:            v6Enabled,
+		ofClient:                client,
+		dirtyRuleHandler:        dirtyRuleHandler,
+		ruleSyncTracker:         &ruleSyncTracker{updateCh: make(chan ruleRealizationUpdate, 1), ruleToSubscribers: map[string][]*subscriber{}, dirtyRules: sets.NewString()},
+		idAllocator:             allocator,
+		dnsQueryQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "fqdn"),
+		dnsEntryCache:           map[string]dnsMeta{},
+		ipToFQDNs:               map[string]sets.String{},
+		fqdnRuleToSelectedPods:  map[string]sets.Int32{},
+		fqdnToSelectorItem:      map[string]map[fqdnSelectorItem]struct{}{},
+		selectorItemToFQDN:      map[fqdnSelectorItem]sets.String{},
+		selectorItemToRuleIDs:   map[fqdnSelectorItem]sets.String{},
+		ipv4Enabled:             v4Enabled,
+		ipv6Enabled:             v6Enabled,
+		minTTL:                  fqdnConfig.MinTTL,
+		ipExpirationGracePeriod: fqdnConfig.IPExpirationGracePeriod,
+		tcpDNSReassembler:       newTCPDNSReassembler(realClock),
+		clock:                   realClock,
 	}
 	if controller.ofClient != nil {
 		if err := controller.ofClient.NewDNSpacketInConjunction(dnsInterceptRuleID); err != nil {
 			return nil, fmt.Errorf("failed to install flow for DNS response interception: %w", err)
 		}
 	}
-	if dnsServerOverride != "" {
-		klog.InfoS("DNS server override provided by user", "dnsServer", dnsServerOverride)
-		controller.dnsServerAddr = dnsServerOverride
+	if fqdnConfig.DNSServerOverride != "" {
+		klog.InfoS("DNS server override provided by user", "dnsServer", fqdnConfig.DNSServerOverride)
+		controller.dnsServerAddr = fqdnConfig.DNSServerOverride
 	} else {
 		host, port := os.Getenv(kubeDNSServiceHost), os.Getenv(kubeDNSServicePort)
 		if host == "" || port == "" {
@@ -247,8 +292,8 @@ func (f *fqdnController) getIPsForFQDNSelectors(fqdns []string) []net.IP {
 		}
 		for fqdn := range fqdnsMatched {
 			if dnsMeta, ok := f.dnsEntryCache[fqdn]; ok {
-				for _, ip := range dnsMeta.responseIPs {
-					matchedIPs = append(matchedIPs, ip)
+				for _, cachedIP := range dnsMeta.responseIPs {
+					matchedIPs = append(matchedIPs, cachedIP.ip)
 				}
 			}
 		}
@@ -357,13 +402,14 @@ func (f *fqdnController) cleanupFQDNSelectorItem(fs fqdnSelectorItem) {
 				// that selects this FQDN. Hence this FQDN no longer needs to be
 				// tracked by the fqdnController.
 				delete(f.fqdnToSelectorItem, fqdn)
-				delete(f.dnsEntryCache, fqdn)
+				f.deleteDNSEntry(fqdn)
 			}
 			delete(selectors, fs)
 		}
 	}
 	delete(f.selectorItemToFQDN, fs)
 	delete(f.selectorItemToRuleIDs, fs)
+	metrics.FQDNCacheEntryCount.Set(float64(len(f.dnsEntryCache)))
 }
 
 // deleteRuleSelectedPods removes the Pod OFAddresses selected by a FQDN rule.
@@ -413,7 +459,19 @@ func (f *fqdnController) onDNSResponse(
 	// addressUpdate is only true if there has been an update in IP addresses
 	// corresponded with the FQDN.
 	mustCacheResponse, addressUpdate := false, false
-	recordTTL := lookupTime.Add(time.Duration(lowestTTL) * time.Second)
+	ttl := lowestTTL
+	if ttl < f.minTTL {
+		ttl = f.minTTL
+	}
+	recordTTL := lookupTime.Add(time.Duration(ttl) * time.Second)
+	cachedIPs := make(map[string]ipWithExpiration, len(responseIPs))
+	for ipStr, ip := range responseIPs {
+		cachedIPs[ipStr] = ipWithExpiration{ip: ip, expirationTime: recordTTL}
+	}
+	// nextQueryTime is the time at which the FQDN must be queried again, either because the
+	// records expired or because a stale IP must be removed from the cache.
+	nextQueryTime := recordTTL
+	now := f.clock.Now()
 
 	f.fqdnSelectorMutex.Lock()
 	defer f.fqdnSelectorMutex.Unlock()
@@ -427,17 +485,20 @@ func (f *fqdnController) onDNSResponse(
 			}
 		}
 		for oldIPStr, oldIP := range oldDNSMeta.responseIPs {
-			if _, ok := responseIPs[oldIPStr]; !ok {
-				if oldDNSMeta.expirationTime.Before(time.Now()) {
-					// This IP entry has already expired and not seen in the latest DNS response.
-					// It should be removed from the cache.
-					addressUpdate = true
-				} else {
-					// Add the unexpired IP entry to responseIP and update the lowest applicable TTL if needed.
-					responseIPs[oldIPStr] = oldIP
-					if oldDNSMeta.expirationTime.Before(recordTTL) {
-						recordTTL = oldDNSMeta.expirationTime
-					}
+			if _, ok := responseIPs[oldIPStr]; ok {
+				continue
+			}
+			// The IP is not seen in the latest DNS response. It is kept until the grace period
+			// after its expiration elapsed, so that the connections established to it are not
+			// disrupted when the records of the FQDN rotate.
+			staleTime := oldIP.expirationTime.Add(f.ipExpirationGracePeriod)
+			if !staleTime.After(now) {
+				// This IP entry has already expired. It should be removed from the cache.
+				addressUpdate = true
+			} else {
+				cachedIPs[oldIPStr] = oldIP
+				if staleTime.Before(nextQueryTime) {
+					nextQueryTime = staleTime
 				}
 			}
 		}
@@ -451,15 +512,152 @@ func (f *fqdnController) onDNSResponse(
 		}
 	}
 	if mustCacheResponse {
-		f.dnsEntryCache[fqdn] = dnsMeta{
-			expirationTime: recordTTL,
-			responseIPs:    responseIPs,
-		}
-		f.dnsQueryQueue.AddAfter(fqdn, recordTTL.Sub(time.Now()))
+		f.setDNSEntry(fqdn, dnsMeta{
+			expirationTime: nextQueryTime,
+			responseIPs:    cachedIPs,
+		})
+		metrics.FQDNCacheEntryCount.Set(float64(len(f.dnsEntryCache)))
+		f.dnsQueryQueue.AddAfter(fqdn, nextQueryTime.Sub(now))
 	}
 	f.syncDirtyRules(fqdn, waitCh, addressUpdate)
 }
 
+// setDNSEntry sets the dnsMeta of a FQDN in dnsEntryCache and updates ipToFQDNs accordingly.
+// fqdnSelectorMutex must have been acquired by the caller.
+func (f *fqdnController) setDNSEntry(fqdn string, meta dnsMeta) {
+	f.deleteDNSEntry(fqdn)
+	f.dnsEntryCache[fqdn] = meta
+	for ipStr := range meta.responseIPs {
+		fqdns, ok := f.ipToFQDNs[ipStr]
+		if !ok {
+			fqdns = sets.NewString()
+			f.ipToFQDNs[ipStr] = fqdns
+		}
+		fqdns.Insert(fqdn)
+	}
+}
+
+// deleteDNSEntry removes a FQDN from dnsEntryCache and ipToFQDNs.
+// fqdnSelectorMutex must have been acquired by the caller.
+func (f *fqdnController) deleteDNSEntry(fqdn string) {
+	meta, ok := f.dnsEntryCache[fqdn]
+	if !ok {
+		return
+	}
+	for ipStr := range meta.responseIPs {
+		if fqdns, ok := f.ipToFQDNs[ipStr]; ok {
+			fqdns.Delete(fqdn)
+			if fqdns.Len() == 0 {
+				delete(f.ipToFQDNs, ipStr)
+			}
+		}
+	}
+	delete(f.dnsEntryCache, fqdn)
+}
+
+// getFQDNsForIP returns the FQDNs tracked by this controller which were resolved to the provided
+// IP, in alphabetical order.
+func (f *fqdnController) getFQDNsForIP(ip net.IP) []string {
+	f.fqdnSelectorMutex.Lock()
+	defer f.fqdnSelectorMutex.Unlock()
+	fqdns, ok := f.ipToFQDNs[ip.String()]
+	if !ok {
+		return nil
+	}
+	return fqdns.List()
+}
+
+// domainMatcher returns a function which knows if a FQDN matches a domain, which can contain
+// wildcards like the FQDN peers of policies. An empty domain matches all FQDNs.
+func domainMatcher(domain string) func(fqdn string) bool {
+	if domain == "" {
+		return func(string) bool { return true }
+	}
+	selectorItem := fqdnToSelectorItem(strings.ToLower(domain))
+	return selectorItem.matches
+}
+
+// getFQDNCache returns the FQDNs tracked by this controller which match the provided domain. The
+// Rules of the returned entries are rule IDs.
+func (f *fqdnController) getFQDNCache(domain string) []querier.FQDNCacheEntry {
+	matches := domainMatcher(domain)
+	f.fqdnSelectorMutex.Lock()
+	defer f.fqdnSelectorMutex.Unlock()
+	var entries []querier.FQDNCacheEntry
+	for fqdn, selectorItems := range f.fqdnToSelectorItem {
+		if !matches(fqdn) {
+			continue
+		}
+		entry := querier.FQDNCacheEntry{FQDN: fqdn}
+		if meta, ok := f.dnsEntryCache[fqdn]; ok {
+			for ipStr := range meta.responseIPs {
+				entry.IPs = append(entry.IPs, ipStr)
+			}
+			sort.Strings(entry.IPs)
+			expirationTime := meta.expirationTime
+			entry.ExpirationTime = &expirationTime
+		}
+		ruleIDs := sets.NewString()
+		for selectorItem := range selectorItems {
+			entry.Selectors = append(entry.Selectors, selectorItem.String())
+			utilsets.MergeString(ruleIDs, f.selectorItemToRuleIDs[selectorItem])
+		}
+		sort.Strings(entry.Selectors)
+		entry.Rules = ruleIDs.List()
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].FQDN < entries[j].FQDN
+	})
+	return entries
+}
+
+// flushFQDNCache removes the IPs cached for the FQDNs which match the provided domain, and
+// returns the number of FQDNs flushed. The rules selecting the FQDNs are synced to remove the IPs
+// from the datapath, and the FQDNs selected by matchName selectors are queried again immediately.
+// The FQDNs only selected by matchRegex selectors are no longer tracked until they are seen in a
+// DNS response again.
+func (f *fqdnController) flushFQDNCache(domain string) int {
+	matches := domainMatcher(domain)
+	f.fqdnSelectorMutex.Lock()
+	defer f.fqdnSelectorMutex.Unlock()
+	flushed := 0
+	dirtyRules := sets.NewString()
+	for fqdn, selectorItems := range f.fqdnToSelectorItem {
+		if !matches(fqdn) {
+			continue
+		}
+		if _, ok := f.dnsEntryCache[fqdn]; !ok {
+			continue
+		}
+		f.deleteDNSEntry(fqdn)
+		flushed++
+		requery := false
+		for selectorItem := range selectorItems {
+			utilsets.MergeString(dirtyRules, f.selectorItemToRuleIDs[selectorItem])
+			if selectorItem.matchName != "" {
+				requery = true
+				continue
+			}
+			f.selectorItemToFQDN[selectorItem].Delete(fqdn)
+			delete(selectorItems, selectorItem)
+		}
+		if len(selectorItems) == 0 {
+			delete(f.fqdnToSelectorItem, fqdn)
+		}
+		if requery {
+			f.dnsQueryQueue.Add(fqdn)
+		}
+	}
+	metrics.FQDNCacheEntryCount.Set(float64(len(f.dnsEntryCache)))
+	for ruleID := range dirtyRules {
+		klog.V(4).InfoS("Reconciling dirty rule after flushing FQDN cache", "ruleID", ruleID)
+		f.dirtyRuleHandler(ruleID)
+	}
+	klog.InfoS("Flushed FQDN cache", "domain", domain, "count", flushed)
+	return flushed
+}
+
 // onDNSResponseMsg handles a DNS response message intercepted.
 func (f *fqdnController) onDNSResponseMsg(dnsMsg *dns.Msg, lookupTime time.Time, waitCh chan error) {
 	fqdn, responseIPs, lowestTTL, err := f.parseDNSResponse(dnsMsg)
@@ -473,6 +671,26 @@ func (f *fqdnController) onDNSResponseMsg(dnsMsg *dns.Msg, lookupTime time.Time,
 	f.onDNSResponse(fqdn, responseIPs, lowestTTL, lookupTime, waitCh)
 }
 
+// onDNSResponseMsgs handles DNS response messages intercepted together, and reports the result to
+// waitCh once the rules affected by all of them are synced.
+func (f *fqdnController) onDNSResponseMsgs(dnsMsgs []*dns.Msg, lookupTime time.Time, waitCh chan error) {
+	for _, dnsMsg := range dnsMsgs {
+		msgWaitCh := make(chan error, 1)
+		f.onDNSResponseMsg(dnsMsg, lookupTime, msgWaitCh)
+		select {
+		case <-time.After(ruleRealizationTimeout):
+			waitCh <- fmt.Errorf("rules not synced within %v", ruleRealizationTimeout)
+			return
+		case err := <-msgWaitCh:
+			if err != nil {
+				waitCh <- err
+				return
+			}
+		}
+	}
+	waitCh <- nil
+}
+
 // syncDirtyRules triggers rule syncs for rules that are affected by the FQDN of DNS response
 // event. Note that if the query is initiated by the client Pod (not by the fqdnController, in
 // which case waitCh will not be nil), even when addressUpdate is false, the function will still
@@ -655,7 +873,7 @@ func (f *fqdnController) lookupIP(ctx context.Context, fqdn string) error {
 	}
 
 	if f.ipv4Enabled {
-		lookupTime := time.Now()
+		lookupTime := f.clock.Now()
 		if ips, err := resolver.LookupIP(ctx, "ip4", fqdn); err == nil {
 			f.onDNSResponse(fqdn, makeResponseIPs(ips), defaultTTL, lookupTime, nil)
 		} else {
@@ -663,7 +881,7 @@ func (f *fqdnController) lookupIP(ctx context.Context, fqdn string) error {
 		}
 	}
 	if f.ipv6Enabled {
-		lookupTime := time.Now()
+		lookupTime := f.clock.Now()
 		if ips, err := resolver.LookupIP(ctx, "ip6", fqdn); err == nil {
 			f.onDNSResponse(fqdn, makeResponseIPs(ips), defaultTTL, lookupTime, nil)
 		} else {
@@ -685,6 +903,7 @@ func (f *fqdnController) makeDNSRequest(ctx context.Context, fqdn string) error
 	}
 	klog.V(2).InfoS("Making DNS request", "fqdn", fqdn, "dnsServer", f.dnsServerAddr)
 	dnsClient := dns.Client{SingleInflight: true}
+	tcpDNSClient := dns.Client{Net: "tcp", SingleInflight: true}
 	fqdnToQuery := fqdn
 	// The FQDN in the DNS request needs to end by a dot
 	if fqdn[len(fqdn)-1] != '.' {
@@ -692,6 +911,11 @@ func (f *fqdnController) makeDNSRequest(ctx context.Context, fqdn string) error
 	}
 	query := func(m *dns.Msg) (*dns.Msg, error) {
 		r, _, err := dnsClient.ExchangeContext(ctx, m, f.dnsServerAddr)
+		if err == nil && r.Truncated {
+			// The response doesn't fit in a UDP message, retry over TCP to get all the records.
+			klog.V(2).InfoS("DNS response is truncated, retrying over TCP", "fqdn", fqdn, "dnsServer", f.dnsServerAddr)
+			r, _, err = tcpDNSClient.ExchangeContext(ctx, m, f.dnsServerAddr)
+		}
 		if err != nil {
 			klog.ErrorS(err, "DNS exchange failed")
 			return nil, err
@@ -702,7 +926,7 @@ func (f *fqdnController) makeDNSRequest(ctx context.Context, fqdn string) error
 	if f.ipv4Enabled {
 		m := dns.Msg{}
 		m.SetQuestion(fqdnToQuery, dns.TypeA)
-		lookupTime := time.Now()
+		lookupTime := f.clock.Now()
 		if res, err := query(&m); err == nil {
 			f.onDNSResponseMsg(res, lookupTime, nil)
 		} else {
@@ -712,7 +936,7 @@ func (f *fqdnController) makeDNSRequest(ctx context.Context, fqdn string) error
 	if f.ipv6Enabled {
 		m := dns.Msg{}
 		m.SetQuestion(fqdnToQuery, dns.TypeAAAA)
-		lookupTime := time.Now()
+		lookupTime := f.clock.Now()
 		if res, err := query(&m); err == nil {
 			f.onDNSResponseMsg(res, lookupTime, nil)
 		} else {
@@ -744,6 +968,25 @@ func (f *fqdnController) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 
 func (f *fqdnController) handlePacketIn(pktIn *ofctrl.PacketIn) error {
 	klog.V(4).InfoS("Received a packetIn for DNS response")
+	// DNS responses sent over TCP are reassembled before the goroutine is started, as the
+	// segments of a connection must be processed in order.
+	tcpDNSMsgs, rollbackTCPStream, isTCP, err := f.handleTCPPacketIn(pktIn)
+	if err != nil {
+		return fmt.Errorf("error when parsing TCP segment of DNS reply, dropping packet: %v", err)
+	}
+	if isTCP && len(tcpDNSMsgs) == 0 {
+		// The segment doesn't complete any DNS response, so the client cannot use any new
+		// address yet and the segment can be forwarded immediately.
+		return f.sendDNSPacketout(pktIn)
+	}
+	dropped := func() {
+		metrics.FQDNDNSResponseCount.WithLabelValues("dropped").Inc()
+		if rollbackTCPStream != nil {
+			// The segment will be retransmitted by the server, in which case the DNS
+			// responses it completes must be processed again.
+			rollbackTCPStream()
+		}
+	}
 	waitCh := make(chan error, 1)
 	handleUDPData := func(dnsPkt *protocol.UDP) {
 		dnsData := dnsPkt.Data
@@ -752,9 +995,13 @@ func (f *fqdnController) handlePacketIn(pktIn *ofctrl.PacketIn) error {
 			waitCh <- err
 			return
 		}
-		f.onDNSResponseMsg(&dnsMsg, time.Now(), waitCh)
+		f.onDNSResponseMsg(&dnsMsg, f.clock.Now(), waitCh)
 	}
 	go func() {
+		if isTCP {
+			f.onDNSResponseMsgs(tcpDNSMsgs, f.clock.Now(), waitCh)
+			return
+		}
 		switch ipPkt := pktIn.Data.Data.(type) {
 		case *protocol.IPv4:
 			switch dnsPkt := ipPkt.Data.(type) {
@@ -770,20 +1017,56 @@ func (f *fqdnController) handlePacketIn(pktIn *ofctrl.PacketIn) error {
 	}()
 	select {
 	case <-time.After(ruleRealizationTimeout):
+		dropped()
 		return fmt.Errorf("rules not synced within %v for DNS reply, dropping packet", ruleRealizationTimeout)
 	case err := <-waitCh:
 		if err != nil {
+			dropped()
 			return fmt.Errorf("error when syncing up rules for DNS reply, dropping packet: %v", err)
 		}
 		klog.V(2).InfoS("Rule sync is successful or not needed, forwarding DNS response to Pod")
-		return f.sendDNSPacketout(pktIn)
+		if err := f.sendDNSPacketout(pktIn); err != nil {
+			dropped()
+			return err
+		}
+		metrics.FQDNDNSResponseCount.WithLabelValues("forwarded").Inc()
+		return nil
 	}
 }
 
+// handleTCPPacketIn adds the TCP segment of a DNS response packetIn to the tcpDNSReassembler, and
+// returns the DNS messages completed by the segment, as well as the function which reverts the
+// segment from the reassembler. isTCP is false if the packet doesn't carry a TCP segment.
+func (f *fqdnController) handleTCPPacketIn(pktIn *ofctrl.PacketIn) (dnsMsgs []*dns.Msg, rollback func(), isTCP bool, err error) {
+	var srcIP, dstIP net.IP
+	var l4Packet util.Message
+	switch ipPkt := pktIn.Data.Data.(type) {
+	case *protocol.IPv4:
+		if ipPkt.Protocol != protocol.Type_TCP {
+			return nil, nil, false, nil
+		}
+		srcIP, dstIP, l4Packet = ipPkt.NWSrc, ipPkt.NWDst, ipPkt.Data
+	case *protocol.IPv6:
+		if ipPkt.NextHeader != protocol.Type_TCP {
+			return nil, nil, false, nil
+		}
+		srcIP, dstIP, l4Packet = ipPkt.NWSrc, ipPkt.NWDst, ipPkt.Data
+	default:
+		return nil, nil, false, nil
+	}
+	segment, err := l4Packet.MarshalBinary()
+	if err != nil {
+		return nil, nil, true, err
+	}
+	dnsMsgs, rollback, err = f.tcpDNSReassembler.addSegment(srcIP, dstIP, segment)
+	return dnsMsgs, rollback, true, err
+}
+
 // sendDNSPacketout forwards the DNS response packet to the original requesting client.
 func (f *fqdnController) sendDNSPacketout(pktIn *ofctrl.PacketIn) error {
 	var (
 		packetData   []byte
+		l4Packet     util.Message
 		srcIP, dstIP string
 		prot         uint8
 		isIPv6       bool
@@ -794,6 +1077,7 @@ func (f *fqdnController) sendDNSPacketout(pktIn *ofctrl.PacketIn) error {
 		dstIP = ipPkt.NWDst.String()
 		prot = ipPkt.Protocol
 		isIPv6 = false
+		l4Packet = ipPkt.Data
 		switch dnsPkt := ipPkt.Data.(type) {
 		case *protocol.UDP:
 			packetData = dnsPkt.Data
@@ -803,20 +1087,21 @@ func (f *fqdnController) sendDNSPacketout(pktIn *ofctrl.PacketIn) error {
 		dstIP = ipPkt.NWDst.String()
 		prot = ipPkt.NextHeader
 		isIPv6 = true
+		l4Packet = ipPkt.Data
 		switch dnsPkt := ipPkt.Data.(type) {
 		case *protocol.UDP:
 			packetData = dnsPkt.Data
 		}
 	}
+	mutatePacketOut := func(packetOutBuilder binding.PacketOutBuilder) binding.PacketOutBuilder {
+		return packetOutBuilder.AddLoadRegMark(openflow.CustomReasonDNSRegMark)
+	}
 	if prot == protocol.Type_UDP {
 		udpSrcPort, udpDstPort, err := binding.GetUDPHeaderData(pktIn.Data.Data)
 		if err != nil {
 			klog.ErrorS(err, "Failed to get UDP header data")
 			return err
 		}
-		mutatePacketOut := func(packetOutBuilder binding.PacketOutBuilder) binding.PacketOutBuilder {
-			return packetOutBuilder.AddLoadRegMark(openflow.CustomReasonDNSRegMark)
-		}
 		return f.ofClient.SendUDPPacketOut(
 			pktIn.Data.HWSrc.String(),
 			pktIn.Data.HWDst.String(),
@@ -830,5 +1115,19 @@ func (f *fqdnController) sendDNSPacketout(pktIn *ofctrl.PacketIn) error {
 			packetData,
 			mutatePacketOut)
 	}
+	if prot == protocol.Type_TCP {
+		// The TCP segment is forwarded unmodified, so its checksum is still valid.
+		return f.ofClient.SendIPPacketOut(
+			pktIn.Data.HWSrc.String(),
+			pktIn.Data.HWDst.String(),
+			srcIP,
+			dstIP,
+			uint32(config.HostGatewayOFPort),
+			0,
+			isIPv6,
+			prot,
+			l4Packet,
+			mutatePacketOut)
+	}
 	return nil
 }
@@ -0,0 +1,190 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	tcpFlagFIN = 0x01
+	tcpFlagRST = 0x04
+
+	tcpMinHeaderLen = 20
+	// tcpDNSStreamIdleTimeout is the duration after which the stream of a DNS over TCP connection
+	// is removed if no segment was received for it.
+	tcpDNSStreamIdleTimeout = dnsRequestTimeout
+)
+
+// tcpDNSConnKey identifies the direction of a DNS over TCP connection in which DNS responses are
+// sent, i.e. from the DNS server to the client.
+type tcpDNSConnKey struct {
+	srcIP   string
+	dstIP   string
+	srcPort uint16
+	dstPort uint16
+}
+
+// tcpDNSStream stores the payload received from a DNS over TCP connection which doesn't form a
+// complete DNS message yet.
+type tcpDNSStream struct {
+	// nextSeq is the sequence number of the next byte expected from the DNS server.
+	nextSeq  uint32
+	buf      []byte
+	lastSeen time.Time
+}
+
+// tcpDNSReassembler reassembles the DNS responses sent over TCP connections. Over TCP, each DNS
+// message is prefixed with a two-byte length field, a message can span multiple segments and a
+// segment can carry multiple messages (RFC 7766). Only the segments which complete DNS messages
+// must be held until the FQDN rules are realized, as the client cannot use the responses before
+// receiving them. The reassembly is best-effort: a segment received out of order resets the stream
+// of its connection, and the following messages of the connection are not parsed.
+type tcpDNSReassembler struct {
+	mutex   sync.Mutex
+	clock   clock.Clock
+	streams map[tcpDNSConnKey]*tcpDNSStream
+}
+
+func newTCPDNSReassembler(clock clock.Clock) *tcpDNSReassembler {
+	return &tcpDNSReassembler{
+		clock:   clock,
+		streams: map[tcpDNSConnKey]*tcpDNSStream{},
+	}
+}
+
+// parseTCPSegment returns the ports, sequence number, flags and payload of a TCP segment.
+func parseTCPSegment(segment []byte) (srcPort, dstPort uint16, seq uint32, flags uint8, payload []byte, err error) {
+	if len(segment) < tcpMinHeaderLen {
+		return 0, 0, 0, 0, nil, fmt.Errorf("TCP segment is too short: %d bytes", len(segment))
+	}
+	headerLen := int(segment[12]>>4) * 4
+	if headerLen < tcpMinHeaderLen || headerLen > len(segment) {
+		return 0, 0, 0, 0, nil, fmt.Errorf("invalid TCP header length: %d bytes", headerLen)
+	}
+	srcPort = binary.BigEndian.Uint16(segment[0:2])
+	dstPort = binary.BigEndian.Uint16(segment[2:4])
+	seq = binary.BigEndian.Uint32(segment[4:8])
+	flags = segment[13]
+	return srcPort, dstPort, seq, flags, segment[headerLen:], nil
+}
+
+// addSegment adds a TCP segment sent from srcIP to dstIP, and returns the DNS messages completed by
+// the segment. The returned rollback function reverts the stream of the connection to its state
+// before the segment was added, so that the segment can be processed again when it is
+// retransmitted.
+func (r *tcpDNSReassembler) addSegment(srcIP, dstIP net.IP, segment []byte) ([]*dns.Msg, func(), error) {
+	srcPort, dstPort, seq, flags, payload, err := parseTCPSegment(segment)
+	if err != nil {
+		return nil, nil, err
+	}
+	key := tcpDNSConnKey{srcIP: srcIP.String(), dstIP: dstIP.String(), srcPort: srcPort, dstPort: dstPort}
+	now := r.clock.Now()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.removeIdleStreams(now)
+	if flags&tcpFlagRST != 0 {
+		delete(r.streams, key)
+		return nil, nil, nil
+	}
+	stream, exists := r.streams[key]
+	var oldStream *tcpDNSStream
+	if exists {
+		copied := *stream
+		oldStream = &copied
+	}
+	rollback := func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		if oldStream != nil {
+			r.streams[key] = oldStream
+		} else {
+			delete(r.streams, key)
+		}
+	}
+
+	var dnsMsgs []*dns.Msg
+	if len(payload) > 0 {
+		if !exists {
+			stream = &tcpDNSStream{nextSeq: seq}
+			r.streams[key] = stream
+		}
+		stream.lastSeen = now
+		// offset is the number of bytes of the payload which were already received, which is
+		// negative if some bytes before the segment are missing. The subtraction handles the
+		// wrapping of sequence numbers.
+		offset := int32(stream.nextSeq - seq)
+		if offset < 0 {
+			klog.V(2).InfoS("Missing TCP segment in DNS response stream, resetting stream", "srcIP", key.srcIP, "srcPort", srcPort, "dstIP", key.dstIP, "dstPort", dstPort)
+			delete(r.streams, key)
+		} else if int(offset) < len(payload) {
+			stream.buf = append(stream.buf, payload[offset:]...)
+			stream.nextSeq = seq + uint32(len(payload))
+			if dnsMsgs, err = stream.extractMessages(); err != nil {
+				// The stream may not start at the beginning of a DNS message, for example
+				// if the connection was established before the agent started. The messages
+				// of the connection cannot be parsed, so the segments are forwarded as is.
+				klog.V(2).InfoS("Failed to parse DNS response stream, resetting stream", "srcIP", key.srcIP, "srcPort", srcPort, "dstIP", key.dstIP, "dstPort", dstPort, "err", err)
+				delete(r.streams, key)
+				dnsMsgs = nil
+			}
+		}
+	}
+	if flags&tcpFlagFIN != 0 {
+		delete(r.streams, key)
+	}
+	if len(dnsMsgs) == 0 {
+		return nil, nil, nil
+	}
+	return dnsMsgs, rollback, nil
+}
+
+// extractMessages removes the complete DNS messages from the buffer of the stream and returns them.
+func (s *tcpDNSStream) extractMessages() ([]*dns.Msg, error) {
+	var dnsMsgs []*dns.Msg
+	for len(s.buf) >= 2 {
+		msgLen := int(binary.BigEndian.Uint16(s.buf[0:2]))
+		if len(s.buf) < 2+msgLen {
+			break
+		}
+		dnsMsg := new(dns.Msg)
+		if err := dnsMsg.Unpack(s.buf[2 : 2+msgLen]); err != nil {
+			return nil, err
+		}
+		dnsMsgs = append(dnsMsgs, dnsMsg)
+		s.buf = s.buf[2+msgLen:]
+	}
+	return dnsMsgs, nil
+}
+
+// removeIdleStreams removes the streams of the connections which didn't receive any segment for
+// tcpDNSStreamIdleTimeout. The connections may have been closed without the FIN or RST segments
+// being intercepted.
+func (r *tcpDNSReassembler) removeIdleStreams(now time.Time) {
+	for key, stream := range r.streams {
+		if now.Sub(stream.lastSeen) > tcpDNSStreamIdleTimeout {
+			delete(r.streams, key)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var (
+	testDNSServerIP = net.ParseIP("10.96.0.10")
+	testDNSClientIP = net.ParseIP("10.10.0.2")
+)
+
+// newTestTCPSegment builds a TCP segment sent by the DNS server to the client.
+func newTestTCPSegment(seq uint32, flags uint8, payload []byte) []byte {
+	segment := make([]byte, tcpMinHeaderLen, tcpMinHeaderLen+len(payload))
+	binary.BigEndian.PutUint16(segment[0:2], 53)
+	binary.BigEndian.PutUint16(segment[2:4], 34567)
+	binary.BigEndian.PutUint32(segment[4:8], seq)
+	segment[12] = (tcpMinHeaderLen / 4) << 4
+	segment[13] = flags | 0x10
+	return append(segment, payload...)
+}
+
+// newTestTCPDNSMessage returns a DNS response for fqdn in the DNS over TCP format, i.e. prefixed
+// with its length.
+func newTestTCPDNSMessage(t *testing.T, fqdn string, ip string) []byte {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(fqdn), dns.TypeA)
+	msg.Response = true
+	msg.Answer = append(msg.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: dns.Fqdn(fqdn), Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60},
+		A:   net.ParseIP(ip),
+	})
+	packed, err := msg.Pack()
+	require.NoError(t, err)
+	data := make([]byte, 2, 2+len(packed))
+	binary.BigEndian.PutUint16(data, uint16(len(packed)))
+	return append(data, packed...)
+}
+
+func TestParseTCPSegment(t *testing.T) {
+	srcPort, dstPort, seq, flags, payload, err := parseTCPSegment(newTestTCPSegment(100, tcpFlagFIN, []byte{0x1, 0x2}))
+	require.NoError(t, err)
+	assert.Equal(t, uint16(53), srcPort)
+	assert.Equal(t, uint16(34567), dstPort)
+	assert.Equal(t, uint32(100), seq)
+	assert.Equal(t, uint8(tcpFlagFIN|0x10), flags)
+	assert.Equal(t, []byte{0x1, 0x2}, payload)
+
+	_, _, _, _, _, err = parseTCPSegment(make([]byte, 10))
+	assert.Error(t, err)
+	invalidHeaderLen := newTestTCPSegment(100, 0, nil)
+	invalidHeaderLen[12] = 15 << 4
+	_, _, _, _, _, err = parseTCPSegment(invalidHeaderLen)
+	assert.Error(t, err)
+}
+
+func TestTCPDNSReassembler(t *testing.T) {
+	msg1 := newTestTCPDNSMessage(t, "www.antrea.io", "10.0.0.1")
+	msg2 := newTestTCPDNSMessage(t, "docs.antrea.io", "10.0.0.2")
+	fqdns := func(msgs []*dns.Msg) []string {
+		var names []string
+		for _, msg := range msgs {
+			names = append(names, msg.Question[0].Name)
+		}
+		return names
+	}
+
+	t.Run("message split across segments", func(t *testing.T) {
+		r := newTCPDNSReassembler(clocktesting.NewFakeClock(time.Now()))
+		msgs, rollback, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000, 0, msg1[:10]))
+		require.NoError(t, err)
+		assert.Empty(t, msgs)
+		assert.Nil(t, rollback)
+		msgs, rollback, err = r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1010, 0, msg1[10:]))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"www.antrea.io."}, fqdns(msgs))
+		assert.NotNil(t, rollback)
+	})
+
+	t.Run("multiple messages in a segment", func(t *testing.T) {
+		r := newTCPDNSReassembler(clocktesting.NewFakeClock(time.Now()))
+		payload := append(append([]byte{}, msg1...), msg2[:5]...)
+		msgs, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000, 0, payload))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"www.antrea.io."}, fqdns(msgs))
+		msgs, _, err = r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000+uint32(len(payload)), tcpFlagFIN, msg2[5:]))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"docs.antrea.io."}, fqdns(msgs))
+		assert.Empty(t, r.streams)
+	})
+
+	t.Run("retransmission after rollback", func(t *testing.T) {
+		r := newTCPDNSReassembler(clocktesting.NewFakeClock(time.Now()))
+		segment := newTestTCPSegment(1000, 0, msg1)
+		msgs, rollback, err := r.addSegment(testDNSServerIP, testDNSClientIP, segment)
+		require.NoError(t, err)
+		require.Len(t, msgs, 1)
+		// Without rollback, the retransmitted segment doesn't complete any new message.
+		msgs, _, err = r.addSegment(testDNSServerIP, testDNSClientIP, segment)
+		require.NoError(t, err)
+		assert.Empty(t, msgs)
+		rollback()
+		msgs, _, err = r.addSegment(testDNSServerIP, testDNSClientIP, segment)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"www.antrea.io."}, fqdns(msgs))
+	})
+
+	t.Run("sequence number wrapping", func(t *testing.T) {
+		r := newTCPDNSReassembler(clocktesting.NewFakeClock(time.Now()))
+		seq := uint32(0xfffffffa)
+		_, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(seq, 0, msg1[:10]))
+		require.NoError(t, err)
+		msgs, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(seq+10, 0, msg1[10:]))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"www.antrea.io."}, fqdns(msgs))
+	})
+
+	t.Run("missing segment", func(t *testing.T) {
+		r := newTCPDNSReassembler(clocktesting.NewFakeClock(time.Now()))
+		_, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000, 0, msg1[:10]))
+		require.NoError(t, err)
+		msgs, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1020, 0, msg1[20:]))
+		require.NoError(t, err)
+		assert.Empty(t, msgs)
+		assert.Empty(t, r.streams)
+	})
+
+	t.Run("reset and idle connections", func(t *testing.T) {
+		fakeClock := clocktesting.NewFakeClock(time.Now())
+		r := newTCPDNSReassembler(fakeClock)
+		_, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000, 0, msg1[:10]))
+		require.NoError(t, err)
+		require.Len(t, r.streams, 1)
+		_, _, err = r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1010, tcpFlagRST, nil))
+		require.NoError(t, err)
+		assert.Empty(t, r.streams)
+
+		_, _, err = r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000, 0, msg1[:10]))
+		require.NoError(t, err)
+		fakeClock.Step(tcpDNSStreamIdleTimeout + time.Second)
+		_, _, err = r.addSegment(testDNSClientIP, testDNSServerIP, newTestTCPSegment(1, 0, nil))
+		require.NoError(t, err)
+		assert.Empty(t, r.streams)
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		r := newTCPDNSReassembler(clocktesting.NewFakeClock(time.Now()))
+		msgs, _, err := r.addSegment(testDNSServerIP, testDNSClientIP, newTestTCPSegment(1000, 0, []byte{0x0, 0x2, 0xff, 0xff}))
+		require.NoError(t, err)
+		assert.Empty(t, msgs)
+		assert.Empty(t, r.streams)
+	})
+}
@@ -16,15 +16,20 @@ package networkpolicy
 
 import (
 	"context"
+	"net"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/querier"
 )
 
 func newMockFQDNController(t *testing.T, controller *gomock.Controller, dnsServer *string) (*fqdnController, *openflowtest.MockClient) {
@@ -38,7 +43,7 @@ func newMockFQDNController(t *testing.T, controller *gomock.Controller, dnsServe
 	f, err := newFQDNController(
 		mockOFClient,
 		newIDAllocator(testAsyncDeleteInterval),
-		dnsServerAddr,
+		FQDNConfig{DNSServerOverride: dnsServerAddr},
 		dirtyRuleHandler,
 		true,
 		false,
@@ -303,3 +308,274 @@ func TestLookupIPFallback(t *testing.T) {
 	err := f.lookupIP(ctx, "www.google.com")
 	require.NoError(t, err, "Error when resolving name")
 }
+
+func TestGetAndFlushFQDNCache(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	f, c := newMockFQDNController(t, controller, nil)
+	c.EXPECT().AddAddressToDNSConjunction(dnsInterceptRuleID, gomock.Any()).Times(2)
+	dirtyRules := sets.NewString()
+	f.dirtyRuleHandler = func(ruleID string) {
+		dirtyRules.Insert(ruleID)
+	}
+	require.NoError(t, f.addFQDNRule("mockRule1", []string{"test.antrea.io"}, sets.NewInt32(1)))
+	require.NoError(t, f.addFQDNRule("mockRule2", []string{"*antrea.io"}, sets.NewInt32(2)))
+
+	lookupTime := time.Now()
+	expirationTime := lookupTime.Add(600 * time.Second)
+	f.onDNSResponse("test.antrea.io", map[string]net.IP{"10.0.0.2": net.ParseIP("10.0.0.2"), "10.0.0.1": net.ParseIP("10.0.0.1")}, 600, lookupTime, nil)
+	f.onDNSResponse("www.antrea.io", map[string]net.IP{"10.0.0.3": net.ParseIP("10.0.0.3")}, 600, lookupTime, nil)
+	// A FQDN which is not selected by any rule is not tracked.
+	f.onDNSResponse("www.google.com", map[string]net.IP{"10.0.0.4": net.ParseIP("10.0.0.4")}, 600, lookupTime, nil)
+
+	testEntry := querier.FQDNCacheEntry{
+		FQDN:           "test.antrea.io",
+		IPs:            []string{"10.0.0.1", "10.0.0.2"},
+		ExpirationTime: &expirationTime,
+		Selectors:      []string{"matchName:test.antrea.io", "matchRegex:^.*antrea[.]io$"},
+		Rules:          []string{"mockRule1", "mockRule2"},
+	}
+	wwwEntry := querier.FQDNCacheEntry{
+		FQDN:           "www.antrea.io",
+		IPs:            []string{"10.0.0.3"},
+		ExpirationTime: &expirationTime,
+		Selectors:      []string{"matchRegex:^.*antrea[.]io$"},
+		Rules:          []string{"mockRule2"},
+	}
+	assert.Equal(t, []querier.FQDNCacheEntry{testEntry, wwwEntry}, f.getFQDNCache(""))
+	assert.Equal(t, []querier.FQDNCacheEntry{wwwEntry}, f.getFQDNCache("WWW.antrea.io"))
+	assert.Equal(t, []querier.FQDNCacheEntry{testEntry, wwwEntry}, f.getFQDNCache("*.antrea.io"))
+	assert.Empty(t, f.getFQDNCache("*.google.com"))
+
+	dirtyRules = sets.NewString()
+	assert.Equal(t, 1, f.flushFQDNCache("www.antrea.io"))
+	assert.Equal(t, sets.NewString("mockRule2"), dirtyRules)
+	// The FQDN is only selected by a matchRegex selector, it is no longer tracked.
+	assert.Equal(t, []querier.FQDNCacheEntry{testEntry}, f.getFQDNCache(""))
+	assert.Equal(t, sets.NewString("test.antrea.io"), f.selectorItemToFQDN[fqdnSelectorItem{matchRegex: "^.*antrea[.]io$"}])
+
+	dirtyRules = sets.NewString()
+	assert.Equal(t, 1, f.flushFQDNCache(""))
+	assert.Equal(t, sets.NewString("mockRule1", "mockRule2"), dirtyRules)
+	// The FQDN is still tracked as it's selected by a matchName selector, but its IPs are flushed.
+	assert.Equal(t, []querier.FQDNCacheEntry{{
+		FQDN:      "test.antrea.io",
+		Selectors: []string{"matchName:test.antrea.io"},
+		Rules:     []string{"mockRule1"},
+	}}, f.getFQDNCache(""))
+	assert.Empty(t, f.getIPsForFQDNSelectors([]string{"test.antrea.io", "*antrea.io"}))
+	assert.Equal(t, 0, f.flushFQDNCache(""))
+}
+
+func TestGetFQDNsForIP(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	f, c := newMockFQDNController(t, controller, nil)
+	c.EXPECT().AddAddressToDNSConjunction(dnsInterceptRuleID, gomock.Any()).Times(1)
+	f.dirtyRuleHandler = func(string) {}
+	require.NoError(t, f.addFQDNRule("mockRule1", []string{"*antrea.io"}, sets.NewInt32(1)))
+
+	lookupTime := time.Now()
+	f.onDNSResponse("test.antrea.io", map[string]net.IP{"10.0.0.1": net.ParseIP("10.0.0.1"), "10.0.0.2": net.ParseIP("10.0.0.2")}, 600, lookupTime, nil)
+	f.onDNSResponse("www.antrea.io", map[string]net.IP{"10.0.0.2": net.ParseIP("10.0.0.2")}, 600, lookupTime, nil)
+	assert.Equal(t, []string{"test.antrea.io"}, f.getFQDNsForIP(net.ParseIP("10.0.0.1")))
+	assert.Equal(t, []string{"test.antrea.io", "www.antrea.io"}, f.getFQDNsForIP(net.ParseIP("10.0.0.2")))
+	assert.Empty(t, f.getFQDNsForIP(net.ParseIP("10.0.0.3")))
+
+	// The IP which is still valid is kept when the FQDN is resolved to a new IP.
+	f.onDNSResponse("www.antrea.io", map[string]net.IP{"10.0.0.3": net.ParseIP("10.0.0.3")}, 600, lookupTime.Add(time.Second), nil)
+	assert.Equal(t, []string{"test.antrea.io", "www.antrea.io"}, f.getFQDNsForIP(net.ParseIP("10.0.0.2")))
+	assert.Equal(t, []string{"www.antrea.io"}, f.getFQDNsForIP(net.ParseIP("10.0.0.3")))
+
+	assert.Equal(t, 1, f.flushFQDNCache("test.antrea.io"))
+	assert.Empty(t, f.getFQDNsForIP(net.ParseIP("10.0.0.1")))
+	assert.Equal(t, []string{"www.antrea.io"}, f.getFQDNsForIP(net.ParseIP("10.0.0.2")))
+
+	c.EXPECT().DeleteAddressFromDNSConjunction(dnsInterceptRuleID, gomock.Any()).Times(1)
+	require.NoError(t, f.deleteFQDNRule("mockRule1", []string{"*antrea.io"}))
+	assert.Empty(t, f.getFQDNsForIP(net.ParseIP("10.0.0.2")))
+	assert.Empty(t, f.ipToFQDNs)
+}
+
+func ipStrings(ips []net.IP) sets.String {
+	ipStrs := sets.NewString()
+	for _, ip := range ips {
+		ipStrs.Insert(ip.String())
+	}
+	return ipStrs
+}
+
+func TestOnDNSResponseIPExpiration(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	f, c := newMockFQDNController(t, controller, nil)
+	c.EXPECT().AddAddressToDNSConjunction(dnsInterceptRuleID, gomock.Any()).Times(1)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	f.clock = fakeClock
+	f.minTTL = 30
+	f.ipExpirationGracePeriod = 60 * time.Second
+	dirtyRules := sets.NewString()
+	f.dirtyRuleHandler = func(ruleID string) {
+		dirtyRules.Insert(ruleID)
+	}
+	require.NoError(t, f.addFQDNRule("mockRule1", []string{"test.antrea.io"}, sets.NewInt32(1)))
+	startTime := fakeClock.Now()
+
+	// The TTL of the records is lower than minTTL.
+	f.onDNSResponse("test.antrea.io", map[string]net.IP{"10.0.0.1": net.ParseIP("10.0.0.1")}, 5, fakeClock.Now(), nil)
+	assert.Equal(t, sets.NewString("10.0.0.1"), ipStrings(f.getIPsForFQDNSelectors([]string{"test.antrea.io"})))
+	assert.Equal(t, startTime.Add(30*time.Second), f.dnsEntryCache["test.antrea.io"].expirationTime)
+	assert.Equal(t, sets.NewString("mockRule1"), dirtyRules)
+
+	// The record rotates: the previous IP is kept during the grace period after its expiration.
+	dirtyRules = sets.NewString()
+	fakeClock.Step(30 * time.Second)
+	f.onDNSResponse("test.antrea.io", map[string]net.IP{"10.0.0.2": net.ParseIP("10.0.0.2")}, 300, fakeClock.Now(), nil)
+	assert.Equal(t, sets.NewString("10.0.0.1", "10.0.0.2"), ipStrings(f.getIPsForFQDNSelectors([]string{"test.antrea.io"})))
+	// The FQDN is queried again when the previous IP becomes stale.
+	assert.Equal(t, startTime.Add(90*time.Second), f.dnsEntryCache["test.antrea.io"].expirationTime)
+	assert.Equal(t, sets.NewString("mockRule1"), dirtyRules)
+
+	// The previous IP is still in the grace period.
+	dirtyRules = sets.NewString()
+	fakeClock.Step(59 * time.Second)
+	f.onDNSResponse("test.antrea.io", map[string]net.IP{"10.0.0.2": net.ParseIP("10.0.0.2")}, 300, fakeClock.Now(), nil)
+	assert.Equal(t, sets.NewString("10.0.0.1", "10.0.0.2"), ipStrings(f.getIPsForFQDNSelectors([]string{"test.antrea.io"})))
+	assert.Empty(t, dirtyRules)
+
+	// The grace period of the previous IP elapsed.
+	fakeClock.Step(time.Second)
+	f.onDNSResponse("test.antrea.io", map[string]net.IP{"10.0.0.2": net.ParseIP("10.0.0.2")}, 300, fakeClock.Now(), nil)
+	assert.Equal(t, sets.NewString("10.0.0.2"), ipStrings(f.getIPsForFQDNSelectors([]string{"test.antrea.io"})))
+	assert.Equal(t, fakeClock.Now().Add(300*time.Second), f.dnsEntryCache["test.antrea.io"].expirationTime)
+	assert.Equal(t, sets.NewString("mockRule1"), dirtyRules)
+}
+
+// stubDNSServer is a DNS server listening on the loopback interface, which answers A queries with
+// the IPs configured for each FQDN. It serves queries over both UDP and TCP.
+type stubDNSServer struct {
+	mutex sync.Mutex
+	// records stores the IPs returned for each FQDN.
+	records map[string][]string
+	ttl     uint32
+	// truncateUDP makes the server set the truncated flag in the responses sent over UDP,
+	// without any answer, so that the clients retry over TCP.
+	truncateUDP bool
+	tcpQueries  int
+
+	addr      string
+	udpServer *dns.Server
+	tcpServer *dns.Server
+}
+
+func newStubDNSServer(t *testing.T, ttl uint32, truncateUDP bool) *stubDNSServer {
+	s := &stubDNSServer{records: map[string][]string{}, ttl: ttl, truncateUDP: truncateUDP}
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s.addr = packetConn.LocalAddr().String()
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		packetConn.Close()
+		require.NoError(t, err)
+	}
+	s.udpServer = &dns.Server{PacketConn: packetConn, Handler: s}
+	s.tcpServer = &dns.Server{Listener: listener, Handler: s}
+	for _, server := range []*dns.Server{s.udpServer, s.tcpServer} {
+		started := make(chan struct{})
+		server.NotifyStartedFunc = func() { close(started) }
+		go server.ActivateAndServe()
+		<-started
+	}
+	t.Cleanup(func() {
+		s.udpServer.Shutdown()
+		s.tcpServer.Shutdown()
+	})
+	return s
+}
+
+func (s *stubDNSServer) setRecords(fqdn string, ips ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.records[dns.Fqdn(fqdn)] = ips
+}
+
+func (s *stubDNSServer) getTCPQueries() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.tcpQueries
+}
+
+func (s *stubDNSServer) ServeDNS(w dns.ResponseWriter, r *dns.Msg) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	m := new(dns.Msg)
+	m.SetReply(r)
+	isTCP := w.LocalAddr().Network() == "tcp"
+	if isTCP {
+		s.tcpQueries++
+	}
+	if s.truncateUDP && !isTCP {
+		m.Truncated = true
+		w.WriteMsg(m)
+		return
+	}
+	for _, q := range r.Question {
+		if q.Qtype != dns.TypeA {
+			continue
+		}
+		for _, ip := range s.records[q.Name] {
+			m.Answer = append(m.Answer, &dns.A{
+				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: s.ttl},
+				A:   net.ParseIP(ip),
+			})
+		}
+	}
+	w.WriteMsg(m)
+}
+
+func TestDNSRecordRotation(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		truncateUDP bool
+	}{
+		{name: "UDP", truncateUDP: false},
+		{name: "TCP fallback", truncateUDP: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newStubDNSServer(t, 10, tc.truncateUDP)
+			server.setRecords("www.antrea.io", "10.0.0.1", "10.0.0.2")
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+			f, c := newMockFQDNController(t, controller, &server.addr)
+			c.EXPECT().AddAddressToDNSConjunction(dnsInterceptRuleID, gomock.Any()).Times(1)
+			fakeClock := clocktesting.NewFakeClock(time.Now())
+			f.clock = fakeClock
+			f.ipExpirationGracePeriod = 30 * time.Second
+			require.NoError(t, f.addFQDNRule("mockRule1", []string{"*.antrea.io"}, sets.NewInt32(1)))
+
+			makeDNSRequest := func() sets.String {
+				ctx, cancel := context.WithTimeout(context.Background(), dnsRequestTimeout)
+				defer cancel()
+				require.NoError(t, f.makeDNSRequest(ctx, "www.antrea.io"))
+				return ipStrings(f.getIPsForFQDNSelectors([]string{"*.antrea.io"}))
+			}
+			assert.Equal(t, sets.NewString("10.0.0.1", "10.0.0.2"), makeDNSRequest())
+
+			// One of the records rotates after its TTL expired.
+			server.setRecords("www.antrea.io", "10.0.0.2", "10.0.0.3")
+			fakeClock.Step(11 * time.Second)
+			assert.Equal(t, sets.NewString("10.0.0.1", "10.0.0.2", "10.0.0.3"), makeDNSRequest())
+
+			// The rotated IP is removed once the grace period elapsed.
+			fakeClock.Step(30 * time.Second)
+			assert.Equal(t, sets.NewString("10.0.0.2", "10.0.0.3"), makeDNSRequest())
+
+			if tc.truncateUDP {
+				assert.Equal(t, 3, server.getTCPQueries())
+			} else {
+				assert.Equal(t, 0, server.getTCPQueries())
+			}
+		})
+	}
+}
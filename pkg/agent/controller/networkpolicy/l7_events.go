@@ -0,0 +1,123 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	// l7EveSocketPath is the Unix domain socket to which the layer 7 engine streams its events.
+	l7EveSocketPath = "/var/run/antrea/suricata-eve.sock"
+	// l7NetworkPolicyTableName is used as table name when logging the traffic handled by the
+	// layer 7 engine, as it's not processed by any OVS table.
+	l7NetworkPolicyTableName = "L7NetworkPolicy"
+)
+
+var l7ProtocolNumbers = map[string]uint8{
+	"TCP":  6,
+	"UDP":  17,
+	"SCTP": 132,
+}
+
+// handleL7Event handles an event generated by the layer 7 engine: it logs the HTTP requests
+// allowed or rejected by rules with logging enabled, and reports the rejected connections to
+// the flow exporter.
+func (c *Controller) handleL7Event(event *l7engine.EveEvent) {
+	var disposition string
+	switch {
+	case event.IsReject():
+		disposition = openflow.DispositionToString[openflow.DispositionRej]
+	case event.EventType == "http":
+		disposition = openflow.DispositionToString[openflow.DispositionAllow]
+	default:
+		return
+	}
+	ruleID, exists := c.l7VlanIDAllocator.getRuleID(event.VlanID())
+	if !exists {
+		klog.V(4).InfoS("Cannot find L7 NetworkPolicy rule for event", "vlan", event.VlanID())
+		return
+	}
+	rule, _, _ := c.ruleCache.GetCompletedRule(ruleID)
+	if rule == nil {
+		// The rule must have been deleted or updated.
+		klog.V(4).InfoS("Cannot find L7 NetworkPolicy rule", "rule", ruleID)
+		return
+	}
+
+	if c.antreaPolicyLogger != nil && rule.EnableLogging {
+		ob := &logInfo{
+			tableName:   l7NetworkPolicyTableName,
+			npRef:       rule.SourceRef.ToString(),
+			disposition: disposition,
+			ofPriority:  "<nil>",
+			srcIP:       event.SrcIP,
+			srcPort:     strconv.Itoa(int(event.SrcPort)),
+			destIP:      event.DestIP,
+			destPort:    strconv.Itoa(int(event.DestPort)),
+			protocolStr: strings.ToUpper(event.Proto),
+		}
+		c.setPolicyRuleInfo(ob, rule.SourceRef, rule.Name, rule.Direction)
+		c.setPodInfo(ob)
+		c.antreaPolicyLogger.LogDedupPacket(ob)
+	}
+	if event.IsReject() && c.denyConnStore != nil {
+		c.storeL7DenyConnection(event, rule, disposition)
+	}
+}
+
+// storeL7DenyConnection adds the connection rejected by the layer 7 engine to the deny
+// connection store of the flow exporter.
+func (c *Controller) storeL7DenyConnection(event *l7engine.EveEvent, rule *CompletedRule, disposition string) {
+	tuple := flowexporter.Tuple{
+		SourceAddress:      net.ParseIP(event.SrcIP),
+		DestinationAddress: net.ParseIP(event.DestIP),
+		SourcePort:         event.SrcPort,
+		DestinationPort:    event.DestPort,
+		Protocol:           l7ProtocolNumbers[strings.ToUpper(event.Proto)],
+	}
+	denyConn := flowexporter.Connection{}
+	denyConn.FlowKey = tuple
+	denyConn.DestinationServiceAddress = tuple.DestinationAddress
+	denyConn.DestinationServicePort = tuple.DestinationPort
+	if conn, exist := c.denyConnStore.GetConnByKey(flowexporter.NewConnectionKey(&denyConn)); exist {
+		c.denyConnStore.AddOrUpdateConn(conn, time.Now(), 0)
+		return
+	}
+	if rule.Direction == v1beta2.DirectionIn {
+		denyConn.IngressNetworkPolicyName = rule.SourceRef.Name
+		denyConn.IngressNetworkPolicyNamespace = rule.SourceRef.Namespace
+		denyConn.IngressNetworkPolicyType = flowexporter.PolicyTypeToUint8(rule.SourceRef.Type)
+		denyConn.IngressNetworkPolicyRuleName = rule.Name
+		denyConn.IngressNetworkPolicyRuleAction = flowexporter.RuleActionToUint8(disposition)
+	} else {
+		denyConn.EgressNetworkPolicyName = rule.SourceRef.Name
+		denyConn.EgressNetworkPolicyNamespace = rule.SourceRef.Namespace
+		denyConn.EgressNetworkPolicyType = flowexporter.PolicyTypeToUint8(rule.SourceRef.Type)
+		denyConn.EgressNetworkPolicyRuleName = rule.Name
+		denyConn.EgressNetworkPolicyRuleAction = flowexporter.RuleActionToUint8(disposition)
+	}
+	c.denyConnStore.AddOrUpdateConn(&denyConn, time.Now(), 0)
+}
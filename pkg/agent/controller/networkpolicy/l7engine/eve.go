@@ -0,0 +1,135 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// Alert is the alert event generated by Suricata when a signature matches a packet.
+type Alert struct {
+	Action      string `json:"action"`
+	SignatureID int    `json:"signature_id"`
+	Signature   string `json:"signature"`
+}
+
+// HTTP is the HTTP metadata of an event generated by Suricata.
+type HTTP struct {
+	Hostname string `json:"hostname"`
+	URL      string `json:"url"`
+	Method   string `json:"http_method"`
+	Status   int    `json:"status"`
+}
+
+// EveEvent is an event in Suricata's Extensible Event Format (EVE).
+type EveEvent struct {
+	Timestamp string   `json:"timestamp"`
+	EventType string   `json:"event_type"`
+	Vlan      []uint32 `json:"vlan"`
+	SrcIP     string   `json:"src_ip"`
+	SrcPort   uint16   `json:"src_port"`
+	DestIP    string   `json:"dest_ip"`
+	DestPort  uint16   `json:"dest_port"`
+	Proto     string   `json:"proto"`
+	AppProto  string   `json:"app_proto"`
+	Alert     *Alert   `json:"alert"`
+	HTTP      *HTTP    `json:"http"`
+}
+
+// VlanID returns the outermost VLAN ID of the event, which identifies the tenant, or 0 if the event has no VLAN.
+func (e *EveEvent) VlanID() uint32 {
+	if len(e.Vlan) == 0 {
+		return 0
+	}
+	return e.Vlan[0]
+}
+
+// IsReject returns whether the event reports a request rejected by a tenant.
+func (e *EveEvent) IsReject() bool {
+	return e.EventType == "alert" && e.Alert != nil && e.Alert.SignatureID == rejectSignatureID
+}
+
+// EventHandler is called for each event received from Suricata.
+type EventHandler func(event *EveEvent)
+
+// EventListener receives the events streamed by Suricata to a Unix domain socket.
+type EventListener struct {
+	socketPath string
+	handler    EventHandler
+}
+
+// NewEventListener returns a new *EventListener which calls handler for each event received on socketPath.
+func NewEventListener(socketPath string, handler EventHandler) *EventListener {
+	return &EventListener{
+		socketPath: socketPath,
+		handler:    handler,
+	}
+}
+
+// Run listens on the socket and handles the events until stopCh is closed. Suricata reconnects to the socket when
+// the connection is lost.
+func (l *EventListener) Run(stopCh <-chan struct{}) {
+	// Remove the stale socket file left by the previous process, otherwise listening on it fails.
+	if err := os.Remove(l.socketPath); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove stale Suricata event socket", "path", l.socketPath)
+	}
+	listener, err := net.Listen("unix", l.socketPath)
+	if err != nil {
+		klog.ErrorS(err, "Failed to listen on Suricata event socket", "path", l.socketPath)
+		return
+	}
+	go func() {
+		<-stopCh
+		listener.Close()
+	}()
+	klog.InfoS("Listening on Suricata event socket", "path", l.socketPath)
+	wait.Until(func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-stopCh:
+			default:
+				klog.ErrorS(err, "Failed to accept connection on Suricata event socket")
+			}
+			return
+		}
+		l.handleConn(conn)
+	}, time.Second, stopCh)
+}
+
+func (l *EventListener) handleConn(conn io.ReadCloser) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var event EveEvent
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			klog.ErrorS(err, "Failed to parse Suricata event", "event", scanner.Text())
+			continue
+		}
+		l.handler(&event)
+	}
+	if err := scanner.Err(); err != nil {
+		klog.ErrorS(err, "Failed to read Suricata events")
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package l7engine manages the application-aware engine (Suricata) which enforces the layer 7 rules of Antrea-native
+// policies. The traffic matching a layer 7 rule is tagged with the VLAN ID allocated for the rule and redirected to the
+// engine by OVS. Suricata runs in IPS mode with multi-tenancy enabled, using the VLAN ID as tenant selector: each rule
+// is realized as a tenant whose signatures pass the allowed requests and reject all others.
+package l7engine
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	suricataConfigDir         = "/etc/suricata"
+	suricataDefaultConfigPath = "/etc/suricata/suricata.yaml"
+	antreaSuricataConfigName  = "antrea.yaml"
+	suricataCommandSocket     = "/var/run/suricata/suricata-command.socket"
+	// The directory in which Suricata writes the eve log files, relative to the log directory of antrea-agent.
+	suricataLogSubdir = "networkpolicy/l7engine"
+
+	// The signature ID of the rule rejecting the requests which are not allowed by a tenant.
+	rejectSignatureID = 1
+	// The signature IDs of the rules allowing requests start from it.
+	passSignatureIDBase = 2
+
+	actionPass   = "pass"
+	actionReject = "reject"
+
+	// How long to wait before retrying to start Suricata after a failure. The rules are retried by the
+	// NetworkPolicy controller, but Suricata is not started again until the backoff expires.
+	minStartRetryDelay = 5 * time.Second
+	maxStartRetryDelay = 300 * time.Second
+	// The ID of the backoff entry of starting Suricata.
+	startBackoffID = "suricata"
+)
+
+const antreaSuricataConfigTemplate = `%%YAML 1.1
+---
+outputs:
+  - eve-log:
+      enabled: yes
+      filetype: regular
+      filename: %[1]s/eve-%%Y-%%m-%%d.json
+      rotate-interval: day
+      types:
+        - alert:
+            tagged-packets: no
+        - http:
+            extended: yes
+  - eve-log:
+      enabled: yes
+      filetype: unix_stream
+      filename: %[2]s
+      types:
+        - alert
+        - http:
+            extended: yes
+af-packet:
+  - interface: %[3]s
+    threads: auto
+    cluster-id: 80
+    cluster-type: cluster_flow
+    defrag: no
+    use-mmap: yes
+    tpacket-v2: yes
+    checksum-checks: no
+    copy-mode: ips
+    copy-iface: %[4]s
+  - interface: %[4]s
+    threads: auto
+    cluster-id: 81
+    cluster-type: cluster_flow
+    defrag: no
+    use-mmap: yes
+    tpacket-v2: yes
+    checksum-checks: no
+    copy-mode: ips
+    copy-iface: %[3]s
+vlan:
+  use-for-tracking: yes
+multi-detect:
+  enabled: yes
+  selector: vlan
+  loaders: 1
+unix-command:
+  enabled: yes
+  filename: %[5]s
+`
+
+const tenantConfigTemplate = `%%YAML 1.1
+---
+default-rule-path: %s
+rule-files:
+  - %s
+`
+
+var (
+	// Functions and paths which are overridden in unit tests.
+	configDir         = suricataConfigDir
+	defaultConfigPath = suricataDefaultConfigPath
+	execCommand       = runCommand
+)
+
+func runCommand(name string, args ...string) ([]byte, error) {
+	return exec.Command(name, args...).CombinedOutput() // #nosec G204: the arguments are generated by Antrea.
+}
+
+// Reconciler realizes the layer 7 rules of Antrea-native policies in Suricata.
+type Reconciler struct {
+	// eveSocketPath is the path of the Unix domain socket to which Suricata streams the eve events.
+	eveSocketPath string
+	logDir        string
+
+	// startMutex protects started, startErr and startBackoff.
+	startMutex sync.Mutex
+	started    bool
+	// startErr is the error of the last attempt to start Suricata.
+	startErr     error
+	startBackoff *flowcontrol.Backoff
+
+	// tenantsMutex protects tenants.
+	tenantsMutex sync.Mutex
+	// tenants maps the VLAN ID, i.e. the tenant ID, to the ID of the rule realized by the tenant.
+	tenants map[uint32]string
+}
+
+// NewReconciler returns a new *Reconciler. Suricata is started when the first rule is added.
+func NewReconciler(eveSocketPath, logDir string) *Reconciler {
+	return &Reconciler{
+		eveSocketPath: eveSocketPath,
+		logDir:        filepath.Join(logDir, suricataLogSubdir),
+		tenants:       map[uint32]string{},
+		startBackoff:  flowcontrol.NewBackOff(minStartRetryDelay, maxStartRetryDelay),
+	}
+}
+
+func tenantRulesPath(vlanID uint32) string {
+	return filepath.Join(configDir, fmt.Sprintf("antrea-l7-networkpolicy-%d.rules", vlanID))
+}
+
+func tenantConfigPath(vlanID uint32) string {
+	return filepath.Join(configDir, fmt.Sprintf("antrea-tenant-%d.yaml", vlanID))
+}
+
+// AddRule realizes the layer 7 protocols of a rule in Suricata, with the VLAN ID allocated for the rule as tenant ID.
+// It's idempotent, and updates the tenant if it already exists.
+func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol) error {
+	if err := r.startSuricataIfNeeded(); err != nil {
+		return err
+	}
+
+	rules := convertProtocolsToRules(policyName, l7Protocols)
+	if err := os.WriteFile(tenantRulesPath(vlanID), []byte(rules), 0600); err != nil {
+		return fmt.Errorf("failed to write Suricata rules for tenant %d: %w", vlanID, err)
+	}
+	tenantConfig := fmt.Sprintf(tenantConfigTemplate, configDir, filepath.Base(tenantRulesPath(vlanID)))
+	if err := os.WriteFile(tenantConfigPath(vlanID), []byte(tenantConfig), 0600); err != nil {
+		return fmt.Errorf("failed to write Suricata config for tenant %d: %w", vlanID, err)
+	}
+
+	r.tenantsMutex.Lock()
+	defer r.tenantsMutex.Unlock()
+	command := "register-tenant"
+	if _, exists := r.tenants[vlanID]; exists {
+		command = "reload-tenant"
+	}
+	if err := suricataCommand(command, vlanID, tenantConfigPath(vlanID)); err != nil {
+		return err
+	}
+	if command == "register-tenant" {
+		if err := suricataCommand("register-tenant-handler", vlanID, "vlan", vlanID); err != nil {
+			return err
+		}
+	}
+	r.tenants[vlanID] = ruleID
+	klog.InfoS("Realized L7 NetworkPolicy rule in Suricata", "rule", ruleID, "vlan", vlanID)
+	return nil
+}
+
+// DeleteRule removes the tenant realizing the layer 7 protocols of a rule from Suricata.
+func (r *Reconciler) DeleteRule(ruleID string, vlanID uint32) error {
+	r.tenantsMutex.Lock()
+	defer r.tenantsMutex.Unlock()
+	if _, exists := r.tenants[vlanID]; !exists {
+		return nil
+	}
+	if err := suricataCommand("unregister-tenant-handler", vlanID, "vlan", vlanID); err != nil {
+		return err
+	}
+	if err := suricataCommand("unregister-tenant", vlanID); err != nil {
+		return err
+	}
+	for _, path := range []string{tenantRulesPath(vlanID), tenantConfigPath(vlanID)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+	}
+	delete(r.tenants, vlanID)
+	klog.InfoS("Deleted L7 NetworkPolicy rule from Suricata", "rule", ruleID, "vlan", vlanID)
+	return nil
+}
+
+// GetRuleByVlanID returns the ID of the rule realized by the tenant with the provided VLAN ID.
+func (r *Reconciler) GetRuleByVlanID(vlanID uint32) (string, bool) {
+	r.tenantsMutex.Lock()
+	defer r.tenantsMutex.Unlock()
+	ruleID, exists := r.tenants[vlanID]
+	return ruleID, exists
+}
+
+func suricataCommand(command string, args ...interface{}) error {
+	cmd := command
+	for _, arg := range args {
+		cmd = fmt.Sprintf("%s %v", cmd, arg)
+	}
+	output, err := execCommand("suricatasc", "-c", cmd, suricataCommandSocket)
+	if err != nil {
+		return fmt.Errorf("failed to run Suricata command %q: %w, output: %s", cmd, err, string(output))
+	}
+	// suricatasc always exits with 0, the result of the command is in its output.
+	if !bytes.Contains(output, []byte(`"OK"`)) {
+		return fmt.Errorf("Suricata command %q failed: %s", cmd, string(output))
+	}
+	return nil
+}
+
+// startSuricataIfNeeded starts Suricata if it hasn't been started successfully. After a failure, Suricata is started
+// again by the next call after the backoff expires, which doubles after each failure.
+func (r *Reconciler) startSuricataIfNeeded() error {
+	r.startMutex.Lock()
+	defer r.startMutex.Unlock()
+	if r.started {
+		return nil
+	}
+	now := r.startBackoff.Clock.Now()
+	if r.startErr != nil && r.startBackoff.IsInBackOffSinceUpdate(startBackoffID, now) {
+		return fmt.Errorf("not retrying to start Suricata within %v after the last failure: %w", r.startBackoff.Get(startBackoffID), r.startErr)
+	}
+	if err := r.startSuricata(); err != nil {
+		r.startErr = err
+		r.startBackoff.Next(startBackoffID, now)
+		return err
+	}
+	r.started = true
+	r.startErr = nil
+	r.startBackoff.Reset(startBackoffID)
+	return nil
+}
+
+// startSuricata writes the Antrea specific configuration of Suricata, includes it in the default configuration, and
+// starts Suricata as a daemon.
+func (r *Reconciler) startSuricata() error {
+	if err := os.MkdirAll(r.logDir, 0755); err != nil {
+		return fmt.Errorf("failed to create Suricata log directory %s: %w", r.logDir, err)
+	}
+	antreaConfigPath := filepath.Join(configDir, antreaSuricataConfigName)
+	antreaConfig := fmt.Sprintf(antreaSuricataConfigTemplate, r.logDir, r.eveSocketPath,
+		config.L7NetworkPolicyTargetPortName, config.L7NetworkPolicyReturnPortName, suricataCommandSocket)
+	if err := os.WriteFile(antreaConfigPath, []byte(antreaConfig), 0600); err != nil {
+		return fmt.Errorf("failed to write Suricata config %s: %w", antreaConfigPath, err)
+	}
+	defaultConfig, err := os.ReadFile(defaultConfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to read Suricata config %s: %w", defaultConfigPath, err)
+	}
+	includeLine := fmt.Sprintf("include: %s\n", antreaConfigPath)
+	if !bytes.Contains(defaultConfig, []byte(includeLine)) {
+		f, err := os.OpenFile(defaultConfigPath, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open Suricata config %s: %w", defaultConfigPath, err)
+		}
+		defer f.Close()
+		if _, err := f.WriteString("\n" + includeLine); err != nil {
+			return fmt.Errorf("failed to update Suricata config %s: %w", defaultConfigPath, err)
+		}
+	}
+	// Remove the stale command socket left by the previous Suricata process, otherwise Suricata fails to start.
+	if err := os.Remove(suricataCommandSocket); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove stale Suricata command socket: %w", err)
+	}
+	if output, err := execCommand("suricata", "-c", defaultConfigPath, "--af-packet", "-D", "-l", r.logDir); err != nil {
+		return fmt.Errorf("failed to start Suricata: %w, output: %s", err, string(output))
+	}
+	klog.InfoS("Started Suricata", "config", defaultConfigPath)
+	return nil
+}
+
+// convertProtocolsToRules generates the Suricata signatures of a tenant: one pass signature for each layer 7
+// protocol, and a reject signature matching all established connections. As pass signatures take precedence over
+// reject signatures in Suricata, only the requests matching any of the layer 7 protocols are allowed.
+func convertProtocolsToRules(policyName string, l7Protocols []v1beta2.L7Protocol) string {
+	var rules []string
+	sid := passSignatureIDBase
+	for _, protocol := range l7Protocols {
+		if protocol.HTTP != nil {
+			rules = append(rules, fmt.Sprintf(`%s http any any -> any any (msg: "Allow http by %s"; %ssid: %d;)`,
+				actionPass, escapeContent(policyName), convertProtocolHTTP(protocol.HTTP), sid))
+			sid++
+		}
+	}
+	rules = append(rules, fmt.Sprintf(`%s ip any any -> any any (msg: "Reject by %s"; flow: to_server, established; sid: %d;)`,
+		actionReject, escapeContent(policyName), rejectSignatureID))
+	return strings.Join(rules, "\n") + "\n"
+}
+
+// convertProtocolHTTP generates the keywords matching the provided HTTP protocol. An empty HTTP protocol matches
+// all HTTP requests.
+func convertProtocolHTTP(http *v1beta2.HTTPProtocol) string {
+	var keywords []string
+	if http.Path != "" {
+		keywords = append(keywords, fmt.Sprintf(`http.uri; pcre: "/^%s$/";`, convertWildcardToPCRE(http.Path)))
+	}
+	if http.Method != "" {
+		keywords = append(keywords, fmt.Sprintf(`http.method; content: "%s";`, escapeContent(http.Method)))
+	}
+	if http.Host != "" {
+		// The host is normalized to lowercase by Suricata.
+		keywords = append(keywords, fmt.Sprintf(`http.host; pcre: "/^%s$/";`, convertWildcardToPCRE(strings.ToLower(http.Host))))
+	}
+	if len(keywords) == 0 {
+		return ""
+	}
+	return strings.Join(keywords, " ") + " "
+}
+
+var pcreEscaper = strings.NewReplacer(`/`, `\/`, `"`, `\"`, `;`, `\;`)
+
+// convertWildcardToPCRE converts a string which may contain "*" wildcards to a PCRE pattern which can be used in a
+// Suricata signature.
+func convertWildcardToPCRE(s string) string {
+	parts := strings.Split(s, "*")
+	for i := range parts {
+		parts[i] = pcreEscaper.Replace(regexp.QuoteMeta(parts[i]))
+	}
+	return strings.Join(parts, ".*")
+}
+
+var contentEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `;`, `\;`)
+
+// escapeContent escapes the characters which have special meanings in the content of a Suricata signature.
+func escapeContent(s string) string {
+	return contentEscaper.Replace(s)
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/flowcontrol"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+func TestConvertProtocolsToRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		l7Protocols []v1beta2.L7Protocol
+		expected    string
+	}{
+		{
+			name:        "no protocol",
+			l7Protocols: nil,
+			expected: `reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:ns1/np1"; flow: to_server, established; sid: 1;)
+`,
+		},
+		{
+			name: "any http",
+			l7Protocols: []v1beta2.L7Protocol{
+				{HTTP: &v1beta2.HTTPProtocol{}},
+			},
+			expected: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:ns1/np1"; sid: 2;)
+reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:ns1/np1"; flow: to_server, established; sid: 1;)
+`,
+		},
+		{
+			name: "http with host, method and path",
+			l7Protocols: []v1beta2.L7Protocol{
+				{HTTP: &v1beta2.HTTPProtocol{Host: "*.Foo.com", Method: "GET", Path: "/api/v2/*"}},
+				{HTTP: &v1beta2.HTTPProtocol{Method: "POST", Path: "/admin"}},
+			},
+			expected: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:ns1/np1"; http.uri; pcre: "/^\/api\/v2\/.*$/"; http.method; content: "GET"; http.host; pcre: "/^.*\.foo\.com$/"; sid: 2;)
+pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:ns1/np1"; http.uri; pcre: "/^\/admin$/"; http.method; content: "POST"; sid: 3;)
+reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:ns1/np1"; flow: to_server, established; sid: 1;)
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, convertProtocolsToRules("AntreaNetworkPolicy:ns1/np1", tt.l7Protocols))
+		})
+	}
+}
+
+func TestConvertWildcardToPCRE(t *testing.T) {
+	assert.Equal(t, `.*`, convertWildcardToPCRE("*"))
+	assert.Equal(t, `\/a\.b\?c=.*`, convertWildcardToPCRE("/a.b?c=*"))
+	assert.Equal(t, `\/\"\;`, convertWildcardToPCRE(`/";`))
+}
+
+func TestReconcilerAddDeleteRule(t *testing.T) {
+	tempDir := t.TempDir()
+	defer func(originalConfigDir, originalDefaultConfigPath string) {
+		configDir = originalConfigDir
+		defaultConfigPath = originalDefaultConfigPath
+		execCommand = runCommand
+	}(configDir, defaultConfigPath)
+	configDir = tempDir
+	defaultConfigPath = filepath.Join(tempDir, "suricata.yaml")
+	require.NoError(t, os.WriteFile(defaultConfigPath, []byte("%YAML 1.1\n---\n"), 0644))
+	var commands []string
+	execCommand = func(name string, args ...string) ([]byte, error) {
+		commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+		return []byte(`{"message": "done", "return": "OK"}`), nil
+	}
+
+	r := NewReconciler("/var/run/antrea/suricata-eve.sock", filepath.Join(tempDir, "log"))
+	l7Protocols := []v1beta2.L7Protocol{{HTTP: &v1beta2.HTTPProtocol{Method: "GET"}}}
+	require.NoError(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols))
+	require.NoError(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols))
+	ruleID, exists := r.GetRuleByVlanID(1)
+	assert.True(t, exists)
+	assert.Equal(t, "rule1", ruleID)
+	assert.FileExists(t, filepath.Join(tempDir, "antrea-l7-networkpolicy-1.rules"))
+	assert.FileExists(t, filepath.Join(tempDir, "antrea-tenant-1.yaml"))
+	defaultConfig, err := os.ReadFile(defaultConfigPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(defaultConfig), "include: "+filepath.Join(tempDir, antreaSuricataConfigName))
+
+	require.NoError(t, r.DeleteRule("rule1", 1))
+	_, exists = r.GetRuleByVlanID(1)
+	assert.False(t, exists)
+	assert.NoFileExists(t, filepath.Join(tempDir, "antrea-l7-networkpolicy-1.rules"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "antrea-tenant-1.yaml"))
+
+	tenantConfigFile := filepath.Join(tempDir, "antrea-tenant-1.yaml")
+	assert.Equal(t, []string{
+		"suricata -c " + defaultConfigPath + " --af-packet -D -l " + filepath.Join(tempDir, "log", suricataLogSubdir),
+		"suricatasc -c register-tenant 1 " + tenantConfigFile + " " + suricataCommandSocket,
+		"suricatasc -c register-tenant-handler 1 vlan 1 " + suricataCommandSocket,
+		"suricatasc -c reload-tenant 1 " + tenantConfigFile + " " + suricataCommandSocket,
+		"suricatasc -c unregister-tenant-handler 1 vlan 1 " + suricataCommandSocket,
+		"suricatasc -c unregister-tenant 1 " + suricataCommandSocket,
+	}, commands)
+}
+
+func TestReconcilerRetryStartSuricata(t *testing.T) {
+	tempDir := t.TempDir()
+	defer func(originalConfigDir, originalDefaultConfigPath string) {
+		configDir = originalConfigDir
+		defaultConfigPath = originalDefaultConfigPath
+		execCommand = runCommand
+	}(configDir, defaultConfigPath)
+	configDir = tempDir
+	defaultConfigPath = filepath.Join(tempDir, "suricata.yaml")
+	require.NoError(t, os.WriteFile(defaultConfigPath, []byte("%YAML 1.1\n---\n"), 0644))
+	startFailed := true
+	var startCount int
+	execCommand = func(name string, args ...string) ([]byte, error) {
+		if name == "suricata" {
+			startCount++
+			if startFailed {
+				return []byte("af-packet interface not found"), fmt.Errorf("exit status 1")
+			}
+		}
+		return []byte(`{"message": "done", "return": "OK"}`), nil
+	}
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	r := NewReconciler("/var/run/antrea/suricata-eve.sock", filepath.Join(tempDir, "log"))
+	r.startBackoff = flowcontrol.NewFakeBackOff(minStartRetryDelay, maxStartRetryDelay, fakeClock)
+	l7Protocols := []v1beta2.L7Protocol{{HTTP: &v1beta2.HTTPProtocol{Method: "GET"}}}
+	assert.ErrorContains(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols), "failed to start Suricata")
+	assert.Equal(t, 1, startCount)
+
+	// Suricata is not started again until the backoff expires.
+	assert.ErrorContains(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols), "not retrying to start Suricata")
+	assert.Equal(t, 1, startCount)
+	fakeClock.Step(minStartRetryDelay)
+	assert.ErrorContains(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols), "failed to start Suricata")
+	assert.Equal(t, 2, startCount)
+
+	// The backoff is doubled after each failure.
+	startFailed = false
+	fakeClock.Step(minStartRetryDelay)
+	assert.Error(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols))
+	assert.Equal(t, 2, startCount)
+	fakeClock.Step(minStartRetryDelay)
+	require.NoError(t, r.AddRule("rule1", "AntreaNetworkPolicy:ns1/np1", 1, l7Protocols))
+	assert.Equal(t, 3, startCount)
+	_, exists := r.GetRuleByVlanID(1)
+	assert.True(t, exists)
+
+	// Suricata is only started once successfully.
+	require.NoError(t, r.AddRule("rule2", "AntreaNetworkPolicy:ns1/np2", 2, l7Protocols))
+	assert.Equal(t, 3, startCount)
+}
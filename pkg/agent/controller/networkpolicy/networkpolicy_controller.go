@@ -17,6 +17,7 @@ package networkpolicy
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
 	"antrea.io/antrea/pkg/agent/flowexporter/connections"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
@@ -38,6 +40,7 @@ import (
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	"antrea.io/antrea/pkg/querier"
 	"antrea.io/antrea/pkg/util/channel"
+	"antrea.io/antrea/pkg/util/logdir"
 )
 
 const (
@@ -77,6 +80,8 @@ type Controller struct {
 	statusManagerEnabled bool
 	// loggingEnabled indicates where Antrea policy audit logging is enabled.
 	loggingEnabled bool
+	// nodeNetworkPolicyEnabled indicates whether the policies applied to Nodes can be enforced.
+	nodeNetworkPolicyEnabled bool
 	// antreaClientProvider provides interfaces to get antreaClient, which can be
 	// used to watch Antrea AddressGroups, AppliedToGroups, and NetworkPolicies.
 	// We need to get antreaClient dynamically because the apiserver cert can be
@@ -106,6 +111,11 @@ type Controller struct {
 	ifaceStore            interfacestore.InterfaceStore
 	// denyConnStore is for storing deny connections for flow exporter.
 	denyConnStore *connections.DenyConnectionStore
+	// l7VlanIDAllocator allocates the VLAN IDs identifying the rules with layer 7 protocols.
+	// It's nil if L7NetworkPolicy is not enabled.
+	l7VlanIDAllocator *l7VlanIDAllocator
+	// l7EventListener receives the events generated by the layer 7 engine.
+	l7EventListener *l7engine.EventListener
 }
 
 // NewNetworkPolicyController returns a new *Controller.
@@ -120,30 +130,40 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 	antreaProxyEnabled bool,
 	statusManagerEnabled bool,
 	loggingEnabled bool,
+	auditLoggingConfig AuditLoggingConfig,
+	l7NetworkPolicyEnabled bool,
+	nodeNetworkPolicyEnabled bool,
 	asyncRuleDeleteInterval time.Duration,
-	dnsServerOverride string,
+	fqdnConfig FQDNConfig,
 	v4Enabled bool,
 	v6Enabled bool) (*Controller, error) {
 	idAllocator := newIDAllocator(asyncRuleDeleteInterval, dnsInterceptRuleID)
 	c := &Controller{
-		antreaClientProvider: antreaClientGetter,
-		queue:                workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "networkpolicyrule"),
-		ofClient:             ofClient,
-		antreaPolicyEnabled:  antreaPolicyEnabled,
-		antreaProxyEnabled:   antreaProxyEnabled,
-		statusManagerEnabled: statusManagerEnabled,
-		loggingEnabled:       loggingEnabled,
+		antreaClientProvider:     antreaClientGetter,
+		queue:                    workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "networkpolicyrule"),
+		ofClient:                 ofClient,
+		antreaPolicyEnabled:      antreaPolicyEnabled,
+		antreaProxyEnabled:       antreaProxyEnabled,
+		statusManagerEnabled:     statusManagerEnabled,
+		loggingEnabled:           loggingEnabled,
+		nodeNetworkPolicyEnabled: nodeNetworkPolicyEnabled,
 	}
 	if antreaPolicyEnabled {
 		var err error
-		if c.fqdnController, err = newFQDNController(ofClient, idAllocator, dnsServerOverride, c.enqueueRule, v4Enabled, v6Enabled); err != nil {
+		if c.fqdnController, err = newFQDNController(ofClient, idAllocator, fqdnConfig, c.enqueueRule, v4Enabled, v6Enabled); err != nil {
 			return nil, err
 		}
 		if c.ofClient != nil {
 			c.ofClient.RegisterPacketInHandler(uint8(openflow.PacketInReasonNP), "dnsresponse", c.fqdnController)
 		}
 	}
-	c.reconciler = newReconciler(ofClient, ifaceStore, idAllocator, c.fqdnController, groupCounters, v4Enabled, v6Enabled, antreaPolicyEnabled)
+	var l7RuleReconciler *l7engine.Reconciler
+	if antreaPolicyEnabled && l7NetworkPolicyEnabled {
+		l7RuleReconciler = l7engine.NewReconciler(l7EveSocketPath, logdir.GetLogDir())
+		c.l7VlanIDAllocator = newL7VlanIDAllocator()
+		c.l7EventListener = l7engine.NewEventListener(l7EveSocketPath, c.handleL7Event)
+	}
+	c.reconciler = newReconciler(ofClient, ifaceStore, idAllocator, c.fqdnController, groupCounters, l7RuleReconciler, c.l7VlanIDAllocator, v4Enabled, v6Enabled, antreaPolicyEnabled)
 	c.ruleCache = newRuleCache(c.enqueueRule, podUpdateSubscriber, groupIDUpdates)
 	if statusManagerEnabled {
 		c.statusManager = newStatusController(antreaClientGetter, nodeName, c.ruleCache)
@@ -159,7 +179,7 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 		c.ofClient.RegisterPacketInHandler(uint8(openflow.PacketInReasonNP), "networkpolicy", c)
 		if loggingEnabled {
 			// Initiate logger for Antrea Policy audit logging
-			antreaPolicyLogger, err := newAntreaPolicyLogger()
+			antreaPolicyLogger, err := newAntreaPolicyLogger(nodeName, auditLoggingConfig)
 			if err != nil {
 				return nil, err
 			}
@@ -410,6 +430,47 @@ func (c *Controller) GetRuleByFlowID(ruleFlowID uint32) *types.PolicyRule {
 	return rule
 }
 
+// GetFQDNCache returns the FQDNs tracked for FQDN policy rules which match the provided domain.
+// The rules selecting each FQDN are described with the policy they belong to.
+func (c *Controller) GetFQDNCache(domain string) []querier.FQDNCacheEntry {
+	if c.fqdnController == nil {
+		return nil
+	}
+	entries := c.fqdnController.getFQDNCache(domain)
+	for i := range entries {
+		rules := make([]string, 0, len(entries[i].Rules))
+		for _, ruleID := range entries[i].Rules {
+			obj, exists, _ := c.ruleCache.rules.GetByKey(ruleID)
+			if !exists {
+				// The rule is being deleted.
+				rules = append(rules, ruleID)
+				continue
+			}
+			r := obj.(*rule)
+			rules = append(rules, r.SourceRef.ToString()+"/"+r.Name)
+		}
+		entries[i].Rules = rules
+	}
+	return entries
+}
+
+// FlushFQDNCache removes the IPs cached for the FQDNs which match the provided domain.
+func (c *Controller) FlushFQDNCache(domain string) int {
+	if c.fqdnController == nil {
+		return 0
+	}
+	return c.fqdnController.flushFQDNCache(domain)
+}
+
+// GetFQDNsForIP returns the FQDNs tracked for FQDN policy rules which were resolved to the
+// provided IP, in alphabetical order.
+func (c *Controller) GetFQDNsForIP(ip net.IP) []string {
+	if c.fqdnController == nil {
+		return nil
+	}
+	return c.fqdnController.getFQDNsForIP(ip)
+}
+
 func (c *Controller) GetControllerConnectionStatus() bool {
 	// When the watchers are connected, controller connection status is true. Otherwise, it is false.
 	return c.addressGroupWatcher.isConnected() && c.appliedToGroupWatcher.isConnected() && c.networkPolicyWatcher.isConnected()
@@ -452,6 +513,12 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		}
 		go c.fqdnController.runRuleSyncTracker(stopCh)
 	}
+	if c.l7EventListener != nil {
+		go c.l7EventListener.Run(stopCh)
+	}
+	if c.antreaPolicyLogger != nil {
+		go c.antreaPolicyLogger.Run(stopCh)
+	}
 	klog.Infof("Waiting for all watchers to complete full sync")
 	c.fullSyncGroup.Wait()
 	klog.Infof("All watchers have completed full sync, installing flows for init events")
@@ -544,6 +611,17 @@ func (c *Controller) syncRule(key string) error {
 		klog.V(2).InfoS("Rule is not realizable, skipping", "ruleID", key)
 		return nil
 	}
+	// The rule is not realized, so that the policy is not reported as realized on this Node.
+	if rule.isNodeNetworkPolicyRule() && !c.nodeNetworkPolicyEnabled {
+		klog.ErrorS(nil, "Rule is applied to the Node but NodeNetworkPolicy is not enabled, skipping", "ruleID", key, "policy", rule.SourceRef.ToString())
+		if err := c.reconciler.Forget(key); err != nil {
+			return err
+		}
+		if c.statusManagerEnabled {
+			c.statusManager.DeleteRuleRealization(key)
+		}
+		return nil
+	}
 	err := c.reconciler.Reconcile(rule)
 	if c.fqdnController != nil {
 		// No matter whether the rule reconciliation succeeds or not, fqdnController
@@ -578,6 +656,8 @@ func (c *Controller) syncRules(keys []string) error {
 			klog.Infof("Rule %s is not effective on this Node", key)
 		} else if !realizable {
 			klog.Errorf("Rule %s is effective but not realizable", key)
+		} else if rule.isNodeNetworkPolicyRule() && !c.nodeNetworkPolicyEnabled {
+			klog.ErrorS(nil, "Rule is applied to the Node but NodeNetworkPolicy is not enabled, skipping", "ruleID", key, "policy", rule.SourceRef.ToString())
 		} else {
 			allRules = append(allRules, rule)
 		}
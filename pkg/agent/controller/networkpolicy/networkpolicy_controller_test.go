@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"fmt"
+	"net"
 	"strings"
 	"sync"
 	"testing"
@@ -59,7 +60,7 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 	groupIDAllocator := openflow.NewGroupAllocator(false)
 	groupCounters := []proxytypes.GroupCounter{proxytypes.NewGroupCounter(groupIDAllocator, ch2)}
 	controller, _ := NewNetworkPolicyController(&antreaClientGetter{clientset}, nil, nil, "node1", podUpdateChannel, groupCounters, ch2,
-		true, true, true, true, testAsyncDeleteInterval, "8.8.8.8:53", true, false)
+		true, true, true, true, AuditLoggingConfig{}, false, false, testAsyncDeleteInterval, FQDNConfig{DNSServerOverride: "8.8.8.8:53"}, true, false)
 	reconciler := newMockReconciler()
 	controller.reconciler = reconciler
 	controller.antreaPolicyLogger = nil
@@ -203,12 +204,14 @@ func newNetworkPolicyWithMultipleRules(name string, uid types.UID, from, to, app
 func prepareMockTables() {
 	openflow.InitMockTables(
 		map[*openflow.Table]uint8{
-			openflow.AntreaPolicyEgressRuleTable:  uint8(5),
-			openflow.EgressRuleTable:              uint8(6),
-			openflow.EgressDefaultTable:           uint8(7),
-			openflow.AntreaPolicyIngressRuleTable: uint8(12),
-			openflow.IngressRuleTable:             uint8(13),
-			openflow.IngressDefaultTable:          uint8(14),
+			openflow.AntreaPolicyEgressAuditTable:  uint8(4),
+			openflow.AntreaPolicyEgressRuleTable:   uint8(5),
+			openflow.EgressRuleTable:               uint8(6),
+			openflow.EgressDefaultTable:            uint8(7),
+			openflow.AntreaPolicyIngressAuditTable: uint8(11),
+			openflow.AntreaPolicyIngressRuleTable:  uint8(12),
+			openflow.IngressRuleTable:              uint8(13),
+			openflow.IngressDefaultTable:           uint8(14),
 		})
 }
 
@@ -372,6 +375,55 @@ func TestAddMultipleGroupsRule(t *testing.T) {
 	assert.Equal(t, 2, controller.GetAppliedToGroupNum())
 }
 
+func TestAddNodeNetworkPolicyRule(t *testing.T) {
+	for _, nodeNetworkPolicyEnabled := range []bool{false, true} {
+		t.Run(fmt.Sprintf("NodeNetworkPolicy enabled: %t", nodeNetworkPolicyEnabled), func(t *testing.T) {
+			prepareMockTables()
+			controller, clientset, reconciler := newTestController()
+			controller.nodeNetworkPolicyEnabled = nodeNetworkPolicyEnabled
+			addressGroupWatcher := watch.NewFake()
+			appliedToGroupWatcher := watch.NewFake()
+			networkPolicyWatcher := watch.NewFake()
+			clientset.AddWatchReactor("addressgroups", k8stesting.DefaultWatchReactor(addressGroupWatcher, nil))
+			clientset.AddWatchReactor("appliedtogroups", k8stesting.DefaultWatchReactor(appliedToGroupWatcher, nil))
+			clientset.AddWatchReactor("networkpolicies", k8stesting.DefaultWatchReactor(networkPolicyWatcher, nil))
+
+			protocolTCP := v1beta2.ProtocolTCP
+			port := intstr.FromInt(22)
+			services := []v1beta2.Service{{Protocol: &protocolTCP, Port: &port}}
+			nodeMember := v1beta2.GroupMember{Node: &v1beta2.NodeReference{Name: "node1"}, IPs: []v1beta2.IPAddress{v1beta2.IPAddress(net.ParseIP("192.168.1.1"))}}
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			go controller.Run(stopCh)
+
+			expectUpdate := func() {
+				select {
+				case ruleID := <-reconciler.updated:
+					if !nodeNetworkPolicyEnabled {
+						t.Fatalf("Expected no update, got %v", ruleID)
+					}
+				case <-time.After(time.Millisecond * 100):
+					if nodeNetworkPolicyEnabled {
+						t.Fatal("Expected one update, got none")
+					}
+				}
+			}
+			// The rules applied to the Node are only realized when NodeNetworkPolicy is enabled, both
+			// during the initial sync and afterwards.
+			addressGroupWatcher.Add(newAddressGroup("addressGroup1", []v1beta2.GroupMember{*newAddressGroupMember("1.1.1.1")}))
+			addressGroupWatcher.Action(watch.Bookmark, nil)
+			appliedToGroupWatcher.Add(newAppliedToGroup("appliedToGroup1", []v1beta2.GroupMember{nodeMember}))
+			appliedToGroupWatcher.Action(watch.Bookmark, nil)
+			networkPolicyWatcher.Add(newNetworkPolicy("policy1", "uid1", []string{"addressGroup1"}, []string{}, []string{"appliedToGroup1"}, services))
+			networkPolicyWatcher.Action(watch.Bookmark, nil)
+			expectUpdate()
+
+			networkPolicyWatcher.Add(newNetworkPolicy("policy2", "uid2", []string{"addressGroup1"}, []string{}, []string{"appliedToGroup1"}, services))
+			expectUpdate()
+		})
+	}
+}
+
 func TestDeleteRule(t *testing.T) {
 	prepareMockTables()
 	controller, clientset, reconciler := newTestController()
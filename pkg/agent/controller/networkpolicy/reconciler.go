@@ -26,11 +26,13 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	proxytypes "antrea.io/antrea/pkg/agent/proxy/types"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -143,6 +145,10 @@ type lastRealized struct {
 	// It's only used for ingress rule as its "to" addresses.
 	// It's grouped by servicesKey, mapping to multiple Openflow rules.
 	podOFPorts map[servicesKey]sets.Int32
+	// The IP set we have realized for target Nodes. It's only used for ingress
+	// rule as its "to" addresses, as a Node is not attached to an OFPort.
+	// It's grouped by servicesKey, same as podOFPorts.
+	nodeIPs map[servicesKey]sets.String
 	// The IP set we have realized for target Pods. Same as podOFPorts.
 	// It's only used for egress rule as its "from" addresses.
 	// It's same in all Openflow rules, because named port is only for
@@ -163,6 +169,7 @@ func newLastRealized(rule *CompletedRule) *lastRealized {
 		ofIDs:            map[servicesKey]uint32{},
 		CompletedRule:    rule,
 		podOFPorts:       map[servicesKey]sets.Int32{},
+		nodeIPs:          map[servicesKey]sets.String{},
 		podIPs:           nil,
 		fqdnIPAddresses:  nil,
 		groupIDAddresses: nil,
@@ -209,6 +216,12 @@ type reconciler struct {
 	// groupCounters is a list of GroupCounter for v4 and v6 env. reconciler uses these
 	// GroupCounters to get the groupIDs of a specific Service.
 	groupCounters []proxytypes.GroupCounter
+
+	// l7RuleReconciler realizes the layer 7 protocols of rules in the layer 7 engine. It's nil
+	// if L7NetworkPolicy is not enabled.
+	l7RuleReconciler *l7engine.Reconciler
+	// l7VlanIDAllocator allocates the VLAN IDs identifying the rules with layer 7 protocols.
+	l7VlanIDAllocator *l7VlanIDAllocator
 }
 
 // newReconciler returns a new *reconciler.
@@ -217,6 +230,8 @@ func newReconciler(ofClient openflow.Client,
 	idAllocator *idAllocator,
 	fqdnController *fqdnController,
 	groupCounters []proxytypes.GroupCounter,
+	l7RuleReconciler *l7engine.Reconciler,
+	l7VlanIDAllocator *l7VlanIDAllocator,
 	v4Enabled bool,
 	v6Enabled bool,
 	antreaPolicyEnabled bool,
@@ -233,6 +248,11 @@ func newReconciler(ofClient openflow.Client,
 				assigner: newPriorityAssigner(false),
 			}
 		}
+		for _, table := range openflow.GetAntreaPolicyAuditTables() {
+			priorityAssigners[table.GetID()] = &tablePriorityAssigner{
+				assigner: newPriorityAssigner(false),
+			}
+		}
 	}
 	reconciler := &reconciler{
 		ofClient:          ofClient,
@@ -242,6 +262,8 @@ func newReconciler(ofClient openflow.Client,
 		priorityAssigners: priorityAssigners,
 		fqdnController:    fqdnController,
 		groupCounters:     groupCounters,
+		l7RuleReconciler:  l7RuleReconciler,
+		l7VlanIDAllocator: l7VlanIDAllocator,
 	}
 	// Check if ofClient is nil or not to be compatible with unit tests.
 	if ofClient != nil {
@@ -300,13 +322,24 @@ func (r *reconciler) getOFRuleTable(rule *CompletedRule) uint8 {
 		}
 		return openflow.EgressRuleTable.GetID()
 	}
+	// The Drop and Reject rules of policies in Audit mode are installed in the audit tables,
+	// which are evaluated before all the Antrea-native policy rules of all Tiers and never
+	// decide the fate of the packets.
+	if rule.isAuditedRule() {
+		if rule.Direction == v1beta2.DirectionIn {
+			return openflow.AntreaPolicyIngressAuditTable.GetID()
+		}
+		return openflow.AntreaPolicyEgressAuditTable.GetID()
+	}
 	var ruleTables []*openflow.Table
 	if rule.Direction == v1beta2.DirectionIn {
 		ruleTables = openflow.GetAntreaPolicyIngressTables()
 	} else {
 		ruleTables = openflow.GetAntreaPolicyEgressTables()
 	}
-	if *rule.TierPriority != baselineTierPriority {
+	// Policies in the baseline Tier and in Tiers with lower priorities, i.e. the Tier of
+	// BaselineAdminNetworkPolicies, are enforced after K8s NetworkPolicies.
+	if *rule.TierPriority < baselineTierPriority {
 		return ruleTables[0].GetID()
 	}
 	return ruleTables[1].GetID()
@@ -419,6 +452,9 @@ func (r *reconciler) registerOFPriorities(rules []*CompletedRule) error {
 // add converts CompletedRule to PolicyRule(s) and invokes installOFRule to install them.
 func (r *reconciler) add(rule *CompletedRule, ofPriority *uint16, table uint8) error {
 	klog.V(2).Infof("Adding new rule %v", rule)
+	if err := r.addL7Rule(rule); err != nil {
+		return err
+	}
 	ofRuleByServicesMap, lastRealized := r.computeOFRulesForAdd(rule, ofPriority, table)
 	for svcKey, ofRule := range ofRuleByServicesMap {
 		// Each pod group gets an Openflow ID.
@@ -456,11 +492,13 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 		membersByServicesMap, servicesMap := groupMembersByServices(rule.Services, rule.TargetMembers)
 		for svcKey, members := range membersByServicesMap {
 			ofPorts := r.getOFPorts(members)
+			nodeIPs := r.getNodeIPs(members)
 			lastRealized.podOFPorts[svcKey] = ofPorts
+			lastRealized.nodeIPs[svcKey] = nodeIPs
 			ofRuleByServicesMap[svcKey] = &types.PolicyRule{
 				Direction:     v1beta2.DirectionIn,
 				From:          append(from1, from2...),
-				To:            ofPortsToOFAddresses(ofPorts),
+				To:            append(ofPortsToOFAddresses(ofPorts), ipsToOFAddresses(nodeIPs)...),
 				Service:       filterUnresolvablePort(servicesMap[svcKey]),
 				Action:        rule.Action,
 				Name:          rule.Name,
@@ -468,6 +506,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 				TableID:       table,
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
+				L7RuleVlanID:  r.getL7RuleVlanID(rule.ID),
 			}
 		}
 	} else {
@@ -496,6 +535,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 				TableID:       table,
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
+				L7RuleVlanID:  r.getL7RuleVlanID(rule.ID),
 			}
 		}
 
@@ -520,6 +560,7 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 					TableID:       table,
 					PolicyRef:     rule.SourceRef,
 					EnableLogging: rule.EnableLogging,
+					L7RuleVlanID:  r.getL7RuleVlanID(rule.ID),
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -557,9 +598,29 @@ func (r *reconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *uint1
 			}
 		}
 	}
+	for _, ofRule := range ofRuleByServicesMap {
+		applyEnforcementMode(ofRule, rule.EnforcementMode)
+	}
 	return ofRuleByServicesMap, lastRealized
 }
 
+// applyEnforcementMode converts the Drop or Reject action of a PolicyRule to a non-terminal
+// action if the policy is in Audit mode: the rule is installed in an audit table, where the
+// traffic it would block is logged and then evaluated by all the enforced NetworkPolicy rules.
+// The original action is kept in AuditAction.
+func applyEnforcementMode(ofRule *types.PolicyRule, mode crdv1alpha1.EnforcementMode) {
+	if mode != crdv1alpha1.EnforcementModeAudit || ofRule.Action == nil {
+		return
+	}
+	if *ofRule.Action != crdv1alpha1.RuleActionDrop && *ofRule.Action != crdv1alpha1.RuleActionReject {
+		return
+	}
+	passAction := crdv1alpha1.RuleActionPass
+	ofRule.AuditAction = ofRule.Action
+	ofRule.Action = &passAction
+	ofRule.EnableLogging = true
+}
+
 // batchAdd converts CompletedRules to PolicyRules and invokes BatchInstallPolicyRuleFlows to install them.
 func (r *reconciler) batchAdd(rules []*CompletedRule, ofPriorities []*uint16) error {
 	lastRealizeds := make([]*lastRealized, len(rules))
@@ -568,6 +629,9 @@ func (r *reconciler) batchAdd(rules []*CompletedRule, ofPriorities []*uint16) er
 	var allOFRules []*types.PolicyRule
 
 	for idx, rule := range rules {
+		if err := r.addL7Rule(rule); err != nil {
+			return err
+		}
 		ruleTable := r.getOFRuleTable(rule)
 		ofRuleByServicesMap, lastRealized := r.computeOFRulesForAdd(rule, ofPriorities[idx], ruleTable)
 		lastRealizeds[idx] = lastRealized
@@ -620,13 +684,14 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 		membersByServicesMap, servicesMap := groupMembersByServices(newRule.Services, newRule.TargetMembers)
 		for svcKey, members := range membersByServicesMap {
 			newOFPorts := r.getOFPorts(members)
+			newNodeIPs := r.getNodeIPs(members)
 			ofID, exists := lastRealized.ofIDs[svcKey]
 			// Install a new Openflow rule if this group doesn't exist, otherwise do incremental update.
 			if !exists {
 				ofRule := &types.PolicyRule{
 					Direction:     v1beta2.DirectionIn,
 					From:          append(from1, from2...),
-					To:            ofPortsToOFAddresses(newOFPorts),
+					To:            append(ofPortsToOFAddresses(newOFPorts), ipsToOFAddresses(newNodeIPs)...),
 					Service:       filterUnresolvablePort(servicesMap[svcKey]),
 					Action:        newRule.Action,
 					Priority:      ofPriority,
@@ -634,7 +699,9 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 					TableID:       table,
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
+					L7RuleVlanID:  r.getL7RuleVlanID(newRule.ID),
 				}
+				applyEnforcementMode(ofRule, newRule.EnforcementMode)
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
 					return err
@@ -646,6 +713,8 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 			} else {
 				addedTo := ofPortsToOFAddresses(newOFPorts.Difference(lastRealized.podOFPorts[svcKey]))
 				deletedTo := ofPortsToOFAddresses(lastRealized.podOFPorts[svcKey].Difference(newOFPorts))
+				addedTo = append(addedTo, ipsToOFAddresses(newNodeIPs.Difference(lastRealized.nodeIPs[svcKey]))...)
+				deletedTo = append(deletedTo, ipsToOFAddresses(lastRealized.nodeIPs[svcKey].Difference(newNodeIPs))...)
 				if err := r.updateOFRule(ofID, addedFrom, addedTo, deletedFrom, deletedTo, ofPriority); err != nil {
 					return err
 				}
@@ -653,6 +722,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 				delete(staleOFIDs, svcKey)
 			}
 			lastRealized.podOFPorts[svcKey] = newOFPorts
+			lastRealized.nodeIPs[svcKey] = newNodeIPs
 		}
 	} else {
 		if r.fqdnController != nil && len(newRule.To.FQDNs) > 0 {
@@ -688,6 +758,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 					TableID:       table,
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
+					L7RuleVlanID:  r.getL7RuleVlanID(newRule.ID),
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// reconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
@@ -695,6 +766,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 					to := ipBlocksToOFAddresses(newRule.To.IPBlocks, r.ipv4Enabled, r.ipv6Enabled)
 					ofRule.To = append(ofRule.To, to...)
 				}
+				applyEnforcementMode(ofRule, newRule.EnforcementMode)
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
 					return fmt.Errorf("error allocating Openflow ID")
@@ -769,6 +841,7 @@ func (r *reconciler) update(lastRealized *lastRealized, newRule *CompletedRule,
 		}
 		delete(lastRealized.ofIDs, svcKey)
 		delete(lastRealized.podOFPorts, svcKey)
+		delete(lastRealized.nodeIPs, svcKey)
 	}
 	lastRealized.CompletedRule = newRule
 	return nil
@@ -858,14 +931,64 @@ func (r *reconciler) Forget(ruleID string) error {
 		}
 		delete(lastRealized.ofIDs, svcKey)
 		delete(lastRealized.podOFPorts, svcKey)
+		delete(lastRealized.nodeIPs, svcKey)
 	}
 	if r.fqdnController != nil {
 		r.fqdnController.deleteFQDNRule(ruleID, lastRealized.To.FQDNs)
 	}
+	if err := r.deleteL7Rule(ruleID); err != nil {
+		return err
+	}
 	r.lastRealizeds.Delete(ruleID)
 	return nil
 }
 
+// addL7Rule allocates a VLAN ID for the rule if it has layer 7 protocols, and realizes the
+// layer 7 protocols in the layer 7 engine. The traffic matching the rule will be tagged with
+// the VLAN ID when being redirected to the engine.
+func (r *reconciler) addL7Rule(rule *CompletedRule) error {
+	if r.l7RuleReconciler == nil || len(rule.L7Protocols) == 0 {
+		return nil
+	}
+	vlanID, err := r.l7VlanIDAllocator.allocate(rule.ID)
+	if err != nil {
+		return err
+	}
+	if err := r.l7RuleReconciler.AddRule(rule.ID, rule.SourceRef.ToString(), vlanID, rule.L7Protocols); err != nil {
+		return fmt.Errorf("error realizing L7 NetworkPolicy rule %s: %w", rule.ID, err)
+	}
+	return nil
+}
+
+// deleteL7Rule removes the layer 7 protocols of the rule from the layer 7 engine, and
+// releases the VLAN ID allocated for it.
+func (r *reconciler) deleteL7Rule(ruleID string) error {
+	if r.l7RuleReconciler == nil {
+		return nil
+	}
+	vlanID, exists := r.l7VlanIDAllocator.query(ruleID)
+	if !exists {
+		return nil
+	}
+	if err := r.l7RuleReconciler.DeleteRule(ruleID, vlanID); err != nil {
+		return fmt.Errorf("error deleting L7 NetworkPolicy rule %s: %w", ruleID, err)
+	}
+	r.l7VlanIDAllocator.release(ruleID)
+	return nil
+}
+
+// getL7RuleVlanID returns the VLAN ID allocated for the rule, or nil if the rule has no layer
+// 7 protocols.
+func (r *reconciler) getL7RuleVlanID(ruleID string) *uint32 {
+	if r.l7VlanIDAllocator == nil {
+		return nil
+	}
+	if vlanID, exists := r.l7VlanIDAllocator.query(ruleID); exists {
+		return &vlanID
+	}
+	return nil
+}
+
 func (r *reconciler) GetRuleByFlowID(ruleFlowID uint32) (*types.PolicyRule, bool, error) {
 	return r.idAllocator.getRuleFromAsyncCache(ruleFlowID)
 }
@@ -874,7 +997,10 @@ func (r *reconciler) getOFPorts(members v1beta2.GroupMemberSet) sets.Int32 {
 	ofPorts := sets.NewInt32()
 	for _, m := range members {
 		var entityName, ns string
-		if m.Pod != nil {
+		if m.Node != nil {
+			// Nodes are not attached to any OFPort, their IPs are used instead.
+			continue
+		} else if m.Pod != nil {
 			entityName, ns = m.Pod.Name, m.Pod.Namespace
 		} else if m.ExternalEntity != nil {
 			entityName, ns = m.ExternalEntity.Name, m.ExternalEntity.Namespace
@@ -894,10 +1020,12 @@ func (r *reconciler) getOFPorts(members v1beta2.GroupMemberSet) sets.Int32 {
 }
 
 func (r *reconciler) getIPs(members v1beta2.GroupMemberSet) sets.String {
-	ips := sets.NewString()
+	ips := r.getNodeIPs(members)
 	for _, m := range members {
 		var entityName, ns string
-		if m.Pod != nil {
+		if m.Node != nil {
+			continue
+		} else if m.Pod != nil {
 			entityName, ns = m.Pod.Name, m.Pod.Namespace
 		} else if m.ExternalEntity != nil {
 			entityName, ns = m.ExternalEntity.Name, m.ExternalEntity.Namespace
@@ -920,6 +1048,25 @@ func (r *reconciler) getIPs(members v1beta2.GroupMemberSet) sets.String {
 	return ips
 }
 
+// getNodeIPs returns the IPs of the Nodes in the provided members, which are applied to when
+// enforcing policies on the host network of Nodes. IPs of a disabled family are ignored.
+func (r *reconciler) getNodeIPs(members v1beta2.GroupMemberSet) sets.String {
+	ips := sets.NewString()
+	for _, m := range members {
+		if m.Node == nil {
+			continue
+		}
+		for _, ipAddr := range m.IPs {
+			ip := net.IP(ipAddr)
+			if (ip.To4() != nil && r.ipv4Enabled) || (ip.To4() == nil && r.ipv6Enabled) {
+				klog.V(2).Infof("Got IP %v for Node %s", ip, m.Node.Name)
+				ips.Insert(ip.String())
+			}
+		}
+	}
+	return ips
+}
+
 // groupMembersByServices groups the provided groupMembers based on their services resolving result.
 // A map of servicesHash to the grouped members and a map of servicesHash to the services resolving result will be returned.
 func groupMembersByServices(services []v1beta2.Service, memberSet v1beta2.GroupMemberSet) (map[servicesKey]v1beta2.GroupMemberSet, map[servicesKey][]v1beta2.Service) {
@@ -33,6 +33,7 @@ import (
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
 var (
@@ -54,6 +55,8 @@ var (
 	)
 	appliedToGroupWithSingleContainerPort = v1beta2.NewGroupMemberSet(
 		newAppliedToGroupMember("pod1", "ns1", v1beta2.NamedPort{Name: "http", Protocol: v1beta2.ProtocolTCP, Port: 80}))
+	appliedToGroupNode1 = v1beta2.NewGroupMemberSet(newAppliedToGroupNodeMember("node1", "172.16.0.1", "2001:db8::1"))
+	appliedToGroupNode2 = v1beta2.NewGroupMemberSet(newAppliedToGroupNodeMember("node1", "172.16.0.2", "2001:db8::1"))
 
 	protocolTCP = v1beta2.ProtocolTCP
 
@@ -103,7 +106,7 @@ func newTestReconciler(t *testing.T, controller *gomock.Controller, ifaceStore i
 	ch := make(chan string, 100)
 	groupIDAllocator := openflow.NewGroupAllocator(v6Enabled)
 	groupCounters := []proxytypes.GroupCounter{proxytypes.NewGroupCounter(groupIDAllocator, ch)}
-	r := newReconciler(ofClient, ifaceStore, newIDAllocator(testAsyncDeleteInterval), f, groupCounters, v4Enabled, v6Enabled, true)
+	r := newReconciler(ofClient, ifaceStore, newIDAllocator(testAsyncDeleteInterval), f, groupCounters, nil, nil, v4Enabled, v6Enabled, true)
 	return r
 }
 
@@ -946,6 +949,44 @@ func TestReconcilerUpdate(t *testing.T) {
 			false,
 			false,
 		},
+		{
+			"updating-cnp-ingress-rule-applied-to-node",
+			&CompletedRule{
+				rule:          &rule{ID: "ingress-rule", Direction: v1beta2.DirectionIn, PolicyPriority: &policyPriority, TierPriority: &tierPriority, SourceRef: &cnp1, EnableLogging: false},
+				FromAddresses: addressGroup1,
+				TargetMembers: appliedToGroupNode1,
+			},
+			&CompletedRule{
+				rule:          &rule{ID: "ingress-rule", Direction: v1beta2.DirectionIn, PolicyPriority: &policyPriority, TierPriority: &tierPriority, SourceRef: &cnp1, EnableLogging: false},
+				FromAddresses: addressGroup1,
+				TargetMembers: appliedToGroupNode2,
+			},
+			[]types.Address{},
+			ipsToOFAddresses(sets.NewString("172.16.0.2")),
+			[]types.Address{},
+			ipsToOFAddresses(sets.NewString("172.16.0.1")),
+			false,
+			false,
+		},
+		{
+			"updating-cnp-egress-rule-applied-to-node",
+			&CompletedRule{
+				rule:          &rule{ID: "egress-rule", Direction: v1beta2.DirectionOut, PolicyPriority: &policyPriority, TierPriority: &tierPriority, SourceRef: &cnp1, EnableLogging: false},
+				ToAddresses:   addressGroup1,
+				TargetMembers: appliedToGroupNode1,
+			},
+			&CompletedRule{
+				rule:          &rule{ID: "egress-rule", Direction: v1beta2.DirectionOut, PolicyPriority: &policyPriority, TierPriority: &tierPriority, SourceRef: &cnp1, EnableLogging: false},
+				ToAddresses:   addressGroup1,
+				TargetMembers: appliedToGroupNode2,
+			},
+			ipsToOFAddresses(sets.NewString("172.16.0.2")),
+			[]types.Address{},
+			ipsToOFAddresses(sets.NewString("172.16.0.1")),
+			[]types.Address{},
+			false,
+			false,
+		},
 		{
 			"updating-cnp-ingress-rule-uninstall",
 			&CompletedRule{
@@ -1117,6 +1158,121 @@ func TestGroupMembersByServices(t *testing.T) {
 	}
 }
 
+func TestApplyEnforcementMode(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
+	rejectAction := crdv1alpha1.RuleActionReject
+	passAction := crdv1alpha1.RuleActionPass
+	tests := []struct {
+		name                  string
+		action                *crdv1alpha1.RuleAction
+		mode                  crdv1alpha1.EnforcementMode
+		expectedAction        *crdv1alpha1.RuleAction
+		expectedAuditAction   *crdv1alpha1.RuleAction
+		expectedEnableLogging bool
+	}{
+		{
+			name:           "drop in default mode",
+			action:         &dropAction,
+			expectedAction: &dropAction,
+		},
+		{
+			name:           "drop in enforce mode",
+			action:         &dropAction,
+			mode:           crdv1alpha1.EnforcementModeEnforce,
+			expectedAction: &dropAction,
+		},
+		{
+			name:                  "drop in audit mode",
+			action:                &dropAction,
+			mode:                  crdv1alpha1.EnforcementModeAudit,
+			expectedAction:        &passAction,
+			expectedAuditAction:   &dropAction,
+			expectedEnableLogging: true,
+		},
+		{
+			name:                  "reject in audit mode",
+			action:                &rejectAction,
+			mode:                  crdv1alpha1.EnforcementModeAudit,
+			expectedAction:        &passAction,
+			expectedAuditAction:   &rejectAction,
+			expectedEnableLogging: true,
+		},
+		{
+			name:           "allow in audit mode",
+			action:         &allowAction,
+			mode:           crdv1alpha1.EnforcementModeAudit,
+			expectedAction: &allowAction,
+		},
+		{
+			name:           "pass in audit mode",
+			action:         &passAction,
+			mode:           crdv1alpha1.EnforcementModeAudit,
+			expectedAction: &passAction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ofRule := &types.PolicyRule{Action: tt.action}
+			applyEnforcementMode(ofRule, tt.mode)
+			assert.Equal(t, tt.expectedAction, ofRule.Action)
+			assert.Equal(t, tt.expectedAuditAction, ofRule.AuditAction)
+			assert.Equal(t, tt.expectedEnableLogging, ofRule.EnableLogging)
+		})
+	}
+}
+
+func TestGetOFRuleTable(t *testing.T) {
+	prepareMockTables()
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	r := newTestReconciler(t, controller, interfacestore.NewInterfaceStore(), openflowtest.NewMockClient(controller), true, false)
+	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
+	baselinePriority := baselineTierPriority
+	tests := []struct {
+		name          string
+		rule          *rule
+		expectedTable *openflow.Table
+	}{
+		{
+			name:          "K8s NetworkPolicy ingress rule",
+			rule:          &rule{Direction: v1beta2.DirectionIn, SourceRef: &np1},
+			expectedTable: openflow.IngressRuleTable,
+		},
+		{
+			name:          "Antrea-native policy egress rule",
+			rule:          &rule{Direction: v1beta2.DirectionOut, Action: &dropAction, TierPriority: &tierPriority, SourceRef: &cnp1},
+			expectedTable: openflow.AntreaPolicyEgressRuleTable,
+		},
+		{
+			name:          "baseline Tier ingress rule",
+			rule:          &rule{Direction: v1beta2.DirectionIn, Action: &dropAction, TierPriority: &baselinePriority, SourceRef: &cnp1},
+			expectedTable: openflow.IngressDefaultTable,
+		},
+		{
+			name:          "audited ingress drop rule",
+			rule:          &rule{Direction: v1beta2.DirectionIn, Action: &dropAction, TierPriority: &tierPriority, SourceRef: &cnp1, EnforcementMode: crdv1alpha1.EnforcementModeAudit},
+			expectedTable: openflow.AntreaPolicyIngressAuditTable,
+		},
+		{
+			name:          "audited baseline Tier egress drop rule",
+			rule:          &rule{Direction: v1beta2.DirectionOut, Action: &dropAction, TierPriority: &baselinePriority, SourceRef: &cnp1, EnforcementMode: crdv1alpha1.EnforcementModeAudit},
+			expectedTable: openflow.AntreaPolicyEgressAuditTable,
+		},
+		{
+			name:          "allow rule in audit mode",
+			rule:          &rule{Direction: v1beta2.DirectionOut, Action: &allowAction, TierPriority: &tierPriority, SourceRef: &cnp1, EnforcementMode: crdv1alpha1.EnforcementModeAudit},
+			expectedTable: openflow.AntreaPolicyEgressRuleTable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedTable.GetID(), r.getOFRuleTable(&CompletedRule{rule: tt.rule}))
+		})
+	}
+}
+
 func TestReconcilerReconcileIPv6Only(t *testing.T) {
 	ifaceStore := interfacestore.NewInterfaceStore()
 	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
@@ -43,6 +43,11 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 		klog.Errorf("parsePacketIn error: %+v", err)
 		return err
 	}
+	// The packet exceeds the number of packets to sample.
+	if oldTf == nil {
+		return nil
+	}
+	sampling := oldTf.Spec.LiveTraffic && oldTf.Spec.SampleCount > 1
 
 	// Retry when update CRD conflict which caused by multiple agents updating one CRD at same time.
 	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
@@ -52,9 +57,13 @@ func (c *Controller) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 			return err
 		}
 		update := tf.DeepCopy()
-		update.Status.Results = append(update.Status.Results, *nodeResult)
-		if packet != nil {
-			update.Status.CapturedPacket = packet
+		if sampling {
+			update.Status.Samples = addSampleResult(update.Status.Samples, packet, nodeResult, int(tf.Spec.SampleCount))
+		} else {
+			update.Status.Results = append(update.Status.Results, *nodeResult)
+			if packet != nil {
+				update.Status.CapturedPacket = packet
+			}
 		}
 		_, err = c.traceflowClient.CrdV1alpha1().Traceflows().UpdateStatus(context.TODO(), update, v1.UpdateOptions{})
 		if err != nil {
@@ -114,20 +123,34 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1alpha1.Tracefl
 		return nil, nil, nil, fmt.Errorf("unsupported traceflow packet Ethertype: %d", pktIn.Data.Ethertype)
 	}
 
-	firstPacket := false
-	c.runningTraceflowsMutex.RLock()
+	var receivedPackets int32
+	c.runningTraceflowsMutex.Lock()
 	tfState, exists := c.runningTraceflows[tag]
 	if exists {
-		firstPacket = !tfState.receivedPacket
-		tfState.receivedPacket = true
+		tfState.receivedPackets++
+		receivedPackets = tfState.receivedPackets
 	}
-	c.runningTraceflowsMutex.RUnlock()
+	c.runningTraceflowsMutex.Unlock()
 	if !exists {
 		return nil, nil, nil, fmt.Errorf("Traceflow for dataplane tag %d not found in cache", tag)
 	}
 
 	var capturedPacket *crdv1alpha1.Packet
-	if tfState.liveTraffic && firstPacket {
+	if tfState.liveTraffic && tfState.sampleCount > 1 {
+		// Packets matched before the OVS flows are uninstalled may still
+		// be received, ignore them.
+		if receivedPackets > tfState.sampleCount {
+			return nil, nil, nil, nil
+		}
+		// Uninstall the OVS flows after receiving the last packet to
+		// sample.
+		if receivedPackets == tfState.sampleCount {
+			c.ofClient.UninstallTraceflowFlows(tag)
+		}
+		// Every Node reports the sampled packet, so that the observations
+		// of the same packet on different Nodes can be merged.
+		capturedPacket = parseCapturedPacket(pktIn)
+	} else if tfState.liveTraffic && receivedPackets == 1 {
 		// Uninstall the OVS flows after receiving the first packet, to
 		// avoid capturing too many matched packets.
 		c.ofClient.UninstallTraceflowFlows(tag)
@@ -265,6 +288,9 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1alpha1.Tracefl
 		}
 		ob.ComponentInfo = openflow.L2ForwardingOutTable.GetName()
 		ob.Component = crdv1alpha1.ComponentForwarding
+		if egressOb := c.getEgressObservation(tf, tfState, ob, outputPort, tunnelDstIP); egressOb != nil {
+			obs = append(obs, *egressOb)
+		}
 		obs = append(obs, *ob)
 	}
 
@@ -272,6 +298,53 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1alpha1.Tracefl
 	return tf, &nodeResult, capturedPacket, nil
 }
 
+// getEgressObservation returns the Egress observation of the packet, if the packet is output to the Antrea gateway to
+// be SNAT'd by an Egress on this Node, or tunnelled to the remote Egress Node that holds the Egress IP. On the sender
+// Node, the Egress of the source Pod is queried from the Egress controller. On a remote Egress Node, the Egress IP is
+// the tunnel destination IP of the packet, and the name of the Egress is not known.
+func (c *Controller) getEgressObservation(tf *crdv1alpha1.Traceflow, tfState *traceflowState, outputOb *crdv1alpha1.Observation, outputPort uint32, tunnelDstIP string) *crdv1alpha1.Observation {
+	if c.egressQuerier == nil {
+		return nil
+	}
+	if !tfState.isSender {
+		if outputPort != config.HostGatewayOFPort || tunnelDstIP == "" || c.isLocalTransportIP(tunnelDstIP) {
+			return nil
+		}
+		return &crdv1alpha1.Observation{
+			Component:       crdv1alpha1.ComponentEgress,
+			Action:          crdv1alpha1.ActionMarkedForSNAT,
+			TranslatedSrcIP: tunnelDstIP,
+			EgressNode:      c.nodeConfig.Name,
+		}
+	}
+	egressName, egressIP, egressNode, err := c.egressQuerier.GetEgress(tf.Spec.Source.Namespace, tf.Spec.Source.Pod)
+	if err != nil {
+		// The source Pod is not selected by any Egress.
+		return nil
+	}
+	ob := &crdv1alpha1.Observation{
+		Component:       crdv1alpha1.ComponentEgress,
+		Egress:          egressName,
+		TranslatedSrcIP: egressIP,
+		EgressNode:      egressNode,
+	}
+	if outputPort == config.HostGatewayOFPort && outputOb.Action != crdv1alpha1.ActionDelivered && egressNode == c.nodeConfig.Name {
+		ob.Action = crdv1alpha1.ActionMarkedForSNAT
+	} else if outputPort == config.DefaultTunOFPort && tunnelDstIP == egressIP {
+		ob.Action = crdv1alpha1.ActionForwardedToEgressNode
+	} else {
+		return nil
+	}
+	return ob
+}
+
+func (c *Controller) isLocalTransportIP(ip string) bool {
+	if c.nodeConfig.NodeTransportIPv4Addr != nil && c.nodeConfig.NodeTransportIPv4Addr.IP.String() == ip {
+		return true
+	}
+	return c.nodeConfig.NodeTransportIPv6Addr != nil && c.nodeConfig.NodeTransportIPv6Addr.IP.String() == ip
+}
+
 func getMatchRegField(matchers *ofctrl.Matchers, field *binding.RegField) *ofctrl.MatchField {
 	return matchers.GetMatchByName(field.GetNXFieldName())
 }
@@ -404,3 +477,47 @@ func parseCapturedPacket(pktIn *ofctrl.PacketIn) *crdv1alpha1.Packet {
 	}
 	return &capturedPacket
 }
+
+// addSampleResult adds the observations of a sampled packet on a Node to the samples of a Traceflow. The observations
+// of the same packet on different Nodes are merged into one sample. A new sample is not added if there are already
+// maxSamples samples.
+func addSampleResult(samples []crdv1alpha1.TraceflowSample, packet *crdv1alpha1.Packet, nodeResult *crdv1alpha1.NodeResult, maxSamples int) []crdv1alpha1.TraceflowSample {
+	for i := range samples {
+		if isSamePacket(&samples[i].Packet, packet) {
+			samples[i].Results = append(samples[i].Results, *nodeResult)
+			return samples
+		}
+	}
+	if len(samples) >= maxSamples {
+		klog.V(2).InfoS("Ignored packet as the number of samples reached the limit", "packet", packet, "node", nodeResult.Node)
+		return samples
+	}
+	return append(samples, crdv1alpha1.TraceflowSample{Packet: *packet, Results: []crdv1alpha1.NodeResult{*nodeResult}})
+}
+
+// isSamePacket checks whether two packets captured on different Nodes are the same packet. As the sampled packets are
+// the first packets of different connections, and the destination IP and port may be translated by Service load
+// balancing, the packets are compared with the source IP, the IP protocol, and the source port or the ICMP echo ID and
+// sequence.
+func isSamePacket(a, b *crdv1alpha1.Packet) bool {
+	if a.SrcIP != b.SrcIP || getIPProtocol(a) != getIPProtocol(b) {
+		return false
+	}
+	ah, bh := a.TransportHeader, b.TransportHeader
+	switch {
+	case ah.TCP != nil && bh.TCP != nil:
+		return ah.TCP.SrcPort == bh.TCP.SrcPort
+	case ah.UDP != nil && bh.UDP != nil:
+		return ah.UDP.SrcPort == bh.UDP.SrcPort
+	case ah.ICMP != nil && bh.ICMP != nil:
+		return ah.ICMP.ID == bh.ICMP.ID && ah.ICMP.Sequence == bh.ICMP.Sequence
+	}
+	return ah.TCP == nil && bh.TCP == nil && ah.UDP == nil && bh.UDP == nil && ah.ICMP == nil && bh.ICMP == nil
+}
+
+func getIPProtocol(packet *crdv1alpha1.Packet) int32 {
+	if packet.IPv6Header != nil && packet.IPv6Header.NextHeader != nil {
+		return *packet.IPv6Header.NextHeader
+	}
+	return packet.IPHeader.Protocol
+}
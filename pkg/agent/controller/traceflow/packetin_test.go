@@ -15,6 +15,7 @@
 package traceflow
 
 import (
+	"errors"
 	"net"
 	"reflect"
 	"testing"
@@ -22,10 +23,13 @@ import (
 	"antrea.io/libOpenflow/protocol"
 	"antrea.io/libOpenflow/util"
 	"antrea.io/ofnet/ofctrl"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/openflow"
 	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
 )
 
 func prepareMockTables() {
@@ -177,3 +181,141 @@ func TestParseCapturedPacket(t *testing.T) {
 		})
 	}
 }
+
+func TestAddSampleResult(t *testing.T) {
+	newTCPPacket := func(srcIP, dstIP string, srcPort int32) *crdv1alpha1.Packet {
+		return &crdv1alpha1.Packet{
+			SrcIP:           srcIP,
+			DstIP:           dstIP,
+			IPHeader:        crdv1alpha1.IPHeader{Protocol: int32(protocol.Type_TCP)},
+			TransportHeader: crdv1alpha1.TransportHeader{TCP: &crdv1alpha1.TCPHeader{SrcPort: srcPort, DstPort: 80}},
+		}
+	}
+	senderResult := crdv1alpha1.NodeResult{Node: "node1", Observations: []crdv1alpha1.Observation{{Component: crdv1alpha1.ComponentSpoofGuard, Action: crdv1alpha1.ActionForwarded}}}
+	receiverResult := crdv1alpha1.NodeResult{Node: "node2", Observations: []crdv1alpha1.Observation{{Component: crdv1alpha1.ComponentForwarding, Action: crdv1alpha1.ActionDelivered}}}
+
+	var samples []crdv1alpha1.TraceflowSample
+	// The first packet is captured on the sender Node before the Service destination is translated.
+	samples = addSampleResult(samples, newTCPPacket("10.1.1.11", "10.96.0.10", 10001), &senderResult, 2)
+	// The same packet is captured on the receiver Node after the Service destination is translated.
+	samples = addSampleResult(samples, newTCPPacket("10.1.1.11", "10.1.2.12", 10001), &receiverResult, 2)
+	// The second packet of another connection.
+	samples = addSampleResult(samples, newTCPPacket("10.1.1.11", "10.96.0.10", 10002), &senderResult, 2)
+	// The third packet exceeds the limit.
+	samples = addSampleResult(samples, newTCPPacket("10.1.1.11", "10.96.0.10", 10003), &senderResult, 2)
+
+	expected := []crdv1alpha1.TraceflowSample{
+		{Packet: *newTCPPacket("10.1.1.11", "10.96.0.10", 10001), Results: []crdv1alpha1.NodeResult{senderResult, receiverResult}},
+		{Packet: *newTCPPacket("10.1.1.11", "10.96.0.10", 10002), Results: []crdv1alpha1.NodeResult{senderResult}},
+	}
+	assert.Equal(t, expected, samples)
+}
+
+func TestGetEgressObservation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	egressQuerier := queriertest.NewMockAgentEgressQuerier(ctrl)
+	c := &Controller{
+		egressQuerier: egressQuerier,
+		nodeConfig: &config.NodeConfig{
+			Name:                  "node1",
+			NodeTransportIPv4Addr: &net.IPNet{IP: net.ParseIP("192.168.0.1"), Mask: net.CIDRMask(24, 32)},
+		},
+	}
+	tf := &crdv1alpha1.Traceflow{
+		Spec: crdv1alpha1.TraceflowSpec{Source: crdv1alpha1.Source{Namespace: "ns1", Pod: "pod1"}},
+	}
+	forwardedOb := &crdv1alpha1.Observation{Action: crdv1alpha1.ActionForwardedOutOfOverlay}
+	tunnelOb := &crdv1alpha1.Observation{Action: crdv1alpha1.ActionForwarded}
+
+	tests := []struct {
+		name         string
+		isSender     bool
+		outputOb     *crdv1alpha1.Observation
+		outputPort   uint32
+		tunnelDstIP  string
+		expectedCall func()
+		want         *crdv1alpha1.Observation
+	}{
+		{
+			name:       "local Egress Node",
+			isSender:   true,
+			outputOb:   forwardedOb,
+			outputPort: config.HostGatewayOFPort,
+			expectedCall: func() {
+				egressQuerier.EXPECT().GetEgress("ns1", "pod1").Return("egress1", "1.1.1.1", "node1", nil)
+			},
+			want: &crdv1alpha1.Observation{
+				Component:       crdv1alpha1.ComponentEgress,
+				Action:          crdv1alpha1.ActionMarkedForSNAT,
+				Egress:          "egress1",
+				TranslatedSrcIP: "1.1.1.1",
+				EgressNode:      "node1",
+			},
+		},
+		{
+			name:        "remote Egress Node",
+			isSender:    true,
+			outputOb:    tunnelOb,
+			outputPort:  config.DefaultTunOFPort,
+			tunnelDstIP: "1.1.1.2",
+			expectedCall: func() {
+				egressQuerier.EXPECT().GetEgress("ns1", "pod1").Return("egress1", "1.1.1.2", "node2", nil)
+			},
+			want: &crdv1alpha1.Observation{
+				Component:       crdv1alpha1.ComponentEgress,
+				Action:          crdv1alpha1.ActionForwardedToEgressNode,
+				Egress:          "egress1",
+				TranslatedSrcIP: "1.1.1.2",
+				EgressNode:      "node2",
+			},
+		},
+		{
+			name:        "tunnelled to Pod on remote Node",
+			isSender:    true,
+			outputOb:    tunnelOb,
+			outputPort:  config.DefaultTunOFPort,
+			tunnelDstIP: "192.168.0.2",
+			expectedCall: func() {
+				egressQuerier.EXPECT().GetEgress("ns1", "pod1").Return("egress1", "1.1.1.2", "node2", nil)
+			},
+		},
+		{
+			name:       "no Egress",
+			isSender:   true,
+			outputOb:   forwardedOb,
+			outputPort: config.HostGatewayOFPort,
+			expectedCall: func() {
+				egressQuerier.EXPECT().GetEgress("ns1", "pod1").Return("", "", "", errors.New("no Egress applied to Pod ns1/pod1"))
+			},
+		},
+		{
+			name:        "received on Egress Node",
+			outputOb:    forwardedOb,
+			outputPort:  config.HostGatewayOFPort,
+			tunnelDstIP: "1.1.1.2",
+			want: &crdv1alpha1.Observation{
+				Component:       crdv1alpha1.ComponentEgress,
+				Action:          crdv1alpha1.ActionMarkedForSNAT,
+				TranslatedSrcIP: "1.1.1.2",
+				EgressNode:      "node1",
+			},
+		},
+		{
+			name:        "received from Node tunnel",
+			outputOb:    forwardedOb,
+			outputPort:  config.HostGatewayOFPort,
+			tunnelDstIP: "192.168.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectedCall != nil {
+				tt.expectedCall()
+			}
+			tfState := &traceflowState{isSender: tt.isSender}
+			got := c.getEgressObservation(tf, tfState, tt.outputOb, tt.outputPort, tt.tunnelDstIP)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
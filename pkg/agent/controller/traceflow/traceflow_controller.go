@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"antrea.io/libOpenflow/protocol"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -79,8 +80,10 @@ type traceflowState struct {
 	// Live-traffic Traceflow with only destination Pod specified.
 	receiverOnly bool
 	isSender     bool
-	// Agent received the first Traceflow packet from OVS.
-	receivedPacket bool
+	// Maximum number of packets to sample in live-traffic Traceflow.
+	sampleCount int32
+	// Number of Traceflow packets the Agent received from OVS.
+	receivedPackets int32
 }
 
 // Controller is responsible for setting up Openflow entries and injecting traceflow packet into
@@ -96,6 +99,7 @@ type Controller struct {
 	ovsBridgeClient        ovsconfig.OVSBridgeClient
 	ofClient               openflow.Client
 	networkPolicyQuerier   querier.AgentNetworkPolicyInfoQuerier
+	egressQuerier          querier.AgentEgressQuerier
 	interfaceStore         interfacestore.InterfaceStore
 	networkConfig          *config.NetworkConfig
 	nodeConfig             *config.NodeConfig
@@ -116,6 +120,7 @@ func NewTraceflowController(
 	traceflowInformer crdinformers.TraceflowInformer,
 	client openflow.Client,
 	npQuerier querier.AgentNetworkPolicyInfoQuerier,
+	egressQuerier querier.AgentEgressQuerier,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
 	interfaceStore interfacestore.InterfaceStore,
 	networkConfig *config.NetworkConfig,
@@ -130,6 +135,7 @@ func NewTraceflowController(
 		ovsBridgeClient:       ovsBridgeClient,
 		ofClient:              client,
 		networkPolicyQuerier:  npQuerier,
+		egressQuerier:         egressQuerier,
 		interfaceStore:        interfaceStore,
 		networkConfig:         networkConfig,
 		nodeConfig:            nodeConfig,
@@ -297,47 +303,66 @@ func (c *Controller) startTraceflow(tf *crdv1alpha1.Traceflow) error {
 	}
 
 	liveTraffic := tf.Spec.LiveTraffic
-	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" {
+	externalSource := isExternalSourceTraceflow(tf)
+	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" && !externalSource {
 		klog.Errorf("Traceflow %s has neither source nor destination Pod specified", tf.Name)
 		return nil
 	}
-	if tf.Spec.Source.Pod == "" && !liveTraffic {
+	if tf.Spec.Source.Pod == "" && !liveTraffic && !externalSource {
 		klog.Errorf("Traceflow %s does not have source Pod specified", tf.Name)
 		return nil
 	}
 
 	receiverOnly := false
-	var pod, ns string
-	if tf.Spec.Source.Pod != "" {
-		pod = tf.Spec.Source.Pod
-		ns = tf.Spec.Source.Namespace
-	} else {
-		// Live-traffic Traceflow with only the Destination Pod specified.
-		pod = tf.Spec.Destination.Pod
-		ns = tf.Spec.Destination.Namespace
-		receiverOnly = true
-	}
-
-	// TODO: let controller compute the sender/receiver Node, and the sender
-	// /receiver Node can just return an error, if fails to find the Pod.
-	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
-	isSender := len(podInterfaces) > 0 && !receiverOnly
-
+	isSender := false
+	// Whether the Traceflow packet should be injected on the local Node.
+	injectPacket := false
 	var packet, matchPacket *binding.Packet
 	var ofPort uint32
-	if len(podInterfaces) > 0 {
-		packet, err = c.preparePacket(tf, podInterfaces[0], receiverOnly)
-		if err != nil {
-			return err
+	if externalSource {
+		// The packet from the external client is injected from the
+		// Antrea gateway of the Node it enters the cluster from.
+		if tf.Spec.Source.Node == c.nodeConfig.Name {
+			packet, err = c.preparePacket(tf, nil, false)
+			if err != nil {
+				return err
+			}
+			ofPort = config.HostGatewayOFPort
+			injectPacket = true
+			klog.V(2).Infof("Traceflow packet %v", *packet)
 		}
-		ofPort = uint32(podInterfaces[0].OFPort)
-		// On the sender or receiver (the receiverOnly case) Node, trace
-		// the first packet of the first connection that matches the
-		// Traceflow spec.
-		if liveTraffic {
-			matchPacket = packet
+	} else {
+		var pod, ns string
+		if tf.Spec.Source.Pod != "" {
+			pod = tf.Spec.Source.Pod
+			ns = tf.Spec.Source.Namespace
+		} else {
+			// Live-traffic Traceflow with only the Destination Pod specified.
+			pod = tf.Spec.Destination.Pod
+			ns = tf.Spec.Destination.Namespace
+			receiverOnly = true
+		}
+
+		// TODO: let controller compute the sender/receiver Node, and the sender
+		// /receiver Node can just return an error, if fails to find the Pod.
+		podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
+		isSender = len(podInterfaces) > 0 && !receiverOnly
+		injectPacket = isSender && !liveTraffic
+
+		if len(podInterfaces) > 0 {
+			packet, err = c.preparePacket(tf, podInterfaces[0], receiverOnly)
+			if err != nil {
+				return err
+			}
+			ofPort = uint32(podInterfaces[0].OFPort)
+			// On the sender or receiver (the receiverOnly case) Node, trace
+			// the first packet of the first connection that matches the
+			// Traceflow spec.
+			if liveTraffic {
+				matchPacket = packet
+			}
+			klog.V(2).Infof("Traceflow packet %v", *packet)
 		}
-		klog.V(2).Infof("Traceflow packet %v", *packet)
 	}
 
 	// Store Traceflow to cache.
@@ -346,6 +371,9 @@ func (c *Controller) startTraceflow(tf *crdv1alpha1.Traceflow) error {
 		name: tf.Name, tag: tf.Status.DataplaneTag,
 		liveTraffic: liveTraffic, droppedOnly: tf.Spec.DroppedOnly && liveTraffic,
 		receiverOnly: receiverOnly, isSender: isSender}
+	if liveTraffic && tf.Spec.SampleCount > 1 {
+		tfState.sampleCount = tf.Spec.SampleCount
+	}
 	c.runningTraceflows[tfState.tag] = &tfState
 	c.runningTraceflowsMutex.Unlock()
 
@@ -360,9 +388,10 @@ func (c *Controller) startTraceflow(tf *crdv1alpha1.Traceflow) error {
 		return err
 	}
 
-	// Skip packet injection if the source Pod is not found on the local Node.
-	if !liveTraffic && isSender {
-		if packet.DestinationMAC == nil {
+	// Skip packet injection if the source Pod is not found on the local Node,
+	// or the external client's packet does not enter from the local Node.
+	if injectPacket {
+		if packet.DestinationMAC == nil || externalSource {
 			// If the destination is Service/IP or the packet will
 			// be sent to remote Node, wait a small period for other
 			// Nodes.
@@ -398,10 +427,23 @@ func (c *Controller) validateTraceflow(tf *crdv1alpha1.Traceflow) error {
 
 func (c *Controller) preparePacket(tf *crdv1alpha1.Traceflow, intf *interfacestore.InterfaceConfig, receiverOnly bool) (*binding.Packet, error) {
 	liveTraffic := tf.Spec.LiveTraffic
+	externalSource := isExternalSourceTraceflow(tf)
 	isICMP := false
+	var dstSvc *corev1.Service
 	packet := new(binding.Packet)
 	packet.IsIPv6 = tf.Spec.Packet.IPv6Header != nil
-	if !liveTraffic {
+	if externalSource {
+		packet.SourceIP = net.ParseIP(tf.Spec.Source.IP)
+		if packet.SourceIP == nil {
+			return nil, errors.New("invalid source IP address")
+		}
+		isIPv6 := packet.SourceIP.To4() == nil
+		if isIPv6 != packet.IsIPv6 {
+			return nil, errors.New("source IP does not match the IP header family")
+		}
+		// The packet is received from the Antrea gateway.
+		packet.SourceMAC = c.nodeConfig.GatewayConfig.MAC
+	} else if !liveTraffic {
 		if packet.IsIPv6 {
 			packet.SourceIP = intf.GetIPv6Addr()
 			if packet.SourceIP == nil {
@@ -476,21 +518,27 @@ func (c *Controller) preparePacket(tf *crdv1alpha1.Traceflow, intf *interfacesto
 			return nil, errors.New("destination Pod does not have an IPv4 address")
 		}
 	} else if tf.Spec.Destination.Service != "" {
-		dstSvc, err := c.serviceLister.Services(tf.Spec.Destination.Namespace).Get(tf.Spec.Destination.Service)
+		var err error
+		dstSvc, err = c.serviceLister.Services(tf.Spec.Destination.Namespace).Get(tf.Spec.Destination.Service)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get the destination Service: %v", err)
 		}
-		if dstSvc.Spec.ClusterIP == "" {
-			return nil, errors.New("destination Service does not have a ClusterIP")
-		}
-		packet.DestinationIP = net.ParseIP(dstSvc.Spec.ClusterIP)
-		if !packet.IsIPv6 {
-			packet.DestinationIP = packet.DestinationIP.To4()
-			if packet.DestinationIP == nil {
-				return nil, errors.New("destination Service does not have an IPv4 ClusterIP")
+		// For an external client, the destination address is set after
+		// the transport header is parsed, as the NodePort is mapped from
+		// the destination port.
+		if !externalSource {
+			if dstSvc.Spec.ClusterIP == "" {
+				return nil, errors.New("destination Service does not have a ClusterIP")
+			}
+			packet.DestinationIP = net.ParseIP(dstSvc.Spec.ClusterIP)
+			if !packet.IsIPv6 {
+				packet.DestinationIP = packet.DestinationIP.To4()
+				if packet.DestinationIP == nil {
+					return nil, errors.New("destination Service does not have an IPv4 ClusterIP")
+				}
+			} else if packet.DestinationIP.To4() != nil {
+				return nil, errors.New("destination Service does not have an IPv6 ClusterIP")
 			}
-		} else if packet.DestinationIP.To4() != nil {
-			return nil, errors.New("destination Service does not have an IPv6 ClusterIP")
 		}
 
 		if !liveTraffic {
@@ -548,6 +596,21 @@ func (c *Controller) preparePacket(tf *crdv1alpha1.Traceflow, intf *interfacesto
 	if packet.IPProto == 0 && !liveTraffic || packet.IPProto == protocol.Type_ICMP || packet.IPProto == protocol.Type_IPv6ICMP {
 		isICMP = true
 	}
+	if externalSource {
+		if dstSvc != nil {
+			var err error
+			packet.DestinationIP, packet.DestinationPort, err = c.getServiceExternalAddress(dstSvc, packet)
+			if err != nil {
+				return nil, err
+			}
+		}
+		// The packet is routed by the Node to OVS through the Antrea
+		// gateway, unless it is destined for a local Pod.
+		if packet.DestinationMAC == nil {
+			packet.DestinationMAC = c.ofClient.GetTunnelVirtualMAC()
+		}
+	}
+
 	if isICMP {
 		if packet.IsIPv6 {
 			packet.IPProto = protocol.Type_IPv6ICMP
@@ -568,6 +631,47 @@ func (c *Controller) preparePacket(tf *crdv1alpha1.Traceflow, intf *interfacesto
 	return packet, nil
 }
 
+// isExternalSourceTraceflow checks whether the Traceflow traces a packet injected for an external client.
+func isExternalSourceTraceflow(tf *crdv1alpha1.Traceflow) bool {
+	return !tf.Spec.LiveTraffic && tf.Spec.Source.IP != "" && tf.Spec.Source.Node != ""
+}
+
+// getServiceExternalAddress returns the destination IP and port of the packet sent by an external client to the
+// Service: the LoadBalancer ingress IP and the Service port if the Service has one, otherwise the local Node IP and
+// the NodePort of the Service port.
+func (c *Controller) getServiceExternalAddress(svc *corev1.Service, packet *binding.Packet) (net.IP, uint16, error) {
+	if svc.Spec.Type != corev1.ServiceTypeNodePort && svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil, 0, errors.New("destination Service of an external client must be a NodePort or LoadBalancer Service")
+	}
+	if svc.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			ingressIP := net.ParseIP(ingress.IP)
+			if ingressIP != nil && (ingressIP.To4() == nil) == packet.IsIPv6 {
+				return ingressIP, packet.DestinationPort, nil
+			}
+		}
+	}
+	var nodeIP net.IP
+	if packet.IsIPv6 && c.nodeConfig.NodeIPv6Addr != nil {
+		nodeIP = c.nodeConfig.NodeIPv6Addr.IP
+	} else if !packet.IsIPv6 && c.nodeConfig.NodeIPv4Addr != nil {
+		nodeIP = c.nodeConfig.NodeIPv4Addr.IP
+	}
+	if nodeIP == nil {
+		return nil, 0, errors.New("Node does not have an IP address of the packet's IP family")
+	}
+	for _, port := range svc.Spec.Ports {
+		if port.Port != int32(packet.DestinationPort) || port.NodePort == 0 {
+			continue
+		}
+		if port.Protocol == corev1.ProtocolTCP && packet.IPProto == protocol.Type_TCP ||
+			port.Protocol == corev1.ProtocolUDP && packet.IPProto == protocol.Type_UDP {
+			return nodeIP, uint16(port.NodePort), nil
+		}
+	}
+	return nil, 0, fmt.Errorf("destination Service does not have a NodePort for port %d", packet.DestinationPort)
+}
+
 func (c *Controller) errorTraceflowCRD(tf *crdv1alpha1.Traceflow, reason string) (*crdv1alpha1.Traceflow, error) {
 	tf.Status.Phase = crdv1alpha1.Failed
 
@@ -0,0 +1,993 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trafficcontrol
+
+import (
+	"context"
+	"crypto/sha1" // #nosec G505: not used for security purposes
+	"encoding/hex"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent"
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha2"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha2"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+	"antrea.io/antrea/pkg/util/channel"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	controllerName = "AntreaAgentTrafficControlController"
+	// How long to wait before retrying the processing of a TrafficControl change.
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+	// Default number of workers processing a TrafficControl change.
+	defaultWorkers = 4
+	// Disable resyncing.
+	resyncPeriod time.Duration = 0
+	// How often the realization status of all TrafficControls is reported to antrea-controller again. The statuses
+	// are only kept in the memory of antrea-controller, so they are lost when antrea-controller restarts.
+	statusResyncPeriod = 5 * time.Minute
+
+	// The port names of tunnel ports are generated by hashing the tunnel configuration. portNamePrefixLen + "-" +
+	// portNameHashLen must not exceed the maximum length (15) of a network interface name.
+	portNameHashLen = 7
+
+	// Default destination ports of UDP tunnels, assigned by IANA.
+	defaultVXLANTunnelDestinationPort  = 4789
+	defaultGENEVETunnelDestinationPort = 6081
+)
+
+// trafficControlState keeps the actual state of a TrafficControl that has been realized.
+type trafficControlState struct {
+	// The name of the target port of the TrafficControl.
+	targetPortName string
+	// The ofPort of the target port of the TrafficControl.
+	targetOFPort uint32
+	// The name of the return port of the TrafficControl. Empty if the action is Mirror.
+	returnPortName string
+	// The action and direction of the TrafficControl, used to check whether the mark flows need to be updated.
+	action    v1alpha2.TrafficControlAction
+	direction v1alpha2.Direction
+	// The actual openflow ports for which we have installed mark flows. Used to identify whether the flows need to be
+	// updated when the Pods selected by the TrafficControl change.
+	ofPorts sets.Int32
+	// The actual Pods applied by the TrafficControl. Used to identify stale Pods when updating or deleting a
+	// TrafficControl.
+	pods sets.String
+	// The status last reported to antrea-controller. Used to avoid reporting the same status repeatedly.
+	reportedStatus *v1beta2.TrafficControlNodeStatus
+	// The status generation of the Controller when the status was last reported. The status is reported again if
+	// it's older than the current one.
+	reportedStatusGeneration uint64
+}
+
+// portToTCBinding keeps the TrafficControls referencing an OVS port. A port is referenced by TrafficControls either
+// as the target port or as the return port, and it's removed from OVS when it's no longer referenced.
+type portToTCBinding struct {
+	// The interface config of the port.
+	interfaceConfig *interfacestore.InterfaceConfig
+	// The names of the TrafficControls referencing the port.
+	trafficControls sets.String
+	// Whether the port is used as a return port.
+	isReturnPort bool
+}
+
+// podToTCBinding keeps the TrafficControls applying to a Pod. The mark flows of different TrafficControls would
+// conflict with each other if they were applied to the same Pod, so there is one effective TrafficControl for a Pod
+// at any given time.
+type podToTCBinding struct {
+	effectiveTC    string
+	alternativeTCs sets.String
+}
+
+type Controller struct {
+	nodeName string
+	ofClient openflow.Client
+	// statusControlInterface knows how to report the realization status of TrafficControls to antrea-controller.
+	statusControlInterface trafficControlStatusControlInterface
+	// statusGeneration is increased every time the statuses of all TrafficControls need to be reported again. It
+	// must be accessed atomically.
+	statusGeneration uint64
+
+	ovsBridgeClient ovsconfig.OVSBridgeClient
+	interfaceStore  interfacestore.InterfaceStore
+
+	podInformer     cache.SharedIndexInformer
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+
+	namespaceInformer     cache.SharedIndexInformer
+	namespaceLister       corelisters.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+
+	tcInformer     cache.SharedIndexInformer
+	tcLister       crdlisters.TrafficControlLister
+	tcListerSynced cache.InformerSynced
+	queue          workqueue.RateLimitingInterface
+
+	tcStates map[string]*trafficControlState
+	// The mutex is to protect the map, not the trafficControlState items. The workqueue guarantees a TrafficControl
+	// will only be processed by a single worker at any time. So the returned trafficControlState has no race
+	// condition.
+	tcStatesMutex sync.RWMutex
+
+	podToTCBindings      map[string]*podToTCBinding
+	podToTCBindingsMutex sync.RWMutex
+
+	portToTCBindings map[string]*portToTCBinding
+	// portToTCBindingsMutex also serializes the creation and deletion of OVS ports, as different TrafficControls
+	// processed by different workers may reference the same port.
+	portToTCBindingsMutex sync.Mutex
+}
+
+func NewTrafficControlController(nodeName string,
+	ofClient openflow.Client,
+	antreaClientProvider agent.AntreaClientProvider,
+	interfaceStore interfacestore.InterfaceStore,
+	ovsBridgeClient ovsconfig.OVSBridgeClient,
+	tcInformer crdinformers.TrafficControlInformer,
+	podInformer cache.SharedIndexInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
+	podUpdateSubscriber channel.Subscriber) *Controller {
+	c := &Controller{
+		nodeName:               nodeName,
+		ofClient:               ofClient,
+		statusControlInterface: &trafficControlStatusControl{antreaClientProvider: antreaClientProvider},
+		ovsBridgeClient:        ovsBridgeClient,
+		interfaceStore:         interfaceStore,
+		tcInformer:             tcInformer.Informer(),
+		tcLister:               tcInformer.Lister(),
+		tcListerSynced:         tcInformer.Informer().HasSynced,
+		podInformer:            podInformer,
+		podLister:              corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:        podInformer.HasSynced,
+		namespaceInformer:      namespaceInformer.Informer(),
+		namespaceLister:        namespaceInformer.Lister(),
+		namespaceListerSynced:  namespaceInformer.Informer().HasSynced,
+		queue:                  workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "trafficControlGroup"),
+		tcStates:               map[string]*trafficControlState{},
+		podToTCBindings:        map[string]*podToTCBinding{},
+		portToTCBindings:       map[string]*portToTCBinding{},
+	}
+	c.tcInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addTC,
+			UpdateFunc: c.updateTC,
+			DeleteFunc: c.deleteTC,
+		},
+		resyncPeriod,
+	)
+	c.podInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPod,
+			UpdateFunc: c.updatePod,
+			DeleteFunc: c.deletePod,
+		},
+		resyncPeriod,
+	)
+	c.namespaceInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addNamespace,
+			UpdateFunc: c.updateNamespace,
+			DeleteFunc: nil,
+		},
+		resyncPeriod,
+	)
+	// Subscribe Pod update events from CNIServer to enforce TrafficControl as soon as the OVS port of a Pod is
+	// created, as the Pod ADD event may be received before the port is available in the interface store.
+	podUpdateSubscriber.Subscribe(c.processPodUpdate)
+	return c
+}
+
+// processPodUpdate will be called when CNIServer publishes a Pod update event. It triggers reconciling the effective
+// TrafficControl of the Pod.
+func (c *Controller) processPodUpdate(e interface{}) {
+	c.podToTCBindingsMutex.RLock()
+	defer c.podToTCBindingsMutex.RUnlock()
+	podEvent := e.(types.PodUpdate)
+	pod := k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName)
+	binding, exists := c.podToTCBindings[pod]
+	if !exists {
+		return
+	}
+	c.queue.Add(binding.effectiveTC)
+}
+
+func (c *Controller) addTC(obj interface{}) {
+	tc := obj.(*v1alpha2.TrafficControl)
+	c.queue.Add(tc.Name)
+	klog.V(2).InfoS("Processed TrafficControl ADD event", "trafficControl", klog.KObj(tc))
+}
+
+func (c *Controller) updateTC(oldObj interface{}, obj interface{}) {
+	oldTC := oldObj.(*v1alpha2.TrafficControl)
+	curTC := obj.(*v1alpha2.TrafficControl)
+	if curTC.Generation != oldTC.Generation {
+		c.queue.Add(curTC.Name)
+		klog.V(2).InfoS("Processed TrafficControl UPDATE event", "trafficControl", klog.KObj(curTC))
+	}
+}
+
+func (c *Controller) deleteTC(obj interface{}) {
+	tc, ok := obj.(*v1alpha2.TrafficControl)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		tc, ok = deletedState.Obj.(*v1alpha2.TrafficControl)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-TrafficControl object: %v", deletedState.Obj)
+			return
+		}
+	}
+	c.queue.Add(tc.Name)
+	klog.V(2).InfoS("Processed TrafficControl DELETE event", "trafficControl", klog.KObj(tc))
+}
+
+func (c *Controller) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	if pod.Spec.HostNetwork {
+		return
+	}
+	c.enqueueTCsByPod(pod, nil)
+	klog.V(2).InfoS("Processed Pod ADD event", "pod", klog.KObj(pod))
+}
+
+func (c *Controller) updatePod(oldObj interface{}, obj interface{}) {
+	oldPod := oldObj.(*corev1.Pod)
+	curPod := obj.(*corev1.Pod)
+	if curPod.Spec.HostNetwork {
+		return
+	}
+	// Only the label changes of a Pod can affect the TrafficControls selecting it. The changes of the Pod's OVS port
+	// are notified by CNIServer.
+	if reflect.DeepEqual(oldPod.Labels, curPod.Labels) {
+		return
+	}
+	c.enqueueTCsByPod(curPod, oldPod.Labels)
+	klog.V(2).InfoS("Processed Pod UPDATE event", "pod", klog.KObj(curPod))
+}
+
+func (c *Controller) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-Pod object: %v", deletedState.Obj)
+			return
+		}
+	}
+	if pod.Spec.HostNetwork {
+		return
+	}
+	// The TrafficControls that have been applied to the Pod must be reconciled, no matter whether they still select
+	// the Pod or not.
+	c.podToTCBindingsMutex.RLock()
+	if binding, exists := c.podToTCBindings[k8s.NamespacedName(pod.Namespace, pod.Name)]; exists {
+		c.queue.Add(binding.effectiveTC)
+		for tcName := range binding.alternativeTCs {
+			c.queue.Add(tcName)
+		}
+	}
+	c.podToTCBindingsMutex.RUnlock()
+	klog.V(2).InfoS("Processed Pod DELETE event", "pod", klog.KObj(pod))
+}
+
+func (c *Controller) addNamespace(obj interface{}) {
+	ns := obj.(*corev1.Namespace)
+	c.enqueueTCsByNamespace(ns, nil)
+	klog.V(2).InfoS("Processed Namespace ADD event", "namespace", klog.KObj(ns))
+}
+
+func (c *Controller) updateNamespace(oldObj interface{}, obj interface{}) {
+	oldNS := oldObj.(*corev1.Namespace)
+	curNS := obj.(*corev1.Namespace)
+	if reflect.DeepEqual(oldNS.Labels, curNS.Labels) {
+		return
+	}
+	c.enqueueTCsByNamespace(curNS, oldNS.Labels)
+	klog.V(2).InfoS("Processed Namespace UPDATE event", "namespace", klog.KObj(curNS))
+}
+
+// enqueueTCsByPod enqueues the TrafficControls that select the Pod with its current labels or with its previous
+// labels (if oldLabels is not nil).
+func (c *Controller) enqueueTCsByPod(pod *corev1.Pod, oldLabels map[string]string) {
+	ns, err := c.namespaceLister.Get(pod.Namespace)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get Namespace of Pod", "pod", klog.KObj(pod))
+		return
+	}
+	tcs, _ := c.tcLister.List(labels.Everything())
+	for _, tc := range tcs {
+		if !appliedToMatchesNamespace(&tc.Spec.AppliedTo, ns.Labels) {
+			continue
+		}
+		if appliedToMatchesPod(&tc.Spec.AppliedTo, pod.Labels) ||
+			(oldLabels != nil && appliedToMatchesPod(&tc.Spec.AppliedTo, oldLabels)) {
+			c.queue.Add(tc.Name)
+		}
+	}
+}
+
+// enqueueTCsByNamespace enqueues the TrafficControls that select the Namespace with its current labels or with its
+// previous labels (if oldLabels is not nil).
+func (c *Controller) enqueueTCsByNamespace(ns *corev1.Namespace, oldLabels map[string]string) {
+	tcs, _ := c.tcLister.List(labels.Everything())
+	for _, tc := range tcs {
+		if appliedToMatchesNamespace(&tc.Spec.AppliedTo, ns.Labels) ||
+			(oldLabels != nil && appliedToMatchesNamespace(&tc.Spec.AppliedTo, oldLabels)) {
+			c.queue.Add(tc.Name)
+		}
+	}
+}
+
+// appliedToMatchesNamespace returns whether Pods in a Namespace with the provided labels can be selected by the
+// AppliedTo.
+func appliedToMatchesNamespace(appliedTo *v1alpha2.AppliedTo, nsLabels map[string]string) bool {
+	if appliedTo.NamespaceSelector == nil {
+		// No Pod will be selected if neither of the selectors is set.
+		return appliedTo.PodSelector != nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(appliedTo.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(nsLabels))
+}
+
+// appliedToMatchesPod returns whether a Pod with the provided labels can be selected by the AppliedTo, assuming its
+// Namespace is matched.
+func appliedToMatchesPod(appliedTo *v1alpha2.AppliedTo, podLabels map[string]string) bool {
+	if appliedTo.PodSelector == nil {
+		return appliedTo.NamespaceSelector != nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(appliedTo.PodSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(podLabels))
+}
+
+// Run will create defaultWorkers workers (go routines) which will process the TrafficControl events from the
+// workqueue.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.Infof("Starting %s", controllerName)
+	defer klog.Infof("Shutting down %s", controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.podListerSynced, c.namespaceListerSynced, c.tcListerSynced) {
+		return
+	}
+
+	c.removeStalePorts()
+
+	for i := 0; i < defaultWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	go wait.Until(c.resyncStatus, statusResyncPeriod, stopCh)
+	<-stopCh
+}
+
+// resyncStatus makes the realization status of all TrafficControls be reported to antrea-controller again, even if
+// it hasn't changed since it was last reported.
+func (c *Controller) resyncStatus() {
+	atomic.AddUint64(&c.statusGeneration, 1)
+	tcs, _ := c.tcLister.List(labels.Everything())
+	for _, tc := range tcs {
+		c.queue.Add(tc.Name)
+	}
+}
+
+// removeStalePorts deletes the traffic control ports created by antrea-agent which are not referenced by any
+// TrafficControl. These TrafficControls were either deleted or updated when the agent on this Node was not running.
+func (c *Controller) removeStalePorts() {
+	// The ports used by L7 NetworkPolicy are managed by antrea-agent itself.
+	desiredPorts := sets.NewString(config.L7NetworkPolicyTargetPortName, config.L7NetworkPolicyReturnPortName)
+	tcs, _ := c.tcLister.List(labels.Everything())
+	for _, tc := range tcs {
+		desiredPorts.Insert(genPortName(&tc.Spec.TargetPort))
+		if tc.Spec.ReturnPort != nil {
+			desiredPorts.Insert(genPortName(tc.Spec.ReturnPort))
+		}
+	}
+	for _, intf := range c.interfaceStore.GetInterfacesByType(interfacestore.TrafficControlInterface) {
+		if desiredPorts.Has(intf.InterfaceName) {
+			continue
+		}
+		if err := c.ovsBridgeClient.DeletePort(intf.PortUUID); err != nil {
+			klog.ErrorS(err, "Failed to delete stale traffic control port", "port", intf.InterfaceName)
+			continue
+		}
+		c.interfaceStore.DeleteInterface(intf)
+		klog.InfoS("Deleted stale traffic control port", "port", intf.InterfaceName)
+	}
+}
+
+// worker is a long-running function that will continually call the processNextWorkItem function in order to read and
+// process a message on the workqueue.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	obj, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(obj)
+
+	if key, ok := obj.(string); !ok {
+		c.queue.Forget(obj)
+		klog.Errorf("Expected string in work queue but got %#v", obj)
+		return true
+	} else if err := c.syncTrafficControl(key); err == nil {
+		// If no error occurs we Forget this item so it does not get queued again until another change happens.
+		c.queue.Forget(key)
+	} else {
+		// Put the item back on the workqueue to handle any transient errors.
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Syncing TrafficControl failed, requeue", "trafficControl", key)
+	}
+	return true
+}
+
+func (c *Controller) getTCState(tcName string) (*trafficControlState, bool) {
+	c.tcStatesMutex.RLock()
+	defer c.tcStatesMutex.RUnlock()
+	state, exists := c.tcStates[tcName]
+	return state, exists
+}
+
+func (c *Controller) newTCState(tcName string, action v1alpha2.TrafficControlAction, direction v1alpha2.Direction) *trafficControlState {
+	c.tcStatesMutex.Lock()
+	defer c.tcStatesMutex.Unlock()
+	state := &trafficControlState{
+		action:    action,
+		direction: direction,
+		ofPorts:   sets.NewInt32(),
+		pods:      sets.NewString(),
+	}
+	c.tcStates[tcName] = state
+	return state
+}
+
+func (c *Controller) deleteTCState(tcName string) {
+	c.tcStatesMutex.Lock()
+	defer c.tcStatesMutex.Unlock()
+	delete(c.tcStates, tcName)
+}
+
+// filterPods returns the local Pods selected by the AppliedTo.
+func (c *Controller) filterPods(appliedTo *v1alpha2.AppliedTo) ([]*corev1.Pod, error) {
+	// If both selectors are nil, no Pod should be selected.
+	if appliedTo.PodSelector == nil && appliedTo.NamespaceSelector == nil {
+		return nil, nil
+	}
+	podSelector := labels.Everything()
+	if appliedTo.PodSelector != nil {
+		var err error
+		podSelector, err = metav1.LabelSelectorAsSelector(appliedTo.PodSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if appliedTo.NamespaceSelector == nil {
+		// If Namespace selector is nil, Pods are selected from all Namespaces.
+		return c.podLister.List(podSelector)
+	}
+	nsSelector, err := metav1.LabelSelectorAsSelector(appliedTo.NamespaceSelector)
+	if err != nil {
+		return nil, err
+	}
+	namespaces, err := c.namespaceLister.List(nsSelector)
+	if err != nil {
+		return nil, err
+	}
+	var selectedPods []*corev1.Pod
+	for _, ns := range namespaces {
+		pods, err := c.podLister.Pods(ns.Name).List(podSelector)
+		if err != nil {
+			return nil, err
+		}
+		selectedPods = append(selectedPods, pods...)
+	}
+	return selectedPods, nil
+}
+
+// bindPodToTC binds the Pod with the TrafficControl and returns whether this TrafficControl is the effective one for
+// the Pod.
+func (c *Controller) bindPodToTC(pod, tc string) bool {
+	c.podToTCBindingsMutex.Lock()
+	defer c.podToTCBindingsMutex.Unlock()
+	binding, exists := c.podToTCBindings[pod]
+	if !exists {
+		c.podToTCBindings[pod] = &podToTCBinding{effectiveTC: tc, alternativeTCs: sets.NewString()}
+		return true
+	}
+	if binding.effectiveTC == tc {
+		return true
+	}
+	if !binding.alternativeTCs.Has(tc) {
+		klog.InfoS("Pod is already applied with another TrafficControl, it will be taken as an alternative",
+			"pod", pod, "effectiveTrafficControl", binding.effectiveTC, "alternativeTrafficControl", tc)
+		binding.alternativeTCs.Insert(tc)
+	}
+	return false
+}
+
+// unbindPodFromTC unbinds the Pod from the TrafficControl. If the TrafficControl was the effective one for the Pod
+// and there are alternative TrafficControls, one of them becomes the new effective TrafficControl and is returned.
+func (c *Controller) unbindPodFromTC(pod, tc string) (string, bool) {
+	c.podToTCBindingsMutex.Lock()
+	defer c.podToTCBindingsMutex.Unlock()
+	binding, exists := c.podToTCBindings[pod]
+	if !exists {
+		return "", false
+	}
+	if binding.effectiveTC == tc {
+		var popped bool
+		binding.effectiveTC, popped = binding.alternativeTCs.PopAny()
+		if !popped {
+			delete(c.podToTCBindings, pod)
+			return "", false
+		}
+		return binding.effectiveTC, true
+	}
+	binding.alternativeTCs.Delete(tc)
+	return "", false
+}
+
+func (c *Controller) syncTrafficControl(tcName string) error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(4).InfoS("Finished syncing TrafficControl", "trafficControl", tcName, "durationTime", time.Since(startTime))
+	}()
+
+	tc, err := c.tcLister.Get(tcName)
+	if err != nil {
+		// The TrafficControl has been deleted.
+		if errors.IsNotFound(err) {
+			return c.uninstallTrafficControl(tcName)
+		}
+		return err
+	}
+
+	tcState, exists := c.getTCState(tcName)
+	if !exists {
+		tcState = c.newTCState(tcName, tc.Spec.Action, tc.Spec.Direction)
+	}
+	nodeStatus := &v1beta2.TrafficControlNodeStatus{NodeName: c.nodeName, Generation: tc.Generation}
+	err = c.realizeTrafficControl(tc, tcState, nodeStatus)
+	if err != nil {
+		nodeStatus.Message = err.Error()
+	}
+	if reportErr := c.reportStatus(tcName, tcState, nodeStatus); reportErr != nil {
+		klog.ErrorS(reportErr, "Failed to report TrafficControl status", "trafficControl", tcName)
+		if err == nil {
+			return reportErr
+		}
+	}
+	return err
+}
+
+// realizeTrafficControl creates the ports and installs the mark flows of the TrafficControl. The progress is recorded
+// in the provided status.
+func (c *Controller) realizeTrafficControl(tc *v1alpha2.TrafficControl, tcState *trafficControlState, nodeStatus *v1beta2.TrafficControlNodeStatus) error {
+	tcName := tc.Name
+	// The mark flows must be re-installed if the action or the target port is changed.
+	flowsOutdated := tcState.action != tc.Spec.Action || tcState.direction != tc.Spec.Direction
+
+	targetPortName := genPortName(&tc.Spec.TargetPort)
+	if tcState.targetPortName != "" && tcState.targetPortName != targetPortName {
+		if err := c.releasePort(tcName, tcState.targetPortName); err != nil {
+			return err
+		}
+		tcState.targetPortName = ""
+		tcState.targetOFPort = 0
+	}
+	if tcState.targetPortName == "" {
+		targetOFPort, err := c.acquirePort(tcName, targetPortName, &tc.Spec.TargetPort, false)
+		if err != nil {
+			nodeStatus.TargetUnreachable = true
+			return fmt.Errorf("failed to acquire target port %s: %w", targetPortName, err)
+		}
+		tcState.targetPortName = targetPortName
+		tcState.targetOFPort = targetOFPort
+		flowsOutdated = true
+	}
+
+	// The return port is only used by the Redirect action.
+	var returnPortName string
+	if tc.Spec.Action == v1alpha2.ActionRedirect && tc.Spec.ReturnPort != nil {
+		returnPortName = genPortName(tc.Spec.ReturnPort)
+	}
+	if tcState.returnPortName != "" && tcState.returnPortName != returnPortName {
+		if err := c.releasePort(tcName, tcState.returnPortName); err != nil {
+			return err
+		}
+		tcState.returnPortName = ""
+	}
+	if tcState.returnPortName == "" && returnPortName != "" {
+		if _, err := c.acquirePort(tcName, returnPortName, tc.Spec.ReturnPort, true); err != nil {
+			return fmt.Errorf("failed to acquire return port %s: %w", returnPortName, err)
+		}
+		tcState.returnPortName = returnPortName
+	}
+	nodeStatus.PortCreated = true
+
+	pods, err := c.filterPods(&tc.Spec.AppliedTo)
+	if err != nil {
+		return err
+	}
+	newPods := sets.NewString()
+	newOFPorts := sets.NewInt32()
+	for _, pod := range pods {
+		// Skip the Pods which are not running on the local Node or are using the host network.
+		if pod.Spec.HostNetwork {
+			continue
+		}
+		podKey := k8s.NamespacedName(pod.Namespace, pod.Name)
+		newPods.Insert(podKey)
+		if !c.bindPodToTC(podKey, tcName) {
+			continue
+		}
+		podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod.Name, pod.Namespace)
+		if len(podInterfaces) == 0 {
+			klog.V(2).InfoS("Interfaces of Pod not found", "pod", klog.KObj(pod))
+			continue
+		}
+		newOFPorts.Insert(podInterfaces[0].OFPort)
+	}
+	// Unbind the stale Pods and enqueue the TrafficControls that become effective for them.
+	for podKey := range tcState.pods.Difference(newPods) {
+		if newEffectiveTC, exists := c.unbindPodFromTC(podKey, tcName); exists {
+			c.queue.Add(newEffectiveTC)
+		}
+	}
+	tcState.pods = newPods
+
+	if flowsOutdated || !newOFPorts.Equal(tcState.ofPorts) {
+		if newOFPorts.Len() == 0 {
+			if tcState.ofPorts.Len() > 0 {
+				if err := c.ofClient.UninstallTrafficControlMarkFlows(tcName); err != nil {
+					return err
+				}
+			}
+		} else {
+			if err := c.ofClient.InstallTrafficControlMarkFlows(tcName, sortedOFPorts(newOFPorts), tcState.targetOFPort, tc.Spec.Direction, tc.Spec.Action); err != nil {
+				return err
+			}
+		}
+		tcState.ofPorts = newOFPorts
+		tcState.action = tc.Spec.Action
+		tcState.direction = tc.Spec.Direction
+	}
+	nodeStatus.FlowsInstalled = true
+	return nil
+}
+
+// reportStatus reports the realization status of the TrafficControl on this Node to antrea-controller if it's
+// different from the last reported one, or if the statuses have been resynced since it was last reported.
+func (c *Controller) reportStatus(tcName string, tcState *trafficControlState, nodeStatus *v1beta2.TrafficControlNodeStatus) error {
+	statusGeneration := atomic.LoadUint64(&c.statusGeneration)
+	if tcState.reportedStatus != nil && *tcState.reportedStatus == *nodeStatus && tcState.reportedStatusGeneration == statusGeneration {
+		return nil
+	}
+	status := &v1beta2.TrafficControlStatus{
+		ObjectMeta: metav1.ObjectMeta{Name: tcName},
+		Nodes:      []v1beta2.TrafficControlNodeStatus{*nodeStatus},
+	}
+	if err := c.statusControlInterface.UpdateTrafficControlStatus(status); err != nil {
+		return err
+	}
+	tcState.reportedStatus = nodeStatus
+	tcState.reportedStatusGeneration = statusGeneration
+	return nil
+}
+
+// uninstallTrafficControl removes the mark flows of the TrafficControl, releases the ports referenced by it and
+// unbinds the Pods applied by it.
+func (c *Controller) uninstallTrafficControl(tcName string) error {
+	tcState, exists := c.getTCState(tcName)
+	if !exists {
+		return nil
+	}
+	if tcState.ofPorts.Len() > 0 {
+		if err := c.ofClient.UninstallTrafficControlMarkFlows(tcName); err != nil {
+			return err
+		}
+		tcState.ofPorts = sets.NewInt32()
+	}
+	if tcState.targetPortName != "" {
+		if err := c.releasePort(tcName, tcState.targetPortName); err != nil {
+			return err
+		}
+		tcState.targetPortName = ""
+		tcState.targetOFPort = 0
+	}
+	if tcState.returnPortName != "" {
+		if err := c.releasePort(tcName, tcState.returnPortName); err != nil {
+			return err
+		}
+		tcState.returnPortName = ""
+	}
+	for podKey := range tcState.pods {
+		if newEffectiveTC, exists := c.unbindPodFromTC(podKey, tcName); exists {
+			c.queue.Add(newEffectiveTC)
+		}
+	}
+	c.deleteTCState(tcName)
+	return nil
+}
+
+// acquirePort returns the ofPort of the port referenced by the TrafficControl, creating the port if it doesn't exist.
+// The flow to classify the packets from a return port is installed when the return port is referenced for the first
+// time.
+func (c *Controller) acquirePort(tcName, portName string, port *v1alpha2.TrafficControlPort, isReturnPort bool) (uint32, error) {
+	c.portToTCBindingsMutex.Lock()
+	defer c.portToTCBindingsMutex.Unlock()
+
+	if binding, exists := c.portToTCBindings[portName]; exists {
+		if binding.isReturnPort != isReturnPort {
+			return 0, fmt.Errorf("port %s cannot be used as both a target port and a return port", portName)
+		}
+		binding.trafficControls.Insert(tcName)
+		return uint32(binding.interfaceConfig.OFPort), nil
+	}
+
+	itf, exists := c.interfaceStore.GetInterfaceByName(portName)
+	if !exists {
+		var err error
+		if itf, err = c.createPort(portName, port); err != nil {
+			return 0, err
+		}
+	}
+	if isReturnPort {
+		if err := c.ofClient.InstallTrafficControlReturnPortFlow(uint32(itf.OFPort)); err != nil {
+			return 0, err
+		}
+	}
+	c.portToTCBindings[portName] = &portToTCBinding{
+		interfaceConfig: itf,
+		trafficControls: sets.NewString(tcName),
+		isReturnPort:    isReturnPort,
+	}
+	return uint32(itf.OFPort), nil
+}
+
+// releasePort removes the TrafficControl from the references of the port. The port is deleted when it's no longer
+// referenced by any TrafficControl.
+func (c *Controller) releasePort(tcName, portName string) error {
+	c.portToTCBindingsMutex.Lock()
+	defer c.portToTCBindingsMutex.Unlock()
+
+	binding, exists := c.portToTCBindings[portName]
+	if !exists {
+		return nil
+	}
+	if binding.trafficControls.Len() > 1 || !binding.trafficControls.Has(tcName) {
+		binding.trafficControls.Delete(tcName)
+		return nil
+	}
+	itf := binding.interfaceConfig
+	if binding.isReturnPort {
+		if err := c.ofClient.UninstallTrafficControlReturnPortFlow(uint32(itf.OFPort)); err != nil {
+			return err
+		}
+	}
+	// Only the ports created by antrea-agent are deleted.
+	if itf.Type == interfacestore.TrafficControlInterface {
+		if err := c.ovsBridgeClient.DeletePort(itf.PortUUID); err != nil {
+			return err
+		}
+		c.interfaceStore.DeleteInterface(itf)
+		klog.InfoS("Deleted traffic control port", "port", portName)
+	}
+	delete(c.portToTCBindings, portName)
+	return nil
+}
+
+// createPort creates the OVS port for the TrafficControlPort and adds it to the interface store.
+func (c *Controller) createPort(portName string, port *v1alpha2.TrafficControlPort) (*interfacestore.InterfaceConfig, error) {
+	externalIDs := map[string]interface{}{
+		interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTrafficControl,
+	}
+	var portUUID string
+	var err error
+	switch {
+	case port.OVSInternal != nil:
+		portUUID, err = c.ovsBridgeClient.CreateInternalPort(portName, 0, externalIDs)
+	case port.Device != nil:
+		portUUID, err = c.ovsBridgeClient.CreatePort(portName, portName, externalIDs)
+	case port.GENEVE != nil:
+		portUUID, err = c.createUDPTunnelPort(portName, ovsconfig.GeneveTunnel, port.GENEVE, externalIDs)
+	case port.VXLAN != nil:
+		portUUID, err = c.createUDPTunnelPort(portName, ovsconfig.VXLANTunnel, port.VXLAN, externalIDs)
+	case port.GRE != nil:
+		extraOptions := map[string]interface{}{}
+		if port.GRE.Key != nil {
+			extraOptions["key"] = strconv.Itoa(int(*port.GRE.Key))
+		}
+		portUUID, err = c.createTunnelPort(portName, ovsconfig.GRETunnel, port.GRE.RemoteIP, extraOptions, externalIDs)
+	case port.ERSPAN != nil:
+		extraOptions := map[string]interface{}{
+			"erspan_ver": strconv.Itoa(int(port.ERSPAN.Version)),
+		}
+		if port.ERSPAN.SessionID != nil {
+			extraOptions["key"] = strconv.Itoa(int(*port.ERSPAN.SessionID))
+		}
+		if port.ERSPAN.Version == 1 && port.ERSPAN.Index != nil {
+			extraOptions["erspan_idx"] = fmt.Sprintf("%x", *port.ERSPAN.Index)
+		}
+		if port.ERSPAN.Version == 2 {
+			if port.ERSPAN.Dir != nil {
+				extraOptions["erspan_dir"] = strconv.Itoa(int(*port.ERSPAN.Dir))
+			}
+			if port.ERSPAN.HardwareID != nil {
+				extraOptions["erspan_hwid"] = strconv.Itoa(int(*port.ERSPAN.HardwareID))
+			}
+		}
+		portUUID, err = c.createTunnelPort(portName, ovsconfig.ERSPANTunnel, port.ERSPAN.RemoteIP, extraOptions, externalIDs)
+	default:
+		return nil, fmt.Errorf("no valid port is specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+	ofPort, err := c.ovsBridgeClient.GetOFPort(portName, false)
+	if err != nil {
+		// Don't leave a port whose ofPort cannot be allocated, e.g. the network device doesn't exist.
+		if delErr := c.ovsBridgeClient.DeletePort(portUUID); delErr != nil {
+			klog.ErrorS(delErr, "Failed to delete traffic control port after failing to get its ofPort", "port", portName)
+		}
+		return nil, err
+	}
+	itf := interfacestore.NewTrafficControlInterface(portName)
+	itf.OVSPortConfig = &interfacestore.OVSPortConfig{PortUUID: portUUID, OFPort: ofPort}
+	c.interfaceStore.AddInterface(itf)
+	klog.InfoS("Created traffic control port", "port", portName, "ofPort", ofPort)
+	return itf, nil
+}
+
+func (c *Controller) createUDPTunnelPort(portName string, tunnelType ovsconfig.TunnelType, tunnel *v1alpha2.UDPTunnel, externalIDs map[string]interface{}) (string, error) {
+	extraOptions := map[string]interface{}{}
+	if tunnel.VNI != nil {
+		extraOptions["key"] = strconv.Itoa(int(*tunnel.VNI))
+	}
+	if tunnel.DestinationPort != nil {
+		extraOptions["dst_port"] = strconv.Itoa(int(*tunnel.DestinationPort))
+	}
+	return c.createTunnelPort(portName, tunnelType, tunnel.RemoteIP, extraOptions, externalIDs)
+}
+
+func (c *Controller) createTunnelPort(portName string, tunnelType ovsconfig.TunnelType, remoteIP string, extraOptions, externalIDs map[string]interface{}) (string, error) {
+	if net.ParseIP(remoteIP) == nil {
+		return "", fmt.Errorf("invalid remote IP %s", remoteIP)
+	}
+	portUUID, err := c.ovsBridgeClient.CreateTunnelPortExt(portName, tunnelType, 0, false, "", remoteIP, "", extraOptions, externalIDs)
+	if err != nil {
+		return "", err
+	}
+	return portUUID, nil
+}
+
+// genPortName returns the name of the OVS port for the TrafficControlPort. The names of OVS internal ports and
+// network devices are specified by users, while the names of tunnel ports are generated by hashing the tunnel
+// configuration, so that the TrafficControls with the same tunnel configuration share the same tunnel port.
+func genPortName(port *v1alpha2.TrafficControlPort) string {
+	var prefix, hashString string
+	switch {
+	case port.OVSInternal != nil:
+		return port.OVSInternal.Name
+	case port.Device != nil:
+		return port.Device.Name
+	case port.GENEVE != nil:
+		prefix = ovsconfig.GeneveTunnel
+		hashString = genUDPTunnelHashString(port.GENEVE, defaultGENEVETunnelDestinationPort)
+	case port.VXLAN != nil:
+		prefix = ovsconfig.VXLANTunnel
+		hashString = genUDPTunnelHashString(port.VXLAN, defaultVXLANTunnelDestinationPort)
+	case port.GRE != nil:
+		prefix = ovsconfig.GRETunnel
+		hashString = fmt.Sprintf("%s-%s", port.GRE.RemoteIP, int32PtrToString(port.GRE.Key))
+	case port.ERSPAN != nil:
+		prefix = ovsconfig.ERSPANTunnel
+		hashString = fmt.Sprintf("%s-%s-%d-%s-%s-%s", port.ERSPAN.RemoteIP, int32PtrToString(port.ERSPAN.SessionID),
+			port.ERSPAN.Version, int32PtrToString(port.ERSPAN.Index), int32PtrToString(port.ERSPAN.Dir),
+			int32PtrToString(port.ERSPAN.HardwareID))
+	default:
+		return ""
+	}
+	hash := sha1.Sum([]byte(hashString)) // #nosec G401: not used for security purposes
+	return fmt.Sprintf("%s-%s", prefix, hex.EncodeToString(hash[:])[:portNameHashLen])
+}
+
+func genUDPTunnelHashString(tunnel *v1alpha2.UDPTunnel, defaultDestinationPort int32) string {
+	destinationPort := defaultDestinationPort
+	if tunnel.DestinationPort != nil {
+		destinationPort = *tunnel.DestinationPort
+	}
+	return fmt.Sprintf("%s-%s-%d", tunnel.RemoteIP, int32PtrToString(tunnel.VNI), destinationPort)
+}
+
+func int32PtrToString(v *int32) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*v))
+}
+
+func sortedOFPorts(ofPorts sets.Int32) []uint32 {
+	ports := make([]uint32, 0, ofPorts.Len())
+	for port := range ofPorts {
+		ports = append(ports, uint32(port))
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
+
+// trafficControlStatusControlInterface is an interface that knows how to report TrafficControl status to
+// antrea-controller. It's created as an interface to allow testing.
+type trafficControlStatusControlInterface interface {
+	UpdateTrafficControlStatus(status *v1beta2.TrafficControlStatus) error
+}
+
+type trafficControlStatusControl struct {
+	antreaClientProvider agent.AntreaClientProvider
+}
+
+func (c *trafficControlStatusControl) UpdateTrafficControlStatus(status *v1beta2.TrafficControlStatus) error {
+	antreaClient, err := c.antreaClientProvider.GetAntreaClient()
+	if err != nil {
+		return fmt.Errorf("error getting antrea client: %v", err)
+	}
+	_, err = antreaClient.ControlplaneV1beta2().TrafficControlStatuses().Create(context.TODO(), status, metav1.CreateOptions{})
+	return err
+}
@@ -0,0 +1,371 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trafficcontrol
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/util"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	ovsconfigtest "antrea.io/antrea/pkg/ovs/ovsconfig/testing"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+const (
+	defaultTimeout  = time.Second
+	defaultInterval = 10 * time.Millisecond
+)
+
+var (
+	labelsApp1 = map[string]string{"app": "app1"}
+	labelsApp2 = map[string]string{"app": "app2"}
+
+	ns1 = newNamespace("ns1", map[string]string{"env": "test"})
+	ns2 = newNamespace("ns2", map[string]string{"env": "prod"})
+
+	pod1 = newPod("ns1", "pod1", labelsApp1)
+	pod2 = newPod("ns1", "pod2", labelsApp2)
+	pod3 = newPod("ns2", "pod3", labelsApp1)
+
+	vni int32 = 1
+)
+
+type fakeController struct {
+	*Controller
+	mockOFClient   *openflowtest.MockClient
+	mockOVSClient  *ovsconfigtest.MockOVSBridgeClient
+	crdClient      *fakeversioned.Clientset
+	k8sClient      *fake.Clientset
+	interfaceStore interfacestore.InterfaceStore
+	statusControl  *fakeStatusControl
+}
+
+// fakeStatusControl records the last status reported for each TrafficControl.
+type fakeStatusControl struct {
+	sync.Mutex
+	statuses map[string]v1beta2.TrafficControlNodeStatus
+	count    int
+}
+
+func (c *fakeStatusControl) UpdateTrafficControlStatus(status *v1beta2.TrafficControlStatus) error {
+	c.Lock()
+	defer c.Unlock()
+	c.statuses[status.Name] = status.Nodes[0]
+	c.count++
+	return nil
+}
+
+func newNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func newPod(namespace, name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec:       corev1.PodSpec{NodeName: "node1"},
+	}
+}
+
+func newTrafficControl(name string, appliedTo v1alpha2.AppliedTo, action v1alpha2.TrafficControlAction, targetPort v1alpha2.TrafficControlPort, returnPort *v1alpha2.TrafficControlPort) *v1alpha2.TrafficControl {
+	return &v1alpha2.TrafficControl{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha2.TrafficControlSpec{
+			AppliedTo:  appliedTo,
+			Direction:  v1alpha2.DirectionBoth,
+			Action:     action,
+			TargetPort: targetPort,
+			ReturnPort: returnPort,
+		},
+	}
+}
+
+func addPodInterface(ifaceStore interfacestore.InterfaceStore, podNamespace, podName string, ofPort int32) {
+	containerName := util.GenerateContainerInterfaceName(podName, podNamespace, "container-id")
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            containerName,
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: podName, PodNamespace: podNamespace, ContainerID: containerName},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: ofPort},
+		IPs:                      []net.IP{net.ParseIP("10.10.10.1")},
+	})
+}
+
+func newFakeController(t *testing.T, objects []runtime.Object, crdObjects []runtime.Object) *fakeController {
+	controller := gomock.NewController(t)
+	mockOFClient := openflowtest.NewMockClient(controller)
+	mockOVSClient := ovsconfigtest.NewMockOVSBridgeClient(controller)
+
+	k8sClient := fake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(k8sClient, 0)
+	crdClient := fakeversioned.NewSimpleClientset(crdObjects...)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+
+	ifaceStore := interfacestore.NewInterfaceStore()
+	addPodInterface(ifaceStore, "ns1", "pod1", 1)
+	addPodInterface(ifaceStore, "ns1", "pod2", 2)
+	addPodInterface(ifaceStore, "ns2", "pod3", 3)
+
+	podUpdateChannel := channel.NewSubscribableChannel("PodUpdate", 100)
+	c := NewTrafficControlController("node1",
+		mockOFClient,
+		nil,
+		ifaceStore,
+		mockOVSClient,
+		crdInformerFactory.Crd().V1alpha2().TrafficControls(),
+		informerFactory.Core().V1().Pods().Informer(),
+		informerFactory.Core().V1().Namespaces(),
+		podUpdateChannel)
+	statusControl := &fakeStatusControl{statuses: map[string]v1beta2.TrafficControlNodeStatus{}}
+	c.statusControlInterface = statusControl
+
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	crdInformerFactory.WaitForCacheSync(stopCh)
+
+	return &fakeController{
+		Controller:     c,
+		mockOFClient:   mockOFClient,
+		mockOVSClient:  mockOVSClient,
+		crdClient:      crdClient,
+		k8sClient:      k8sClient,
+		interfaceStore: ifaceStore,
+		statusControl:  statusControl,
+	}
+}
+
+func TestGenPortName(t *testing.T) {
+	vxlanPort1 := v1alpha2.TrafficControlPort{VXLAN: &v1alpha2.UDPTunnel{RemoteIP: "1.1.1.1", VNI: &vni}}
+	vxlanPort2 := v1alpha2.TrafficControlPort{VXLAN: &v1alpha2.UDPTunnel{RemoteIP: "1.1.1.1", VNI: &vni}}
+	vxlanPort3 := v1alpha2.TrafficControlPort{VXLAN: &v1alpha2.UDPTunnel{RemoteIP: "1.1.1.2", VNI: &vni}}
+	erspanPort := v1alpha2.TrafficControlPort{ERSPAN: &v1alpha2.ERSPANTunnel{RemoteIP: "1.1.1.1", Version: 2}}
+
+	assert.Equal(t, "tap0", genPortName(&v1alpha2.TrafficControlPort{OVSInternal: &v1alpha2.OVSInternalPort{Name: "tap0"}}))
+	assert.Equal(t, "eth1", genPortName(&v1alpha2.TrafficControlPort{Device: &v1alpha2.NetworkDevice{Name: "eth1"}}))
+	assert.Equal(t, genPortName(&vxlanPort1), genPortName(&vxlanPort2))
+	assert.NotEqual(t, genPortName(&vxlanPort1), genPortName(&vxlanPort3))
+	for _, port := range []*v1alpha2.TrafficControlPort{&vxlanPort1, &vxlanPort3, &erspanPort} {
+		// The names of tunnel ports must be valid network interface names.
+		assert.LessOrEqual(t, len(genPortName(port)), 15)
+	}
+}
+
+func TestTrafficControlMirrorWithTunnelPort(t *testing.T) {
+	targetPort := v1alpha2.TrafficControlPort{VXLAN: &v1alpha2.UDPTunnel{RemoteIP: "1.1.1.1", VNI: &vni}}
+	tc1 := newTrafficControl("tc1", v1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{MatchLabels: labelsApp1}}, v1alpha2.ActionMirror, targetPort, nil)
+	// tc2 shares the same tunnel port with tc1.
+	tc2 := newTrafficControl("tc2", v1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{MatchLabels: labelsApp2}}, v1alpha2.ActionMirror, targetPort, nil)
+	c := newFakeController(t, []runtime.Object{ns1, ns2, pod1, pod2, pod3}, []runtime.Object{tc1, tc2})
+
+	portName := genPortName(&targetPort)
+	c.mockOVSClient.EXPECT().CreateTunnelPortExt(portName, gomock.Any(), int32(0), false, "", "1.1.1.1", "",
+		map[string]interface{}{"key": "1"},
+		map[string]interface{}{interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTrafficControl}).Return("uuid1", nil).Times(1)
+	c.mockOVSClient.EXPECT().GetOFPort(portName, false).Return(int32(10), nil).Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc1", []uint32{1, 3}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc2", []uint32{2}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	require.NoError(t, c.syncTrafficControl("tc2"))
+
+	itf, exists := c.interfaceStore.GetInterfaceByName(portName)
+	require.True(t, exists)
+	assert.Equal(t, interfacestore.TrafficControlInterface, itf.Type)
+	assert.Equal(t, sets.NewString("tc1", "tc2"), c.portToTCBindings[portName].trafficControls)
+
+	// Deleting tc1 must not delete the tunnel port as it's still referenced by tc2.
+	require.NoError(t, c.crdClient.CrdV1alpha2().TrafficControls().Delete(context.TODO(), "tc1", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := c.tcLister.Get("tc1")
+		return err != nil
+	}, defaultTimeout, defaultInterval)
+	c.mockOFClient.EXPECT().UninstallTrafficControlMarkFlows("tc1").Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	_, exists = c.interfaceStore.GetInterfaceByName(portName)
+	assert.True(t, exists)
+
+	// Deleting tc2 must delete the tunnel port.
+	require.NoError(t, c.crdClient.CrdV1alpha2().TrafficControls().Delete(context.TODO(), "tc2", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := c.tcLister.Get("tc2")
+		return err != nil
+	}, defaultTimeout, defaultInterval)
+	c.mockOFClient.EXPECT().UninstallTrafficControlMarkFlows("tc2").Times(1)
+	c.mockOVSClient.EXPECT().DeletePort("uuid1").Times(1)
+	require.NoError(t, c.syncTrafficControl("tc2"))
+	_, exists = c.interfaceStore.GetInterfaceByName(portName)
+	assert.False(t, exists)
+	assert.Empty(t, c.portToTCBindings)
+	assert.Empty(t, c.podToTCBindings)
+}
+
+func TestTrafficControlRedirect(t *testing.T) {
+	targetPort := v1alpha2.TrafficControlPort{OVSInternal: &v1alpha2.OVSInternalPort{Name: "target0"}}
+	returnPort := v1alpha2.TrafficControlPort{OVSInternal: &v1alpha2.OVSInternalPort{Name: "return0"}}
+	tc1 := newTrafficControl("tc1", v1alpha2.AppliedTo{NamespaceSelector: &metav1.LabelSelector{MatchLabels: ns1.Labels}}, v1alpha2.ActionRedirect, targetPort, &returnPort)
+	c := newFakeController(t, []runtime.Object{ns1, ns2, pod1, pod2, pod3}, []runtime.Object{tc1})
+
+	externalIDs := map[string]interface{}{interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTrafficControl}
+	c.mockOVSClient.EXPECT().CreateInternalPort("target0", int32(0), externalIDs).Return("uuid1", nil).Times(1)
+	c.mockOVSClient.EXPECT().GetOFPort("target0", false).Return(int32(10), nil).Times(1)
+	c.mockOVSClient.EXPECT().CreateInternalPort("return0", int32(0), externalIDs).Return("uuid2", nil).Times(1)
+	c.mockOVSClient.EXPECT().GetOFPort("return0", false).Return(int32(11), nil).Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlReturnPortFlow(uint32(11)).Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc1", []uint32{1, 2}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionRedirect).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	assert.Equal(t, sets.NewString("ns1/pod1", "ns1/pod2"), c.tcStates["tc1"].pods)
+
+	// Deleting pod2 should update the mark flows.
+	require.NoError(t, c.k8sClient.CoreV1().Pods("ns1").Delete(context.TODO(), "pod2", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := c.podLister.Pods("ns1").Get("pod2")
+		return err != nil
+	}, defaultTimeout, defaultInterval)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc1", []uint32{1}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionRedirect).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	assert.Equal(t, sets.NewString("ns1/pod1"), c.tcStates["tc1"].pods)
+
+	// Changing the action to Mirror should release the return port.
+	tc1Updated := tc1.DeepCopy()
+	tc1Updated.Spec.Action = v1alpha2.ActionMirror
+	tc1Updated.Spec.ReturnPort = nil
+	tc1Updated.Generation = 2
+	_, err := c.crdClient.CrdV1alpha2().TrafficControls().Update(context.TODO(), tc1Updated, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		tc, err := c.tcLister.Get("tc1")
+		return err == nil && tc.Spec.Action == v1alpha2.ActionMirror
+	}, defaultTimeout, defaultInterval)
+	c.mockOFClient.EXPECT().UninstallTrafficControlReturnPortFlow(uint32(11)).Times(1)
+	c.mockOVSClient.EXPECT().DeletePort("uuid2").Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc1", []uint32{1}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	_, exists := c.interfaceStore.GetInterfaceByName("return0")
+	assert.False(t, exists)
+}
+
+func TestPodAppliedByMultipleTrafficControls(t *testing.T) {
+	targetPort1 := v1alpha2.TrafficControlPort{Device: &v1alpha2.NetworkDevice{Name: "eth1"}}
+	targetPort2 := v1alpha2.TrafficControlPort{Device: &v1alpha2.NetworkDevice{Name: "eth2"}}
+	tc1 := newTrafficControl("tc1", v1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{MatchLabels: labelsApp1}}, v1alpha2.ActionMirror, targetPort1, nil)
+	tc2 := newTrafficControl("tc2", v1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{}}, v1alpha2.ActionMirror, targetPort2, nil)
+	c := newFakeController(t, []runtime.Object{ns1, ns2, pod1, pod2, pod3}, []runtime.Object{tc1, tc2})
+
+	externalIDs := map[string]interface{}{interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTrafficControl}
+	c.mockOVSClient.EXPECT().CreatePort("eth1", "eth1", externalIDs).Return("uuid1", nil).Times(1)
+	c.mockOVSClient.EXPECT().GetOFPort("eth1", false).Return(int32(10), nil).Times(1)
+	c.mockOVSClient.EXPECT().CreatePort("eth2", "eth2", externalIDs).Return("uuid2", nil).Times(1)
+	c.mockOVSClient.EXPECT().GetOFPort("eth2", false).Return(int32(11), nil).Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc1", []uint32{1, 3}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	// pod1 and pod3 are already applied by tc1, so only pod2 is effectively applied by tc2.
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc2", []uint32{2}, uint32(11), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	require.NoError(t, c.syncTrafficControl("tc2"))
+
+	// After tc1 is deleted, tc2 becomes the effective TrafficControl of pod1 and pod3.
+	require.NoError(t, c.crdClient.CrdV1alpha2().TrafficControls().Delete(context.TODO(), "tc1", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := c.tcLister.Get("tc1")
+		return err != nil
+	}, defaultTimeout, defaultInterval)
+	c.mockOFClient.EXPECT().UninstallTrafficControlMarkFlows("tc1").Times(1)
+	c.mockOVSClient.EXPECT().DeletePort("uuid1").Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc2", []uint32{1, 2, 3}, uint32(11), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc2"))
+}
+
+func TestRemoveStalePorts(t *testing.T) {
+	targetPort := v1alpha2.TrafficControlPort{OVSInternal: &v1alpha2.OVSInternalPort{Name: "target0"}}
+	tc1 := newTrafficControl("tc1", v1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{MatchLabels: labelsApp1}}, v1alpha2.ActionMirror, targetPort, nil)
+	c := newFakeController(t, nil, []runtime.Object{tc1})
+
+	for i, name := range []string{"target0", "stale0"} {
+		itf := interfacestore.NewTrafficControlInterface(name)
+		itf.OVSPortConfig = &interfacestore.OVSPortConfig{PortUUID: name + "-uuid", OFPort: int32(10 + i)}
+		c.interfaceStore.AddInterface(itf)
+	}
+	c.mockOVSClient.EXPECT().DeletePort("stale0-uuid").Times(1)
+	c.removeStalePorts()
+	_, exists := c.interfaceStore.GetInterfaceByName("target0")
+	assert.True(t, exists)
+	_, exists = c.interfaceStore.GetInterfaceByName("stale0")
+	assert.False(t, exists)
+}
+
+func TestTrafficControlStatus(t *testing.T) {
+	targetPort := v1alpha2.TrafficControlPort{Device: &v1alpha2.NetworkDevice{Name: "eth1"}}
+	tc1 := newTrafficControl("tc1", v1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{MatchLabels: labelsApp1}}, v1alpha2.ActionMirror, targetPort, nil)
+	tc1.Generation = 1
+	c := newFakeController(t, []runtime.Object{ns1, ns2, pod1, pod2, pod3}, []runtime.Object{tc1})
+
+	// The target device doesn't exist, the TrafficControl should be reported as TargetUnreachable.
+	externalIDs := map[string]interface{}{interfacestore.AntreaInterfaceTypeKey: interfacestore.AntreaTrafficControl}
+	c.mockOVSClient.EXPECT().CreatePort("eth1", "eth1", externalIDs).Return("uuid1", nil).Times(2)
+	c.mockOVSClient.EXPECT().GetOFPort("eth1", false).Return(int32(0), fmt.Errorf("device not found")).Times(1)
+	c.mockOVSClient.EXPECT().DeletePort("uuid1").Times(1)
+	require.Error(t, c.syncTrafficControl("tc1"))
+	status := c.statusControl.statuses["tc1"]
+	assert.Equal(t, "node1", status.NodeName)
+	assert.Equal(t, int64(1), status.Generation)
+	assert.True(t, status.TargetUnreachable)
+	assert.False(t, status.PortCreated)
+	assert.False(t, status.FlowsInstalled)
+	assert.Contains(t, status.Message, "device not found")
+
+	// The target device is available now.
+	c.mockOVSClient.EXPECT().GetOFPort("eth1", false).Return(int32(10), nil).Times(1)
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows("tc1", []uint32{1, 3}, uint32(10), v1alpha2.DirectionBoth, v1alpha2.ActionMirror).Times(1)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	assert.Equal(t, v1beta2.TrafficControlNodeStatus{
+		NodeName:       "node1",
+		Generation:     1,
+		PortCreated:    true,
+		FlowsInstalled: true,
+	}, c.statusControl.statuses["tc1"])
+	assert.Equal(t, 2, c.statusControl.count)
+
+	// The same status should not be reported again.
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	assert.Equal(t, 2, c.statusControl.count)
+
+	// The same status should be reported again after the statuses are resynced.
+	c.resyncStatus()
+	assert.Equal(t, 1, c.queue.Len())
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	assert.Equal(t, 3, c.statusControl.count)
+	require.NoError(t, c.syncTrafficControl("tc1"))
+	assert.Equal(t, 3, c.statusControl.count)
+}
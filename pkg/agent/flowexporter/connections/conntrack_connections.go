@@ -17,6 +17,7 @@ package connections
 import (
 	"encoding/binary"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/vmware/go-ipfix/pkg/registry"
@@ -45,6 +46,8 @@ type ConntrackConnectionStore struct {
 	networkPolicyQuerier  querier.AgentNetworkPolicyInfoQuerier
 	pollInterval          time.Duration
 	connectUplinkToBridge bool
+	// tcpInfoCollector is nil if the collection of TCP metrics is disabled.
+	tcpInfoCollector TCPInfoCollector
 	connectionStore
 }
 
@@ -57,6 +60,10 @@ func NewConntrackConnectionStore(
 	proxier proxy.Proxier,
 	o *flowexporter.FlowExporterOptions,
 ) *ConntrackConnectionStore {
+	var tcpInfoCollector TCPInfoCollector
+	if o.CollectTCPMetrics {
+		tcpInfoCollector = NewTCPInfoCollector(ifaceStore)
+	}
 	return &ConntrackConnectionStore{
 		connDumper:            connTrackDumper,
 		v4Enabled:             v4Enabled,
@@ -65,6 +72,7 @@ func NewConntrackConnectionStore(
 		pollInterval:          o.PollInterval,
 		connectionStore:       NewConnectionStore(ifaceStore, proxier, o),
 		connectUplinkToBridge: o.ConnectUplinkToBridge,
+		tcpInfoCollector:      tcpInfoCollector,
 	}
 }
 
@@ -124,6 +132,11 @@ func (cs *ConntrackConnectionStore) Poll() ([]int, error) {
 		filteredConnsList = append(filteredConnsList, filteredConnsListPerZone...)
 		connsLens = append(connsLens, len(filteredConnsList))
 	}
+	// The TCP metrics are collected before holding the lock, as dumping the sockets of the
+	// local Pods may take some time.
+	if cs.tcpInfoCollector != nil {
+		cs.tcpInfoCollector.CollectTCPInfo(filteredConnsList)
+	}
 
 	// Reset IsPresent flag for all connections in connection map before updating
 	// the dumped flows information in connection map. If the connection does not
@@ -212,6 +225,18 @@ func (cs *ConntrackConnectionStore) addNetworkPolicyMetadata(conn *flowexporter.
 	}
 }
 
+// addDestinationFQDN fills the FQDNs which were resolved to the destination IP of the connection,
+// according to the DNS responses intercepted for FQDN policy rules. Destinations which are local
+// Pods are skipped as they cannot be selected by FQDN peers.
+func (cs *ConntrackConnectionStore) addDestinationFQDN(conn *flowexporter.Connection) {
+	if cs.networkPolicyQuerier == nil || conn.DestinationPodName != "" {
+		return
+	}
+	if fqdns := cs.networkPolicyQuerier.GetFQDNsForIP(conn.FlowKey.DestinationAddress); len(fqdns) > 0 {
+		conn.DestinationFQDN = strings.Join(fqdns, ",")
+	}
+}
+
 // AddOrUpdateConn updates the connection if it is already present, i.e., update timestamp, counters etc.,
 // or adds a new connection with the resolved K8s metadata.
 func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connection) {
@@ -232,6 +257,11 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connectio
 		existingConn.ReverseBytes = conn.ReverseBytes
 		existingConn.ReversePackets = conn.ReversePackets
 		existingConn.TCPState = conn.TCPState
+		// Keep the last TCP metrics when the socket is gone, e.g. in TIME_WAIT state.
+		if conn.TCPSmoothedRTT != 0 {
+			existingConn.TCPSmoothedRTT = conn.TCPSmoothedRTT
+			existingConn.TCPRetransmissions = conn.TCPRetransmissions
+		}
 		existingConn.IsActive = flowexporter.CheckConntrackConnActive(existingConn)
 		if existingConn.IsActive {
 			existingItem, exists := cs.expirePriorityQueue.KeyToItem[connKey]
@@ -260,6 +290,7 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connectio
 			}
 		}
 		cs.addNetworkPolicyMetadata(conn)
+		cs.addDestinationFQDN(conn)
 		if conn.StartTime.IsZero() {
 			conn.StartTime = time.Now()
 			conn.StopTime = time.Now()
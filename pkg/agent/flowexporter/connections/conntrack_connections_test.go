@@ -142,27 +142,32 @@ func TestConntrackConnectionStore_AddOrUpdateConn(t *testing.T) {
 				ReverseBytes:    0xbaa,
 				FlowKey:         tuple2,
 				IsPresent:       true,
+				TCPSmoothedRTT:  1000,
 			},
 			newConn: flowexporter.Connection{
-				StartTime:       refTime.Add(-(time.Second * 50)),
-				StopTime:        refTime,
-				OriginalPackets: 0xffff,
-				OriginalBytes:   0xbaaaaa0000000000,
-				ReversePackets:  0xff,
-				ReverseBytes:    0xbaaa,
-				FlowKey:         tuple2,
-				IsPresent:       true,
+				StartTime:          refTime.Add(-(time.Second * 50)),
+				StopTime:           refTime,
+				OriginalPackets:    0xffff,
+				OriginalBytes:      0xbaaaaa0000000000,
+				ReversePackets:     0xff,
+				ReverseBytes:       0xbaaa,
+				FlowKey:            tuple2,
+				IsPresent:          true,
+				TCPSmoothedRTT:     1200,
+				TCPRetransmissions: 3,
 			},
 			expectedConn: flowexporter.Connection{
-				StartTime:       refTime.Add(-(time.Second * 50)),
-				StopTime:        refTime,
-				OriginalPackets: 0xffff,
-				OriginalBytes:   0xbaaaaa0000000000,
-				ReversePackets:  0xff,
-				ReverseBytes:    0xbaaa,
-				FlowKey:         tuple2,
-				IsPresent:       true,
-				IsActive:        true,
+				StartTime:          refTime.Add(-(time.Second * 50)),
+				StopTime:           refTime,
+				OriginalPackets:    0xffff,
+				OriginalBytes:      0xbaaaaa0000000000,
+				ReversePackets:     0xff,
+				ReverseBytes:       0xbaaa,
+				FlowKey:            tuple2,
+				IsPresent:          true,
+				IsActive:           true,
+				TCPSmoothedRTT:     1200,
+				TCPRetransmissions: 3,
 			},
 		},
 		{
@@ -230,6 +235,32 @@ func TestConntrackConnectionStore_AddOrUpdateConn(t *testing.T) {
 	}
 }
 
+func TestConntrackConnectionStore_AddDestinationFQDN(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	metrics.InitializeConnectionMetrics()
+	refTime := time.Now()
+
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+	mockConnDumper := connectionstest.NewMockConnTrackDumper(ctrl)
+	npQuerier := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	conntrackConnStore := NewConntrackConnectionStore(mockConnDumper, true, false, npQuerier, mockIfaceStore, nil, testFlowExporterOptions)
+
+	conn := flowexporter.Connection{
+		StartTime: refTime,
+		StopTime:  refTime,
+		FlowKey:   tuple2,
+	}
+	mockIfaceStore.EXPECT().GetInterfaceByIP(tuple2.SourceAddress.String()).Return(interfaceFlow1, true)
+	mockIfaceStore.EXPECT().GetInterfaceByIP(tuple2.DestinationAddress.String()).Return(nil, false)
+	npQuerier.EXPECT().GetFQDNsForIP(tuple2.DestinationAddress).Return([]string{"test.antrea.io", "www.antrea.io"})
+	conntrackConnStore.AddOrUpdateConn(&conn)
+	actualConn, exist := conntrackConnStore.GetConnByKey(flowexporter.NewConnectionKey(&conn))
+	require.True(t, exist, "The connection should exist in the connection store")
+	assert.Equal(t, "pod1", actualConn.SourcePodName)
+	assert.Equal(t, "test.antrea.io,www.antrea.io", actualConn.DestinationFQDN)
+}
+
 // testAddNewConn tests podInfo, Services, network policy mapping.
 func testAddNewConn(mockIfaceStore *interfacestoretest.MockInterfaceStore, mockProxier *proxytest.MockProxier, npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, conn flowexporter.Connection) {
 	mockIfaceStore.EXPECT().GetInterfaceByIP(conn.FlowKey.SourceAddress.String()).Return(nil, false)
@@ -27,3 +27,11 @@ type ConnTrackDumper interface {
 	// GetMaxConnections returns the size of the connection tracking table.
 	GetMaxConnections() (int, error)
 }
+
+// TCPInfoCollector is an interface that is used to collect the TCP health metrics of connections
+// from the TCP sockets of the local Pods.
+type TCPInfoCollector interface {
+	// CollectTCPInfo sets the TCP metrics of the given connections which have an endpoint in a
+	// local Pod. The metrics of the other connections are left unchanged.
+	CollectTCPInfo(conns []*flowexporter.Connection)
+}
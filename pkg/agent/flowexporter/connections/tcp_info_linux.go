@@ -0,0 +1,155 @@
+//go:build linux
+// +build linux
+
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"net"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
+
+// tcpInfoCollector implements TCPInfoCollector. It reads the TCP_INFO of the sockets of the local
+// Pods through the sock_diag netlink interface, in the network namespace of each Pod. The connection
+// setup latency is not collected, as TCP_INFO doesn't include the time of the TCP handshake.
+var _ TCPInfoCollector = new(tcpInfoCollector)
+
+type tcpInfoCollector struct {
+	ifaceStore interfacestore.InterfaceStore
+	// dumpTCPInfo returns the TCP sockets of the given address family in a network namespace.
+	// It can be overridden in tests.
+	dumpTCPInfo func(netNS string, family uint8) ([]*netlink.InetDiagTCPInfoResp, error)
+}
+
+// socketKey identifies a TCP socket with its local and remote addresses.
+type socketKey struct {
+	localAddress  string
+	localPort     uint16
+	remoteAddress string
+	remotePort    uint16
+}
+
+func NewTCPInfoCollector(ifaceStore interfacestore.InterfaceStore) TCPInfoCollector {
+	return &tcpInfoCollector{
+		ifaceStore:  ifaceStore,
+		dumpTCPInfo: dumpTCPInfo,
+	}
+}
+
+func dumpTCPInfo(netNS string, family uint8) ([]*netlink.InetDiagTCPInfoResp, error) {
+	var sockets []*netlink.InetDiagTCPInfoResp
+	err := ns.WithNetNSPath(netNS, func(_ ns.NetNS) error {
+		var err error
+		sockets, err = netlink.SocketDiagTCPInfo(family)
+		return err
+	})
+	return sockets, err
+}
+
+func (c *tcpInfoCollector) CollectTCPInfo(conns []*flowexporter.Connection) {
+	// The sockets of each network namespace are dumped at most once per call.
+	socketsByNetNS := make(map[string]map[socketKey]*netlink.TCPInfo)
+	for _, conn := range conns {
+		if conn.FlowKey.Protocol != unix.IPPROTO_TCP {
+			continue
+		}
+		netNS, key, found := c.getSocketKey(conn)
+		if !found {
+			continue
+		}
+		sockets, dumped := socketsByNetNS[netNS]
+		if !dumped {
+			sockets = c.getSockets(netNS)
+			socketsByNetNS[netNS] = sockets
+		}
+		if tcpInfo, ok := sockets[key]; ok {
+			conn.TCPSmoothedRTT = tcpInfo.Rtt
+			conn.TCPRetransmissions = tcpInfo.Total_retrans
+		}
+	}
+}
+
+// getSocketKey returns the network namespace of the local Pod which is an endpoint of the
+// connection, and the key of the socket of the connection in this network namespace. The socket of
+// the source Pod is preferred, as the client is the side which measures the latency of the requests.
+func (c *tcpInfoCollector) getSocketKey(conn *flowexporter.Connection) (string, socketKey, bool) {
+	if netNS, found := c.getPodNetNS(conn.FlowKey.SourceAddress); found {
+		// The client socket is connected to the Service address for Service connections, which
+		// is the destination of the original direction of the conntrack connection.
+		remoteAddress, remotePort := conn.FlowKey.DestinationAddress, conn.FlowKey.DestinationPort
+		if conn.DestinationServiceAddress != nil && conn.DestinationServicePort != 0 {
+			remoteAddress, remotePort = conn.DestinationServiceAddress, conn.DestinationServicePort
+		}
+		return netNS, socketKey{
+			localAddress:  conn.FlowKey.SourceAddress.String(),
+			localPort:     conn.FlowKey.SourcePort,
+			remoteAddress: remoteAddress.String(),
+			remotePort:    remotePort,
+		}, true
+	}
+	if netNS, found := c.getPodNetNS(conn.FlowKey.DestinationAddress); found {
+		return netNS, socketKey{
+			localAddress:  conn.FlowKey.DestinationAddress.String(),
+			localPort:     conn.FlowKey.DestinationPort,
+			remoteAddress: conn.FlowKey.SourceAddress.String(),
+			remotePort:    conn.FlowKey.SourcePort,
+		}, true
+	}
+	return "", socketKey{}, false
+}
+
+func (c *tcpInfoCollector) getPodNetNS(ip net.IP) (string, bool) {
+	iface, found := c.ifaceStore.GetInterfaceByIP(ip.String())
+	if !found || iface.Type != interfacestore.ContainerInterface || iface.NetNS == "" {
+		return "", false
+	}
+	return iface.NetNS, true
+}
+
+// getSockets returns the TCP_INFO of the TCP sockets in a network namespace, indexed by socket
+// key. Both address families are dumped, as the connections accepted by a dual-stack listener are
+// AF_INET6 sockets with IPv4-mapped addresses, which are formatted like IPv4 addresses.
+func (c *tcpInfoCollector) getSockets(netNS string) map[socketKey]*netlink.TCPInfo {
+	sockets := make(map[socketKey]*netlink.TCPInfo)
+	for _, family := range []uint8{unix.AF_INET, unix.AF_INET6} {
+		resps, err := c.dumpTCPInfo(netNS, family)
+		if err != nil {
+			klog.V(2).InfoS("Failed to dump TCP sockets", "netns", netNS, "family", family, "err", err)
+			continue
+		}
+		for _, resp := range resps {
+			// TCP_INFO is only reported for full sockets, e.g. not for sockets in TIME_WAIT state.
+			if resp.InetDiagMsg == nil || resp.TCPInfo == nil {
+				continue
+			}
+			id := resp.InetDiagMsg.ID
+			sockets[socketKey{
+				localAddress:  id.Source.String(),
+				localPort:     id.SourcePort,
+				remoteAddress: id.Destination.String(),
+				remotePort:    id.DestinationPort,
+			}] = resp.TCPInfo
+		}
+	}
+	return sockets
+}
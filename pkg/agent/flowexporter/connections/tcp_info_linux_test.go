@@ -0,0 +1,130 @@
+//go:build linux
+// +build linux
+
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	interfacestoretest "antrea.io/antrea/pkg/agent/interfacestore/testing"
+)
+
+func newTCPInfoResp(srcIP string, srcPort uint16, dstIP string, dstPort uint16, rtt, retrans uint32) *netlink.InetDiagTCPInfoResp {
+	return &netlink.InetDiagTCPInfoResp{
+		InetDiagMsg: &netlink.Socket{
+			ID: netlink.SocketID{
+				Source:          net.ParseIP(srcIP),
+				SourcePort:      srcPort,
+				Destination:     net.ParseIP(dstIP),
+				DestinationPort: dstPort,
+			},
+		},
+		TCPInfo: &netlink.TCPInfo{Rtt: rtt, Total_retrans: retrans},
+	}
+}
+
+func TestTCPInfoCollector_CollectTCPInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientPod := &interfacestore.InterfaceConfig{
+		Type:                     interfacestore.ContainerInterface,
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "client", NetNS: "/var/run/netns/client"},
+	}
+	serverPod := &interfacestore.InterfaceConfig{
+		Type:                     interfacestore.ContainerInterface,
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "server", NetNS: "/var/run/netns/server"},
+	}
+	mockIfaceStore := interfacestoretest.NewMockInterfaceStore(ctrl)
+	mockIfaceStore.EXPECT().GetInterfaceByIP("10.10.0.1").Return(clientPod, true).AnyTimes()
+	mockIfaceStore.EXPECT().GetInterfaceByIP("10.10.0.2").Return(serverPod, true).AnyTimes()
+	mockIfaceStore.EXPECT().GetInterfaceByIP("10.10.1.1").Return(nil, false).AnyTimes()
+	mockIfaceStore.EXPECT().GetInterfaceByIP("192.168.0.1").Return(nil, false).AnyTimes()
+
+	sockets := map[string]map[uint8][]*netlink.InetDiagTCPInfoResp{
+		"/var/run/netns/client": {
+			unix.AF_INET: {
+				// Socket of a connection to a Service.
+				newTCPInfoResp("10.10.0.1", 40000, "10.96.0.10", 80, 1500, 2),
+				newTCPInfoResp("10.10.0.1", 40001, "10.10.1.1", 443, 800, 0),
+			},
+		},
+		"/var/run/netns/server": {
+			// Socket accepted by a dual-stack listener.
+			unix.AF_INET6: {newTCPInfoResp("::ffff:10.10.0.2", 8080, "::ffff:10.10.1.1", 50000, 300, 1)},
+		},
+	}
+	numDumps := 0
+	collector := &tcpInfoCollector{
+		ifaceStore: mockIfaceStore,
+		dumpTCPInfo: func(netNS string, family uint8) ([]*netlink.InetDiagTCPInfoResp, error) {
+			numDumps++
+			resps, ok := sockets[netNS]
+			if !ok {
+				return nil, fmt.Errorf("unknown netns %s", netNS)
+			}
+			return resps[family], nil
+		},
+	}
+
+	serviceConn := &flowexporter.Connection{
+		FlowKey:                   flowexporter.Tuple{SourceAddress: net.ParseIP("10.10.0.1"), DestinationAddress: net.ParseIP("10.10.1.1"), Protocol: 6, SourcePort: 40000, DestinationPort: 8080},
+		DestinationServiceAddress: net.ParseIP("10.96.0.10"),
+		DestinationServicePort:    80,
+	}
+	egressConn := &flowexporter.Connection{
+		FlowKey:                   flowexporter.Tuple{SourceAddress: net.ParseIP("10.10.0.1"), DestinationAddress: net.ParseIP("10.10.1.1"), Protocol: 6, SourcePort: 40001, DestinationPort: 443},
+		DestinationServiceAddress: net.ParseIP("10.10.1.1"),
+		DestinationServicePort:    443,
+	}
+	ingressConn := &flowexporter.Connection{
+		FlowKey: flowexporter.Tuple{SourceAddress: net.ParseIP("10.10.1.1"), DestinationAddress: net.ParseIP("10.10.0.2"), Protocol: 6, SourcePort: 50000, DestinationPort: 8080},
+	}
+	unknownSocketConn := &flowexporter.Connection{
+		FlowKey: flowexporter.Tuple{SourceAddress: net.ParseIP("10.10.0.1"), DestinationAddress: net.ParseIP("10.10.1.1"), Protocol: 6, SourcePort: 40002, DestinationPort: 443},
+	}
+	udpConn := &flowexporter.Connection{
+		FlowKey: flowexporter.Tuple{SourceAddress: net.ParseIP("10.10.0.1"), DestinationAddress: net.ParseIP("10.10.1.1"), Protocol: 17, SourcePort: 40000, DestinationPort: 53},
+	}
+	remoteConn := &flowexporter.Connection{
+		FlowKey: flowexporter.Tuple{SourceAddress: net.ParseIP("192.168.0.1"), DestinationAddress: net.ParseIP("10.10.1.1"), Protocol: 6, SourcePort: 40000, DestinationPort: 443},
+	}
+
+	collector.CollectTCPInfo([]*flowexporter.Connection{serviceConn, egressConn, ingressConn, unknownSocketConn, udpConn, remoteConn})
+
+	assert.Equal(t, uint32(1500), serviceConn.TCPSmoothedRTT)
+	assert.Equal(t, uint32(2), serviceConn.TCPRetransmissions)
+	assert.Equal(t, uint32(800), egressConn.TCPSmoothedRTT)
+	assert.Equal(t, uint32(0), egressConn.TCPRetransmissions)
+	assert.Equal(t, uint32(300), ingressConn.TCPSmoothedRTT)
+	assert.Equal(t, uint32(1), ingressConn.TCPRetransmissions)
+	for _, conn := range []*flowexporter.Connection{unknownSocketConn, udpConn, remoteConn} {
+		assert.Zero(t, conn.TCPSmoothedRTT)
+		assert.Zero(t, conn.TCPRetransmissions)
+	}
+	// The sockets of each network namespace are dumped once for each address family.
+	assert.Equal(t, 4, numDumps)
+}
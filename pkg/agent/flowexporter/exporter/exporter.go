@@ -23,7 +23,13 @@ import (
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	"github.com/vmware/go-ipfix/pkg/exporter"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
@@ -96,6 +102,9 @@ var (
 		"egressNetworkPolicyRuleAction",
 		"tcpState",
 		"flowType",
+		"destinationFQDN",
+		"tcpSmoothedRTT",
+		"tcpRetransmissions",
 	}
 	AntreaInfoElementsIPv4 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
 	AntreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
@@ -122,6 +131,11 @@ type FlowExporter struct {
 	conntrackPriorityQueue *priorityqueue.ExpirePriorityQueue
 	denyPriorityQueue      *priorityqueue.ExpirePriorityQueue
 	expiredConns           []flowexporter.Connection
+	// The following fields are only set when the flow records are sharded across the
+	// Endpoints of the Flow Aggregator Service.
+	collectorService         types.NamespacedName
+	endpointsInformerFactory informers.SharedInformerFactory
+	endpointsLister          corelisters.EndpointsLister
 }
 
 func genObservationID(nodeName string) uint32 {
@@ -165,7 +179,7 @@ func NewFlowExporter(ifaceStore interfacestore.InterfaceStore, proxier proxy.Pro
 	denyConnStore := connections.NewDenyConnectionStore(ifaceStore, proxier, o)
 	conntrackConnStore := connections.NewConntrackConnectionStore(connTrackDumper, v4Enabled, v6Enabled, npQuerier, ifaceStore, proxier, o)
 
-	return &FlowExporter{
+	fe := &FlowExporter{
 		conntrackConnStore:     conntrackConnStore,
 		denyConnStore:          denyConnStore,
 		registry:               registry,
@@ -180,7 +194,20 @@ func NewFlowExporter(ifaceStore interfacestore.InterfaceStore, proxier proxy.Pro
 		conntrackPriorityQueue: conntrackConnStore.GetPriorityQueue(),
 		denyPriorityQueue:      denyConnStore.GetPriorityQueue(),
 		expiredConns:           make([]flowexporter.Connection, 0, maxConnsToExport*2),
-	}, nil
+	}
+	if o.FlowCollectorSharding {
+		fe.collectorService = o.FlowCollectorService
+		fe.endpointsInformerFactory = informers.NewSharedInformerFactoryWithOptions(
+			k8sClient,
+			0,
+			informers.WithNamespace(o.FlowCollectorService.Namespace),
+			informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.FieldSelector = fields.OneTermEqualSelector("metadata.name", o.FlowCollectorService.Name).String()
+			}),
+		)
+		fe.endpointsLister = fe.endpointsInformerFactory.Core().V1().Endpoints().Lister()
+	}
+	return fe, nil
 }
 
 func (exp *FlowExporter) GetDenyConnStore() *connections.DenyConnectionStore {
@@ -194,6 +221,10 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 	// Start the goroutine to poll conntrack flows.
 	go exp.conntrackConnStore.Run(stopCh)
 
+	if exp.endpointsInformerFactory != nil {
+		exp.endpointsInformerFactory.Start(stopCh)
+	}
+
 	defaultTimeout := exp.conntrackPriorityQueue.ActiveFlowTimeout
 	expireTimer := time.NewTimer(defaultTimeout)
 	for {
@@ -281,11 +312,17 @@ func (exp *FlowExporter) initFlowExporter() error {
 		// For UDP transport, hardcoding tempRefTimeout value as 1800s.
 		exp.exporterInput.TempRefTimeout = 1800
 	}
-	expProcess, err := ipfix.NewIPFIXExportingProcess(exp.exporterInput)
-	if err != nil {
-		return fmt.Errorf("error when starting exporter: %v", err)
+	if exp.endpointsLister != nil {
+		// The connections to the Flow Aggregator replicas are established when sending
+		// the first data record to each of them.
+		exp.process = newShardedExportingProcess(exp.exporterInput, exp.getCollectorAddrs)
+	} else {
+		expProcess, err := ipfix.NewIPFIXExportingProcess(exp.exporterInput)
+		if err != nil {
+			return fmt.Errorf("error when starting exporter: %v", err)
+		}
+		exp.process = expProcess
 	}
-	exp.process = expProcess
 	if exp.v4Enabled {
 		templateID := exp.process.NewTemplateID()
 		exp.templateIDv4 = templateID
@@ -308,6 +345,20 @@ func (exp *FlowExporter) initFlowExporter() error {
 	return nil
 }
 
+// getCollectorAddrs returns the addresses of the Flow Aggregator replicas, from the Endpoints of
+// the Flow Aggregator Service.
+func (exp *FlowExporter) getCollectorAddrs() ([]string, error) {
+	endpoints, err := exp.endpointsLister.Endpoints(exp.collectorService.Namespace).Get(exp.collectorService.Name)
+	if err != nil {
+		return nil, err
+	}
+	protocol := corev1.ProtocolTCP
+	if exp.exporterInput.CollectorProtocol == "udp" {
+		protocol = corev1.ProtocolUDP
+	}
+	return getEndpointsAddrs(endpoints, protocol), nil
+}
+
 func (exp *FlowExporter) sendTemplateSet(isIPv6 bool) (int, error) {
 	elements := make([]ipfixentities.InfoElementWithValue, 0)
 
@@ -519,6 +570,12 @@ func (exp *FlowExporter) addConnToSet(conn *flowexporter.Connection) error {
 			ie.SetStringValue(conn.TCPState)
 		case "flowType":
 			ie.SetUnsigned8Value(exp.findFlowType(*conn))
+		case "destinationFQDN":
+			ie.SetStringValue(conn.DestinationFQDN)
+		case "tcpSmoothedRTT":
+			ie.SetUnsigned32Value(conn.TCPSmoothedRTT)
+		case "tcpRetransmissions":
+			ie.SetUnsigned32Value(conn.TCPRetransmissions)
 		}
 	}
 	err := exp.ipfixSet.AddRecord(eL, templateID)
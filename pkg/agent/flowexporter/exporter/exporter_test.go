@@ -34,6 +34,7 @@ import (
 	"antrea.io/antrea/pkg/agent/flowexporter/connections"
 	connectionstest "antrea.io/antrea/pkg/agent/flowexporter/connections/testing"
 	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/ipfix"
 	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
 )
 
@@ -45,7 +46,7 @@ const (
 )
 
 func init() {
-	registry.LoadRegistry()
+	ipfix.NewIPFIXRegistry().LoadRegistry()
 }
 
 func TestFlowExporter_sendTemplateSet(t *testing.T) {
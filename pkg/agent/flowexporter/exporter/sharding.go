@@ -0,0 +1,228 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"strconv"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"github.com/vmware/go-ipfix/pkg/exporter"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/ipfix"
+)
+
+// startTemplateID is the first template ID returned by the go-ipfix exporting process, minus one.
+const startTemplateID uint16 = 255
+
+// newIPFIXExportingProcess can be overridden in tests.
+var newIPFIXExportingProcess = func(input exporter.ExporterInput) (ipfix.IPFIXExportingProcess, error) {
+	return ipfix.NewIPFIXExportingProcess(input)
+}
+
+// shardedExportingProcess is an IPFIX exporting process which sends the flow records to all the
+// replicas of the Flow Aggregator. Each data record is sent to a single replica, selected by
+// rendezvous hashing of its flow key: the records of a connection exported by the source and
+// destination Nodes are received by the same replica, and when a replica is added or removed,
+// only the connections assigned to it are moved to other replicas. Template records are sent to
+// all the replicas, including the replicas added later.
+type shardedExportingProcess struct {
+	exporterInput exporter.ExporterInput
+	// getCollectorAddrs returns the addresses of the Flow Aggregator replicas.
+	getCollectorAddrs func() ([]string, error)
+	templateID        uint16
+	templates         map[uint16][]ipfixentities.InfoElementWithValue
+	processes         map[string]ipfix.IPFIXExportingProcess
+}
+
+var _ ipfix.IPFIXExportingProcess = new(shardedExportingProcess)
+
+func newShardedExportingProcess(input exporter.ExporterInput, getCollectorAddrs func() ([]string, error)) *shardedExportingProcess {
+	return &shardedExportingProcess{
+		exporterInput:     input,
+		getCollectorAddrs: getCollectorAddrs,
+		templateID:        startTemplateID,
+		templates:         make(map[uint16][]ipfixentities.InfoElementWithValue),
+		processes:         make(map[string]ipfix.IPFIXExportingProcess),
+	}
+}
+
+func (p *shardedExportingProcess) NewTemplateID() uint16 {
+	p.templateID++
+	return p.templateID
+}
+
+// SendSet sends a template set to all the replicas the exporting process is connected to, and a
+// data set to the replica selected by hashing the flow key of its first record. The Flow
+// Exporter sends one data record per set.
+func (p *shardedExportingProcess) SendSet(set ipfixentities.Set) (int, error) {
+	records := set.GetRecords()
+	if set.GetSetType() == ipfixentities.Template {
+		for _, record := range records {
+			p.templates[record.GetTemplateID()] = record.GetOrderedElementList()
+		}
+		sentBytes := 0
+		for addr, process := range p.processes {
+			n, err := process.SendSet(set)
+			if err != nil {
+				return sentBytes, fmt.Errorf("error when sending template set to %s: %v", addr, err)
+			}
+			sentBytes += n
+		}
+		return sentBytes, nil
+	}
+	if len(records) == 0 {
+		return 0, nil
+	}
+	addrs, err := p.getCollectorAddrs()
+	if err != nil {
+		return 0, fmt.Errorf("error when getting Flow Aggregator addresses: %v", err)
+	}
+	if len(addrs) == 0 {
+		return 0, fmt.Errorf("no Flow Aggregator replica is available")
+	}
+	p.closeRemovedProcesses(addrs)
+	key, err := getFlowKeyFromRecord(records[0])
+	if err != nil {
+		return 0, err
+	}
+	addr := selectCollector(key, addrs)
+	process, err := p.getOrCreateProcess(addr)
+	if err != nil {
+		return 0, err
+	}
+	return process.SendSet(set)
+}
+
+func (p *shardedExportingProcess) CloseConnToCollector() {
+	for addr, process := range p.processes {
+		process.CloseConnToCollector()
+		delete(p.processes, addr)
+	}
+}
+
+// getOrCreateProcess returns the exporting process connected to the replica with address addr.
+// A new exporting process is sent all the templates sent so far.
+func (p *shardedExportingProcess) getOrCreateProcess(addr string) (ipfix.IPFIXExportingProcess, error) {
+	if process, ok := p.processes[addr]; ok {
+		return process, nil
+	}
+	input := p.exporterInput
+	input.CollectorAddress = addr
+	process, err := newIPFIXExportingProcess(input)
+	if err != nil {
+		return nil, fmt.Errorf("error when starting exporter for %s: %v", addr, err)
+	}
+	templateSet := ipfixentities.NewSet(false)
+	for templateID, elements := range p.templates {
+		templateSet.ResetSet()
+		if err := templateSet.PrepareSet(ipfixentities.Template, templateID); err != nil {
+			process.CloseConnToCollector()
+			return nil, err
+		}
+		if err := templateSet.AddRecord(elements, templateID); err != nil {
+			process.CloseConnToCollector()
+			return nil, fmt.Errorf("error in adding record to template set: %v", err)
+		}
+		if _, err := process.SendSet(templateSet); err != nil {
+			process.CloseConnToCollector()
+			return nil, fmt.Errorf("error when sending template set to %s: %v", addr, err)
+		}
+	}
+	klog.InfoS("Connected to Flow Aggregator replica", "address", addr)
+	p.processes[addr] = process
+	return process, nil
+}
+
+// closeRemovedProcesses closes the exporting processes connected to replicas which have been
+// removed.
+func (p *shardedExportingProcess) closeRemovedProcesses(addrs []string) {
+	for addr, process := range p.processes {
+		found := false
+		for _, a := range addrs {
+			if a == addr {
+				found = true
+				break
+			}
+		}
+		if !found {
+			klog.InfoS("Disconnecting from removed Flow Aggregator replica", "address", addr)
+			process.CloseConnToCollector()
+			delete(p.processes, addr)
+		}
+	}
+}
+
+// getFlowKeyFromRecord returns a string representation of the 5-tuple of the data record, which
+// is the same for the records of a connection exported by the source and destination Nodes.
+func getFlowKeyFromRecord(record ipfixentities.Record) (string, error) {
+	var srcIP, dstIP net.IP
+	if ie, _, exist := record.GetInfoElementWithValue("sourceIPv4Address"); exist {
+		srcIP = ie.GetIPAddressValue()
+	} else if ie, _, exist := record.GetInfoElementWithValue("sourceIPv6Address"); exist {
+		srcIP = ie.GetIPAddressValue()
+	}
+	if ie, _, exist := record.GetInfoElementWithValue("destinationIPv4Address"); exist {
+		dstIP = ie.GetIPAddressValue()
+	} else if ie, _, exist := record.GetInfoElementWithValue("destinationIPv6Address"); exist {
+		dstIP = ie.GetIPAddressValue()
+	}
+	srcPort, _, srcPortExist := record.GetInfoElementWithValue("sourceTransportPort")
+	dstPort, _, dstPortExist := record.GetInfoElementWithValue("destinationTransportPort")
+	protocol, _, protocolExist := record.GetInfoElementWithValue("protocolIdentifier")
+	if srcIP == nil || dstIP == nil || !srcPortExist || !dstPortExist || !protocolExist {
+		return "", fmt.Errorf("flow key is missing in data record")
+	}
+	return fmt.Sprintf("%s/%s/%d/%d/%d", srcIP, dstIP, srcPort.GetUnsigned16Value(), dstPort.GetUnsigned16Value(), protocol.GetUnsigned8Value()), nil
+}
+
+// selectCollector selects the address with the highest hash of the flow key and the address
+// (rendezvous hashing).
+func selectCollector(key string, addrs []string) string {
+	var selected string
+	var maxScore uint64
+	for _, addr := range addrs {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		h.Write([]byte{'|'})
+		h.Write([]byte(addr))
+		score := h.Sum64()
+		if selected == "" || score > maxScore || (score == maxScore && addr < selected) {
+			selected = addr
+			maxScore = score
+		}
+	}
+	return selected
+}
+
+// getEndpointsAddrs returns the addresses of the ready Endpoints for the given protocol.
+func getEndpointsAddrs(endpoints *corev1.Endpoints, protocol corev1.Protocol) []string {
+	var addrs []string
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			if port.Protocol != protocol {
+				continue
+			}
+			for _, address := range subset.Addresses {
+				addrs = append(addrs, net.JoinHostPort(address.IP, strconv.Itoa(int(port.Port))))
+			}
+		}
+	}
+	return addrs
+}
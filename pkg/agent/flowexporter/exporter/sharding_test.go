@@ -0,0 +1,173 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"github.com/vmware/go-ipfix/pkg/exporter"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+
+	"antrea.io/antrea/pkg/ipfix"
+	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
+)
+
+func TestSelectCollector(t *testing.T) {
+	addrs := []string{"10.0.0.1:4739", "10.0.0.2:4739", "10.0.0.3:4739"}
+	counts := make(map[string]int)
+	moved := 0
+	for i := 0; i < 3000; i++ {
+		key := fmt.Sprintf("10.10.0.1/10.10.1.1/%d/80/6", 10000+i)
+		selected := selectCollector(key, addrs)
+		counts[selected]++
+		// The selection does not depend on the order of the addresses.
+		assert.Equal(t, selected, selectCollector(key, []string{addrs[2], addrs[0], addrs[1]}))
+		// When a replica is removed, only its flows are moved to other replicas.
+		if selectCollector(key, addrs[:2]) != selected {
+			assert.Equal(t, addrs[2], selected)
+			moved++
+		}
+	}
+	for _, addr := range addrs {
+		assert.InDelta(t, 1000, counts[addr], 200, "Flows are not balanced across replicas: %v", counts)
+	}
+	assert.Equal(t, counts[addrs[2]], moved)
+}
+
+func TestGetEndpointsAddrs(t *testing.T) {
+	endpoints := &corev1.Endpoints{
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses:         []corev1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+				NotReadyAddresses: []corev1.EndpointAddress{{IP: "10.0.0.2"}},
+				Ports: []corev1.EndpointPort{
+					{Name: "ipfix-udp", Port: 4739, Protocol: corev1.ProtocolUDP},
+					{Name: "ipfix-tcp", Port: 4739, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"10.0.0.1:4739", "[fd00::1]:4739"}, getEndpointsAddrs(endpoints, corev1.ProtocolTCP))
+}
+
+func newTestDataSet(t *testing.T, templateID uint16, srcPort uint16) ipfixentities.Set {
+	var elements []ipfixentities.InfoElementWithValue
+	for name, value := range map[string]interface{}{
+		"sourceIPv4Address":        net.ParseIP("10.10.0.1"),
+		"destinationIPv4Address":   net.ParseIP("10.10.1.1"),
+		"sourceTransportPort":      srcPort,
+		"destinationTransportPort": uint16(80),
+		"protocolIdentifier":       uint8(6),
+	} {
+		element, err := ipfixregistry.GetInfoElement(name, ipfixregistry.IANAEnterpriseID)
+		require.NoError(t, err)
+		ie, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+		require.NoError(t, err)
+		switch v := value.(type) {
+		case net.IP:
+			ie.SetIPAddressValue(v)
+		case uint16:
+			ie.SetUnsigned16Value(v)
+		case uint8:
+			ie.SetUnsigned8Value(v)
+		}
+		elements = append(elements, ie)
+	}
+	set := ipfixentities.NewSet(false)
+	require.NoError(t, set.PrepareSet(ipfixentities.Data, templateID))
+	require.NoError(t, set.AddRecord(elements, templateID))
+	return set
+}
+
+func TestShardedExportingProcess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addrs := []string{"10.0.0.1:4739", "10.0.0.2:4739"}
+	mockProcesses := map[string]*ipfixtest.MockIPFIXExportingProcess{
+		addrs[0]: ipfixtest.NewMockIPFIXExportingProcess(ctrl),
+		addrs[1]: ipfixtest.NewMockIPFIXExportingProcess(ctrl),
+	}
+	origNewIPFIXExportingProcess := newIPFIXExportingProcess
+	defer func() {
+		newIPFIXExportingProcess = origNewIPFIXExportingProcess
+	}()
+	newIPFIXExportingProcess = func(input exporter.ExporterInput) (ipfix.IPFIXExportingProcess, error) {
+		return mockProcesses[input.CollectorAddress], nil
+	}
+	currentAddrs := addrs
+	p := newShardedExportingProcess(exporter.ExporterInput{CollectorProtocol: "tcp"}, func() ([]string, error) {
+		return currentAddrs, nil
+	})
+
+	templateID := p.NewTemplateID()
+	assert.Equal(t, uint16(256), templateID)
+	dataSet := newTestDataSet(t, templateID, 10000)
+	element, err := ipfixregistry.GetInfoElement("sourceTransportPort", ipfixregistry.IANAEnterpriseID)
+	require.NoError(t, err)
+	ie, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+	require.NoError(t, err)
+	templateSet := ipfixentities.NewSet(false)
+	require.NoError(t, templateSet.PrepareSet(ipfixentities.Template, templateID))
+	require.NoError(t, templateSet.AddRecord([]ipfixentities.InfoElementWithValue{ie}, templateID))
+	// No replica is connected yet, the template is sent when connecting to a replica.
+	_, err = p.SendSet(templateSet)
+	require.NoError(t, err)
+
+	key, err := getFlowKeyFromRecord(dataSet.GetRecords()[0])
+	require.NoError(t, err)
+	assert.Equal(t, "10.10.0.1/10.10.1.1/10000/80/6", key)
+	selected := selectCollector(key, addrs)
+	other := addrs[0]
+	if selected == addrs[0] {
+		other = addrs[1]
+	}
+	gomock.InOrder(
+		mockProcesses[selected].EXPECT().SendSet(gomock.Any()).DoAndReturn(func(set ipfixentities.Set) (int, error) {
+			assert.Equal(t, ipfixentities.Template, set.GetSetType())
+			assert.Equal(t, templateID, set.GetRecords()[0].GetTemplateID())
+			return 10, nil
+		}),
+		mockProcesses[selected].EXPECT().SendSet(dataSet).Return(20, nil),
+	)
+	sentBytes, err := p.SendSet(dataSet)
+	require.NoError(t, err)
+	assert.Equal(t, 20, sentBytes)
+
+	// When the selected replica is removed, its flows are sent to the other replica.
+	currentAddrs = []string{other}
+	mockProcesses[selected].EXPECT().CloseConnToCollector()
+	gomock.InOrder(
+		mockProcesses[other].EXPECT().SendSet(gomock.Any()).Return(10, nil),
+		mockProcesses[other].EXPECT().SendSet(dataSet).Return(20, nil),
+	)
+	_, err = p.SendSet(dataSet)
+	require.NoError(t, err)
+
+	currentAddrs = nil
+	_, err = p.SendSet(dataSet)
+	assert.Error(t, err)
+
+	mockProcesses[other].EXPECT().CloseConnToCollector()
+	p.CloseConnToCollector()
+	assert.Empty(t, p.processes)
+}
@@ -17,6 +17,8 @@ package flowexporter
 import (
 	"net"
 	"time"
+
+	"k8s.io/apimachinery/pkg/types"
 )
 
 type ConnectionKey [5]string
@@ -72,12 +74,19 @@ type Connection struct {
 	EgressNetworkPolicyType        uint8
 	EgressNetworkPolicyRuleName    string
 	EgressNetworkPolicyRuleAction  uint8
-	PrevPackets, PrevBytes         uint64
+	// DestinationFQDN is a comma-separated list of the FQDNs which were resolved to the
+	// destination IP, according to the DNS responses intercepted for FQDN policy rules.
+	DestinationFQDN        string
+	PrevPackets, PrevBytes uint64
 	// Fields specific to conntrack connections
 	ReversePackets, ReverseBytes         uint64
 	PrevReversePackets, PrevReverseBytes uint64
 	TCPState                             string
 	PrevTCPState                         string
+	// Fields collected from the TCP socket of the local Pod, for TCP connections.
+	// TCPSmoothedRTT is the smoothed round-trip time in microseconds, 0 if it is unknown.
+	TCPSmoothedRTT     uint32
+	TCPRetransmissions uint32
 }
 
 type ItemToExpire struct {
@@ -91,9 +100,12 @@ type ItemToExpire struct {
 type FlowExporterOptions struct {
 	FlowCollectorAddr      string
 	FlowCollectorProto     string
+	FlowCollectorSharding  bool
+	FlowCollectorService   types.NamespacedName
 	ActiveFlowTimeout      time.Duration
 	IdleFlowTimeout        time.Duration
 	StaleConnectionTimeout time.Duration
 	PollInterval           time.Duration
 	ConnectUplinkToBridge  bool
+	CollectTCPMetrics      bool
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/vmware/go-ipfix/pkg/registry"
 
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/ipfix"
 )
 
 const (
@@ -87,6 +88,10 @@ func PolicyTypeToUint8(policyType v1beta2.NetworkPolicyType) uint8 {
 		return registry.PolicyTypeAntreaNetworkPolicy
 	case v1beta2.AntreaClusterNetworkPolicy:
 		return registry.PolicyTypeAntreaClusterNetworkPolicy
+	case v1beta2.AdminNetworkPolicy:
+		return ipfix.PolicyTypeAdminNetworkPolicy
+	case v1beta2.BaselineAdminNetworkPolicy:
+		return ipfix.PolicyTypeBaselineAdminNetworkPolicy
 	default:
 		return registry.PolicyTypeK8sNetworkPolicy
 	}
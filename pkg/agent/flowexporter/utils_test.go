@@ -0,0 +1,40 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowexporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware/go-ipfix/pkg/registry"
+
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/ipfix"
+)
+
+func TestPolicyTypeToUint8(t *testing.T) {
+	for _, tc := range []struct {
+		policyType v1beta2.NetworkPolicyType
+		expected   uint8
+	}{
+		{v1beta2.K8sNetworkPolicy, registry.PolicyTypeK8sNetworkPolicy},
+		{v1beta2.AntreaNetworkPolicy, registry.PolicyTypeAntreaNetworkPolicy},
+		{v1beta2.AntreaClusterNetworkPolicy, registry.PolicyTypeAntreaClusterNetworkPolicy},
+		{v1beta2.AdminNetworkPolicy, ipfix.PolicyTypeAdminNetworkPolicy},
+		{v1beta2.BaselineAdminNetworkPolicy, ipfix.PolicyTypeBaselineAdminNetworkPolicy},
+	} {
+		assert.Equal(t, tc.expected, PolicyTypeToUint8(tc.policyType), "Unexpected IPFIX type for %s", tc.policyType)
+	}
+}
@@ -33,6 +33,8 @@ const (
 	UplinkInterface
 	// HostInterface is used to mark current interface is for host
 	HostInterface
+	// TrafficControlInterface is used to mark current interface is for traffic control port
+	TrafficControlInterface
 
 	AntreaInterfaceTypeKey = "antrea-type"
 	AntreaGateway          = "gateway"
@@ -40,6 +42,7 @@ const (
 	AntreaTunnel           = "tunnel"
 	AntreaUplink           = "uplink"
 	AntreaHost             = "host"
+	AntreaTrafficControl   = "traffic-control"
 	AntreaUnset            = ""
 )
 
@@ -58,6 +61,8 @@ type ContainerInterfaceConfig struct {
 	ContainerID  string
 	PodName      string
 	PodNamespace string
+	// NetNS is the path of the network namespace of the container, as seen by the Agent.
+	NetNS string
 }
 
 type TunnelInterfaceConfig struct {
@@ -159,6 +164,11 @@ func NewHostInterface(hostInterfaceName string) *InterfaceConfig {
 	return &InterfaceConfig{InterfaceName: hostInterfaceName, Type: HostInterface}
 }
 
+// NewTrafficControlInterface creates InterfaceConfig for a traffic control port.
+func NewTrafficControlInterface(interfaceName string) *InterfaceConfig {
+	return &InterfaceConfig{InterfaceName: interfaceName, Type: TrafficControlInterface}
+}
+
 // TODO: remove this method after IPv4/IPv6 dual-stack is supported completely.
 func (c *InterfaceConfig) GetIPv4Addr() net.IP {
 	return util.GetIPv4Addr(c.IPs)
@@ -156,6 +156,80 @@ var (
 		},
 	)
 
+	EgressPacketCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "egress_packet_count",
+			Help:           "Number of packets sent by local Pods through each Egress. The Egress name is used as a label. This metric gets updated every time the stats are reported to the Antrea Controller.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"egress"},
+	)
+
+	EgressByteCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "egress_byte_count",
+			Help:           "Number of bytes sent by local Pods through each Egress. The Egress name is used as a label. This metric gets updated every time the stats are reported to the Antrea Controller.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"egress"},
+	)
+
+	AuditLogSyslogSentRecordCount = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "audit_log_syslog_sent_record_count",
+			Help:           "Number of Antrea-native policy audit log records sent to the syslog server.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	AuditLogSyslogDroppedRecordCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "audit_log_syslog_dropped_record_count",
+			Help:           "Number of Antrea-native policy audit log records dropped from the syslog stream, partitioned by reason (buffer_full and write_error).",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
+	AuditLogSyslogQueueLength = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "audit_log_syslog_queue_length",
+			Help:           "Number of Antrea-native policy audit log records waiting to be sent to the syslog server.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	FQDNDNSResponseCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "fqdn_dns_response_count",
+			Help:           "Number of DNS responses intercepted for FQDN policy rules, partitioned by result (forwarded and dropped).",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"result"},
+	)
+
+	FQDNCacheEntryCount = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "fqdn_cache_entry_count",
+			Help:           "Number of FQDNs for which IPs are cached for FQDN policy rules.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
 	MaxConnectionsInConnTrackTable = metrics.NewGauge(
 		&metrics.GaugeOpts{
 			Namespace:      metricNamespaceAntrea,
@@ -174,6 +248,7 @@ func InitializePrometheusMetrics() {
 	InitializeNetworkPolicyMetrics()
 	InitializeOVSMetrics()
 	InitializeConnectionMetrics()
+	InitializeEgressMetrics()
 }
 
 func InitializePodMetrics() {
@@ -194,6 +269,30 @@ func InitializeNetworkPolicyMetrics() {
 	if err := legacyregistry.Register(NetworkPolicyCount); err != nil {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_count")
 	}
+
+	if err := legacyregistry.Register(AuditLogSyslogSentRecordCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_audit_log_syslog_sent_record_count")
+	}
+	if err := legacyregistry.Register(AuditLogSyslogDroppedRecordCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_audit_log_syslog_dropped_record_count")
+	}
+	if err := legacyregistry.Register(AuditLogSyslogQueueLength); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_audit_log_syslog_queue_length")
+	}
+	// Initialize the dropped record metrics with all reasons, since they won't come out
+	// until observation.
+	for _, reason := range []string{"buffer_full", "write_error"} {
+		AuditLogSyslogDroppedRecordCount.WithLabelValues(reason)
+	}
+	if err := legacyregistry.Register(FQDNDNSResponseCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_fqdn_dns_response_count")
+	}
+	if err := legacyregistry.Register(FQDNCacheEntryCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_fqdn_cache_entry_count")
+	}
+	for _, result := range []string{"forwarded", "dropped"} {
+		FQDNDNSResponseCount.WithLabelValues(result)
+	}
 }
 
 func InitializeOVSMetrics() {
@@ -240,3 +339,12 @@ func InitializeConnectionMetrics() {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_conntrack_max_connection_count")
 	}
 }
+
+func InitializeEgressMetrics() {
+	if err := legacyregistry.Register(EgressPacketCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_egress_packet_count")
+	}
+	if err := legacyregistry.Register(EgressByteCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_egress_byte_count")
+	}
+}
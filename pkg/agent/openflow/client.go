@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"strings"
 
 	"antrea.io/libOpenflow/protocol"
 	ofutil "antrea.io/libOpenflow/util"
@@ -47,7 +48,8 @@ type Client interface {
 		config *config.NodeConfig,
 		networkConfig *config.NetworkConfig,
 		egressConfig *config.EgressConfig,
-		serviceConfig *config.ServiceConfig) (<-chan struct{}, error)
+		serviceConfig *config.ServiceConfig,
+		l7NetworkPolicyConfig *config.L7NetworkPolicyConfig) (<-chan struct{}, error)
 
 	// InstallNodeFlows should be invoked when a connection to a remote Node is going to be set
 	// up. The hostname is used to identify the added flows. When IPsec tunnel is enabled,
@@ -152,6 +154,17 @@ type Client interface {
 	// UninstallPodSNATFlows removes the SNAT flows for the local Pod.
 	UninstallPodSNATFlows(ofPort uint32) error
 
+	// InstallEgressQoS installs or updates the meter limiting the traffic
+	// marked with the SNAT mark to `rate` kilobits per second with a burst
+	// size of `burst` kilobits, and the flow applying the meter to the
+	// traffic. It does nothing if Egress traffic shaping is not enabled or
+	// the OVS datapath doesn't support meters.
+	InstallEgressQoS(mark, rate, burst uint32) error
+
+	// UninstallEgressQoS removes the meter and the flow installed by
+	// InstallEgressQoS for the SNAT mark.
+	UninstallEgressQoS(mark uint32) error
+
 	// Disconnect disconnects the connection between client and OFSwitch.
 	Disconnect() error
 
@@ -218,6 +231,8 @@ type Client interface {
 	StartPacketInHandler(stopCh <-chan struct{})
 	// Get traffic metrics of each NetworkPolicy rule.
 	NetworkPolicyMetrics() map[uint32]*types.RuleMetric
+	// Get traffic metrics of the Egress traffic of each local Pod, keyed by the Pod's OpenFlow port.
+	EgressMetrics() map[uint32]*types.RuleMetric
 	// SendTCPPacketOut sends TCP packet as a packet-out to OVS.
 	SendTCPPacketOut(
 		srcMAC string,
@@ -258,6 +273,19 @@ type Client interface {
 		udpDstPort uint16,
 		udpData []byte,
 		mutatePacketOut func(builder binding.PacketOutBuilder) binding.PacketOutBuilder) error
+	// SendIPPacketOut sends an IP packet carrying an already serialized L4 packet as a packet-out
+	// to OVS.
+	SendIPPacketOut(
+		srcMAC string,
+		dstMAC string,
+		srcIP string,
+		dstIP string,
+		inPort uint32,
+		outPort uint32,
+		isIPv6 bool,
+		ipProtocol uint8,
+		l4Packet ofutil.Message,
+		mutatePacketOut func(builder binding.PacketOutBuilder) binding.PacketOutBuilder) error
 	// NewDNSpacketInConjunction creates a policyRuleConjunction for the dns response interception flows.
 	NewDNSpacketInConjunction(id uint32) error
 	// AddAddressToDNSConjunction adds addresses to the toAddresses of the dns packetIn conjunction,
@@ -655,11 +683,13 @@ func (c *client) Initialize(roundInfo types.RoundInfo,
 	nodeConfig *config.NodeConfig,
 	networkConfig *config.NetworkConfig,
 	egressConfig *config.EgressConfig,
-	serviceConfig *config.ServiceConfig) (<-chan struct{}, error) {
+	serviceConfig *config.ServiceConfig,
+	l7NetworkPolicyConfig *config.L7NetworkPolicyConfig) (<-chan struct{}, error) {
 	c.nodeConfig = nodeConfig
 	c.networkConfig = networkConfig
 	c.egressConfig = egressConfig
 	c.serviceConfig = serviceConfig
+	c.l7NetworkPolicyConfig = l7NetworkPolicyConfig
 
 	if networkConfig.IPv4Enabled {
 		c.ipProtocols = append(c.ipProtocols, binding.ProtocolIP)
@@ -713,7 +743,10 @@ func (c *client) generatePipelines() {
 		c.connectUplinkToBridge,
 		c.enableMulticast,
 		c.proxyAll,
-		c.enableTrafficControl)
+		// The TrafficControl table and the common traffic control flows are also required by L7 NetworkPolicy to
+		// forward the traffic returned from the application-aware engine.
+		c.enableTrafficControl || c.enableL7NetworkPolicy,
+		c.enableNodeNetworkPolicy)
 	c.activatedFeatures = append(c.activatedFeatures, c.featurePodConnectivity)
 	c.traceableFeatures = append(c.traceableFeatures, c.featurePodConnectivity)
 
@@ -723,7 +756,10 @@ func (c *client) generatePipelines() {
 		c.ovsMetersAreSupported,
 		c.enableDenyTracking,
 		c.enableAntreaPolicy,
-		c.connectUplinkToBridge)
+		c.enableL7NetworkPolicy,
+		c.enableNodeNetworkPolicy,
+		c.connectUplinkToBridge,
+		c.l7NetworkPolicyConfig)
 	c.activatedFeatures = append(c.activatedFeatures, c.featureNetworkPolicy)
 	c.traceableFeatures = append(c.traceableFeatures, c.featureNetworkPolicy)
 
@@ -740,7 +776,7 @@ func (c *client) generatePipelines() {
 	c.traceableFeatures = append(c.traceableFeatures, c.featureService)
 
 	if c.enableEgress {
-		c.featureEgress = newFeatureEgress(c.cookieAllocator, c.ipProtocols, c.nodeConfig, c.egressConfig)
+		c.featureEgress = newFeatureEgress(c.cookieAllocator, c.ipProtocols, c.nodeConfig, c.egressConfig, c.bridge, c.enableEgressTrafficShaping)
 		c.activatedFeatures = append(c.activatedFeatures, c.featureEgress)
 	}
 
@@ -814,6 +850,9 @@ func (c *client) UninstallSNATMarkFlows(mark uint32) error {
 
 func (c *client) InstallPodSNATFlows(ofPort uint32, snatIP net.IP, snatMark uint32) error {
 	flows := []binding.Flow{c.featureEgress.snatRuleFlow(ofPort, snatIP, snatMark, c.nodeConfig.GatewayConfig.MAC)}
+	if snatMark != 0 && !c.enableEgressTrafficShaping {
+		flows = append(flows, c.featureEgress.snatRuleEstablishedFlow(ofPort, snatIP))
+	}
 	cacheKey := fmt.Sprintf("p%x", ofPort)
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
@@ -827,6 +866,67 @@ func (c *client) UninstallPodSNATFlows(ofPort uint32) error {
 	return c.deleteFlows(c.featureEgress.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallEgressQoS(mark, rate, burst uint32) error {
+	if !c.enableEgressTrafficShaping {
+		return nil
+	}
+	meterID := egressQoSMeterID(mark)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	// The meter must be installed before the flow referring to it. If the meter has been installed, modify it in case
+	// the rate or burst changes.
+	meter := c.featureEgress.egressQoSMeter(meterID, rate, burst)
+	if _, exists := c.featureEgress.cachedMeters.Load(meterID); exists {
+		if err := meter.Modify(); err != nil {
+			return fmt.Errorf("error when modifying Egress QoS meter %d: %w", meterID, err)
+		}
+	} else if err := meter.Add(); err != nil {
+		return fmt.Errorf("error when installing Egress QoS meter %d: %w", meterID, err)
+	}
+	c.featureEgress.cachedMeters.Store(meterID, meter)
+	cacheKey := fmt.Sprintf("q%x", mark)
+	return c.addFlows(c.featureEgress.cachedFlows, cacheKey, []binding.Flow{c.featureEgress.egressQoSFlow(mark)})
+}
+
+func (c *client) UninstallEgressQoS(mark uint32) error {
+	if !c.enableEgressTrafficShaping {
+		return nil
+	}
+	meterID := egressQoSMeterID(mark)
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	cacheKey := fmt.Sprintf("q%x", mark)
+	if err := c.deleteFlows(c.featureEgress.cachedFlows, cacheKey); err != nil {
+		return err
+	}
+	if obj, ok := c.featureEgress.cachedMeters.Load(meterID); ok {
+		if err := obj.(binding.Meter).Delete(); err != nil {
+			return fmt.Errorf("error when deleting Egress QoS meter %d: %w", meterID, err)
+		}
+		c.featureEgress.cachedMeters.Delete(meterID)
+	}
+	return nil
+}
+
+func (c *client) EgressMetrics() map[uint32]*types.RuleMetric {
+	result := map[uint32]*types.RuleMetric{}
+	flows, _ := c.ovsctlClient.DumpTableFlows(EgressMarkTable.GetID())
+	for _, flow := range flows {
+		// Only the SNAT flows of local Pods match the in_port field.
+		if !strings.Contains(flow, metricFlowIdentifier) || !strings.Contains(flow, "in_port=") {
+			continue
+		}
+		// A Pod using a local SNAT IP may have two SNAT flows, for new and established connections.
+		ofPort, metric := parseEgressMetricFlow(flow)
+		if podMetric, ok := result[ofPort]; ok {
+			podMetric.Merge(&metric)
+		} else {
+			result[ofPort] = &metric
+		}
+	}
+	return result
+}
+
 func (c *client) ReplayFlows() {
 	c.replayMutex.Lock()
 	defer c.replayMutex.Unlock()
@@ -835,6 +935,11 @@ func (c *client) ReplayFlows() {
 		klog.Errorf("Error during flow replay: %v", err)
 	}
 
+	// The meters must be replayed before the flows referring to them.
+	if c.enableEgress {
+		c.featureEgress.replayMeters()
+	}
+
 	c.featureService.replayGroups()
 	if c.enableMulticast {
 		c.featureMulticast.replayGroups()
@@ -1105,6 +1210,35 @@ func (c *client) SendUDPPacketOut(
 	return c.bridge.SendPacketOut(packetOutObj)
 }
 
+// SendIPPacketOut generates an IP packet with the provided L4 packet as a packet-out and sends
+// it to OVS. The L4 packet is sent as is, so its checksum must be valid for the provided IPs.
+func (c *client) SendIPPacketOut(
+	srcMAC string,
+	dstMAC string,
+	srcIP string,
+	dstIP string,
+	inPort uint32,
+	outPort uint32,
+	isIPv6 bool,
+	ipProtocol uint8,
+	l4Packet ofutil.Message,
+	mutatePacketOut func(builder binding.PacketOutBuilder) binding.PacketOutBuilder) error {
+	// Generate a base IP PacketOutBuilder.
+	packetOutBuilder, err := setBasePacketOutBuilder(c.bridge.BuildPacketOut(), srcMAC, dstMAC, srcIP, dstIP, inPort, outPort)
+	if err != nil {
+		return err
+	}
+	// Set protocol and L4 packet.
+	packetOutBuilder = packetOutBuilder.SetIPProtocolValue(isIPv6, ipProtocol).SetL4Packet(l4Packet)
+
+	if mutatePacketOut != nil {
+		packetOutBuilder = mutatePacketOut(packetOutBuilder)
+	}
+
+	packetOutObj := packetOutBuilder.Done()
+	return c.bridge.SendPacketOut(packetOutObj)
+}
+
 func (c *client) InstallMulticastInitialFlows(pktInReason uint8) error {
 	flows := c.featureMulticast.igmpPktInFlows(pktInReason)
 	flows = append(flows, c.featureMulticast.externalMulticastReceiverFlow())
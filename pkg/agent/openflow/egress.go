@@ -16,22 +16,39 @@ package openflow
 
 import (
 	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"antrea.io/ofnet/ofctrl"
+	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
+	"antrea.io/antrea/pkg/agent/types"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
+// egressQoSMeterIDOffset is added to the SNAT mark of an Egress IP to get the ID of the meter enforcing its bandwidth,
+// to avoid conflicts with the meters used to rate-limit packet-in messages.
+const egressQoSMeterIDOffset = 256
+
 type featureEgress struct {
 	cookieAllocator cookie.Allocator
 	ipProtocols     []binding.Protocol
+	bridge          binding.Bridge
 
 	cachedFlows *flowCategoryCache
+	// cachedMeters caches the meters enforcing the bandwidth of Egress IPs, keyed by meter ID. They are reinstalled
+	// when replaying flows.
+	cachedMeters sync.Map
 
 	exceptCIDRs map[binding.Protocol][]net.IPNet
 	nodeIPs     map[binding.Protocol]net.IP
 	gatewayMAC  net.HardwareAddr
 
+	enableEgressTrafficShaping bool
+
 	category cookie.Category
 }
 
@@ -42,7 +59,9 @@ func (f *featureEgress) getFeatureName() string {
 func newFeatureEgress(cookieAllocator cookie.Allocator,
 	ipProtocols []binding.Protocol,
 	nodeConfig *config.NodeConfig,
-	egressConfig *config.EgressConfig) *featureEgress {
+	egressConfig *config.EgressConfig,
+	bridge binding.Bridge,
+	enableEgressTrafficShaping bool) *featureEgress {
 	exceptCIDRs := make(map[binding.Protocol][]net.IPNet)
 	for _, cidr := range egressConfig.ExceptCIDRs {
 		if cidr.IP.To4() == nil {
@@ -61,20 +80,26 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 		}
 	}
 	return &featureEgress{
-		cachedFlows:     newFlowCategoryCache(),
-		cookieAllocator: cookieAllocator,
-		exceptCIDRs:     exceptCIDRs,
-		ipProtocols:     ipProtocols,
-		nodeIPs:         nodeIPs,
-		gatewayMAC:      nodeConfig.GatewayConfig.MAC,
-		category:        cookie.Egress,
+		cachedFlows:                newFlowCategoryCache(),
+		cookieAllocator:            cookieAllocator,
+		exceptCIDRs:                exceptCIDRs,
+		ipProtocols:                ipProtocols,
+		nodeIPs:                    nodeIPs,
+		gatewayMAC:                 nodeConfig.GatewayConfig.MAC,
+		bridge:                     bridge,
+		enableEgressTrafficShaping: enableEgressTrafficShaping,
+		category:                   cookie.Egress,
 	}
 }
 
 func (f *featureEgress) initFlows() []binding.Flow {
 	// This installs the flows to enable Pods to communicate to the external IP addresses. The flows identify the packets
 	// from local Pods to the external IP address, and mark the packets to be SNAT'd with the configured SNAT IPs.
-	return f.externalFlows()
+	flows := f.externalFlows()
+	if f.enableEgressTrafficShaping {
+		flows = append(flows, f.egressQoSDefaultFlow())
+	}
+	return flows
 }
 
 func (f *featureEgress) replayFlows() []binding.Flow {
@@ -84,3 +109,47 @@ func (f *featureEgress) replayFlows() []binding.Flow {
 
 	return flows
 }
+
+// egressQoSMeterID returns the ID of the meter enforcing the bandwidth of the Egress IP using the provided SNAT mark.
+func egressQoSMeterID(mark uint32) uint32 {
+	return mark + egressQoSMeterIDOffset
+}
+
+// egressQoSMeter generates a meter entry which limits the rate of traffic to `rate` kilobits per second, with a burst
+// size of `burst` kilobits. Packets which exceed the rate will be dropped.
+func (f *featureEgress) egressQoSMeter(meterID, rate, burst uint32) binding.Meter {
+	meter := f.bridge.CreateMeter(binding.MeterIDType(meterID), ofctrl.MeterBurst|ofctrl.MeterKbps).ResetMeterBands()
+	meter = meter.MeterBand().
+		MeterType(ofctrl.MeterDrop).
+		Rate(rate).
+		Burst(burst).
+		Done()
+	return meter
+}
+
+func (f *featureEgress) replayMeters() {
+	f.cachedMeters.Range(func(id, value interface{}) bool {
+		meter := value.(binding.Meter)
+		meter.Reset()
+		if err := meter.Add(); err != nil {
+			klog.ErrorS(err, "Error when replaying cached meter", "meterID", id)
+		}
+		return true
+	})
+}
+
+// parseEgressMetricFlow parses the OpenFlow port and the counters of a Pod SNAT flow.
+// example flow format:
+// table=EgressMark, n_packets=10, n_bytes=980, priority=200,ct_state=+trk,ip,in_port=5 actions=load:0x1->NXM_NX_PKT_MARK[0..7],...
+func parseEgressMetricFlow(flow string) (uint32, types.RuleMetric) {
+	// Exclude the actions so that the last match field can be parsed correctly.
+	if i := strings.Index(flow, " actions="); i != -1 {
+		flow = flow[:i]
+	}
+	flowMap := parseFlowToMap(flow)
+	m := types.RuleMetric{}
+	m.Packets, _ = strconv.ParseUint(flowMap["n_packets"], 10, 64)
+	m.Bytes, _ = strconv.ParseUint(flowMap["n_bytes"], 10, 64)
+	ofPort, _ := strconv.ParseUint(flowMap["in_port"], 10, 32)
+	return uint32(ofPort), m
+}
@@ -192,6 +192,11 @@ var (
 	// CTMark[6]: Mark to indicate the connection is hairpin.
 	// This CT mark is used in CtZone / CtZoneV6 and SNATCtZone / SNATCtZoneV6.
 	HairpinCTMark = binding.NewOneBitCTMark(6)
+
+	// CTMark[7]: Mark to indicate the connection should be redirected to the application-aware engine to enforce
+	// layer 7 NetworkPolicy rules.
+	// This CT mark is only used in CtZone / CtZoneV6.
+	L7NPRedirectCTMark = binding.NewOneBitCTMark(7)
 )
 
 // Fields using CT label.
@@ -201,4 +206,8 @@ var (
 
 	// Field to store the egress rule ID.
 	EgressRuleCTLabel = binding.NewCTLabel(32, 63, "egressRuleCTLabel")
+
+	// Field to store the VLAN ID allocated for a layer 7 NetworkPolicy rule. The VLAN ID is used by the
+	// application-aware engine to identify the rule that the redirected traffic should be enforced with.
+	L7NPRuleVlanIDCTLabel = binding.NewCTLabel(64, 75, "l7NPRuleVlanIDCTLabel")
 )
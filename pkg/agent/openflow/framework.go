@@ -206,7 +206,9 @@ func (f *featureNetworkPolicy) getRequiredTables() []*Table {
 	}
 	if f.enableAntreaPolicy {
 		tables = append(tables,
+			AntreaPolicyEgressAuditTable,
 			AntreaPolicyEgressRuleTable,
+			AntreaPolicyIngressAuditTable,
 			AntreaPolicyIngressRuleTable,
 		)
 	}
@@ -237,10 +239,14 @@ func (f *featureService) getRequiredTables() []*Table {
 }
 
 func (f *featureEgress) getRequiredTables() []*Table {
-	return []*Table{
+	tables := []*Table{
 		L3ForwardingTable,
 		EgressMarkTable,
 	}
+	if f.enableEgressTrafficShaping {
+		tables = append(tables, EgressQoSTable)
+	}
+	return tables
 }
 
 func (f *featureMulticast) getRequiredTables() []*Table {
@@ -21,10 +21,12 @@ import (
 	"strings"
 	"sync"
 
+	"antrea.io/libOpenflow/openflow13"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
+	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -65,8 +67,11 @@ var (
 	// There could be other flows like default flow and Traceflow flows in the table. Only metric flows are supposed to
 	// have normal priority.
 	metricFlowIdentifier = fmt.Sprintf("priority=%d,", priorityNormal)
+	// auditFlowIdentifier is used to identify the conjunction action flows in the audit tables.
+	auditFlowIdentifier = ",conj_id="
 
 	protocolUDP = v1beta2.ProtocolUDP
+	protocolTCP = v1beta2.ProtocolTCP
 	dnsPort     = intstr.FromInt(53)
 )
 
@@ -593,9 +598,17 @@ func (c *client) NewDNSpacketInConjunction(id uint32) error {
 	if err := c.ofEntryOperations.AddAll(conj.actionFlows); err != nil {
 		return fmt.Errorf("error when adding action flows for the DNS conjunction: %w", err)
 	}
-	udpService := v1beta2.Service{
-		Protocol: &protocolUDP,
-		Port:     &dnsPort,
+	// DNS responses can be sent over both UDP and TCP. TCP is used when a response doesn't fit
+	// in a UDP message, which is common for FQDNs with many records.
+	dnsServices := []v1beta2.Service{
+		{
+			Protocol: &protocolUDP,
+			Port:     &dnsPort,
+		},
+		{
+			Protocol: &protocolTCP,
+			Port:     &dnsPort,
+		},
 	}
 	dnsPriority := priorityDNSIntercept
 	conj.serviceClause = conj.newClause(1, 2, getTableByID(conj.ruleTableID), nil)
@@ -603,7 +616,7 @@ func (c *client) NewDNSpacketInConjunction(id uint32) error {
 
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
-	ctxChanges := conj.serviceClause.addServiceFlows(c.featureNetworkPolicy, []v1beta2.Service{udpService}, &dnsPriority, true)
+	ctxChanges := conj.serviceClause.addServiceFlows(c.featureNetworkPolicy, dnsServices, &dnsPriority, true)
 	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(ctxChanges); err != nil {
 		return err
 	}
@@ -1016,7 +1029,9 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		// Install action flows.
 		var actionFlows []binding.Flow
 		var metricFlows []binding.Flow
-		if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1alpha1.RuleActionDrop {
+		if rule.IsAntreaNetworkPolicyRule() && rule.AuditAction != nil {
+			actionFlows = append(actionFlows, f.conjunctionActionAuditFlow(ruleOfID, ruleTable, rule.Priority))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1alpha1.RuleActionDrop {
 			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress))
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1alpha1.RuleActionReject {
@@ -1026,7 +1041,7 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
 		} else {
 			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress)...)
-			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging)...)
+			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID)...)
 		}
 		conj.actionFlows = actionFlows
 		conj.metricFlows = metricFlows
@@ -1712,6 +1727,26 @@ func parseAllowFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	return uint32(id), m
 }
 
+// parseAuditFlow parses the metrics of a Drop or Reject rule of a policy in Audit mode from its conjunction action flow.
+// Only the first packets of connections are audited, hence each packet is counted as a session like for Drop rules.
+func parseAuditFlow(flow string) (uint32, types.RuleMetric) {
+	// example audit flow format:
+	// table=44, n_packets=3, n_bytes=222, priority=14900,conj_id=7 actions=load:0x7->NXM_NX_REG5[],...
+	flowMap := parseFlowToMap(flow)
+	m := types.RuleMetric{}
+	pkts, _ := strconv.ParseUint(flowMap["n_packets"], 10, 64)
+	m.Packets = pkts
+	m.Sessions = pkts
+	bytes, _ := strconv.ParseUint(flowMap["n_bytes"], 10, 64)
+	m.Bytes = bytes
+	conjID := flowMap["conj_id"]
+	if i := strings.Index(conjID, " "); i != -1 {
+		conjID = conjID[:i]
+	}
+	id, _ := strconv.ParseUint(conjID, 10, 32)
+	return uint32(id), m
+}
+
 func parseFlowToMap(flow string) map[string]string {
 	split := strings.Split(flow, ",")
 	flowMap := make(map[string]string)
@@ -1744,18 +1779,19 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	egressFlows, _ := c.ovsctlClient.DumpTableFlows(EgressMetricTable.ofTable.GetID())
 	ingressFlows, _ := c.ovsctlClient.DumpTableFlows(IngressMetricTable.ofTable.GetID())
 
+	mergeMetric := func(ruleID uint32, metric types.RuleMetric) {
+		if accMetric, ok := result[ruleID]; ok {
+			accMetric.Merge(&metric)
+		} else {
+			result[ruleID] = &metric
+		}
+	}
 	collectMetricsFromFlows := func(flows []string) {
 		for _, flow := range flows {
 			if !strings.Contains(flow, metricFlowIdentifier) {
 				continue
 			}
-			ruleID, metric := parseMetricFlow(flow)
-
-			if accMetric, ok := result[ruleID]; ok {
-				accMetric.Merge(&metric)
-			} else {
-				result[ruleID] = &metric
-			}
+			mergeMetric(parseMetricFlow(flow))
 		}
 	}
 	// We have two flows for each allow rule. One matches 'ct_state=+new'
@@ -1766,6 +1802,19 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	// flows to get the correct number of total packets.
 	collectMetricsFromFlows(egressFlows)
 	collectMetricsFromFlows(ingressFlows)
+	// The Drop and Reject rules of policies in Audit mode don't have metric flows, as the packets matching them are
+	// not decided by them. Their metrics are collected from their conjunction action flows in the audit tables.
+	if c.featureNetworkPolicy.enableAntreaPolicy {
+		for _, table := range GetAntreaPolicyAuditTables() {
+			auditFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
+			for _, flow := range auditFlows {
+				if !strings.Contains(flow, auditFlowIdentifier) {
+					continue
+				}
+				mergeMetric(parseAuditFlow(flow))
+			}
+		}
+	}
 	return result
 }
 
@@ -1787,7 +1836,12 @@ type featureNetworkPolicy struct {
 	ovsMetersAreSupported bool
 	enableDenyTracking    bool
 	enableAntreaPolicy    bool
-	ctZoneSrcField        *binding.RegField
+	enableL7NetworkPolicy bool
+	// enableNodeNetworkPolicy indicates whether Antrea-native policies can be applied to the local Node.
+	enableNodeNetworkPolicy bool
+	ctZoneSrcField          *binding.RegField
+	// l7NetworkPolicyConfig is only set when enableL7NetworkPolicy is true.
+	l7NetworkPolicyConfig *config.L7NetworkPolicyConfig
 	// deterministic represents whether to generate flows deterministically.
 	// For example, if a flow has multiple actions, setting it to true can get consistent flow.
 	// Enabling it may carry a performance impact. It's disabled by default and should only be used in testing.
@@ -1807,7 +1861,10 @@ func newFeatureNetworkPolicy(
 	ovsMetersAreSupported,
 	enableDenyTracking,
 	enableAntreaPolicy bool,
-	connectUplinkToBridge bool) *featureNetworkPolicy {
+	enableL7NetworkPolicy bool,
+	enableNodeNetworkPolicy bool,
+	connectUplinkToBridge bool,
+	l7NetworkPolicyConfig *config.L7NetworkPolicyConfig) *featureNetworkPolicy {
 	return &featureNetworkPolicy{
 		cookieAllocator:          cookieAllocator,
 		ipProtocols:              ipProtocols,
@@ -1817,6 +1874,9 @@ func newFeatureNetworkPolicy(
 		ovsMetersAreSupported:    ovsMetersAreSupported,
 		enableDenyTracking:       enableDenyTracking,
 		enableAntreaPolicy:       enableAntreaPolicy,
+		enableL7NetworkPolicy:    enableL7NetworkPolicy,
+		enableNodeNetworkPolicy:  enableNodeNetworkPolicy,
+		l7NetworkPolicyConfig:    l7NetworkPolicyConfig,
 		category:                 cookie.NetworkPolicy,
 		ctZoneSrcField:           getZoneSrcField(connectUplinkToBridge),
 	}
@@ -1826,10 +1886,48 @@ func (f *featureNetworkPolicy) initFlows() []binding.Flow {
 	f.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}}
 	if f.enableAntreaPolicy {
 		f.egressTables[AntreaPolicyEgressRuleTable.GetID()] = struct{}{}
+		f.egressTables[AntreaPolicyEgressAuditTable.GetID()] = struct{}{}
 	}
 	var flows []binding.Flow
 	flows = append(flows, f.establishedConnectionFlows()...)
 	flows = append(flows, f.relatedConnectionFlows()...)
+	if f.enableAntreaPolicy {
+		flows = append(flows, f.auditTableConnectionFlows()...)
+	}
 	flows = append(flows, f.ingressClassifierFlows()...)
+	if f.enableL7NetworkPolicy {
+		flows = append(flows, f.l7NPTrafficControlFlows()...)
+	}
 	return flows
 }
+
+// l7NPTrafficControlFlows generates the flows to redirect the traffic of the connections committed by layer 7
+// NetworkPolicy rules to the application-aware engine, and to forward the traffic returned from the engine.
+func (f *featureNetworkPolicy) l7NPTrafficControlFlows() []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	vlanMask := uint16(openflow13.OFPVID_PRESENT)
+	return []binding.Flow{
+		// This generates the flow to output the packets marked with L7NPRedirectCTMark to the target port of the
+		// application-aware engine, after tagging them with the VLAN ID of the matched rule. The priority is higher
+		// than the traffic control flows so that the redirection to the engine always takes precedence.
+		L2ForwardingOutTable.ofTable.BuildFlow(priorityHigh+2).
+			Cookie(cookieID).
+			MatchRegMark(OFPortFoundRegMark).
+			MatchCTMark(L7NPRedirectCTMark).
+			Action().PushVLAN(EtherTypeDot1q).
+			Action().MoveRange(binding.NxmFieldCtLabel, binding.OxmFieldVLANVID, *L7NPRuleVlanIDCTLabel.GetRange(), *binding.VLANVIDRange).
+			Action().Output(f.l7NetworkPolicyConfig.TargetOFPort).
+			Done(),
+		// This generates the flow to untag the packets returned from the application-aware engine, mark them with
+		// FromTCReturnRegMark and forward them to stageRouting directly. The returned packets are then output to the
+		// original target port in stageOutput, without being committed or checked by NetworkPolicy again.
+		ClassifierTable.ofTable.BuildFlow(priorityNormal).
+			Cookie(cookieID).
+			MatchInPort(f.l7NetworkPolicyConfig.ReturnOFPort).
+			MatchVLAN(false, 0, &vlanMask).
+			Action().PopVLAN().
+			Action().LoadRegMark(FromTCReturnRegMark).
+			Action().GotoStage(stageRouting).
+			Done(),
+	}
+}
@@ -65,7 +65,6 @@ var (
 	actionAllow  = crdv1alpha1.RuleActionAllow
 	actionDrop   = crdv1alpha1.RuleActionDrop
 	port8080     = intstr.FromInt(8080)
-	protocolTCP  = v1beta2.ProtocolTCP
 	protocolICMP = v1beta2.ProtocolICMP
 	priority100  = uint16(100)
 	priority200  = uint16(200)
@@ -524,7 +523,7 @@ func TestBatchInstallPolicyRuleFlows(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			mockOperations := oftest.NewMockOFEntryOperations(ctrl)
-			ofClient := NewClient(bridgeName, bridgeMgmtAddr, false, true, false, false, false, false, false, false)
+			ofClient := NewClient(bridgeName, bridgeMgmtAddr, false, true, false, false, false, false, false, false, false, false, false)
 			c = ofClient.(*client)
 			c.cookieAllocator = cookie.NewAllocator(0)
 			c.ofEntryOperations = mockOperations
@@ -1083,10 +1082,12 @@ func TestParseMetricFlow(t *testing.T) {
 
 func TestNetworkPolicyMetrics(t *testing.T) {
 	tests := []struct {
-		name         string
-		egressFlows  []string
-		ingressFlows []string
-		want         map[uint32]*types.RuleMetric
+		name              string
+		egressFlows       []string
+		ingressFlows      []string
+		egressAuditFlows  []string
+		ingressAuditFlows []string
+		want              map[uint32]*types.RuleMetric
 	}{
 		{
 			name: "Normal flows",
@@ -1154,6 +1155,34 @@ func TestNetworkPolicyMetrics(t *testing.T) {
 				11: {Bytes: 338, Sessions: 4, Packets: 4},
 			},
 		},
+		{
+			name: "Flows with audited rules",
+			egressFlows: []string{
+				"table=61, n_packets=1, n_bytes=74, priority=200,ct_state=+new,ct_label=0x200000000/0xffffffff00000000,ip actions=goto_table:70",
+				"table=61, n_packets=11, n_bytes=1661, priority=200,ct_state=-new,ct_label=0x200000000/0xffffffff00000000,ip actions=goto_table:70",
+				"table=61, n_packets=1502362, n_bytes=601635949, priority=0 actions=goto_table:70",
+			},
+			ingressFlows: []string{
+				"table=101, n_packets=4, n_bytes=338, priority=200,reg0=0x100000/0x100000,reg3=0xb actions=drop",
+				"table=101, n_packets=1407190, n_bytes=509746586, priority=0 actions=resubmit(,105)",
+			},
+			egressAuditFlows: []string{
+				"table=44, n_packets=3, n_bytes=222, priority=64990,ct_state=-new,ip actions=goto_table:45",
+				"table=44, n_packets=2, n_bytes=148, priority=14900,conj_id=7 actions=load:0x7->NXM_NX_REG5[],load:0x3->NXM_NX_REG0[11..12],load:0x1->NXM_NX_REG0[13..17],controller(max_len=65535,reason=no_match,id=15768,userdata=01.01),goto_table:45",
+				"table=44, n_packets=0, n_bytes=0, priority=14899,conj_id=9 actions=load:0x9->NXM_NX_REG5[],load:0x3->NXM_NX_REG0[11..12],load:0x1->NXM_NX_REG0[13..17],controller(max_len=65535,reason=no_match,id=15768,userdata=01.01),goto_table:45",
+				"table=44, n_packets=120, n_bytes=9000, priority=0 actions=goto_table:45",
+			},
+			ingressAuditFlows: []string{
+				"table=84, n_packets=5, n_bytes=370, priority=14900,conj_id=12 actions=load:0xc->NXM_NX_REG6[],load:0x3->NXM_NX_REG0[11..12],load:0x1->NXM_NX_REG0[13..17],controller(max_len=65535,reason=no_match,id=15768,userdata=01.01),goto_table:85",
+			},
+			want: map[uint32]*types.RuleMetric{
+				2:  {Bytes: 1735, Sessions: 1, Packets: 12},
+				11: {Bytes: 338, Sessions: 4, Packets: 4},
+				7:  {Bytes: 148, Sessions: 2, Packets: 2},
+				9:  {Bytes: 0, Sessions: 0, Packets: 0},
+				12: {Bytes: 370, Sessions: 5, Packets: 5},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1167,6 +1196,8 @@ func TestNetworkPolicyMetrics(t *testing.T) {
 			gomock.InOrder(
 				mockOVSClient.EXPECT().DumpTableFlows(EgressMetricTable.ofTable.GetID()).Return(tt.egressFlows, nil),
 				mockOVSClient.EXPECT().DumpTableFlows(IngressMetricTable.ofTable.GetID()).Return(tt.ingressFlows, nil),
+				mockOVSClient.EXPECT().DumpTableFlows(AntreaPolicyEgressAuditTable.ofTable.GetID()).Return(tt.egressAuditFlows, nil),
+				mockOVSClient.EXPECT().DumpTableFlows(AntreaPolicyIngressAuditTable.ofTable.GetID()).Return(tt.ingressAuditFlows, nil),
 			)
 			got := c.NetworkPolicyMetrics()
 			assert.Equal(t, tt.want, got)
@@ -145,14 +145,16 @@ var (
 	DNATTable = newTable("DNAT", stagePreRouting, pipelineIP)
 
 	// Tables in stageEgressSecurity:
-	AntreaPolicyEgressRuleTable = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
-	EgressRuleTable             = newTable("EgressRule", stageEgressSecurity, pipelineIP)
-	EgressDefaultTable          = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
-	EgressMetricTable           = newTable("EgressMetric", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressAuditTable = newTable("AntreaPolicyEgressAudit", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressRuleTable  = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
+	EgressRuleTable              = newTable("EgressRule", stageEgressSecurity, pipelineIP)
+	EgressDefaultTable           = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
+	EgressMetricTable            = newTable("EgressMetric", stageEgressSecurity, pipelineIP)
 
 	// Tables in stageRouting:
 	L3ForwardingTable = newTable("L3Forwarding", stageRouting, pipelineIP)
 	EgressMarkTable   = newTable("EgressMark", stageRouting, pipelineIP)
+	EgressQoSTable    = newTable("EgressQoS", stageRouting, pipelineIP)
 	L3DecTTLTable     = newTable("L3DecTTL", stageRouting, pipelineIP)
 
 	// Tables in stagePostRouting:
@@ -165,6 +167,7 @@ var (
 
 	// Tables in stageIngressSecurity:
 	IngressSecurityClassifierTable = newTable("IngressSecurityClassifier", stageIngressSecurity, pipelineIP)
+	AntreaPolicyIngressAuditTable  = newTable("AntreaPolicyIngressAudit", stageIngressSecurity, pipelineIP)
 	AntreaPolicyIngressRuleTable   = newTable("AntreaPolicyIngressRule", stageIngressSecurity, pipelineIP)
 	IngressRuleTable               = newTable("IngressRule", stageIngressSecurity, pipelineIP)
 	IngressDefaultTable            = newTable("IngressDefaultRule", stageIngressSecurity, pipelineIP)
@@ -309,6 +312,15 @@ func GetAntreaPolicyMultiTierTables() []*Table {
 	}
 }
 
+// GetAntreaPolicyAuditTables returns the tables of the Drop and Reject rules of policies in Audit mode. They are
+// evaluated before the Antrea-native policy rule tables, and never decide the fate of the packets.
+func GetAntreaPolicyAuditTables() []*Table {
+	return []*Table{
+		AntreaPolicyEgressAuditTable,
+		AntreaPolicyIngressAuditTable,
+	}
+}
+
 const (
 	CtZone       = 0xfff0
 	CtZoneV6     = 0xffe6
@@ -387,17 +399,26 @@ func portToUint16(port int) uint16 {
 }
 
 type client struct {
-	enableProxy           bool
-	proxyAll              bool
-	enableAntreaPolicy    bool
-	enableDenyTracking    bool
-	enableEgress          bool
-	enableMulticast       bool
-	enableTrafficControl  bool
-	connectUplinkToBridge bool
-	roundInfo             types.RoundInfo
-	cookieAllocator       cookie.Allocator
-	bridge                binding.Bridge
+	enableProxy          bool
+	proxyAll             bool
+	enableAntreaPolicy   bool
+	enableDenyTracking   bool
+	enableEgress         bool
+	enableMulticast      bool
+	enableTrafficControl bool
+	// enableL7NetworkPolicy indicates whether the traffic matching layer 7 NetworkPolicy rules is redirected to the
+	// application-aware engine.
+	enableL7NetworkPolicy bool
+	// enableNodeNetworkPolicy indicates whether Antrea-native policies can be applied to the host network traffic of
+	// the Node.
+	enableNodeNetworkPolicy bool
+	// enableEgressTrafficShaping indicates whether the bandwidth of Egress IPs is enforced with OpenFlow meters. It's
+	// false if the OVS datapath doesn't support meters.
+	enableEgressTrafficShaping bool
+	connectUplinkToBridge      bool
+	roundInfo                  types.RoundInfo
+	cookieAllocator            cookie.Allocator
+	bridge                     binding.Bridge
 
 	featurePodConnectivity *featurePodConnectivity
 	featureService         *featureService
@@ -420,6 +441,8 @@ type client struct {
 	networkConfig *config.NetworkConfig
 	egressConfig  *config.EgressConfig
 	serviceConfig *config.ServiceConfig
+	// l7NetworkPolicyConfig is only set when enableL7NetworkPolicy is true.
+	l7NetworkPolicyConfig *config.L7NetworkPolicyConfig
 	// ovsMetersAreSupported indicates whether the OVS datapath supports OpenFlow meters.
 	ovsMetersAreSupported bool
 	// packetInHandlers stores handler to process PacketIn event. Each packetin reason can have multiple handlers registered.
@@ -1669,7 +1692,7 @@ func (f *featurePodConnectivity) ipv6Flows() []binding.Flow {
 
 // conjunctionActionFlow generates the flow to jump to a specific table if policyRuleConjunction ID is matched. Priority of
 // conjunctionActionFlow is created at priorityLow for k8s network policies, and *priority assigned by PriorityAssigner for AntreaPolicy.
-func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table binding.Table, nextTable uint8, priority *uint16, enableLogging bool) []binding.Flow {
+func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table binding.Table, nextTable uint8, priority *uint16, enableLogging bool, l7RuleVlanID *uint32) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	var ofPriority uint16
 	if priority == nil {
@@ -1689,28 +1712,29 @@ func (f *featureNetworkPolicy) conjunctionActionFlow(conjunctionID uint32, table
 		if proto == binding.ProtocolIPv6 {
 			ctZone = CtZoneV6
 		}
+		fb := table.BuildFlow(ofPriority).MatchProtocol(proto).
+			MatchConjID(conjunctionID)
 		if enableLogging {
-			fb := table.BuildFlow(ofPriority).MatchProtocol(proto).
-				MatchConjID(conjunctionID)
 			if f.ovsMetersAreSupported {
 				fb = fb.Action().Meter(PacketInMeterIDNP)
 			}
-			return fb.
+			fb = fb.
 				Action().LoadToRegField(conjReg, conjunctionID).                           // Traceflow.
 				Action().LoadRegMark(DispositionAllowRegMark, CustomReasonLoggingRegMark). // AntreaPolicy, Enable logging.
-				Action().SendToController(uint8(PacketInReasonNP)).
-				Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
-				LoadToLabelField(uint64(conjunctionID), labelField).
-				CTDone().
-				Cookie(cookieID).
-				Done()
+				Action().SendToController(uint8(PacketInReasonNP))
+		} else {
+			fb = fb.Action().LoadToRegField(conjReg, conjunctionID) // Traceflow.
 		}
-		return table.BuildFlow(ofPriority).MatchProtocol(proto).
-			MatchConjID(conjunctionID).
-			Action().LoadToRegField(conjReg, conjunctionID).        // Traceflow.
-			Action().CT(true, nextTable, ctZone, f.ctZoneSrcField). // CT action requires commit flag if actions other than NAT without arguments are specified.
-			LoadToLabelField(uint64(conjunctionID), labelField).
-			CTDone().
+		// CT action requires commit flag if actions other than NAT without arguments are specified.
+		ctAction := fb.Action().CT(true, nextTable, ctZone, f.ctZoneSrcField).
+			LoadToLabelField(uint64(conjunctionID), labelField)
+		if l7RuleVlanID != nil {
+			// Mark the connection to be redirected to the application-aware engine, and persist the VLAN ID of the
+			// rule, which is used to identify the rule in the engine.
+			ctAction = ctAction.LoadToCtMark(L7NPRedirectCTMark).
+				LoadToLabelField(uint64(*l7RuleVlanID), L7NPRuleVlanIDCTLabel)
+		}
+		return ctAction.CTDone().
 			Cookie(cookieID).
 			Done()
 	}
@@ -1787,6 +1811,49 @@ func (f *featureNetworkPolicy) conjunctionActionPassFlow(conjunctionID uint32, t
 		Done()
 }
 
+// conjunctionActionAuditFlow generates the flow for the Drop and Reject rules of policies in Audit mode, which are
+// installed in a dedicated audit table. The packets matching the rule are sent to the controller to be logged, and then
+// go to the Antrea-native policy rule table, so that they are evaluated by all the enforced NetworkPolicy rules as if
+// the rule didn't exist. The packet counters of the flow are used as the metrics of the rule.
+func (f *featureNetworkPolicy) conjunctionActionAuditFlow(conjunctionID uint32, table binding.Table, priority *uint16) binding.Flow {
+	ofPriority := *priority
+	conjReg := TFIngressConjIDField
+	tableID := table.GetID()
+	if _, ok := f.egressTables[tableID]; ok {
+		conjReg = TFEgressConjIDField
+	}
+	flowBuilder := table.BuildFlow(ofPriority).MatchConjID(conjunctionID).
+		Action().LoadToRegField(conjReg, conjunctionID)
+	if f.ovsMetersAreSupported {
+		flowBuilder = flowBuilder.Action().Meter(PacketInMeterIDNP)
+	}
+	return flowBuilder.
+		Action().LoadRegMark(DispositionPassRegMark, CustomReasonLoggingRegMark).
+		Action().SendToController(uint8(PacketInReasonNP)).
+		Action().NextTable().
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		Done()
+}
+
+// auditTableConnectionFlows generates the flows to forward the packets of established and related connections from
+// the audit tables to the Antrea-native policy rule tables directly, so that only the first packets of connections
+// are audited.
+func (f *featureNetworkPolicy) auditTableConnectionFlows() []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	var flows []binding.Flow
+	for _, table := range GetAntreaPolicyAuditTables() {
+		for _, ipProtocol := range f.ipProtocols {
+			flows = append(flows, table.ofTable.BuildFlow(priorityTopAntreaPolicy).
+				Cookie(cookieID).
+				MatchProtocol(ipProtocol).
+				MatchCTStateNew(false).
+				Action().NextTable().
+				Done())
+		}
+	}
+	return flows
+}
+
 func (c *client) Disconnect() error {
 	return c.bridge.Disconnect()
 }
@@ -2108,13 +2175,18 @@ func (f *featurePodConnectivity) localProbeFlows() []binding.Flow {
 // tables within stageIngressSecurity.
 func (f *featureNetworkPolicy) ingressClassifierFlows() []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
-	return []binding.Flow{
+	var flows []binding.Flow
+	if !f.enableNodeNetworkPolicy {
 		// This generates the flow to match the packets to the Antrea gateway and forward them to IngressMetricTable.
-		IngressSecurityClassifierTable.ofTable.BuildFlow(priorityNormal).
+		// When Antrea-native policies can be applied to Nodes, the packets to the Antrea gateway may be destined for
+		// the local Node, and must be evaluated by the ingress rules.
+		flows = append(flows, IngressSecurityClassifierTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchRegMark(ToGatewayRegMark).
 			Action().GotoTable(IngressMetricTable.GetID()).
-			Done(),
+			Done())
+	}
+	return append(flows,
 		// This generates the flow to match the packets to tunnel and forward them to IngressMetricTable.
 		IngressSecurityClassifierTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
@@ -2127,7 +2199,7 @@ func (f *featureNetworkPolicy) ingressClassifierFlows() []binding.Flow {
 			MatchRegMark(ToUplinkRegMark).
 			Action().GotoTable(IngressMetricTable.GetID()).
 			Done(),
-	}
+	)
 }
 
 // snatSkipNodeFlow generates the flow to skip SNAT for connection destined for the transport IP of a remote Node.
@@ -2143,39 +2215,44 @@ func (f *featureEgress) snatSkipNodeFlow(nodeIP net.IP) binding.Flow {
 }
 
 // snatIPFromTunnelFlow generates the flow that marks SNAT packets tunnelled from remote Nodes. The SNAT IP matches the
-// packet's tunnel destination IP.
+// packet's tunnel destination IP. Only the first packet of a connection needs to be marked to be SNAT'd, unless Egress
+// traffic shaping is enabled, in which case all packets are marked so that they can be rate-limited by EgressQoSTable.
 func (f *featureEgress) snatIPFromTunnelFlow(snatIP net.IP, mark uint32) binding.Flow {
 	ipProtocol := getIPProtocol(snatIP)
-	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+	fb := EgressMarkTable.ofTable.BuildFlow(priorityNormal).
 		Cookie(f.cookieAllocator.Request(f.category).Raw()).
-		MatchProtocol(ipProtocol).
-		MatchCTStateNew(true).
-		MatchCTStateTrk(true).
+		MatchProtocol(ipProtocol)
+	if !f.enableEgressTrafficShaping {
+		fb = fb.MatchCTStateNew(true)
+	}
+	fb = fb.MatchCTStateTrk(true).
 		MatchTunnelDst(snatIP).
 		Action().LoadPktMarkRange(mark, snatPktMarkRange).
-		Action().LoadRegMark(ToGatewayRegMark).
-		Action().GotoStage(stageSwitching).
-		Done()
+		Action().LoadRegMark(ToGatewayRegMark)
+	return f.gotoEgressQoSOrSwitching(fb).Done()
 }
 
 // snatRuleFlow generates the flow that applies the SNAT rule for a local Pod. If the SNAT IP exists on the local Node,
 // it sets the packet mark with the ID of the SNAT IP, for the traffic from local Pods to external; if the SNAT IP is
-// on a remote Node, it tunnels the packets to the remote Node.
+// on a remote Node, it tunnels the packets to the remote Node. For a local SNAT IP, only the first packet of a
+// connection is marked unless Egress traffic shaping is enabled, like in snatIPFromTunnelFlow. The counters of the flow
+// are used as the traffic stats of the Pod's Egress.
 func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint32, localGatewayMAC net.HardwareAddr) binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	ipProtocol := getIPProtocol(snatIP)
 	if snatMark != 0 {
 		// Local SNAT IP.
-		return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+		fb := EgressMarkTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
-			MatchProtocol(ipProtocol).
-			MatchCTStateNew(true).
-			MatchCTStateTrk(true).
+			MatchProtocol(ipProtocol)
+		if !f.enableEgressTrafficShaping {
+			fb = fb.MatchCTStateNew(true)
+		}
+		fb = fb.MatchCTStateTrk(true).
 			MatchInPort(ofPort).
 			Action().LoadPktMarkRange(snatMark, snatPktMarkRange).
-			Action().LoadRegMark(ToGatewayRegMark).
-			Action().GotoStage(stageSwitching).
-			Done()
+			Action().LoadRegMark(ToGatewayRegMark)
+		return f.gotoEgressQoSOrSwitching(fb).Done()
 	}
 	// SNAT IP should be on a remote Node.
 	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
@@ -2190,6 +2267,50 @@ func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint
 		Done()
 }
 
+// snatRuleEstablishedFlow generates the flow that matches the packets of established connections from a local Pod using
+// a local SNAT IP, which are not matched by snatRuleFlow when Egress traffic shaping is disabled. The packets are
+// forwarded like with the default flow of EgressMarkTable, the flow is only installed so that its counters are added to
+// the traffic stats of the Pod's Egress.
+func (f *featureEgress) snatRuleEstablishedFlow(ofPort uint32, snatIP net.IP) binding.Flow {
+	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(getIPProtocol(snatIP)).
+		MatchCTStateNew(false).
+		MatchCTStateTrk(true).
+		MatchInPort(ofPort).
+		Action().LoadRegMark(ToGatewayRegMark).
+		Action().GotoStage(stageSwitching).
+		Done()
+}
+
+// gotoEgressQoSOrSwitching forwards the marked SNAT packets to EgressQoSTable if Egress traffic shaping is enabled,
+// otherwise to stageSwitching.
+func (f *featureEgress) gotoEgressQoSOrSwitching(fb binding.FlowBuilder) binding.FlowBuilder {
+	if f.enableEgressTrafficShaping {
+		return fb.Action().GotoTable(EgressQoSTable.GetID())
+	}
+	return fb.Action().GotoStage(stageSwitching)
+}
+
+// egressQoSFlow generates the flow that applies the meter of a SNAT mark to the packets marked with it.
+func (f *featureEgress) egressQoSFlow(mark uint32) binding.Flow {
+	return EgressQoSTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchPktMark(mark, &types.SNATIPMarkMask).
+		Action().Meter(egressQoSMeterID(mark)).
+		Action().GotoStage(stageSwitching).
+		Done()
+}
+
+// egressQoSDefaultFlow generates the default flow of EgressQoSTable, which forwards the packets whose SNAT mark has no
+// meter to stageSwitching.
+func (f *featureEgress) egressQoSDefaultFlow() binding.Flow {
+	return EgressQoSTable.ofTable.BuildFlow(priorityMiss).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		Action().GotoStage(stageSwitching).
+		Done()
+}
+
 // nodePortMarkFlows generates the flows to mark the first packet of Service NodePort connection with ToNodePortAddressRegMark,
 // which indicates the Service type is NodePort.
 func (f *featureService) nodePortMarkFlows() []binding.Flow {
@@ -2646,22 +2767,34 @@ func NewClient(bridgeName string,
 	proxyAll bool,
 	connectUplinkToBridge bool,
 	enableMulticast bool,
-	enableTrafficControl bool) Client {
+	enableTrafficControl bool,
+	enableEgressTrafficShaping bool,
+	enableL7NetworkPolicy bool,
+	enableNodeNetworkPolicy bool) Client {
 	bridge := binding.NewOFBridge(bridgeName, mgmtAddr)
 	c := &client{
-		bridge:                bridge,
-		enableProxy:           enableProxy,
-		proxyAll:              proxyAll,
-		enableAntreaPolicy:    enableAntreaPolicy,
-		enableDenyTracking:    enableDenyTracking,
-		enableEgress:          enableEgress,
-		enableMulticast:       enableMulticast,
-		enableTrafficControl:  enableTrafficControl,
-		connectUplinkToBridge: connectUplinkToBridge,
-		pipelines:             make(map[binding.PipelineID]binding.Pipeline),
-		packetInHandlers:      map[uint8]map[string]PacketInHandler{},
-		ovsctlClient:          ovsctl.NewClient(bridgeName),
-		ovsMetersAreSupported: ovsMetersAreSupported(),
+		bridge:                  bridge,
+		enableProxy:             enableProxy,
+		proxyAll:                proxyAll,
+		enableAntreaPolicy:      enableAntreaPolicy,
+		enableDenyTracking:      enableDenyTracking,
+		enableEgress:            enableEgress,
+		enableMulticast:         enableMulticast,
+		enableTrafficControl:    enableTrafficControl,
+		enableL7NetworkPolicy:   enableL7NetworkPolicy,
+		enableNodeNetworkPolicy: enableNodeNetworkPolicy,
+		connectUplinkToBridge:   connectUplinkToBridge,
+		pipelines:               make(map[binding.PipelineID]binding.Pipeline),
+		packetInHandlers:        map[uint8]map[string]PacketInHandler{},
+		ovsctlClient:            ovsctl.NewClient(bridgeName),
+		ovsMetersAreSupported:   ovsMetersAreSupported(),
+	}
+	if enableEgressTrafficShaping {
+		if c.ovsMetersAreSupported {
+			c.enableEgressTrafficShaping = true
+		} else {
+			klog.InfoS("Egress traffic shaping cannot take effect because the OVS datapath does not support meters")
+		}
 	}
 	c.ofEntryOperations = c
 	return c
@@ -2768,6 +2901,46 @@ func (f *featurePodConnectivity) hostBridgeLocalFlows() []binding.Flow {
 	}
 }
 
+// hostNetworkPolicyFlows generates the flows to make the IP packets forwarded between bridge local port and uplink port
+// go through the pipeline instead of being forwarded directly by the flows generated by hostBridgeLocalFlows, so that
+// Antrea-native policies applied to the Node can be enforced on them. Like the flows generated by hostBridgeUplinkFlows,
+// they only support IPv4, and antrea-agent refuses to enable NodeNetworkPolicy when IPv6 is enabled.
+func (f *featurePodConnectivity) hostNetworkPolicyFlows() []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	ipProtocol := binding.ProtocolIP
+	uplinkMAC := f.nodeConfig.UplinkNetConfig.MAC
+	return []binding.Flow{
+		// This generates the flow to mark the packets from uplink port to bridge local port. The priority is higher
+		// than the flows generated by hostBridgeLocalFlows.
+		ClassifierTable.ofTable.BuildFlow(priorityNormal+1).
+			Cookie(cookieID).
+			MatchInPort(config.UplinkOFPort).
+			MatchDstMAC(uplinkMAC).
+			MatchProtocol(ipProtocol).
+			Action().LoadRegMark(f.ipCtZoneTypeRegMarks[ipProtocol], FromUplinkRegMark).
+			Action().GotoStage(stageConntrackState).
+			Done(),
+		// This generates the flow to mark the packets from bridge local port to uplink port.
+		ClassifierTable.ofTable.BuildFlow(priorityNormal+1).
+			Cookie(cookieID).
+			MatchInPort(config.BridgeOFPort).
+			MatchSrcMAC(uplinkMAC).
+			MatchProtocol(ipProtocol).
+			Action().LoadRegMark(f.ipCtZoneTypeRegMarks[ipProtocol], FromBridgeRegMark).
+			Action().GotoStage(stageConntrackState).
+			Done(),
+		// This generates the flow to output the packets from bridge local port, which are not destined for local Pods,
+		// to uplink port.
+		L2ForwardingCalcTable.ofTable.BuildFlow(priorityLow).
+			Cookie(cookieID).
+			MatchRegMark(FromBridgeRegMark).
+			Action().LoadToRegField(TargetOFPortField, config.UplinkOFPort).
+			Action().LoadRegMark(OFPortFoundRegMark).
+			Action().GotoStage(stageConntrack).
+			Done(),
+	}
+}
+
 // hostBridgeUplinkVLANFlows generates the flows to match VLAN packets from uplink port.
 func (f *featurePodConnectivity) hostBridgeUplinkVLANFlows() []binding.Flow {
 	vlanMask := uint16(openflow13.OFPVID_PRESENT)
@@ -66,6 +66,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -76,6 +77,7 @@ func TestBuildPipeline(t *testing.T) {
 					ServiceMarkTable,
 					SNATConntrackCommitTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -113,6 +115,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -123,6 +126,7 @@ func TestBuildPipeline(t *testing.T) {
 					ServiceMarkTable,
 					SNATConntrackCommitTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -150,6 +154,7 @@ func TestBuildPipeline(t *testing.T) {
 					ConntrackTable,
 					ConntrackStateTable,
 					DNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -157,6 +162,7 @@ func TestBuildPipeline(t *testing.T) {
 					L3ForwardingTable,
 					L3DecTTLTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
@@ -192,6 +198,7 @@ func TestBuildPipeline(t *testing.T) {
 					SessionAffinityTable,
 					ServiceLBTable,
 					EndpointDNATTable,
+					AntreaPolicyEgressAuditTable,
 					AntreaPolicyEgressRuleTable,
 					EgressRuleTable,
 					EgressDefaultTable,
@@ -202,6 +209,7 @@ func TestBuildPipeline(t *testing.T) {
 					ServiceMarkTable,
 					SNATConntrackCommitTable,
 					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditTable,
 					AntreaPolicyIngressRuleTable,
 					IngressRuleTable,
 					IngressDefaultTable,
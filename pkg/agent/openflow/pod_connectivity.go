@@ -45,6 +45,8 @@ type featurePodConnectivity struct {
 	enableMulticast       bool
 	proxyAll              bool
 	enableTrafficControl  bool
+	// enableNodeNetworkPolicy indicates whether Antrea-native policies can be applied to the local Node.
+	enableNodeNetworkPolicy bool
 
 	category cookie.Category
 }
@@ -61,7 +63,8 @@ func newFeaturePodConnectivity(
 	connectUplinkToBridge bool,
 	enableMulticast bool,
 	proxyAll bool,
-	enableTrafficControl bool) *featurePodConnectivity {
+	enableTrafficControl bool,
+	enableNodeNetworkPolicy bool) *featurePodConnectivity {
 	ctZones := make(map[binding.Protocol]int)
 	gatewayIPs := make(map[binding.Protocol]net.IP)
 	localCIDRs := make(map[binding.Protocol]net.IPNet)
@@ -88,24 +91,25 @@ func newFeaturePodConnectivity(
 	}
 
 	return &featurePodConnectivity{
-		cookieAllocator:       cookieAllocator,
-		ipProtocols:           ipProtocols,
-		nodeCachedFlows:       newFlowCategoryCache(),
-		podCachedFlows:        newFlowCategoryCache(),
-		tcCachedFlows:         newFlowCategoryCache(),
-		gatewayIPs:            gatewayIPs,
-		ctZones:               ctZones,
-		localCIDRs:            localCIDRs,
-		nodeIPs:               nodeIPs,
-		nodeConfig:            nodeConfig,
-		networkConfig:         networkConfig,
-		connectUplinkToBridge: connectUplinkToBridge,
-		enableTrafficControl:  enableTrafficControl,
-		ipCtZoneTypeRegMarks:  ipCtZoneTypeRegMarks,
-		ctZoneSrcField:        getZoneSrcField(connectUplinkToBridge),
-		enableMulticast:       enableMulticast,
-		proxyAll:              proxyAll,
-		category:              cookie.PodConnectivity,
+		cookieAllocator:         cookieAllocator,
+		ipProtocols:             ipProtocols,
+		nodeCachedFlows:         newFlowCategoryCache(),
+		podCachedFlows:          newFlowCategoryCache(),
+		tcCachedFlows:           newFlowCategoryCache(),
+		gatewayIPs:              gatewayIPs,
+		ctZones:                 ctZones,
+		localCIDRs:              localCIDRs,
+		nodeIPs:                 nodeIPs,
+		nodeConfig:              nodeConfig,
+		networkConfig:           networkConfig,
+		connectUplinkToBridge:   connectUplinkToBridge,
+		enableTrafficControl:    enableTrafficControl,
+		enableNodeNetworkPolicy: enableNodeNetworkPolicy,
+		ipCtZoneTypeRegMarks:    ipCtZoneTypeRegMarks,
+		ctZoneSrcField:          getZoneSrcField(connectUplinkToBridge),
+		enableMulticast:         enableMulticast,
+		proxyAll:                proxyAll,
+		category:                cookie.PodConnectivity,
 	}
 }
 
@@ -130,6 +134,9 @@ func (f *featurePodConnectivity) initFlows() []binding.Flow {
 				podCIDRMap := map[binding.Protocol]net.IPNet{binding.ProtocolIP: *f.nodeConfig.PodIPv4CIDR}
 				flows = append(flows, f.hostBridgeUplinkFlows(podCIDRMap)...)
 			}
+			if f.connectUplinkToBridge && f.enableNodeNetworkPolicy {
+				flows = append(flows, f.hostNetworkPolicyFlows()...)
+			}
 		}
 	}
 	if f.connectUplinkToBridge {
@@ -23,6 +23,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	agenttypes "antrea.io/antrea/pkg/agent/types"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -44,6 +45,14 @@ type statsCollection struct {
 	antreaClusterNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
 	// antreaNetworkPolicyStats is a mapping from Antrea NetworkPolicy UIDs to their traffic stats.
 	antreaNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
+	// adminNetworkPolicyStats is a mapping from AdminNetworkPolicy UIDs to their traffic stats.
+	adminNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
+	// baselineAdminNetworkPolicyStats is a mapping from BaselineAdminNetworkPolicy UIDs to their traffic stats.
+	baselineAdminNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
+	// egressStats is a mapping from Egress UIDs to their traffic stats.
+	egressStats map[types.UID]*statsv1alpha1.TrafficStats
+	// egressNames is a mapping from Egress UIDs to their names.
+	egressNames map[types.UID]string
 }
 
 // Collector is responsible for collecting stats from the Openflow client, calculating the delta compared with the last
@@ -56,18 +65,21 @@ type Collector struct {
 	// ofClient is the Openflow interface that can fetch the statistic of the Openflow entries.
 	ofClient             openflow.Client
 	networkPolicyQuerier querier.AgentNetworkPolicyInfoQuerier
+	// egressQuerier is nil if the Egress feature is disabled.
+	egressQuerier querier.AgentEgressQuerier
 	// lastStatsCollection is the last statistics that has been reported to antrea-controller successfully.
 	// It is used to calculate the delta of the statistics that will be reported.
 	lastStatsCollection *statsCollection
 }
 
-func NewCollector(antreaClientProvider agent.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier) *Collector {
+func NewCollector(antreaClientProvider agent.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, egressQuerier querier.AgentEgressQuerier) *Collector {
 	nodeName, _ := env.GetNodeName()
 	manager := &Collector{
 		nodeName:             nodeName,
 		antreaClientProvider: antreaClientProvider,
 		ofClient:             ofClient,
 		networkPolicyQuerier: npQuerier,
+		egressQuerier:        egressQuerier,
 	}
 	return manager
 }
@@ -100,13 +112,15 @@ func (m *Collector) Run(stopCh <-chan struct{}) {
 	}
 }
 
-// collect collects the stats of Openflow rules, maps them to the stats of NetworkPolicies.
+// collect collects the stats of Openflow rules, maps them to the stats of NetworkPolicies and Egresses.
 // It returns a map from NetworkPolicyReferences to their stats.
 func (m *Collector) collect() *statsCollection {
 	ruleStatsMap := m.ofClient.NetworkPolicyMetrics()
 	npStatsMap := map[types.UID]*statsv1alpha1.TrafficStats{}
 	acnpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
 	anpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
+	adminNPStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
+	banpStatsMap := map[types.UID]map[string]*statsv1alpha1.TrafficStats{}
 
 	for ofID, ruleStats := range ruleStatsMap {
 		rule := m.networkPolicyQuerier.GetRuleByFlowID(ofID)
@@ -125,15 +139,51 @@ func (m *Collector) collect() *statsCollection {
 			addRuleStatsUp(acnpStatsMap, ruleStats, rule)
 		case cpv1beta.AntreaNetworkPolicy:
 			addRuleStatsUp(anpStatsMap, ruleStats, rule)
+		case cpv1beta.AdminNetworkPolicy:
+			addRuleStatsUp(adminNPStatsMap, ruleStats, rule)
+		case cpv1beta.BaselineAdminNetworkPolicy:
+			addRuleStatsUp(banpStatsMap, ruleStats, rule)
+		default:
+			klog.Warningf("Unknown type %s of policy %s", rule.PolicyRef.Type, rule.PolicyRef.ToString())
 		}
 	}
+	egressStatsMap, egressNames := m.collectEgressStats()
 	return &statsCollection{
 		networkPolicyStats:              npStatsMap,
 		antreaClusterNetworkPolicyStats: acnpStatsMap,
 		antreaNetworkPolicyStats:        anpStatsMap,
+		adminNetworkPolicyStats:         adminNPStatsMap,
+		baselineAdminNetworkPolicyStats: banpStatsMap,
+		egressStats:                     egressStatsMap,
+		egressNames:                     egressNames,
 	}
 }
 
+// collectEgressStats collects the stats of the Egress SNAT flows of local Pods and adds them up by Egress.
+func (m *Collector) collectEgressStats() (map[types.UID]*statsv1alpha1.TrafficStats, map[types.UID]string) {
+	if m.egressQuerier == nil {
+		return nil, nil
+	}
+	egressStatsMap := map[types.UID]*statsv1alpha1.TrafficStats{}
+	egressNames := map[types.UID]string{}
+	for ofPort, podStats := range m.ofClient.EgressMetrics() {
+		name, uid, exists := m.egressQuerier.GetEgressByOFPort(ofPort)
+		if !exists {
+			// The Pod may have been removed or moved to another Egress since the flows were dumped.
+			klog.V(4).InfoS("Cannot find Egress that has SNAT flow for OpenFlow port", "ofPort", ofPort)
+			continue
+		}
+		egressStats, exists := egressStatsMap[uid]
+		if !exists {
+			egressStats = new(statsv1alpha1.TrafficStats)
+			egressStatsMap[uid] = egressStats
+			egressNames[uid] = name
+		}
+		addUp(egressStats, podStats)
+	}
+	return egressStatsMap, egressNames
+}
+
 func addPolicyStatsUp(statsMap map[types.UID]*statsv1alpha1.TrafficStats, ruleStats *agenttypes.RuleMetric, rule *agenttypes.PolicyRule) {
 	policyStats, exists := statsMap[rule.PolicyRef.UID]
 	if !exists {
@@ -168,7 +218,10 @@ func (m *Collector) report(curStatsCollection *statsCollection) error {
 	npStats := calculateDiff(curStatsCollection.networkPolicyStats, m.lastStatsCollection.networkPolicyStats)
 	acnpStats := calculateRuleDiff(curStatsCollection.antreaClusterNetworkPolicyStats, m.lastStatsCollection.antreaClusterNetworkPolicyStats)
 	anpStats := calculateRuleDiff(curStatsCollection.antreaNetworkPolicyStats, m.lastStatsCollection.antreaNetworkPolicyStats)
-	if len(npStats) == 0 && len(acnpStats) == 0 && len(anpStats) == 0 {
+	adminNPStats := calculateRuleDiff(curStatsCollection.adminNetworkPolicyStats, m.lastStatsCollection.adminNetworkPolicyStats)
+	banpStats := calculateRuleDiff(curStatsCollection.baselineAdminNetworkPolicyStats, m.lastStatsCollection.baselineAdminNetworkPolicyStats)
+	egressStats := calculateEgressDiff(curStatsCollection.egressStats, m.lastStatsCollection.egressStats, curStatsCollection.egressNames)
+	if len(npStats) == 0 && len(acnpStats) == 0 && len(anpStats) == 0 && len(adminNPStats) == 0 && len(banpStats) == 0 && len(egressStats) == 0 {
 		klog.V(4).Info("No stats to report, skip reporting")
 		return nil
 	}
@@ -180,6 +233,9 @@ func (m *Collector) report(curStatsCollection *statsCollection) error {
 		NetworkPolicies:              npStats,
 		AntreaClusterNetworkPolicies: acnpStats,
 		AntreaNetworkPolicies:        anpStats,
+		Egresses:                     egressStats,
+		AdminNetworkPolicies:         adminNPStats,
+		BaselineAdminNetworkPolicies: banpStats,
 	}
 	klog.V(6).Infof("Reporting NodeStatsSummary: %v", summary)
 
@@ -191,6 +247,10 @@ func (m *Collector) report(curStatsCollection *statsCollection) error {
 	if err != nil {
 		return err
 	}
+	for _, stats := range egressStats {
+		metrics.EgressPacketCount.WithLabelValues(stats.Name).Add(float64(stats.TrafficStats.Packets))
+		metrics.EgressByteCount.WithLabelValues(stats.Name).Add(float64(stats.TrafficStats.Bytes))
+	}
 	return nil
 }
 
@@ -282,3 +342,35 @@ func calculateDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.Traffi
 	}
 	return statsList
 }
+
+func calculateEgressDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.TrafficStats, names map[types.UID]string) []cpv1beta.EgressStats {
+	if len(curStatsMap) == 0 {
+		return nil
+	}
+	statsList := make([]cpv1beta.EgressStats, 0, len(curStatsMap))
+	for uid, curStats := range curStatsMap {
+		var stats *statsv1alpha1.TrafficStats
+		lastStats, exists := lastStatsMap[uid]
+		// curStats.Bytes < lastStats.Bytes could happen if the SNAT flows of some Pods are reinstalled or removed
+		// in-between two collection. In these cases, curStats is the delta it should report.
+		if !exists || curStats.Bytes < lastStats.Bytes {
+			stats = curStats
+		} else {
+			stats = &statsv1alpha1.TrafficStats{
+				Packets: curStats.Packets - lastStats.Packets,
+				Bytes:   curStats.Bytes - lastStats.Bytes,
+			}
+		}
+		// If the statistics of the Egress remain unchanged, no need to report it.
+		if stats.Bytes == 0 {
+			continue
+		}
+		egressStats := cpv1beta.EgressStats{
+			Name:         names[uid],
+			UID:          uid,
+			TrafficStats: *stats,
+		}
+		statsList = append(statsList, egressStats)
+	}
+	return statsList
+}
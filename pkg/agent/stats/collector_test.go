@@ -54,6 +54,16 @@ var (
 		Name:      "bar",
 		UID:       "uid4",
 	}
+	adminNP1 = cpv1beta.NetworkPolicyReference{
+		Type: cpv1beta.AdminNetworkPolicy,
+		Name: "baz",
+		UID:  "uid5",
+	}
+	baselineAdminNP1 = cpv1beta.NetworkPolicyReference{
+		Type: cpv1beta.BaselineAdminNetworkPolicy,
+		Name: "default",
+		UID:  "uid6",
+	}
 )
 
 func TestCollect(t *testing.T) {
@@ -105,6 +115,8 @@ func TestCollect(t *testing.T) {
 				},
 				antreaClusterNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
 				antreaNetworkPolicyStats:        map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+				adminNetworkPolicyStats:         map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+				baselineAdminNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
 			},
 		},
 		{
@@ -157,6 +169,64 @@ func TestCollect(t *testing.T) {
 						},
 					},
 				},
+				adminNetworkPolicyStats:         map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+				baselineAdminNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+			},
+		},
+		{
+			name: "admin policies",
+			ruleStats: map[uint32]*agenttypes.RuleMetric{
+				1: {
+					Bytes:    10,
+					Packets:  1,
+					Sessions: 1,
+				},
+				2: {
+					Bytes:    15,
+					Packets:  2,
+					Sessions: 1,
+				},
+				3: {
+					Bytes:    30,
+					Packets:  5,
+					Sessions: 3,
+				},
+			},
+			ofIDToPolicyMap: map[uint32]*agenttypes.PolicyRule{
+				1: {Name: "rule1", PolicyRef: &acnp1},
+				2: {Name: "rule1", PolicyRef: &adminNP1},
+				3: {Name: "rule1", PolicyRef: &baselineAdminNP1},
+			},
+			expectedStatsCollection: &statsCollection{
+				networkPolicyStats: map[types.UID]*statsv1alpha1.TrafficStats{},
+				antreaClusterNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+					acnp1.UID: {
+						"rule1": {
+							Bytes:    10,
+							Packets:  1,
+							Sessions: 1,
+						},
+					},
+				},
+				antreaNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+				adminNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+					adminNP1.UID: {
+						"rule1": {
+							Bytes:    15,
+							Packets:  2,
+							Sessions: 1,
+						},
+					},
+				},
+				baselineAdminNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+					baselineAdminNP1.UID: {
+						"rule1": {
+							Bytes:    30,
+							Packets:  5,
+							Sessions: 3,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -187,6 +257,8 @@ func TestCollect(t *testing.T) {
 				},
 				antreaClusterNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
 				antreaNetworkPolicyStats:        map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+				adminNetworkPolicyStats:         map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
+				baselineAdminNetworkPolicyStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{},
 			},
 		},
 	}
@@ -206,6 +278,60 @@ func TestCollect(t *testing.T) {
 	}
 }
 
+func TestCollectEgressStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ofClient := oftest.NewMockClient(ctrl)
+	npQuerier := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	egressQuerier := queriertest.NewMockAgentEgressQuerier(ctrl)
+	ofClient.EXPECT().NetworkPolicyMetrics().Return(map[uint32]*agenttypes.RuleMetric{}).Times(1)
+	ofClient.EXPECT().EgressMetrics().Return(map[uint32]*agenttypes.RuleMetric{
+		1: {Bytes: 10, Packets: 1},
+		2: {Bytes: 15, Packets: 2},
+		3: {Bytes: 30, Packets: 5},
+		4: {Bytes: 100, Packets: 10},
+	}).Times(1)
+	egressQuerier.EXPECT().GetEgressByOFPort(uint32(1)).Return("egress1", types.UID("uid5"), true)
+	egressQuerier.EXPECT().GetEgressByOFPort(uint32(2)).Return("egress1", types.UID("uid5"), true)
+	egressQuerier.EXPECT().GetEgressByOFPort(uint32(3)).Return("egress2", types.UID("uid6"), true)
+	// The Pod has been removed since the flows were dumped.
+	egressQuerier.EXPECT().GetEgressByOFPort(uint32(4)).Return("", types.UID(""), false)
+
+	m := &Collector{ofClient: ofClient, networkPolicyQuerier: npQuerier, egressQuerier: egressQuerier}
+	actualStats := m.collect()
+	assert.Equal(t, map[types.UID]*statsv1alpha1.TrafficStats{
+		"uid5": {Bytes: 25, Packets: 3},
+		"uid6": {Bytes: 30, Packets: 5},
+	}, actualStats.egressStats)
+	assert.Equal(t, map[types.UID]string{"uid5": "egress1", "uid6": "egress2"}, actualStats.egressNames)
+}
+
+func TestCalculateEgressDiff(t *testing.T) {
+	lastStats := map[types.UID]*statsv1alpha1.TrafficStats{
+		"uid1": {Bytes: 10, Packets: 1},
+		"uid2": {Bytes: 20, Packets: 2},
+		"uid3": {Bytes: 30, Packets: 3},
+	}
+	curStats := map[types.UID]*statsv1alpha1.TrafficStats{
+		// Existing Egress.
+		"uid1": {Bytes: 25, Packets: 3},
+		// Unchanged Egress.
+		"uid2": {Bytes: 20, Packets: 2},
+		// Egress whose flows have been reinstalled.
+		"uid3": {Bytes: 5, Packets: 1},
+		// New Egress.
+		"uid4": {Bytes: 40, Packets: 4},
+	}
+	names := map[types.UID]string{"uid1": "egress1", "uid2": "egress2", "uid3": "egress3", "uid4": "egress4"}
+	expectedStatsList := []cpv1beta.EgressStats{
+		{Name: "egress1", UID: "uid1", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 15, Packets: 2}},
+		{Name: "egress3", UID: "uid3", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 5, Packets: 1}},
+		{Name: "egress4", UID: "uid4", TrafficStats: statsv1alpha1.TrafficStats{Bytes: 40, Packets: 4}},
+	}
+	assert.ElementsMatch(t, expectedStatsList, calculateEgressDiff(curStats, lastStats, names))
+}
+
 func TestCalculateDiff(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -84,6 +84,13 @@ type PolicyRule struct {
 	TableID       uint8
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
+	// L7RuleVlanID is the VLAN ID allocated for the rule if it has layer 7 protocols. The traffic matching the rule
+	// is tagged with the VLAN ID and redirected to the application-aware engine.
+	L7RuleVlanID *uint32
+	// AuditAction is the action of the rule in Enforce mode. It's set only for the Drop and Reject rules of policies in
+	// Audit mode, in which case the rule is installed in an audit table, Action is Pass and EnableLogging is true, so
+	// that the traffic that would be blocked is logged and then evaluated by all the enforced NetworkPolicy rules.
+	AuditAction *secv1alpha1.RuleAction
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
@@ -16,14 +16,18 @@ package antctl
 
 import (
 	"fmt"
+	"net/http"
 	"reflect"
 
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/agentinfo"
+	"antrea.io/antrea/pkg/agent/apiserver/handlers/fqdncache"
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/ovsflows"
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/podinterface"
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/serviceexternalip"
 	"antrea.io/antrea/pkg/agent/openflow"
 	fallbackversion "antrea.io/antrea/pkg/antctl/fallback/version"
+	"antrea.io/antrea/pkg/antctl/raw/egress"
+	"antrea.io/antrea/pkg/antctl/raw/externalippool"
 	"antrea.io/antrea/pkg/antctl/raw/featuregates"
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
@@ -456,6 +460,48 @@ var CommandList = &commandList{
 			},
 			transformedResponse: reflect.TypeOf(controllernetworkpolicy.EndpointQueryResponse{}),
 		},
+		{
+			use:   "policy-evaluation",
+			short: "Evaluate the network policies applied to traffic between two endpoints.",
+			long:  "Evaluate the network policies applied to traffic from a source to a destination, and print the effective action along with the rules deciding it in each direction.",
+			example: `  Evaluate the network policies applied to TCP traffic from pod1 in Namespace ns1 to port 80 of pod2 in Namespace ns2
+  $ antctl query policy-evaluation -S ns1/pod1 -D ns2/pod2 --port 80
+  Evaluate the network policies applied to ICMP traffic from pod1 in Namespace default to IP 10.0.0.1
+  $ antctl query policy-evaluation -S pod1 -D 10.0.0.1 --protocol ICMP
+  Evaluate the egress rules applied to UDP traffic from pod1 in Namespace ns1 to port 53 of Service svc1 in Namespace ns2
+  $ antctl query policy-evaluation -S ns1/pod1 -D ns2/svc1 --protocol UDP --port 53
+`,
+			commandGroup: query,
+			controllerEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/policyevaluation",
+					params: []flagInfo{
+						{
+							name:      "source",
+							usage:     "Source of the traffic: Namespace/Pod, Pod (in the default Namespace) or IP",
+							shorthand: "S",
+						},
+						{
+							name:      "destination",
+							usage:     "Destination of the traffic: Namespace/Pod, Pod, Namespace/Service, Service or IP",
+							shorthand: "D",
+						},
+						{
+							name:            "protocol",
+							usage:           "Protocol of the traffic",
+							defaultValue:    "TCP",
+							supportedValues: []string{"TCP", "UDP", "SCTP", "ICMP"},
+						},
+						{
+							name:  "port",
+							usage: "Destination port of the traffic, required unless the protocol is ICMP",
+						},
+					},
+					outputType: single,
+				},
+			},
+			transformedResponse: reflect.TypeOf(controllernetworkpolicy.PolicyEvaluationResponse{}),
+		},
 		{
 			use:   "flowrecords",
 			short: "Print the matching flow records in the flow aggregator",
@@ -536,6 +582,55 @@ var CommandList = &commandList{
 			},
 			transformedResponse: reflect.TypeOf(serviceexternalip.Response{}),
 		},
+		{
+			use:   "fqdncache",
+			short: "Print the FQDNs tracked for FQDN policy rules",
+			long:  "Print the FQDNs tracked by the Antrea Agent for FQDN policy rules. It includes the IPs resolved for each FQDN, the expiration time of the IPs, and the FQDN selectors and policy rules matching the FQDN.",
+			example: `  Get the list of FQDNs tracked by the Antrea Agent
+  $ antctl get fqdncache
+  Get the FQDNs matching a domain, which can contain wildcards
+  $ antctl get fqdncache --domain *.antrea.io`,
+			commandGroup: get,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/fqdncache",
+					params: []flagInfo{
+						{
+							name:      "domain",
+							usage:     "Only get the FQDNs matching the provided domain, which can contain wildcards.",
+							shorthand: "d",
+						},
+					},
+					outputType: multiple,
+				},
+			},
+			transformedResponse: reflect.TypeOf(fqdncache.Response{}),
+		},
+		{
+			use:   "flush-fqdncache",
+			short: "Flush the IPs cached for FQDN policy rules",
+			long:  "Flush the IPs cached by the Antrea Agent for FQDN policy rules. The IPs are removed from the rules, and the FQDNs selected by name are resolved again immediately.",
+			example: `  Flush the IPs cached for all FQDNs
+  $ antctl flush-fqdncache
+  Flush the IPs cached for the FQDNs matching a domain, which can contain wildcards
+  $ antctl flush-fqdncache --domain *.antrea.io`,
+			commandGroup: flat,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path:   "/fqdncache/flush",
+					method: http.MethodPost,
+					params: []flagInfo{
+						{
+							name:      "domain",
+							usage:     "Only flush the FQDNs matching the provided domain, which can contain wildcards.",
+							shorthand: "d",
+						},
+					},
+					outputType: single,
+				},
+			},
+			transformedResponse: reflect.TypeOf(fqdncache.FlushResponse{}),
+		},
 	},
 	rawCommands: []rawCommand{
 		{
@@ -559,6 +654,18 @@ var CommandList = &commandList{
 			supportController: true,
 			commandGroup:      get,
 		},
+		{
+			cobraCommand:      egress.Command,
+			supportAgent:      true,
+			supportController: true,
+			commandGroup:      get,
+		},
+		{
+			cobraCommand:      externalippool.Command,
+			supportAgent:      false,
+			supportController: true,
+			commandGroup:      get,
+		},
 		{
 			cobraCommand:      multicluster.GetCmd,
 			supportAgent:      false,
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"net/url"
 	"time"
 
@@ -130,8 +131,12 @@ func (c *client) nonResourceRequest(e *nonResourceEndpoint, opt *requestOption)
 		q.Set(k, v)
 	}
 	u.RawQuery = q.Encode()
-	getter := restClient.Get().RequestURI(u.RequestURI()).Timeout(opt.timeout)
-	result, err := getter.DoRaw(context.TODO())
+	method := e.method
+	if method == "" {
+		method = http.MethodGet
+	}
+	request := restClient.Verb(method).RequestURI(u.RequestURI()).Timeout(opt.timeout)
+	result, err := request.DoRaw(context.TODO())
 	if err != nil {
 		statusErr, ok := err.(*errors.StatusError)
 		if !ok {
@@ -146,7 +146,10 @@ func getSortByFlag() flagInfo {
 }
 
 type nonResourceEndpoint struct {
-	path       string
+	path string
+	// method is the HTTP method used to request the endpoint. It defaults to
+	// GET when empty.
+	method     string
 	params     []flagInfo
 	outputType OutputType
 }
@@ -492,6 +495,79 @@ func (cd *commandDefinition) tableOutputForQueryEndpoint(obj interface{}, writer
 	return nil
 }
 
+// tableOutputForQueryPolicyEvaluation prints the verdict of a policy evaluation, followed by a
+// table with the evaluation of each direction and the notes of the evaluation.
+func (cd *commandDefinition) tableOutputForQueryPolicyEvaluation(obj interface{}, writer io.Writer) error {
+	response := obj.(*networkpolicy.PolicyEvaluationResponse)
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf("Source:      %s\n", response.Source))
+	buffer.WriteString(fmt.Sprintf("Destination: %s\n", response.Destination))
+	if response.Port != 0 {
+		buffer.WriteString(fmt.Sprintf("Protocol:    %s/%d\n", response.Protocol, response.Port))
+	} else {
+		buffer.WriteString(fmt.Sprintf("Protocol:    %s\n", response.Protocol))
+	}
+	buffer.WriteString(fmt.Sprintf("Verdict:     %s\n\n", response.Verdict))
+	if _, err := io.Copy(writer, &buffer); err != nil {
+		return fmt.Errorf("error when copy output into writer: %w", err)
+	}
+
+	policyString := func(policyType v1beta2.NetworkPolicyType, ref networkpolicy.PolicyRef) string {
+		name := ref.Name
+		if ref.Namespace != "" {
+			name = ref.Namespace + "/" + name
+		}
+		if policyType == "" {
+			return name
+		}
+		return string(policyType) + ":" + name
+	}
+	rows := [][]string{{"Direction", "Endpoint", "Action", "Reason", "Policy", "Tier", "Rule"}}
+	addRow := func(direction string, evaluation *networkpolicy.DirectionEvaluation) {
+		if evaluation == nil {
+			return
+		}
+		row := []string{direction, evaluation.Endpoint, string(evaluation.Action), string(evaluation.Reason), "", "", ""}
+		if rule := evaluation.Rule; rule != nil {
+			if rule.Action != evaluation.Action {
+				row[2] = fmt.Sprintf("%s (%s %s)", evaluation.Action, rule.EnforcementMode, rule.Action)
+			}
+			row[4] = policyString(rule.PolicyType, rule.PolicyRef)
+			row[5] = rule.Tier
+			row[6] = strconv.Itoa(rule.RuleIndex)
+			if rule.RuleName != "" {
+				row[6] += " (" + rule.RuleName + ")"
+			}
+		} else if len(evaluation.IsolatingPolicies) > 0 {
+			policies := make([]string, 0, len(evaluation.IsolatingPolicies))
+			for _, ref := range evaluation.IsolatingPolicies {
+				policies = append(policies, policyString(v1beta2.K8sNetworkPolicy, ref))
+			}
+			sort.Strings(policies)
+			row[4] = strings.Join(policies, ",")
+		}
+		rows = append(rows, row)
+	}
+	addRow("Egress", response.Egress)
+	addRow("Ingress", response.Ingress)
+	numRows, numCols := len(rows), len(rows[0])
+	widths := output.GetColumnWidths(numRows, numCols, rows)
+	if err := output.ConstructTable(numRows, numCols, widths, rows, writer); err != nil {
+		return err
+	}
+
+	if len(response.Notes) > 0 {
+		buffer.WriteString("\nNotes:\n")
+		for _, note := range response.Notes {
+			buffer.WriteString("- " + note + "\n")
+		}
+		if _, err := io.Copy(writer, &buffer); err != nil {
+			return fmt.Errorf("error when copy output into writer: %w", err)
+		}
+	}
+	return nil
+}
+
 // output reads bytes from the resp and outputs the data to the writer in desired
 // format. If the AddonTransform is set, it will use the function to transform
 // the data first. It will try to output the resp in the format ft specified after
@@ -536,6 +612,8 @@ func (cd *commandDefinition) output(resp io.Reader, writer io.Writer, ft formatt
 		} else if cd.commandGroup == query {
 			if cd.controllerEndpoint.nonResourceEndpoint.path == "/endpoint" {
 				return cd.tableOutputForQueryEndpoint(obj, writer)
+			} else if cd.controllerEndpoint.nonResourceEndpoint.path == "/policyevaluation" {
+				return cd.tableOutputForQueryPolicyEvaluation(obj, writer)
 			}
 		} else {
 			return output.TableOutput(obj, writer)
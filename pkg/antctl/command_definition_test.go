@@ -39,7 +39,9 @@ import (
 	"antrea.io/antrea/pkg/antctl/transform/controllerinfo"
 	"antrea.io/antrea/pkg/antctl/transform/networkpolicy"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
+	controllernetworkpolicy "antrea.io/antrea/pkg/controller/networkpolicy"
 )
 
 type Foobar struct {
@@ -398,6 +400,53 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+func TestTableOutputForQueryPolicyEvaluation(t *testing.T) {
+	response := &controllernetworkpolicy.PolicyEvaluationResponse{
+		Source:      "Pod ns1/pod1",
+		Destination: "Pod ns2/pod2",
+		Protocol:    "TCP",
+		Port:        80,
+		Verdict:     crdv1alpha1.RuleActionDrop,
+		Egress: &controllernetworkpolicy.DirectionEvaluation{
+			Endpoint: "Pod ns1/pod1",
+			Action:   crdv1alpha1.RuleActionAllow,
+			Reason:   controllernetworkpolicy.EvaluationReasonRuleMatched,
+			Rule: &controllernetworkpolicy.EvaluatedRule{
+				PolicyRef:       controllernetworkpolicy.PolicyRef{Name: "acnp1"},
+				PolicyType:      cpv1beta.AntreaClusterNetworkPolicy,
+				Tier:            "application",
+				Direction:       cpv1beta.DirectionOut,
+				RuleIndex:       1,
+				RuleName:        "drop-web",
+				Action:          crdv1alpha1.RuleActionDrop,
+				EnforcementMode: crdv1alpha1.EnforcementModeAudit,
+			},
+		},
+		Ingress: &controllernetworkpolicy.DirectionEvaluation{
+			Endpoint:          "Pod ns2/pod2",
+			Action:            crdv1alpha1.RuleActionDrop,
+			Reason:            controllernetworkpolicy.EvaluationReasonK8sIsolation,
+			IsolatingPolicies: []controllernetworkpolicy.PolicyRef{{Namespace: "ns2", Name: "np1"}},
+		},
+		Notes: []string{"note1"},
+	}
+	expected := "Source:      Pod ns1/pod1\n" +
+		"Destination: Pod ns2/pod2\n" +
+		"Protocol:    TCP/80\n" +
+		"Verdict:     Drop\n" +
+		"\n" +
+		"Direction Endpoint     Action             Reason       Policy                           Tier        Rule        \n" +
+		"Egress    Pod ns1/pod1 Allow (Audit Drop) RuleMatched  AntreaClusterNetworkPolicy:acnp1 application 1 (drop-web)\n" +
+		"Ingress   Pod ns2/pod2 Drop               K8sIsolation K8sNetworkPolicy:ns2/np1         <NONE>      <NONE>      \n" +
+		"\n" +
+		"Notes:\n" +
+		"- note1\n"
+	cd := &commandDefinition{}
+	var outputBuf bytes.Buffer
+	assert.NoError(t, cd.tableOutputForQueryPolicyEvaluation(response, &outputBuf))
+	assert.Equal(t, expected, outputBuf.String())
+}
+
 // TestCommandDefinitionGenerateExample checks example strings are generated as
 // expected.
 func TestCommandDefinitionGenerateExample(t *testing.T) {
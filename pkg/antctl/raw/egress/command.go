@@ -0,0 +1,97 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/antctl/transform/egress"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+)
+
+var Command *cobra.Command
+
+var option = &struct {
+	outputFormat string
+}{}
+
+var egressExample = strings.Trim(`
+  Get all Egresses
+  $ antctl get egress
+  Get the specified Egress
+  $ antctl get egress <NAME>
+  Get all Egresses and print them in JSON format
+  $ antctl get egress -o json
+`, "\n")
+
+func init() {
+	Command = &cobra.Command{
+		Use:     "egress",
+		Aliases: []string{"egresses", "eg"},
+		Short:   "Print Egress IPs and the Nodes owning them",
+		Long:    "Print the Egress IPs allocated to Egresses, the Nodes the IPs are assigned to, and the time of the last failover",
+		Example: egressExample,
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    runE,
+	}
+	Command.Flags().StringVarP(&option.outputFormat, "output", "o", "", "Output format. Supported formats: json|yaml")
+}
+
+func runE(cmd *cobra.Command, args []string) error {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return err
+	}
+	_, antreaClient, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	var egresses []crdv1alpha2.Egress
+	if len(args) > 0 {
+		egress, err := antreaClient.CrdV1alpha2().Egresses().Get(context.TODO(), args[0], metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		egresses = append(egresses, *egress)
+	} else {
+		egressList, err := antreaClient.CrdV1alpha2().Egresses().List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		egresses = egressList.Items
+	}
+	if len(egresses) == 0 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "No resources found")
+		return nil
+	}
+
+	switch option.outputFormat {
+	case "json":
+		return output.JsonOutput(egresses, os.Stdout)
+	case "yaml":
+		return output.YamlOutput(egresses, os.Stdout)
+	default:
+		return output.TableOutputForGetCommands(egress.Transform(egresses), os.Stdout)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalippool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"antrea.io/antrea/pkg/antctl/output"
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/antctl/runtime"
+	"antrea.io/antrea/pkg/antctl/transform/externalippool"
+	externalippoolhandler "antrea.io/antrea/pkg/apiserver/handlers/externalippool"
+)
+
+var Command *cobra.Command
+
+var option = &struct {
+	outputFormat string
+}{}
+
+var externalIPPoolExample = strings.Trim(`
+  Get all ExternalIPPools
+  $ antctl get externalippool
+  Get the specified ExternalIPPool
+  $ antctl get externalippool <NAME>
+  Get all ExternalIPPools and print them in JSON format
+  $ antctl get externalippool -o json
+`, "\n")
+
+func init() {
+	Command = &cobra.Command{
+		Use:     "externalippool",
+		Aliases: []string{"externalippools", "eip"},
+		Short:   "Print ExternalIPPool usage and IP allocations",
+		Long:    "Print the usage of ExternalIPPools and the Egresses and Services the allocated IPs are assigned to",
+		Example: externalIPPoolExample,
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    runE,
+	}
+	Command.Flags().StringVarP(&option.outputFormat, "output", "o", "", "Output format. Supported formats: json|yaml")
+}
+
+func runE(cmd *cobra.Command, args []string) error {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return err
+	}
+	kubeconfig.GroupVersion = &schema.GroupVersion{Group: "", Version: ""}
+	restconfigTmpl := rest.CopyConfig(kubeconfig)
+	raw.SetupKubeconfig(restconfigTmpl)
+
+	// The IP allocations are served by the antrea-controller, which is accessed directly when antctl is run
+	// out-of-cluster.
+	var client *rest.RESTClient
+	if runtime.InPod {
+		client, err = rest.RESTClientFor(restconfigTmpl)
+	} else {
+		client, err = getControllerClient(kubeconfig, restconfigTmpl)
+	}
+	if err != nil {
+		return err
+	}
+
+	u := url.URL{Path: "/externalippools"}
+	if len(args) > 0 {
+		q := u.Query()
+		q.Set("name", args[0])
+		u.RawQuery = q.Encode()
+	}
+	rawResp, err := client.Get().RequestURI(u.RequestURI()).DoRaw(context.TODO())
+	if err != nil {
+		return fmt.Errorf("error when requesting ExternalIPPools: %w", err)
+	}
+	var pools []externalippoolhandler.Response
+	if err := json.Unmarshal(rawResp, &pools); err != nil {
+		return fmt.Errorf("failed to unmarshal ExternalIPPools: %w", err)
+	}
+	if len(pools) == 0 {
+		fmt.Fprintln(cmd.ErrOrStderr(), "No resources found")
+		return nil
+	}
+
+	switch option.outputFormat {
+	case "json":
+		return output.JsonOutput(pools, os.Stdout)
+	case "yaml":
+		return output.YamlOutput(pools, os.Stdout)
+	default:
+		return output.TableOutputForGetCommands(externalippool.Transform(pools), os.Stdout)
+	}
+}
+
+func getControllerClient(kubeconfig, cfgTmpl *rest.Config) (*rest.RESTClient, error) {
+	k8sClientset, antreaClientset, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	controllerClientCfg, err := raw.CreateControllerClientCfg(k8sClientset, antreaClientset, cfgTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating controller client config: %w", err)
+	}
+	controllerClient, err := rest.RESTClientFor(controllerClientCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating controller client: %w", err)
+	}
+	return controllerClient, nil
+}
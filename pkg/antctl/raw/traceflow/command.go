@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +37,7 @@ import (
 
 	"antrea.io/antrea/pkg/antctl/raw"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/graphviz"
 )
 
 const defaultTimeout time.Duration = time.Second * 10
@@ -44,11 +46,14 @@ var (
 	Command *cobra.Command
 	option  = &struct {
 		source      string
+		sourceNode  string
 		destination string
 		outputType  string
+		dotFile     string
 		flow        string
 		liveTraffic bool
 		droppedOnly bool
+		sampleCount int32
 		timeout     time.Duration
 		nowait      bool
 	}{}
@@ -71,13 +76,21 @@ type CapturedPacket struct {
 
 // Response is the response of antctl Traceflow.
 type Response struct {
-	Name           string                  `json:"name" yaml:"name"`                                         // Traceflow name
-	Phase          v1alpha1.TraceflowPhase `json:"phase,omitempty" yaml:"phase,omitempty"`                   // Traceflow phase
-	Reason         string                  `json:"reason,omitempty" yaml:"reason,omitempty"`                 // Traceflow phase reason
-	Source         string                  `json:"source,omitempty" yaml:"source,omitempty"`                 // Traceflow source, e.g. "default/pod0"
-	Destination    string                  `json:"destination,omitempty" yaml:"destination,omitempty"`       // Traceflow destination, e.g. "default/pod1"
-	NodeResults    []v1alpha1.NodeResult   `json:"results,omitempty" yaml:"results,omitempty"`               // Traceflow node results
-	CapturedPacket *CapturedPacket         `json:"capturedPacket,omitempty" yaml:"capturedPacket,omitempty"` // Captured packet in live-traffic Traceflow
+	Name           string                          `json:"name" yaml:"name"`                                         // Traceflow name
+	Phase          v1alpha1.TraceflowPhase         `json:"phase,omitempty" yaml:"phase,omitempty"`                   // Traceflow phase
+	Reason         string                          `json:"reason,omitempty" yaml:"reason,omitempty"`                 // Traceflow phase reason
+	Source         string                          `json:"source,omitempty" yaml:"source,omitempty"`                 // Traceflow source, e.g. "default/pod0"
+	Destination    string                          `json:"destination,omitempty" yaml:"destination,omitempty"`       // Traceflow destination, e.g. "default/pod1"
+	NodeResults    []v1alpha1.NodeResult           `json:"results,omitempty" yaml:"results,omitempty"`               // Traceflow node results
+	CapturedPacket *CapturedPacket                 `json:"capturedPacket,omitempty" yaml:"capturedPacket,omitempty"` // Captured packet in live-traffic Traceflow
+	Samples        []Sample                        `json:"samples,omitempty" yaml:"samples,omitempty"`               // Sampled packets in live-traffic Traceflow
+	PacketCounts   *v1alpha1.TraceflowPacketCounts `json:"packetCounts,omitempty" yaml:"packetCounts,omitempty"`     // Aggregated results of the sampled packets
+}
+
+// Sample is a packet sampled in live-traffic Traceflow.
+type Sample struct {
+	CapturedPacket *CapturedPacket       `json:"capturedPacket,omitempty" yaml:"capturedPacket,omitempty"`
+	NodeResults    []v1alpha1.NodeResult `json:"results,omitempty" yaml:"results,omitempty"`
 }
 
 func init() {
@@ -98,17 +111,28 @@ func init() {
   $antctl traceflow -S pod1 -D svc1 -f tcp --live-traffic -t 1m
   Start a Traceflow to capture the first dropped TCP packet to pod1 on port 80, within 10 minutes
   $antctl traceflow -D pod1 -f tcp,tcp_dst=80 --live-traffic --dropped-only -t 10m
+  Start a Traceflow from external client 10.0.0.1 entering from node1 to NodePort or LoadBalancer Service svc1 on port 80
+  $antctl traceflow -S 10.0.0.1 --source-node node1 -D svc1 -f tcp,tcp_dst=80
+  Start a Traceflow to sample up to 20 TCP connections from pod1 to svc1, within 5 minutes
+  $antctl traceflow -S pod1 -D svc1 -f tcp --live-traffic --sample-count 20 -t 5m
+  Start a Traceflow from pod1 to pod2, show the hops as a diagram and save the result as a DOT file
+  $antctl traceflow -S pod1 -D pod2 -o graph --dot-file tf.dot
+  Start a Traceflow from pod1 to pod2 and render the result as an SVG image with Graphviz
+  $antctl traceflow -S pod1 -D pod2 -o dot | dot -Tsvg > tf.svg
 `,
 		RunE: runE,
 		Args: cobra.NoArgs,
 	}
 
 	Command.Flags().StringVarP(&option.source, "source", "S", "", "source of the Traceflow: Namespace/Pod, Pod, or IP")
+	Command.Flags().StringVarP(&option.sourceNode, "source-node", "", "", "the Node from which the packet of an external source IP enters the cluster, required when the source is an IP in a regular Traceflow")
 	Command.Flags().StringVarP(&option.destination, "destination", "D", "", "destination of the Traceflow: Namespace/Pod, Pod, Namespace/Service, Service or IP")
-	Command.Flags().StringVarP(&option.outputType, "output", "o", "yaml", "output type: yaml (default), json")
+	Command.Flags().StringVarP(&option.outputType, "output", "o", "yaml", "output type: yaml (default), json, graph (diagram of the hops in the terminal) or dot (Graphviz DOT language)")
+	Command.Flags().StringVarP(&option.dotFile, "dot-file", "", "", "if set, also write the result in Graphviz DOT language to the file, which can be rendered with e.g. \"dot -Tsvg\"")
 	Command.Flags().StringVarP(&option.flow, "flow", "f", "", "specify the flow (packet headers) of the Traceflow packet, including tcp_src, tcp_dst, tcp_flags, udp_src, udp_dst, ipv6")
 	Command.Flags().BoolVarP(&option.liveTraffic, "live-traffic", "L", false, "if set, the Traceflow will trace the first packet of the matched live traffic flow")
 	Command.Flags().BoolVarP(&option.droppedOnly, "dropped-only", "", false, "if set, capture only the dropped packet in a live-traffic Traceflow")
+	Command.Flags().Int32VarP(&option.sampleCount, "sample-count", "", 1, "maximum number of packets to capture in a live-traffic Traceflow, each from a different connection")
 	Command.Flags().BoolVarP(&option.nowait, "nowait", "", false, "if set, command returns without retrieving results")
 }
 
@@ -137,11 +161,26 @@ func runE(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
+	if option.liveTraffic && option.sourceNode != "" {
+		fmt.Println("--source-node does not work with live-traffic Traceflow")
+		return nil
+	}
+
 	if !option.liveTraffic && option.droppedOnly {
 		fmt.Println("--dropped-only works only with live-traffic Traceflow")
 		return nil
 	}
 
+	if option.sampleCount < 1 || option.sampleCount > 100 {
+		fmt.Println("--sample-count must be between 1 and 100")
+		return nil
+	}
+
+	if !option.liveTraffic && option.sampleCount > 1 {
+		fmt.Println("--sample-count works only with live-traffic Traceflow")
+		return nil
+	}
+
 	kubeconfig, err := raw.ResolveKubeconfig(cmd)
 	if err != nil {
 		return err
@@ -208,10 +247,11 @@ func newTraceflow(client kubernetes.Interface) (*v1alpha1.Traceflow, error) {
 	if option.source != "" {
 		srcIP := net.ParseIP(option.source)
 		if srcIP != nil {
-			if !option.liveTraffic {
-				return nil, errors.New("source must be a Pod if not a live-traffic Traceflow")
+			if !option.liveTraffic && option.sourceNode == "" {
+				return nil, errors.New("source must be a Pod, or an IP with --source-node, if not a live-traffic Traceflow")
 			}
 			src.IP = srcIP.String()
+			src.Node = option.sourceNode
 			srcName = src.IP
 		} else {
 			split := strings.Split(option.source, "/")
@@ -266,7 +306,7 @@ func newTraceflow(client kubernetes.Interface) (*v1alpha1.Traceflow, error) {
 		dstName = "any"
 	}
 
-	if src.Pod == "" && dst.Pod == "" {
+	if src.Node == "" && src.Pod == "" && dst.Pod == "" {
 		return nil, errors.New("one of source and destination must be a Pod")
 	}
 
@@ -286,6 +326,7 @@ func newTraceflow(client kubernetes.Interface) (*v1alpha1.Traceflow, error) {
 			Packet:      *pkt,
 			LiveTraffic: option.liveTraffic,
 			DroppedOnly: option.droppedOnly,
+			SampleCount: option.sampleCount,
 			Timeout:     uint16(option.timeout.Seconds()),
 		},
 	}
@@ -389,6 +430,9 @@ func output(tf *v1alpha1.Traceflow) error {
 		Source:      fmt.Sprintf("%s/%s", tf.Spec.Source.Namespace, tf.Spec.Source.Pod),
 		NodeResults: tf.Status.Results,
 	}
+	if len(tf.Spec.Source.IP) > 0 {
+		r.Source = tf.Spec.Source.IP
+	}
 	if len(tf.Spec.Destination.IP) > 0 {
 		r.Destination = tf.Spec.Destination.IP
 	} else if len(tf.Spec.Destination.Pod) != 0 {
@@ -397,14 +441,20 @@ func output(tf *v1alpha1.Traceflow) error {
 		r.Destination = fmt.Sprintf("%s/%s", tf.Spec.Destination.Namespace, tf.Spec.Destination.Service)
 	}
 
-	pkt := tf.Status.CapturedPacket
-	if pkt != nil {
-		r.CapturedPacket = &CapturedPacket{SrcIP: pkt.SrcIP, DstIP: pkt.DstIP, Length: pkt.Length, IPv6Header: pkt.IPv6Header}
-		if pkt.IPv6Header == nil {
-			r.CapturedPacket.IPHeader = &pkt.IPHeader
-		}
-		if pkt.TransportHeader.TCP != nil || pkt.TransportHeader.UDP != nil || pkt.TransportHeader.ICMP != nil {
-			r.CapturedPacket.TransportHeader = &pkt.TransportHeader
+	if tf.Status.CapturedPacket != nil {
+		r.CapturedPacket = newCapturedPacket(tf.Status.CapturedPacket)
+	}
+	for i := range tf.Status.Samples {
+		r.Samples = append(r.Samples, Sample{
+			CapturedPacket: newCapturedPacket(&tf.Status.Samples[i].Packet),
+			NodeResults:    tf.Status.Samples[i].Results,
+		})
+	}
+	r.PacketCounts = tf.Status.PacketCounts
+
+	if option.dotFile != "" {
+		if err := writeDOTFile(tf, option.dotFile); err != nil {
+			return fmt.Errorf("error when writing DOT file %s: %w", option.dotFile, err)
 		}
 	}
 
@@ -416,12 +466,31 @@ func output(tf *v1alpha1.Traceflow) error {
 		if err := yamlOutput(&r); err != nil {
 			return fmt.Errorf("error when converting output to yaml: %w", err)
 		}
+	} else if option.outputType == "graph" {
+		graphOutput(os.Stdout, tf)
+	} else if option.outputType == "dot" {
+		dot, err := graphviz.GenGraph(tf)
+		if err != nil {
+			return fmt.Errorf("error when converting output to dot: %w", err)
+		}
+		fmt.Println(dot)
 	} else {
-		return fmt.Errorf("output types should be yaml or json")
+		return fmt.Errorf("output types should be yaml, json, graph or dot")
 	}
 	return nil
 }
 
+func newCapturedPacket(pkt *v1alpha1.Packet) *CapturedPacket {
+	capturedPacket := &CapturedPacket{SrcIP: pkt.SrcIP, DstIP: pkt.DstIP, Length: pkt.Length, IPv6Header: pkt.IPv6Header}
+	if pkt.IPv6Header == nil {
+		capturedPacket.IPHeader = &pkt.IPHeader
+	}
+	if pkt.TransportHeader.TCP != nil || pkt.TransportHeader.UDP != nil || pkt.TransportHeader.ICMP != nil {
+		capturedPacket.TransportHeader = &pkt.TransportHeader
+	}
+	return capturedPacket
+}
+
 func yamlOutput(r *Response) error {
 	o, err := yaml.Marshal(&r)
 	if err != nil {
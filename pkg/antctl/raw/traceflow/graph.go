@@ -0,0 +1,263 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/graphviz"
+)
+
+const (
+	nodeRoleSender   = "sender"
+	nodeRoleReceiver = "receiver"
+
+	graphIndent    = "  "
+	graphBoxBorder = "+------------------------------------------------------------"
+)
+
+// graphOutput writes the hop chain of the Traceflow as a terminal diagram: the
+// source, one box per Node with the observations on that Node, the transitions
+// between Nodes, and the destination or the point where the packet was dropped.
+func graphOutput(w io.Writer, tf *v1alpha1.Traceflow) {
+	fmt.Fprintf(w, "Traceflow %s: %s", tf.Name, tf.Status.Phase)
+	if tf.Status.Reason != "" {
+		fmt.Fprintf(w, " (%s)", tf.Status.Reason)
+	}
+	fmt.Fprintln(w)
+	if len(tf.Status.Samples) == 0 {
+		fmt.Fprintln(w)
+		writeHops(w, tf, tf.Status.CapturedPacket, tf.Status.Results)
+		return
+	}
+	for i := range tf.Status.Samples {
+		sample := &tf.Status.Samples[i]
+		fmt.Fprintf(w, "\nSample %d:\n", i+1)
+		writeHops(w, tf, &sample.Packet, sample.Results)
+	}
+}
+
+func writeHops(w io.Writer, tf *v1alpha1.Traceflow, pkt *v1alpha1.Packet, results []v1alpha1.NodeResult) {
+	fmt.Fprintf(w, "%s%s\n", graphIndent, getSourceLabel(tf, pkt))
+	if len(results) == 0 {
+		fmt.Fprintf(w, "%s    |\n%s    ? no observation reported\n", graphIndent, graphIndent)
+		return
+	}
+	var last *v1alpha1.Observation
+	for i, result := range sortNodeResults(results) {
+		writeTransition(w, last, i == 0)
+		writeNodeResult(w, &result)
+		if len(result.Observations) > 0 {
+			last = &result.Observations[len(result.Observations)-1]
+		} else {
+			last = nil
+		}
+	}
+	if last != nil && (last.Action == v1alpha1.ActionDropped || last.Action == v1alpha1.ActionRejected) {
+		fmt.Fprintf(w, "%s    |\n%s    X %s\n", graphIndent, graphIndent, last.Action)
+		return
+	}
+	fmt.Fprintf(w, "%s    |\n", graphIndent)
+	if label := getTransitionLabel(last); label != "" {
+		fmt.Fprintf(w, "%s    | %s\n", graphIndent, label)
+	}
+	fmt.Fprintf(w, "%s    v\n%s%s\n", graphIndent, graphIndent, getDestinationLabel(tf, pkt, last))
+}
+
+func writeTransition(w io.Writer, last *v1alpha1.Observation, first bool) {
+	fmt.Fprintf(w, "%s    |\n", graphIndent)
+	if !first {
+		if label := getTransitionLabel(last); label != "" {
+			fmt.Fprintf(w, "%s    | %s\n", graphIndent, label)
+		}
+	}
+	fmt.Fprintf(w, "%s    v\n", graphIndent)
+}
+
+func writeNodeResult(w io.Writer, result *v1alpha1.NodeResult) {
+	header := "Node " + result.Node
+	if result.ClusterID != "" {
+		header += " @ cluster " + result.ClusterID
+	}
+	if role := getNodeRole(result); role != "" {
+		header += " (" + role + ")"
+	}
+	fmt.Fprintf(w, "%s%s\n", graphIndent, graphBoxBorder)
+	fmt.Fprintf(w, "%s| %s\n", graphIndent, header)
+	for i := range result.Observations {
+		fmt.Fprintf(w, "%s|   %s\n", graphIndent, getObservationLabel(&result.Observations[i]))
+	}
+	fmt.Fprintf(w, "%s%s\n", graphIndent, graphBoxBorder)
+}
+
+// getNodeRole returns the role of a Node in the Traceflow. The agents don't set
+// the role of their results, so it's derived from the observations like in the
+// graphviz package: the sender Node's first observation is SpoofGuard, and the
+// receiver Node receives the packet from another Node or delivers it to the
+// destination Pod.
+func getNodeRole(result *v1alpha1.NodeResult) string {
+	if result.Role != "" {
+		return result.Role
+	}
+	if len(result.Observations) == 0 {
+		return ""
+	}
+	first := &result.Observations[0]
+	if first.Component == v1alpha1.ComponentSpoofGuard {
+		return nodeRoleSender
+	}
+	if first.Component == v1alpha1.ComponentForwarding && first.Action == v1alpha1.ActionReceived {
+		return nodeRoleReceiver
+	}
+	for i := range result.Observations {
+		if result.Observations[i].Action == v1alpha1.ActionDelivered {
+			return nodeRoleReceiver
+		}
+	}
+	return ""
+}
+
+// sortNodeResults returns the results ordered along the packet path, with the
+// sender Node first and the receiver Node last. The order of the other results,
+// e.g. the multi-cluster Gateway and the peer cluster Nodes, is preserved.
+func sortNodeResults(results []v1alpha1.NodeResult) []v1alpha1.NodeResult {
+	sorted := make([]v1alpha1.NodeResult, len(results))
+	copy(sorted, results)
+	localClusterID := results[0].ClusterID
+	rank := func(r *v1alpha1.NodeResult) int {
+		// Results from a peer member cluster are always after the local ones.
+		if r.ClusterID != localClusterID {
+			return 3
+		}
+		switch getNodeRole(r) {
+		case nodeRoleSender:
+			return 0
+		case nodeRoleReceiver:
+			return 2
+		default:
+			return 1
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return rank(&sorted[i]) < rank(&sorted[j])
+	})
+	return sorted
+}
+
+func getObservationLabel(o *v1alpha1.Observation) string {
+	label := string(o.Component)
+	if o.ComponentInfo != "" {
+		label += " [" + o.ComponentInfo + "]"
+	}
+	label += ": " + string(o.Action)
+	var details []string
+	if o.NetworkPolicy != "" {
+		details = append(details, "NetworkPolicy "+o.NetworkPolicy)
+	}
+	if o.Pod != "" {
+		details = append(details, "Pod "+o.Pod)
+	}
+	if o.Action != v1alpha1.ActionDropped {
+		if o.TranslatedSrcIP != "" {
+			details = append(details, "translated source IP "+o.TranslatedSrcIP)
+		}
+		if o.TranslatedDstIP != "" {
+			details = append(details, "translated destination IP "+o.TranslatedDstIP)
+		}
+		if o.TunnelDstIP != "" {
+			details = append(details, "tunnel destination IP "+o.TunnelDstIP)
+		}
+	}
+	if o.Egress != "" {
+		details = append(details, "Egress "+o.Egress)
+	}
+	if o.EgressNode != "" {
+		details = append(details, "Egress Node "+o.EgressNode)
+	}
+	if len(details) > 0 {
+		label += " (" + strings.Join(details, ", ") + ")"
+	}
+	return label
+}
+
+// getTransitionLabel describes how the packet leaves a Node after the given
+// observation, which is the last one on that Node.
+func getTransitionLabel(last *v1alpha1.Observation) string {
+	if last == nil {
+		return ""
+	}
+	switch last.Action {
+	case v1alpha1.ActionForwardedToRemoteCluster:
+		return fmt.Sprintf("to cluster %s via Gateway %s", last.ComponentInfo, last.TunnelDstIP)
+	case v1alpha1.ActionForwardedToEgressNode:
+		return fmt.Sprintf("to Egress Node %s via tunnel %s", last.EgressNode, last.TunnelDstIP)
+	case v1alpha1.ActionForwardedOutOfOverlay:
+		return "out of overlay"
+	case v1alpha1.ActionMarkedForSNAT:
+		return "SNAT'd out of the Node"
+	}
+	if last.TunnelDstIP != "" {
+		return "tunnel to " + last.TunnelDstIP
+	}
+	return ""
+}
+
+func getSourceLabel(tf *v1alpha1.Traceflow, pkt *v1alpha1.Packet) string {
+	if tf.Spec.Source.Pod != "" {
+		return "Pod " + tf.Spec.Source.Namespace + "/" + tf.Spec.Source.Pod
+	}
+	if tf.Spec.Source.IP != "" {
+		return "IP " + tf.Spec.Source.IP
+	}
+	if pkt != nil && pkt.SrcIP != "" {
+		return "IP " + pkt.SrcIP
+	}
+	return "unknown source"
+}
+
+func getDestinationLabel(tf *v1alpha1.Traceflow, pkt *v1alpha1.Packet, last *v1alpha1.Observation) string {
+	if last != nil && last.Action == v1alpha1.ActionDelivered && last.Pod != "" {
+		return "Pod " + last.Pod
+	}
+	if tf.Spec.Destination.Pod != "" {
+		return "Pod " + tf.Spec.Destination.Namespace + "/" + tf.Spec.Destination.Pod
+	}
+	if tf.Spec.Destination.Service != "" {
+		return "Service " + tf.Spec.Destination.Namespace + "/" + tf.Spec.Destination.Service
+	}
+	if tf.Spec.Destination.IP != "" {
+		return "IP " + tf.Spec.Destination.IP
+	}
+	if pkt != nil && pkt.DstIP != "" {
+		return "IP " + pkt.DstIP
+	}
+	return "unknown destination"
+}
+
+// writeDOTFile writes the Traceflow in DOT language to the given file, which
+// can be rendered with the Graphviz tools, e.g. "dot -Tsvg". For a sampling
+// Traceflow, only the first sampled packet is rendered.
+func writeDOTFile(tf *v1alpha1.Traceflow, path string) error {
+	dot, err := graphviz.GenGraph(tf)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(dot), 0644)
+}
@@ -0,0 +1,331 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceflow
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func TestGraphOutput(t *testing.T) {
+	tcs := []struct {
+		name     string
+		tf       *v1alpha1.Traceflow
+		expected string
+	}{
+		{
+			name: "inter-Node delivered",
+			tf: &v1alpha1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+				Spec: v1alpha1.TraceflowSpec{
+					Source:      v1alpha1.Source{Namespace: "default", Pod: "pod1"},
+					Destination: v1alpha1.Destination{Namespace: "default", Pod: "pod2"},
+				},
+				Status: v1alpha1.TraceflowStatus{
+					Phase: v1alpha1.Succeeded,
+					Results: []v1alpha1.NodeResult{
+						{
+							Node: "node-2",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionReceived},
+								{Component: v1alpha1.ComponentNetworkPolicy, ComponentInfo: "IngressRule", Action: v1alpha1.ActionForwarded, NetworkPolicy: "K8sNetworkPolicy:default/allow"},
+								{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionDelivered, Pod: "default/pod2"},
+							},
+						},
+						{
+							Node: "node-1",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentSpoofGuard, Action: v1alpha1.ActionForwarded},
+								{Component: v1alpha1.ComponentForwarding, ComponentInfo: "Output", Action: v1alpha1.ActionForwarded, TunnelDstIP: "192.168.1.2"},
+							},
+						},
+					},
+				},
+			},
+			expected: `Traceflow tf1: Succeeded
+
+  Pod default/pod1
+      |
+      v
+  +------------------------------------------------------------
+  | Node node-1 (sender)
+  |   SpoofGuard: Forwarded
+  |   Forwarding [Output]: Forwarded (tunnel destination IP 192.168.1.2)
+  +------------------------------------------------------------
+      |
+      | tunnel to 192.168.1.2
+      v
+  +------------------------------------------------------------
+  | Node node-2 (receiver)
+  |   Forwarding: Received
+  |   NetworkPolicy [IngressRule]: Forwarded (NetworkPolicy K8sNetworkPolicy:default/allow)
+  |   Forwarding: Delivered (Pod default/pod2)
+  +------------------------------------------------------------
+      |
+      v
+  Pod default/pod2
+`,
+		},
+		{
+			name: "dropped",
+			tf: &v1alpha1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf2"},
+				Spec: v1alpha1.TraceflowSpec{
+					Source:      v1alpha1.Source{Namespace: "default", Pod: "pod1"},
+					Destination: v1alpha1.Destination{IP: "10.0.0.1"},
+				},
+				Status: v1alpha1.TraceflowStatus{
+					Phase: v1alpha1.Succeeded,
+					Results: []v1alpha1.NodeResult{{
+						Node: "node-1",
+						Observations: []v1alpha1.Observation{
+							{Component: v1alpha1.ComponentSpoofGuard, Action: v1alpha1.ActionForwarded},
+							{Component: v1alpha1.ComponentNetworkPolicy, ComponentInfo: "EgressMetric", Action: v1alpha1.ActionDropped, NetworkPolicy: "AntreaClusterNetworkPolicy:deny"},
+						},
+					}},
+				},
+			},
+			expected: `Traceflow tf2: Succeeded
+
+  Pod default/pod1
+      |
+      v
+  +------------------------------------------------------------
+  | Node node-1 (sender)
+  |   SpoofGuard: Forwarded
+  |   NetworkPolicy [EgressMetric]: Dropped (NetworkPolicy AntreaClusterNetworkPolicy:deny)
+  +------------------------------------------------------------
+      |
+      X Dropped
+`,
+		},
+		{
+			name: "multi-cluster",
+			tf: &v1alpha1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf3"},
+				Spec: v1alpha1.TraceflowSpec{
+					Source:      v1alpha1.Source{Namespace: "default", Pod: "pod1"},
+					Destination: v1alpha1.Destination{Namespace: "default", Service: "antrea-mc-nginx"},
+				},
+				Status: v1alpha1.TraceflowStatus{
+					Phase: v1alpha1.Succeeded,
+					Results: []v1alpha1.NodeResult{
+						{
+							Node:      "node-1",
+							ClusterID: "cluster-a",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentSpoofGuard, Action: v1alpha1.ActionForwarded},
+							},
+						},
+						{
+							Node:      "node-3",
+							ClusterID: "cluster-b",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionDelivered, Pod: "default/nginx"},
+							},
+						},
+						{
+							Node:      "gw-1",
+							ClusterID: "cluster-a",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentMulticlusterGateway, ComponentInfo: "cluster-b", Action: v1alpha1.ActionForwardedToRemoteCluster, TunnelDstIP: "10.20.20.20"},
+							},
+						},
+					},
+				},
+			},
+			expected: `Traceflow tf3: Succeeded
+
+  Pod default/pod1
+      |
+      v
+  +------------------------------------------------------------
+  | Node node-1 @ cluster cluster-a (sender)
+  |   SpoofGuard: Forwarded
+  +------------------------------------------------------------
+      |
+      v
+  +------------------------------------------------------------
+  | Node gw-1 @ cluster cluster-a
+  |   MulticlusterGateway [cluster-b]: ForwardedToRemoteCluster (tunnel destination IP 10.20.20.20)
+  +------------------------------------------------------------
+      |
+      | to cluster cluster-b via Gateway 10.20.20.20
+      v
+  +------------------------------------------------------------
+  | Node node-3 @ cluster cluster-b (receiver)
+  |   Forwarding: Delivered (Pod default/nginx)
+  +------------------------------------------------------------
+      |
+      v
+  Pod default/nginx
+`,
+		},
+		{
+			name: "live-traffic sampling",
+			tf: &v1alpha1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf4"},
+				Spec: v1alpha1.TraceflowSpec{
+					Source:      v1alpha1.Source{IP: "10.10.1.1"},
+					Destination: v1alpha1.Destination{Namespace: "default", Pod: "pod2"},
+					LiveTraffic: true,
+					SampleCount: 2,
+				},
+				Status: v1alpha1.TraceflowStatus{
+					Phase: v1alpha1.Succeeded,
+					Samples: []v1alpha1.TraceflowSample{
+						{
+							Packet: v1alpha1.Packet{SrcIP: "10.10.1.1", DstIP: "10.10.0.2"},
+							Results: []v1alpha1.NodeResult{{
+								Node: "node-2",
+								Observations: []v1alpha1.Observation{
+									{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionReceived},
+									{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionDelivered, Pod: "default/pod2"},
+								},
+							}},
+						},
+						{
+							Packet: v1alpha1.Packet{SrcIP: "10.10.1.1", DstIP: "10.10.0.2"},
+							Results: []v1alpha1.NodeResult{{
+								Node: "node-2",
+								Observations: []v1alpha1.Observation{
+									{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionReceived},
+									{Component: v1alpha1.ComponentNetworkPolicy, ComponentInfo: "IngressMetric", Action: v1alpha1.ActionDropped, NetworkPolicy: "K8sNetworkPolicy:default/deny"},
+								},
+							}},
+						},
+					},
+				},
+			},
+			expected: `Traceflow tf4: Succeeded
+
+Sample 1:
+  IP 10.10.1.1
+      |
+      v
+  +------------------------------------------------------------
+  | Node node-2 (receiver)
+  |   Forwarding: Received
+  |   Forwarding: Delivered (Pod default/pod2)
+  +------------------------------------------------------------
+      |
+      v
+  Pod default/pod2
+
+Sample 2:
+  IP 10.10.1.1
+      |
+      v
+  +------------------------------------------------------------
+  | Node node-2 (receiver)
+  |   Forwarding: Received
+  |   NetworkPolicy [IngressMetric]: Dropped (NetworkPolicy K8sNetworkPolicy:default/deny)
+  +------------------------------------------------------------
+      |
+      X Dropped
+`,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			graphOutput(&b, tc.tf)
+			assert.Equal(t, tc.expected, b.String())
+		})
+	}
+}
+
+func TestWriteDOTFile(t *testing.T) {
+	tf := &v1alpha1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+		Spec: v1alpha1.TraceflowSpec{
+			Source:      v1alpha1.Source{Namespace: "default", Pod: "pod1"},
+			Destination: v1alpha1.Destination{Namespace: "default", Pod: "pod2"},
+		},
+		Status: v1alpha1.TraceflowStatus{
+			Phase: v1alpha1.Succeeded,
+			Results: []v1alpha1.NodeResult{{
+				Node: "node-1",
+				Observations: []v1alpha1.Observation{
+					{Component: v1alpha1.ComponentSpoofGuard, Action: v1alpha1.ActionForwarded},
+					{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionDelivered, Pod: "default/pod2"},
+				},
+			}},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "tf1.dot")
+	require.NoError(t, writeDOTFile(tf, path))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "digraph"))
+	assert.Contains(t, string(content), "default/pod1")
+}
+
+func TestWriteDOTFileSampling(t *testing.T) {
+	tf := &v1alpha1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+		Spec: v1alpha1.TraceflowSpec{
+			Source:      v1alpha1.Source{Namespace: "default", Pod: "pod1"},
+			Destination: v1alpha1.Destination{Namespace: "default", Pod: "pod2"},
+			LiveTraffic: true,
+			SampleCount: 2,
+		},
+		Status: v1alpha1.TraceflowStatus{
+			Phase: v1alpha1.Succeeded,
+			Samples: []v1alpha1.TraceflowSample{
+				{
+					Packet: v1alpha1.Packet{SrcIP: "10.10.0.1", DstIP: "10.10.1.2", Length: 84},
+					Results: []v1alpha1.NodeResult{
+						{
+							Node: "node-1",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentSpoofGuard, Action: v1alpha1.ActionForwarded},
+								{Component: v1alpha1.ComponentForwarding, ComponentInfo: "Output", Action: v1alpha1.ActionForwarded, TunnelDstIP: "192.168.1.2"},
+							},
+						},
+						{
+							Node: "node-2",
+							Observations: []v1alpha1.Observation{
+								{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionReceived},
+								{Component: v1alpha1.ComponentForwarding, Action: v1alpha1.ActionDelivered, Pod: "default/pod2"},
+							},
+						},
+					},
+				},
+				{
+					Packet: v1alpha1.Packet{SrcIP: "10.10.0.1", DstIP: "10.10.1.2", Length: 84},
+				},
+			},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "tf1.dot")
+	require.NoError(t, writeDOTFile(tf, path))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "digraph"))
+	assert.Contains(t, string(content), "tf1 (sample 1 of 2)")
+	assert.Contains(t, string(content), "node-2")
+	assert.Contains(t, string(content), "Source IP: 10.10.0.1")
+}
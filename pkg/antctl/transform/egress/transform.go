@@ -0,0 +1,84 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"time"
+
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+)
+
+// reasonFailover is the reason set by antrea-agent in the Assigned condition
+// when an Egress IP is taken over from another Node.
+const reasonFailover = "Failover"
+
+type Response struct {
+	Name         string `json:"name" yaml:"name"`
+	EgressIP     string `json:"egressIP" yaml:"egressIP"`
+	EgressNode   string `json:"egressNode" yaml:"egressNode"`
+	LastFailover string `json:"lastFailover,omitempty" yaml:"lastFailover,omitempty"`
+}
+
+// Transform converts the provided Egresses to table rows, one row per Egress
+// IP.
+func Transform(egresses []crdv1alpha2.Egress) []Response {
+	var result []Response
+	for i := range egresses {
+		egress := &egresses[i]
+		lastFailover := lastFailoverTime(egress)
+		if len(egress.Status.EgressIPs) > 0 {
+			for _, ipStatus := range egress.Status.EgressIPs {
+				result = append(result, Response{
+					Name:         egress.Name,
+					EgressIP:     ipStatus.EgressIP,
+					EgressNode:   ipStatus.EgressNode,
+					LastFailover: lastFailover,
+				})
+			}
+			continue
+		}
+		result = append(result, Response{
+			Name:         egress.Name,
+			EgressIP:     egress.Spec.EgressIP,
+			EgressNode:   egress.Status.EgressNode,
+			LastFailover: lastFailover,
+		})
+	}
+	return result
+}
+
+func lastFailoverTime(egress *crdv1alpha2.Egress) string {
+	for _, condition := range egress.Status.Conditions {
+		if condition.Type == crdv1alpha2.EgressIPAssigned && condition.Reason == reasonFailover {
+			return condition.LastTransitionTime.UTC().Format(time.RFC3339)
+		}
+	}
+	return ""
+}
+
+var _ common.TableOutput = new(Response)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"NAME", "EGRESS-IP", "NODE", "LAST-FAILOVER"}
+}
+
+func (r Response) GetTableRow(maxColumnLength int) []string {
+	return []string{r.Name, r.EgressIP, r.EgressNode, r.LastFailover}
+}
+
+func (r Response) SortRows() bool {
+	return true
+}
@@ -0,0 +1,75 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalippool
+
+import (
+	"strconv"
+
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	externalippoolhandler "antrea.io/antrea/pkg/apiserver/handlers/externalippool"
+)
+
+type Response struct {
+	Name              string `json:"name" yaml:"name"`
+	Total             int    `json:"total" yaml:"total"`
+	Used              int    `json:"used" yaml:"used"`
+	IP                string `json:"ip,omitempty" yaml:"ip,omitempty"`
+	ConsumerKind      string `json:"consumerKind,omitempty" yaml:"consumerKind,omitempty"`
+	ConsumerName      string `json:"consumerName,omitempty" yaml:"consumerName,omitempty"`
+	ConsumerNamespace string `json:"consumerNamespace,omitempty" yaml:"consumerNamespace,omitempty"`
+}
+
+// Transform converts the provided ExternalIPPools to table rows, one row per
+// allocated IP. An ExternalIPPool without any allocated IP is converted to a
+// single row showing its usage only.
+func Transform(pools []externalippoolhandler.Response) []Response {
+	var result []Response
+	for _, pool := range pools {
+		if len(pool.IPAddresses) == 0 {
+			result = append(result, Response{Name: pool.Name, Total: pool.Total, Used: pool.Used})
+			continue
+		}
+		for _, ipAddress := range pool.IPAddresses {
+			result = append(result, Response{
+				Name:              pool.Name,
+				Total:             pool.Total,
+				Used:              pool.Used,
+				IP:                ipAddress.IP,
+				ConsumerKind:      ipAddress.Owner.Kind,
+				ConsumerName:      ipAddress.Owner.Name,
+				ConsumerNamespace: ipAddress.Owner.Namespace,
+			})
+		}
+	}
+	return result
+}
+
+var _ common.TableOutput = new(Response)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"NAME", "TOTAL", "USED", "IP", "CONSUMER-KIND", "CONSUMER"}
+}
+
+func (r Response) GetTableRow(maxColumnLength int) []string {
+	consumer := r.ConsumerName
+	if r.ConsumerNamespace != "" {
+		consumer = r.ConsumerNamespace + "/" + r.ConsumerName
+	}
+	return []string{r.Name, strconv.Itoa(r.Total), strconv.Itoa(r.Used), r.IP, r.ConsumerKind, consumer}
+}
+
+func (r Response) SortRows() bool {
+	return true
+}
@@ -15,6 +15,7 @@
 package networkpolicy
 
 import (
+	"fmt"
 	"io"
 	"reflect"
 	"sort"
@@ -65,9 +66,9 @@ func Transform(reader io.Reader, single bool, opts map[string]string) (interface
 
 const sortByEffectivePriority = "effectivePriority"
 
-// Compute a tierPriority value in between the application tier and the baseline tier,
+// Compute a tierPriority value in between the AdminNetworkPolicy tier and the baseline tier,
 // which can be used to sort all policies by tier.
-var effectiveTierPriorityK8sNP = (networkpolicy.DefaultTierPriority + networkpolicy.BaselineTierPriority) / 2
+var effectiveTierPriorityK8sNP = (networkpolicy.AdminNetworkPolicyTierPriority + networkpolicy.BaselineTierPriority) / 2
 
 type NPSorter struct {
 	networkPolicies []cpv1beta.NetworkPolicy
@@ -124,10 +125,20 @@ func (r Response) GetTableHeader() []string {
 	return []string{"NAME", "APPLIED-TO", "RULES", "SOURCE", "TIER-PRIORITY", "PRIORITY"}
 }
 
+// rulesToString returns the number of rules of the NetworkPolicy, and the number of rules which
+// are not enforced currently because of their schedules if any.
+func rulesToString(np *cpv1beta.NetworkPolicy) string {
+	rules := strconv.Itoa(len(np.Rules))
+	if len(np.InactiveRules) > 0 {
+		rules += fmt.Sprintf(" (%d inactive)", len(np.InactiveRules))
+	}
+	return rules
+}
+
 func (r Response) GetTableRow(maxColumnLength int) []string {
 	return []string{
 		r.Name, common.GenerateTableElementWithSummary(r.AppliedToGroups, maxColumnLength),
-		strconv.Itoa(len(r.Rules)), r.SourceRef.ToString(),
+		rulesToString(r.NetworkPolicy), r.SourceRef.ToString(),
 		priorityToString(r.TierPriority), priorityToString(r.Priority),
 	}
 }
@@ -61,6 +61,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&EgressGroup{},
 		&EgressGroupPatch{},
 		&EgressGroupList{},
+		&TrafficControlStatus{},
 	)
 	return nil
 }
@@ -184,6 +184,8 @@ const (
 	K8sNetworkPolicy           NetworkPolicyType = "K8sNetworkPolicy"
 	AntreaClusterNetworkPolicy NetworkPolicyType = "AntreaClusterNetworkPolicy"
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
+	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
+	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
 )
 
 type NetworkPolicyReference struct {
@@ -216,6 +218,15 @@ type NetworkPolicy struct {
 	TierPriority *int32
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference
+	// EnforcementMode specifies whether the Drop and Reject rules of this policy are enforced or
+	// only audited. An empty value means Enforce.
+	EnforcementMode crdv1alpha1.EnforcementMode
+	// Tier is the name of the Tier associated with this NetworkPolicy.
+	// The Tier will remain empty for K8s NetworkPolicy.
+	Tier string
+	// InactiveRules is a list of names of the rules which are not included in Rules
+	// because the current time is outside of their schedules.
+	InactiveRules []string
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -255,6 +266,8 @@ type NetworkPolicyRule struct {
 	// Cannot be set in conjunction with NetworkPolicy.AppliedToGroups of the NetworkPolicy
 	// that this Rule is referred to.
 	AppliedToGroups []string
+	// L7Protocols is a list of application layer protocols which should be matched.
+	L7Protocols []L7Protocol
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -292,6 +305,24 @@ type Service struct {
 	ICMPCode *int32
 }
 
+// L7Protocol defines application layer protocol to match.
+type L7Protocol struct {
+	HTTP *HTTPProtocol
+}
+
+// HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
+// If all fields are not provided, this matches all HTTP requests.
+type HTTPProtocol struct {
+	// Host represents the hostname present in the URI or the HTTP Host header to match.
+	// It does not contain the port associated with the host.
+	Host string
+	// Method represents the HTTP method to match.
+	// It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.
+	Method string
+	// Path represents the URI path to match (Ex. "/index.html", "/admin").
+	Path string
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
@@ -342,6 +373,12 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats
 	// Multicast group information from the Node.
 	Multicast []MulticastGroupInfo
+	// The TrafficStats of Egresses collected from the Node.
+	Egresses []EgressStats
+	// The TrafficStats of AdminNetworkPolicies collected from the Node.
+	AdminNetworkPolicies []NetworkPolicyStats
+	// The TrafficStats of BaselineAdminNetworkPolicies collected from the Node.
+	BaselineAdminNetworkPolicies []NetworkPolicyStats
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -362,6 +399,16 @@ type NetworkPolicyStats struct {
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats
 }
 
+// EgressStats contains the information and traffic stats of an Egress.
+type EgressStats struct {
+	// The name of the Egress.
+	Name string
+	// The UID of the Egress.
+	UID types.UID
+	// The stats of the Egress.
+	TrafficStats statsv1alpha1.TrafficStats
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatus is the status of a NetworkPolicy.
@@ -427,3 +474,30 @@ type EgressGroupList struct {
 	metav1.ListMeta
 	Items []EgressGroup
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrafficControlStatus is the status of a TrafficControl. It's used by the antrea-agents to report the realization
+// status of a TrafficControl to the antrea-controller.
+type TrafficControlStatus struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	// Nodes contains statuses produced on a list of Nodes.
+	Nodes []TrafficControlNodeStatus
+}
+
+// TrafficControlNodeStatus is the status of a TrafficControl on a Node.
+type TrafficControlNodeStatus struct {
+	// The name of the Node that produces the status.
+	NodeName string
+	// The generation realized by the Node.
+	Generation int64
+	// Whether the target port and the return port (if any) have been created.
+	PortCreated bool
+	// Whether the flows of the TrafficControl have been installed.
+	FlowsInstalled bool
+	// Whether the target port cannot be attached to the OVS bridge, e.g. the target device doesn't exist.
+	TargetUnreachable bool
+	// A human-readable message indicating why the TrafficControl is not realized, if any.
+	Message string
+}
@@ -327,10 +327,38 @@ func (m *EgressGroupPatch) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_EgressGroupPatch proto.InternalMessageInfo
 
+func (m *EgressStats) Reset()      { *m = EgressStats{} }
+func (*EgressStats) ProtoMessage() {}
+func (*EgressStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+}
+func (m *EgressStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressStats.Merge(m, src)
+}
+func (m *EgressStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressStats proto.InternalMessageInfo
+
 func (m *ExternalEntityReference) Reset()      { *m = ExternalEntityReference{} }
 func (*ExternalEntityReference) ProtoMessage() {}
 func (*ExternalEntityReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+	return fileDescriptor_fbaa7d016762fa1d, []int{11}
 }
 func (m *ExternalEntityReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +386,7 @@ var xxx_messageInfo_ExternalEntityReference proto.InternalMessageInfo
 func (m *GroupAssociation) Reset()      { *m = GroupAssociation{} }
 func (*GroupAssociation) ProtoMessage() {}
 func (*GroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+	return fileDescriptor_fbaa7d016762fa1d, []int{12}
 }
 func (m *GroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +414,7 @@ var xxx_messageInfo_GroupAssociation proto.InternalMessageInfo
 func (m *GroupMember) Reset()      { *m = GroupMember{} }
 func (*GroupMember) ProtoMessage() {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+	return fileDescriptor_fbaa7d016762fa1d, []int{13}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +442,7 @@ var xxx_messageInfo_GroupMember proto.InternalMessageInfo
 func (m *GroupReference) Reset()      { *m = GroupReference{} }
 func (*GroupReference) ProtoMessage() {}
 func (*GroupReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+	return fileDescriptor_fbaa7d016762fa1d, []int{14}
 }
 func (m *GroupReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,10 +467,38 @@ func (m *GroupReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupReference proto.InternalMessageInfo
 
+func (m *HTTPProtocol) Reset()      { *m = HTTPProtocol{} }
+func (*HTTPProtocol) ProtoMessage() {}
+func (*HTTPProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+}
+func (m *HTTPProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPProtocol.Merge(m, src)
+}
+func (m *HTTPProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPProtocol proto.InternalMessageInfo
+
 func (m *IPBlock) Reset()      { *m = IPBlock{} }
 func (*IPBlock) ProtoMessage() {}
 func (*IPBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+	return fileDescriptor_fbaa7d016762fa1d, []int{16}
 }
 func (m *IPBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +526,7 @@ var xxx_messageInfo_IPBlock proto.InternalMessageInfo
 func (m *IPNet) Reset()      { *m = IPNet{} }
 func (*IPNet) ProtoMessage() {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+	return fileDescriptor_fbaa7d016762fa1d, []int{17}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,10 +551,38 @@ func (m *IPNet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IPNet proto.InternalMessageInfo
 
+func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
+func (*L7Protocol) ProtoMessage() {}
+func (*L7Protocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+}
+func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *L7Protocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *L7Protocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L7Protocol.Merge(m, src)
+}
+func (m *L7Protocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *L7Protocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_L7Protocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
+
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+	return fileDescriptor_fbaa7d016762fa1d, []int{19}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +610,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+	return fileDescriptor_fbaa7d016762fa1d, []int{20}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +638,7 @@ var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+	return fileDescriptor_fbaa7d016762fa1d, []int{21}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +666,7 @@ var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{19}
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +694,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +722,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +750,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +778,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +806,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +834,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +862,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +890,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +918,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +946,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +974,7 @@ var xxx_messageInfo_PodReference proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1002,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,6 +1027,62 @@ func (m *ServiceReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 
+func (m *TrafficControlNodeStatus) Reset()      { *m = TrafficControlNodeStatus{} }
+func (*TrafficControlNodeStatus) ProtoMessage() {}
+func (*TrafficControlNodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+}
+func (m *TrafficControlNodeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrafficControlNodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TrafficControlNodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficControlNodeStatus.Merge(m, src)
+}
+func (m *TrafficControlNodeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrafficControlNodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficControlNodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficControlNodeStatus proto.InternalMessageInfo
+
+func (m *TrafficControlStatus) Reset()      { *m = TrafficControlStatus{} }
+func (*TrafficControlStatus) ProtoMessage() {}
+func (*TrafficControlStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+}
+func (m *TrafficControlStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrafficControlStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TrafficControlStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficControlStatus.Merge(m, src)
+}
+func (m *TrafficControlStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrafficControlStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficControlStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficControlStatus proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*AddressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.AddressGroup")
 	proto.RegisterType((*AddressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.AddressGroupList")
@@ -954,12 +1094,15 @@ func init() {
 	proto.RegisterType((*EgressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroup")
 	proto.RegisterType((*EgressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupList")
 	proto.RegisterType((*EgressGroupPatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupPatch")
+	proto.RegisterType((*EgressStats)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressStats")
 	proto.RegisterType((*ExternalEntityReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ExternalEntityReference")
 	proto.RegisterType((*GroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupAssociation")
 	proto.RegisterType((*GroupMember)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMember")
 	proto.RegisterType((*GroupReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupReference")
+	proto.RegisterType((*HTTPProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPProtocol")
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
+	proto.RegisterType((*L7Protocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7Protocol")
 	proto.RegisterType((*MulticastGroupInfo)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.MulticastGroupInfo")
 	proto.RegisterType((*NamedPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamedPort")
 	proto.RegisterType((*NetworkPolicy)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicy")
@@ -976,6 +1119,8 @@ func init() {
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodReference")
 	proto.RegisterType((*Service)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Service")
 	proto.RegisterType((*ServiceReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ServiceReference")
+	proto.RegisterType((*TrafficControlNodeStatus)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.TrafficControlNodeStatus")
+	proto.RegisterType((*TrafficControlStatus)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.TrafficControlStatus")
 }
 
 func init() {
@@ -983,141 +1128,165 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 2132 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0xcd, 0x6f, 0x1b, 0x59,
-	0xbd, 0xe3, 0x8f, 0x26, 0xfe, 0xd9, 0x69, 0x9d, 0x97, 0x96, 0x9a, 0xa5, 0xd8, 0xd9, 0x59, 0x40,
-	0x39, 0xc0, 0x78, 0x13, 0xda, 0x6d, 0x61, 0xb7, 0x0b, 0x71, 0x92, 0x46, 0x96, 0xda, 0xd4, 0xbc,
-	0x64, 0x55, 0x09, 0xe8, 0xb2, 0x93, 0x99, 0x67, 0x67, 0x88, 0x3d, 0x6f, 0x98, 0x79, 0x0e, 0x8d,
-	0x90, 0xd0, 0x22, 0xe0, 0xb0, 0x0b, 0x12, 0xdc, 0x10, 0x37, 0x38, 0x71, 0xe1, 0x2f, 0xe0, 0xc6,
-	0xad, 0xe2, 0xb4, 0x2b, 0x84, 0xd8, 0x93, 0x45, 0x8d, 0x00, 0x71, 0xe0, 0x1f, 0x08, 0x17, 0xf4,
-	0xde, 0xbc, 0x99, 0x79, 0x63, 0x27, 0x4d, 0x9d, 0xa4, 0x41, 0x82, 0x3d, 0xd5, 0xf3, 0x7e, 0xdf,
-	0xef, 0xf7, 0xfd, 0x52, 0x78, 0xd3, 0x74, 0x99, 0x4f, 0x4c, 0xc3, 0xa1, 0xf5, 0xf0, 0x57, 0xdd,
-	0xdb, 0xed, 0xd4, 0x4d, 0xcf, 0x09, 0xea, 0x16, 0x75, 0x99, 0x4f, 0xbb, 0x5e, 0xd7, 0x74, 0x49,
-	0x7d, 0x6f, 0x71, 0x9b, 0x30, 0x73, 0xa9, 0xde, 0x21, 0x2e, 0xf1, 0x4d, 0x46, 0x6c, 0xc3, 0xf3,
-	0x29, 0xa3, 0xc8, 0x08, 0xa9, 0xbe, 0xe5, 0x50, 0xf9, 0xcb, 0xf0, 0x76, 0x3b, 0x06, 0xa7, 0x37,
-	0x54, 0x7a, 0x43, 0xd2, 0xbf, 0x74, 0xfb, 0x68, 0x79, 0x01, 0x33, 0x59, 0x50, 0xdf, 0x5b, 0x34,
-	0xbb, 0xde, 0x8e, 0xb9, 0x38, 0x2a, 0xe9, 0xa5, 0x2f, 0x74, 0x1c, 0xb6, 0xd3, 0xdf, 0x36, 0x2c,
-	0xda, 0xab, 0x77, 0x68, 0x87, 0xd6, 0xc5, 0xf1, 0x76, 0xbf, 0x2d, 0xbe, 0xc4, 0x87, 0xf8, 0x25,
-	0xd1, 0x6f, 0xec, 0xde, 0x0e, 0x84, 0x14, 0xcf, 0xe9, 0x99, 0xd6, 0x8e, 0xe3, 0x12, 0x7f, 0x3f,
-	0x91, 0xd5, 0x23, 0xcc, 0xac, 0xef, 0x8d, 0x0b, 0xa9, 0x1f, 0x45, 0xe5, 0xf7, 0x5d, 0xe6, 0xf4,
-	0xc8, 0x18, 0xc1, 0x6b, 0xc7, 0x11, 0x04, 0xd6, 0x0e, 0xe9, 0x99, 0x63, 0x74, 0x5f, 0x3c, 0x8a,
-	0xae, 0xcf, 0x9c, 0x6e, 0xdd, 0x71, 0x59, 0xc0, 0xfc, 0x51, 0x22, 0xfd, 0x1f, 0x1a, 0x94, 0x96,
-	0x6d, 0xdb, 0x27, 0x41, 0xb0, 0xee, 0xd3, 0xbe, 0x87, 0xde, 0x81, 0x69, 0x6e, 0x89, 0x6d, 0x32,
-	0xb3, 0xa2, 0xcd, 0x6b, 0x0b, 0xc5, 0xa5, 0x57, 0x8d, 0x90, 0xb1, 0xa1, 0x32, 0x4e, 0x7c, 0xc2,
-	0xb1, 0x8d, 0xbd, 0x45, 0xe3, 0xc1, 0xf6, 0xb7, 0x89, 0xc5, 0xee, 0x13, 0x66, 0x36, 0xd0, 0x93,
-	0x41, 0xed, 0xc2, 0x70, 0x50, 0x83, 0xe4, 0x0c, 0xc7, 0x5c, 0x51, 0x1f, 0x4a, 0x1d, 0x2e, 0xea,
-	0x3e, 0xe9, 0x6d, 0x13, 0x3f, 0xa8, 0x64, 0xe6, 0xb3, 0x0b, 0xc5, 0xa5, 0xd7, 0x27, 0x74, 0xbb,
-	0xb1, 0x9e, 0xf0, 0x68, 0x5c, 0x91, 0x02, 0x4b, 0xca, 0x61, 0x80, 0x53, 0x62, 0xf4, 0x3f, 0x6a,
-	0x50, 0x56, 0x2d, 0xbd, 0xe7, 0x04, 0x0c, 0x7d, 0x73, 0xcc, 0x5a, 0xe3, 0xf9, 0xac, 0xe5, 0xd4,
-	0xc2, 0xd6, 0xb2, 0x14, 0x3d, 0x1d, 0x9d, 0x28, 0x96, 0x9a, 0x90, 0x77, 0x18, 0xe9, 0x45, 0x26,
-	0xbe, 0x31, 0xa9, 0x89, 0xaa, 0xba, 0x8d, 0x19, 0x29, 0x28, 0xdf, 0xe4, 0x2c, 0x71, 0xc8, 0x59,
-	0x7f, 0x2f, 0x0b, 0xb3, 0x2a, 0x5a, 0xcb, 0x64, 0xd6, 0xce, 0x39, 0x38, 0xf1, 0x47, 0x1a, 0xcc,
-	0x9a, 0xb6, 0x4d, 0xec, 0xf5, 0x33, 0x76, 0xe5, 0x27, 0xa5, 0x58, 0x6e, 0x55, 0x9a, 0x3b, 0x1e,
-	0x17, 0x88, 0xde, 0xd7, 0x60, 0xce, 0x27, 0x3d, 0xba, 0x37, 0xa2, 0x48, 0xf6, 0xf4, 0x8a, 0x7c,
-	0x4a, 0x2a, 0x32, 0x87, 0xc7, 0xf9, 0xe3, 0xc3, 0x84, 0xea, 0xff, 0xd4, 0xe0, 0xd2, 0xb2, 0xe7,
-	0x75, 0x1d, 0x62, 0x6f, 0xd1, 0xff, 0xf1, 0x6c, 0xfa, 0xb3, 0x06, 0x28, 0x6d, 0xeb, 0x39, 0xe4,
-	0x93, 0x95, 0xce, 0xa7, 0x37, 0x27, 0xce, 0xa7, 0x94, 0xc2, 0x47, 0x64, 0xd4, 0x4f, 0xb2, 0x30,
-	0x97, 0x46, 0xfc, 0x38, 0xa7, 0xfe, 0x7b, 0x39, 0xf5, 0xab, 0x1c, 0xcc, 0xad, 0x74, 0xfb, 0x01,
-	0x23, 0x7e, 0x4a, 0xc9, 0x17, 0xef, 0x8d, 0x1f, 0x68, 0x50, 0x26, 0xed, 0x36, 0xb1, 0x98, 0xb3,
-	0x47, 0xce, 0xd0, 0x19, 0x15, 0x29, 0xb5, 0xbc, 0x36, 0xc2, 0x1c, 0x8f, 0x89, 0x43, 0xdf, 0x87,
-	0xd9, 0xf8, 0xac, 0xd9, 0x6a, 0x74, 0xa9, 0xb5, 0x1b, 0xf9, 0xe1, 0xe6, 0xa4, 0x3a, 0x34, 0x5b,
-	0x1b, 0x84, 0x25, 0xa1, 0xb0, 0x36, 0xca, 0x17, 0x8f, 0x8b, 0x42, 0xb7, 0xa1, 0xc4, 0x28, 0x33,
-	0xbb, 0x91, 0xf9, 0xb9, 0x79, 0x6d, 0x21, 0x9b, 0xd4, 0x87, 0x2d, 0x05, 0x86, 0x53, 0x98, 0x68,
-	0x09, 0x40, 0x7c, 0xb7, 0xcc, 0x0e, 0x09, 0x2a, 0x79, 0x41, 0x17, 0xdf, 0xf7, 0x56, 0x0c, 0xc1,
-	0x0a, 0x16, 0xba, 0x09, 0x45, 0xab, 0xef, 0xfb, 0xc4, 0x65, 0xfc, 0xbb, 0x72, 0x51, 0x10, 0xcd,
-	0x49, 0xa2, 0xe2, 0x4a, 0x02, 0xc2, 0x2a, 0x9e, 0xfe, 0x77, 0x0d, 0x8a, 0x6b, 0x9d, 0xff, 0x83,
-	0x09, 0xe6, 0x43, 0x0d, 0x2e, 0x2b, 0x86, 0x9e, 0x43, 0xc1, 0x7d, 0x27, 0x5d, 0x70, 0x27, 0xb6,
-	0x50, 0xd1, 0xf6, 0x88, 0x6a, 0xfb, 0xd3, 0x2c, 0x94, 0x15, 0xac, 0xb0, 0xd4, 0xda, 0x00, 0x34,
-	0xbe, 0xf7, 0x33, 0xf5, 0xa1, 0xc2, 0xf7, 0xe3, 0x72, 0x7b, 0x48, 0xb9, 0xed, 0xc2, 0xb5, 0xb5,
-	0xc7, 0x8c, 0xf8, 0xae, 0xd9, 0x5d, 0x73, 0x99, 0xc3, 0xf6, 0x31, 0x69, 0x13, 0x9f, 0xb8, 0x16,
-	0x41, 0xf3, 0x90, 0x73, 0xcd, 0x1e, 0x11, 0xee, 0x28, 0x34, 0x4a, 0x92, 0x75, 0x6e, 0xc3, 0xec,
-	0x11, 0x2c, 0x20, 0xa8, 0x0e, 0x05, 0xfe, 0x6f, 0xe0, 0x99, 0x16, 0xa9, 0x64, 0x04, 0xda, 0xac,
-	0x44, 0x2b, 0x6c, 0x44, 0x00, 0x9c, 0xe0, 0xe8, 0xff, 0xd6, 0xa0, 0x2c, 0xc4, 0x2f, 0x07, 0x01,
-	0xb5, 0x1c, 0x93, 0x39, 0xd4, 0x3d, 0x9f, 0x3e, 0x5b, 0x36, 0xa5, 0x44, 0x69, 0xff, 0x89, 0x47,
-	0x0a, 0x41, 0x1d, 0x5f, 0x52, 0x52, 0xdc, 0x97, 0x47, 0xf8, 0xe3, 0x31, 0x89, 0xfa, 0x87, 0x59,
-	0x28, 0x2a, 0x97, 0x8f, 0x1e, 0x42, 0xd6, 0xa3, 0xb6, 0xb4, 0x79, 0xe2, 0x5d, 0xa1, 0x45, 0xed,
-	0x44, 0x8d, 0xa9, 0xe1, 0xa0, 0x96, 0xe5, 0x27, 0x9c, 0x23, 0xfa, 0xa1, 0x06, 0x97, 0x48, 0xca,
-	0xab, 0xc2, 0x3b, 0xc5, 0xa5, 0xf5, 0x89, 0xf3, 0xf9, 0xf0, 0xd8, 0x68, 0xa0, 0xe1, 0xa0, 0x76,
-	0x69, 0x04, 0x38, 0x22, 0x12, 0x7d, 0x0e, 0xb2, 0x8e, 0x17, 0x86, 0x75, 0xa9, 0x71, 0x85, 0x2b,
-	0xd8, 0x6c, 0x05, 0x07, 0x83, 0x5a, 0xa1, 0xd9, 0x92, 0x0b, 0x0c, 0xe6, 0x08, 0xe8, 0x6d, 0xc8,
-	0x7b, 0xd4, 0x67, 0xbc, 0xd9, 0x70, 0x8f, 0x7c, 0x69, 0x52, 0x1d, 0x79, 0xa4, 0xd9, 0x2d, 0xea,
-	0xb3, 0xa4, 0xe2, 0xf0, 0xaf, 0x00, 0x87, 0x6c, 0xd1, 0x37, 0x20, 0xe7, 0x52, 0x9b, 0x88, 0x9e,
-	0x54, 0x5c, 0xba, 0x33, 0x31, 0x7b, 0x6a, 0x93, 0xc4, 0xf0, 0x69, 0x91, 0x02, 0xfc, 0x48, 0x30,
-	0xd5, 0x7f, 0xa3, 0xc1, 0xa5, 0x74, 0x48, 0xa4, 0xb3, 0x42, 0x3b, 0x3e, 0x2b, 0xe2, 0x44, 0xcb,
-	0x1c, 0x99, 0x68, 0x0d, 0xc8, 0xf6, 0x1d, 0xbb, 0x92, 0x15, 0x08, 0xaf, 0x4a, 0x84, 0xec, 0x5b,
-	0xcd, 0xd5, 0x83, 0x41, 0xed, 0xe5, 0xa3, 0x5e, 0x01, 0xd8, 0xbe, 0x47, 0x02, 0xe3, 0xad, 0xe6,
-	0x2a, 0xe6, 0xc4, 0xfa, 0xef, 0x35, 0x98, 0x92, 0x7d, 0x1e, 0x3d, 0x84, 0x9c, 0xe5, 0xd8, 0xbe,
-	0x0c, 0xbd, 0x13, 0x4e, 0x16, 0xb1, 0xa2, 0x2b, 0xcd, 0x55, 0x8c, 0x05, 0x43, 0xf4, 0x08, 0x2e,
-	0x92, 0xc7, 0x16, 0xf1, 0x98, 0x4c, 0xaf, 0x13, 0xb2, 0xbe, 0x24, 0x59, 0x5f, 0x5c, 0x13, 0xcc,
-	0xb0, 0x64, 0xaa, 0xb7, 0x21, 0x2f, 0x10, 0xd0, 0x2b, 0x90, 0x71, 0x3c, 0xa1, 0x7e, 0xa9, 0x31,
-	0x37, 0x1c, 0xd4, 0x32, 0xcd, 0x56, 0x3a, 0xb2, 0x32, 0x8e, 0xc7, 0x87, 0x19, 0xcf, 0x27, 0x6d,
-	0xe7, 0xf1, 0x3d, 0xe2, 0x76, 0xd8, 0x8e, 0xb8, 0xdf, 0x7c, 0xd2, 0x78, 0x5b, 0x0a, 0x0c, 0xa7,
-	0x30, 0xf5, 0x5f, 0x6a, 0x80, 0xee, 0xf7, 0xbb, 0xcc, 0xb1, 0xcc, 0x80, 0x09, 0xf7, 0x36, 0xdd,
-	0x36, 0x45, 0xaf, 0x40, 0x5e, 0xf4, 0x67, 0xe9, 0xd5, 0x38, 0xdc, 0xc2, 0x00, 0x08, 0x61, 0xe8,
-	0x6d, 0xc8, 0x79, 0xd4, 0x3e, 0xf1, 0x13, 0x40, 0x2a, 0xad, 0xe3, 0x2b, 0x6e, 0x51, 0x3b, 0xc0,
-	0x82, 0xaf, 0xfe, 0x9e, 0x06, 0x85, 0x38, 0xe4, 0x79, 0xec, 0xf0, 0x28, 0x17, 0x1a, 0xe5, 0x55,
-	0x7c, 0x9f, 0x61, 0x01, 0x79, 0x8e, 0xe8, 0xba, 0x0d, 0xd3, 0xe2, 0x6d, 0xc8, 0xa2, 0x5d, 0x19,
-	0x62, 0xd7, 0xa3, 0x11, 0xa1, 0x25, 0xcf, 0x0f, 0x94, 0xdf, 0x38, 0xc6, 0xd6, 0xff, 0x95, 0x85,
-	0x99, 0x0d, 0xc2, 0xbe, 0x4b, 0xfd, 0xdd, 0x16, 0xed, 0x3a, 0xd6, 0xfe, 0x39, 0x14, 0xf3, 0x36,
-	0xe4, 0xfd, 0x7e, 0x97, 0x44, 0x17, 0xbc, 0x3c, 0x71, 0x3e, 0xab, 0xfa, 0xe2, 0x7e, 0x97, 0x24,
-	0x7e, 0xe4, 0x5f, 0x01, 0x0e, 0xd9, 0xa3, 0x3b, 0x70, 0xd9, 0x4c, 0x6d, 0x85, 0x61, 0x29, 0x2b,
-	0x88, 0x78, 0xbb, 0x9c, 0x5e, 0x18, 0x03, 0x3c, 0x8a, 0x8b, 0x16, 0xf8, 0xa5, 0x3a, 0xd4, 0xe7,
-	0xc5, 0x97, 0x4f, 0xd1, 0x5a, 0xa3, 0x14, 0x5e, 0x68, 0x78, 0x86, 0x63, 0x28, 0xba, 0x01, 0x25,
-	0xe6, 0x10, 0x3f, 0x82, 0x88, 0x3a, 0x95, 0x6f, 0x94, 0xc5, 0xbc, 0xad, 0x9c, 0xe3, 0x14, 0x16,
-	0x0a, 0xa0, 0x10, 0xd0, 0xbe, 0x6f, 0xf1, 0xda, 0x24, 0x26, 0xe7, 0xe2, 0xd2, 0xdd, 0xd3, 0x5d,
-	0x45, 0x1c, 0x75, 0x33, 0xbc, 0x52, 0x6d, 0x46, 0xcc, 0x71, 0x22, 0x47, 0xff, 0x93, 0x06, 0xb3,
-	0x29, 0xa2, 0x73, 0x18, 0x49, 0xb7, 0xd3, 0x23, 0xe9, 0x9d, 0x53, 0x19, 0x79, 0xc4, 0x50, 0xfa,
-	0x3d, 0xb8, 0x96, 0x42, 0xe3, 0x05, 0x7e, 0x93, 0x99, 0xac, 0x1f, 0xa0, 0xcf, 0xc3, 0x34, 0x2f,
-	0xf4, 0x1b, 0xc9, 0x24, 0x14, 0x2b, 0xbb, 0x21, 0xcf, 0x71, 0x8c, 0xc1, 0xb7, 0x20, 0xf9, 0xe0,
-	0xea, 0x50, 0x57, 0xa4, 0x9c, 0xb2, 0x05, 0xad, 0xc7, 0x10, 0xac, 0x60, 0xe9, 0x7f, 0xc8, 0x8c,
-	0x5c, 0x6a, 0x8b, 0x10, 0x1f, 0xdd, 0x82, 0x19, 0x53, 0x79, 0xe6, 0x0b, 0x2a, 0x9a, 0x08, 0xbe,
-	0xd9, 0xe1, 0xa0, 0x36, 0xa3, 0xbe, 0xff, 0x05, 0x38, 0x8d, 0x87, 0x08, 0x4c, 0x3b, 0x9e, 0xdc,
-	0x1c, 0xc3, 0x2b, 0xbb, 0x35, 0x79, 0x11, 0x16, 0xf4, 0x89, 0xa5, 0xf1, 0xca, 0x18, 0xb3, 0x46,
-	0x35, 0xc8, 0xb7, 0xbf, 0x63, 0xbb, 0x51, 0x52, 0x14, 0xf8, 0x9d, 0xde, 0xfd, 0xda, 0xea, 0x46,
-	0x80, 0xc3, 0x73, 0xc4, 0xf8, 0x42, 0xb8, 0x49, 0xfc, 0x3d, 0xc7, 0x22, 0x51, 0x6f, 0xff, 0xea,
-	0xa4, 0x9a, 0x48, 0x7a, 0x65, 0xf0, 0x48, 0x56, 0xca, 0x88, 0x37, 0x56, 0xe4, 0xf0, 0xdd, 0xf0,
-	0x13, 0x87, 0x87, 0x35, 0xba, 0x09, 0x39, 0xde, 0x12, 0xa5, 0x17, 0x5f, 0x8e, 0x0a, 0xe1, 0xd6,
-	0xbe, 0x47, 0x0e, 0x06, 0xb5, 0xb4, 0x0b, 0xf8, 0x21, 0x16, 0xe8, 0x13, 0x0f, 0xb9, 0x71, 0xc1,
-	0xcd, 0x1e, 0xd7, 0xce, 0x73, 0xa7, 0x69, 0xe7, 0xbf, 0xce, 0x8f, 0x44, 0x0d, 0x2f, 0x5e, 0xe8,
-	0x0d, 0x28, 0xd8, 0x8e, 0xcf, 0x97, 0x7a, 0xea, 0x4a, 0x43, 0xab, 0x91, 0xb2, 0xab, 0x11, 0xe0,
-	0x40, 0xfd, 0xc0, 0x09, 0x01, 0xb2, 0x20, 0xd7, 0xf6, 0x69, 0x4f, 0x0e, 0x8b, 0xa7, 0xab, 0xac,
-	0x3c, 0x88, 0x13, 0xe3, 0xef, 0xfa, 0xb4, 0x87, 0x05, 0x73, 0xf4, 0x08, 0x32, 0x8c, 0x8a, 0xcb,
-	0x39, 0x13, 0x11, 0x20, 0x45, 0x64, 0xb6, 0x28, 0xce, 0x30, 0xca, 0xc3, 0x3f, 0x48, 0x07, 0xdd,
-	0xad, 0x13, 0x06, 0x5d, 0x12, 0xfe, 0x71, 0xa4, 0xc5, 0xac, 0x79, 0x59, 0xf0, 0x46, 0x0a, 0x76,
-	0xd2, 0x33, 0xc7, 0x4a, 0xfc, 0x43, 0xb8, 0x68, 0x86, 0x3e, 0xb9, 0x28, 0x7c, 0xf2, 0x15, 0x3e,
-	0xdb, 0x2c, 0x47, 0xce, 0x58, 0x7c, 0xc6, 0xdf, 0xcf, 0x7c, 0x3b, 0xfe, 0x6b, 0x96, 0xc1, 0x3d,
-	0x1c, 0x12, 0x61, 0xc9, 0x0e, 0xbd, 0x0e, 0x33, 0xc4, 0x35, 0xb7, 0xbb, 0xe4, 0x1e, 0xed, 0x74,
-	0x1c, 0xb7, 0x53, 0x99, 0x9a, 0xd7, 0x16, 0xa6, 0x1b, 0x57, 0xa5, 0x2e, 0x33, 0x6b, 0x2a, 0x10,
-	0xa7, 0x71, 0x0f, 0xeb, 0x70, 0xd3, 0x13, 0x74, 0xb8, 0x28, 0xce, 0x0b, 0x47, 0xc5, 0xb9, 0xfe,
-	0xb3, 0x2c, 0xa0, 0x94, 0xc7, 0x78, 0x4d, 0x0d, 0xf8, 0x7a, 0x32, 0xe3, 0xaa, 0xc7, 0xb2, 0x6b,
-	0x9c, 0x55, 0xff, 0x8a, 0xad, 0x4f, 0xc3, 0xd3, 0x32, 0x91, 0x07, 0x25, 0xe6, 0x9b, 0xed, 0xb6,
-	0x63, 0x09, 0xad, 0x64, 0xd0, 0xbf, 0xf6, 0x0c, 0x1d, 0xc4, 0x1f, 0x17, 0x8d, 0xd8, 0x1d, 0x5b,
-	0x0a, 0xb5, 0xf2, 0x44, 0xa6, 0x9c, 0xe2, 0x94, 0x04, 0xf4, 0xae, 0x06, 0x65, 0x3e, 0x5b, 0xa8,
-	0x28, 0x72, 0xeb, 0xff, 0xf2, 0xf3, 0x8b, 0xc5, 0x23, 0x1c, 0x92, 0x15, 0x74, 0x14, 0x82, 0xc7,
-	0xa4, 0xe9, 0x7f, 0xd3, 0x60, 0x6e, 0xcc, 0x23, 0xfd, 0xf3, 0x78, 0x5d, 0xed, 0x42, 0x9e, 0x77,
-	0xc9, 0xa8, 0x27, 0xad, 0x9f, 0xca, 0xd7, 0x49, 0x7f, 0x4e, 0x1a, 0x3a, 0x3f, 0x0b, 0x70, 0x28,
-	0x44, 0x5f, 0x84, 0x99, 0xd4, 0xde, 0x76, 0xfc, 0x63, 0x86, 0xfe, 0xbb, 0x3c, 0x94, 0x23, 0xbe,
-	0xc1, 0x66, 0xbf, 0xd7, 0x33, 0xfd, 0xf3, 0x18, 0x67, 0x7f, 0xac, 0xc1, 0x65, 0x35, 0x30, 0x9d,
-	0xf8, 0x8a, 0x1a, 0xa7, 0xba, 0xa2, 0x30, 0x36, 0xae, 0x49, 0xd9, 0x97, 0x37, 0xd2, 0x22, 0xf0,
-	0xa8, 0x4c, 0xf4, 0x5b, 0x0d, 0xae, 0x87, 0x52, 0xe4, 0xeb, 0xfb, 0x08, 0x85, 0x0c, 0xd4, 0xb3,
-	0x50, 0xea, 0x33, 0x52, 0xa9, 0xeb, 0xcb, 0xcf, 0x90, 0x87, 0x9f, 0xa9, 0x0d, 0xfa, 0x85, 0x06,
-	0x57, 0x43, 0x84, 0x51, 0x3d, 0x73, 0x67, 0xa6, 0xe7, 0xa7, 0xa5, 0x9e, 0x57, 0x97, 0x0f, 0x13,
-	0x84, 0x0f, 0x97, 0xcf, 0x07, 0xf3, 0x5e, 0xb4, 0x3a, 0x56, 0xf2, 0x27, 0x53, 0x66, 0x7c, 0xf7,
-	0x4c, 0x66, 0x8e, 0x18, 0x86, 0x13, 0x39, 0xfa, 0x23, 0xb8, 0xd2, 0x32, 0x3b, 0x8e, 0x2b, 0x26,
-	0xca, 0x75, 0xc2, 0x1e, 0x78, 0xfc, 0x87, 0xa8, 0xd1, 0x9e, 0xd9, 0x09, 0xc3, 0x3e, 0xab, 0xac,
-	0x87, 0x66, 0x87, 0x60, 0x01, 0xe1, 0x3b, 0x6d, 0xd7, 0xe9, 0x39, 0x4c, 0x0e, 0xab, 0x71, 0x3a,
-	0xdd, 0xe3, 0x87, 0x38, 0x84, 0xe9, 0x26, 0x94, 0xd4, 0xbd, 0xf4, 0x45, 0x3c, 0x0d, 0xbe, 0x9f,
-	0x81, 0x29, 0xd9, 0x67, 0xd1, 0x0d, 0x65, 0x21, 0x0d, 0x45, 0x54, 0x8e, 0x5f, 0x46, 0xd1, 0x86,
-	0x5c, 0x85, 0x33, 0xc7, 0xe4, 0x69, 0x9f, 0x39, 0x5d, 0x23, 0xfc, 0xdf, 0x11, 0x46, 0xd3, 0x65,
-	0x0f, 0xfc, 0x4d, 0xe6, 0x3b, 0x6e, 0x27, 0x7c, 0xda, 0x51, 0x16, 0xe7, 0xcf, 0xc2, 0x14, 0x71,
-	0xc5, 0x96, 0x2d, 0xa6, 0x95, 0x7c, 0xa3, 0x38, 0x1c, 0xd4, 0xa6, 0xd6, 0xc2, 0x23, 0x1c, 0xc1,
-	0xf8, 0xa2, 0xe7, 0x58, 0x3d, 0x8f, 0x4f, 0x8c, 0x62, 0xa2, 0xcb, 0x87, 0x8b, 0x5e, 0x73, 0xe5,
-	0x7e, 0x4b, 0x4c, 0x91, 0x31, 0x34, 0xc2, 0x5c, 0x89, 0x1e, 0xa3, 0x14, 0x4c, 0x7e, 0x86, 0x63,
-	0xa8, 0x4e, 0xa0, 0x3c, 0x3a, 0xf9, 0xbe, 0x80, 0x3b, 0x6f, 0x6c, 0x3d, 0x79, 0x5a, 0xbd, 0xf0,
-	0xc1, 0xd3, 0xea, 0x85, 0x8f, 0x9e, 0x56, 0x2f, 0xbc, 0x3b, 0xac, 0x6a, 0x4f, 0x86, 0x55, 0xed,
-	0x83, 0x61, 0x55, 0xfb, 0x68, 0x58, 0xd5, 0xfe, 0x32, 0xac, 0x6a, 0x3f, 0xff, 0x6b, 0xf5, 0xc2,
-	0xd7, 0x8d, 0xc9, 0xfe, 0x7b, 0xcf, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x1d, 0x87, 0x1b,
-	0x0f, 0x24, 0x00, 0x00,
+	// 2518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1a, 0x5d, 0x6f, 0x1c, 0x57,
+	0x35, 0xb3, 0x1f, 0xf6, 0xee, 0xf1, 0xfa, 0xeb, 0xda, 0x6d, 0x96, 0x12, 0xec, 0x74, 0x0a, 0x55,
+	0x90, 0x60, 0xb7, 0x36, 0xf9, 0x82, 0x36, 0x01, 0xaf, 0xe3, 0x38, 0x2b, 0xc5, 0xce, 0xf6, 0xc6,
+	0x51, 0xa4, 0x42, 0x4a, 0xaf, 0x67, 0xef, 0xee, 0x0e, 0x99, 0x99, 0x3b, 0x9d, 0xb9, 0xeb, 0x26,
+	0x54, 0x42, 0x45, 0x85, 0x87, 0x16, 0x24, 0x78, 0xa8, 0x84, 0x78, 0xe3, 0x8d, 0x17, 0xfe, 0x04,
+	0x0f, 0x48, 0x11, 0x4f, 0xad, 0x10, 0xa2, 0x4f, 0x16, 0x31, 0x82, 0x0a, 0xf1, 0x0f, 0xc2, 0x0b,
+	0xba, 0x77, 0xee, 0x7c, 0xae, 0x3f, 0xb2, 0xb6, 0x63, 0x24, 0xe8, 0xd3, 0xce, 0xdc, 0xf3, 0x79,
+	0xcf, 0x39, 0xf7, 0xdc, 0x73, 0xce, 0x2c, 0x5c, 0x25, 0x0e, 0xf7, 0x28, 0xa9, 0x99, 0xac, 0x1e,
+	0x3c, 0xd5, 0xdd, 0xfb, 0xdd, 0x3a, 0x71, 0x4d, 0xbf, 0x6e, 0x30, 0x87, 0x7b, 0xcc, 0x72, 0x2d,
+	0xe2, 0xd0, 0xfa, 0xd6, 0xc2, 0x26, 0xe5, 0x64, 0xb1, 0xde, 0xa5, 0x0e, 0xf5, 0x08, 0xa7, 0xed,
+	0x9a, 0xeb, 0x31, 0xce, 0x50, 0x2d, 0xa0, 0xfa, 0xbe, 0xc9, 0xd4, 0x53, 0xcd, 0xbd, 0xdf, 0xad,
+	0x09, 0xfa, 0x5a, 0x92, 0xbe, 0xa6, 0xe8, 0x5f, 0xb8, 0xbc, 0xb7, 0x3c, 0x9f, 0x13, 0xee, 0xd7,
+	0xb7, 0x16, 0x88, 0xe5, 0xf6, 0xc8, 0x42, 0x56, 0xd2, 0x0b, 0x5f, 0xef, 0x9a, 0xbc, 0xd7, 0xdf,
+	0xac, 0x19, 0xcc, 0xae, 0x77, 0x59, 0x97, 0xd5, 0xe5, 0xf2, 0x66, 0xbf, 0x23, 0xdf, 0xe4, 0x8b,
+	0x7c, 0x52, 0xe8, 0xe7, 0xef, 0x5f, 0xf6, 0xa5, 0x14, 0xd7, 0xb4, 0x89, 0xd1, 0x33, 0x1d, 0xea,
+	0x3d, 0x8c, 0x65, 0xd9, 0x94, 0x93, 0xfa, 0xd6, 0xa0, 0x90, 0xfa, 0x5e, 0x54, 0x5e, 0xdf, 0xe1,
+	0xa6, 0x4d, 0x07, 0x08, 0x2e, 0x1e, 0x44, 0xe0, 0x1b, 0x3d, 0x6a, 0x93, 0x01, 0xba, 0x6f, 0xec,
+	0x45, 0xd7, 0xe7, 0xa6, 0x55, 0x37, 0x1d, 0xee, 0x73, 0x2f, 0x4b, 0xa4, 0x7f, 0xa6, 0x41, 0x65,
+	0xa9, 0xdd, 0xf6, 0xa8, 0xef, 0xaf, 0x7a, 0xac, 0xef, 0xa2, 0xb7, 0xa0, 0x24, 0x76, 0xd2, 0x26,
+	0x9c, 0x54, 0xb5, 0xb3, 0xda, 0xb9, 0xb1, 0xc5, 0x57, 0x6a, 0x01, 0xe3, 0x5a, 0x92, 0x71, 0xec,
+	0x13, 0x81, 0x5d, 0xdb, 0x5a, 0xa8, 0xdd, 0xda, 0xfc, 0x01, 0x35, 0xf8, 0x1a, 0xe5, 0xa4, 0x81,
+	0x1e, 0x6d, 0xcf, 0x9f, 0xda, 0xd9, 0x9e, 0x87, 0x78, 0x0d, 0x47, 0x5c, 0x51, 0x1f, 0x2a, 0x5d,
+	0x21, 0x6a, 0x8d, 0xda, 0x9b, 0xd4, 0xf3, 0xab, 0xb9, 0xb3, 0xf9, 0x73, 0x63, 0x8b, 0xaf, 0x0e,
+	0xe9, 0xf6, 0xda, 0x6a, 0xcc, 0xa3, 0x31, 0xab, 0x04, 0x56, 0x12, 0x8b, 0x3e, 0x4e, 0x89, 0xd1,
+	0xff, 0xa4, 0xc1, 0x54, 0x72, 0xa7, 0x37, 0x4d, 0x9f, 0xa3, 0xef, 0x0d, 0xec, 0xb6, 0xf6, 0x74,
+	0xbb, 0x15, 0xd4, 0x72, 0xaf, 0x53, 0x4a, 0x74, 0x29, 0x5c, 0x49, 0xec, 0x94, 0x40, 0xd1, 0xe4,
+	0xd4, 0x0e, 0xb7, 0xf8, 0xda, 0xb0, 0x5b, 0x4c, 0xaa, 0xdb, 0x18, 0x57, 0x82, 0x8a, 0x4d, 0xc1,
+	0x12, 0x07, 0x9c, 0xf5, 0x0f, 0xf2, 0x30, 0x9d, 0x44, 0x6b, 0x11, 0x6e, 0xf4, 0x4e, 0xc0, 0x89,
+	0x3f, 0xd1, 0x60, 0x9a, 0xb4, 0xdb, 0xb4, 0xbd, 0x7a, 0xcc, 0xae, 0xfc, 0x82, 0x12, 0x3b, 0xbd,
+	0x94, 0xe5, 0x8e, 0x07, 0x05, 0xa2, 0x0f, 0x35, 0x98, 0xf1, 0xa8, 0xcd, 0xb6, 0x32, 0x8a, 0xe4,
+	0x8f, 0xae, 0xc8, 0x17, 0x95, 0x22, 0x33, 0x78, 0x90, 0x3f, 0xde, 0x4d, 0xa8, 0xfe, 0x4f, 0x0d,
+	0x26, 0x96, 0x5c, 0xd7, 0x32, 0x69, 0x7b, 0x83, 0xfd, 0x8f, 0x9f, 0xa6, 0xbf, 0x68, 0x80, 0xd2,
+	0x7b, 0x3d, 0x81, 0xf3, 0x64, 0xa4, 0xcf, 0xd3, 0xd5, 0xa1, 0xcf, 0x53, 0x4a, 0xe1, 0x3d, 0x4e,
+	0xd4, 0xcf, 0xf2, 0x30, 0x93, 0x46, 0xfc, 0xfc, 0x4c, 0xfd, 0xf7, 0xce, 0xd4, 0x6f, 0x0a, 0x30,
+	0xb3, 0x6c, 0xf5, 0x7d, 0x4e, 0xbd, 0x94, 0x92, 0xcf, 0xde, 0x1b, 0x3f, 0xd6, 0x60, 0x8a, 0x76,
+	0x3a, 0xd4, 0xe0, 0xe6, 0x16, 0x3d, 0x46, 0x67, 0x54, 0x95, 0xd4, 0xa9, 0x95, 0x0c, 0x73, 0x3c,
+	0x20, 0x0e, 0xfd, 0x08, 0xa6, 0xa3, 0xb5, 0x66, 0xab, 0x61, 0x31, 0xe3, 0x7e, 0xe8, 0x87, 0x0b,
+	0xc3, 0xea, 0xd0, 0x6c, 0xad, 0x53, 0x1e, 0x87, 0xc2, 0x4a, 0x96, 0x2f, 0x1e, 0x14, 0x85, 0x2e,
+	0x43, 0x85, 0x33, 0x4e, 0xac, 0x70, 0xfb, 0x85, 0xb3, 0xda, 0xb9, 0x7c, 0x9c, 0x1f, 0x36, 0x12,
+	0x30, 0x9c, 0xc2, 0x44, 0x8b, 0x00, 0xf2, 0xbd, 0x45, 0xba, 0xd4, 0xaf, 0x16, 0x25, 0x5d, 0x64,
+	0xef, 0x8d, 0x08, 0x82, 0x13, 0x58, 0xe8, 0x02, 0x8c, 0x19, 0x7d, 0xcf, 0xa3, 0x0e, 0x17, 0xef,
+	0xd5, 0x11, 0x49, 0x34, 0xa3, 0x88, 0xc6, 0x96, 0x63, 0x10, 0x4e, 0xe2, 0xe9, 0xff, 0xd0, 0x60,
+	0x6c, 0xa5, 0xfb, 0x7f, 0x50, 0xc1, 0x7c, 0xa2, 0xc1, 0x64, 0x62, 0xa3, 0x27, 0x90, 0x70, 0xdf,
+	0x4a, 0x27, 0xdc, 0xa1, 0x77, 0x98, 0xd0, 0x76, 0x8f, 0x6c, 0xfb, 0xf3, 0x3c, 0x4c, 0x25, 0xb0,
+	0x82, 0x54, 0xdb, 0x06, 0x60, 0x91, 0xdd, 0x8f, 0xd5, 0x87, 0x09, 0xbe, 0x9f, 0xa7, 0xdb, 0x5d,
+	0xd2, 0xed, 0xdf, 0xa3, 0xb3, 0x74, 0x9b, 0x13, 0xee, 0xa3, 0xb3, 0x50, 0x70, 0x88, 0x4d, 0xa5,
+	0x0f, 0xca, 0x8d, 0x8a, 0xe2, 0x57, 0x58, 0x27, 0x36, 0xc5, 0x12, 0x82, 0x1a, 0x90, 0xef, 0x9b,
+	0xed, 0x6a, 0x4e, 0x22, 0xbc, 0xa2, 0x10, 0xf2, 0x77, 0x9a, 0xd7, 0x9e, 0x6c, 0xcf, 0xbf, 0xb8,
+	0x57, 0x47, 0xc2, 0x1f, 0xba, 0xd4, 0xaf, 0xdd, 0x69, 0x5e, 0xc3, 0x82, 0x18, 0xb9, 0x50, 0xe1,
+	0x1e, 0xe9, 0x74, 0x4c, 0x43, 0x4a, 0xad, 0xe6, 0xa5, 0xc7, 0x2f, 0xee, 0xb3, 0x75, 0xd9, 0xd8,
+	0xd5, 0xc2, 0xc6, 0xae, 0xb6, 0x91, 0xa0, 0x4e, 0xa4, 0xa7, 0xc4, 0x2a, 0x4e, 0x49, 0xd0, 0x2d,
+	0x38, 0xbd, 0xf2, 0x80, 0x53, 0xcf, 0x21, 0xd6, 0x8a, 0xc3, 0x4d, 0xfe, 0x10, 0xd3, 0x0e, 0xf5,
+	0xa8, 0x63, 0xd0, 0xa7, 0xd8, 0x72, 0x1d, 0xca, 0xe2, 0xd7, 0x77, 0x89, 0x41, 0xd5, 0xc6, 0xa7,
+	0x15, 0x5a, 0x79, 0x3d, 0x04, 0xe0, 0x18, 0x47, 0xff, 0xb7, 0x06, 0x53, 0xd2, 0xcc, 0x4b, 0xbe,
+	0xcf, 0x0c, 0x93, 0x70, 0x93, 0x39, 0x27, 0x53, 0x4f, 0x4c, 0x11, 0x25, 0x51, 0xf9, 0xf9, 0xd0,
+	0xa5, 0x93, 0xa4, 0x8e, 0x8c, 0x14, 0x5f, 0x62, 0x4b, 0x19, 0xfe, 0x78, 0x40, 0xa2, 0xfe, 0x49,
+	0x1e, 0xc6, 0x12, 0x41, 0x86, 0xee, 0x42, 0xde, 0x65, 0x6d, 0xb5, 0xe7, 0xa1, 0x7b, 0xa2, 0x16,
+	0x6b, 0xc7, 0x6a, 0x8c, 0x8a, 0x58, 0x13, 0x2b, 0x82, 0x23, 0x7a, 0x5f, 0x83, 0x09, 0x9a, 0xf2,
+	0xaa, 0xf4, 0xce, 0xd8, 0xe2, 0xea, 0xd0, 0x79, 0x6b, 0xf7, 0xd8, 0x68, 0xa0, 0x9d, 0xed, 0xf9,
+	0x89, 0x0c, 0x30, 0x23, 0x12, 0xbd, 0x0c, 0x79, 0xd3, 0x0d, 0x8e, 0x6f, 0xa5, 0x31, 0x2b, 0x14,
+	0x6c, 0xb6, 0xfc, 0x27, 0xdb, 0xf3, 0xe5, 0x66, 0x4b, 0x35, 0x6a, 0x58, 0x20, 0xa0, 0x37, 0xa1,
+	0xe8, 0x32, 0x8f, 0x8b, 0x4b, 0x55, 0x78, 0xe4, 0x9b, 0xc3, 0xea, 0x28, 0x22, 0xad, 0xdd, 0x62,
+	0x1e, 0x8f, 0x33, 0xab, 0x78, 0xf3, 0x71, 0xc0, 0x16, 0x7d, 0x17, 0x0a, 0x0e, 0x6b, 0x53, 0x79,
+	0xf7, 0x8e, 0x2d, 0x5e, 0x19, 0x9a, 0x3d, 0x6b, 0xd3, 0x78, 0xe3, 0x25, 0x79, 0x04, 0xc4, 0x92,
+	0x64, 0xaa, 0xff, 0x56, 0x83, 0x89, 0x74, 0x48, 0xa4, 0x4f, 0x85, 0x76, 0xf0, 0xa9, 0x88, 0x0e,
+	0x5a, 0xee, 0xa0, 0xdc, 0x92, 0x3f, 0x42, 0x6e, 0xd1, 0x7f, 0x08, 0x95, 0x1b, 0x1b, 0x1b, 0xad,
+	0x96, 0xc7, 0x38, 0x33, 0x98, 0x25, 0xa4, 0xf6, 0x98, 0xcf, 0xb3, 0xc7, 0xfb, 0x06, 0xf3, 0x39,
+	0x96, 0x10, 0xf4, 0x32, 0x8c, 0xd8, 0x94, 0xf7, 0x58, 0x98, 0xd4, 0x26, 0x14, 0xce, 0xc8, 0x9a,
+	0x5c, 0xc5, 0x0a, 0x2a, 0x38, 0xb9, 0x84, 0xf7, 0xaa, 0xf9, 0x34, 0xa7, 0x16, 0xe1, 0x3d, 0x2c,
+	0x21, 0xfa, 0xef, 0x35, 0x18, 0x55, 0xb5, 0x14, 0xba, 0x0b, 0x05, 0xc3, 0x6c, 0x7b, 0x2a, 0xec,
+	0x0f, 0x59, 0xbd, 0x45, 0x42, 0x96, 0x9b, 0xd7, 0x30, 0x96, 0x0c, 0xd1, 0x3d, 0x18, 0xa1, 0x0f,
+	0x0c, 0xea, 0x72, 0x75, 0xb4, 0x0f, 0xc9, 0x3a, 0xda, 0xe5, 0x8a, 0x64, 0x86, 0x15, 0x53, 0xbd,
+	0x03, 0x45, 0x89, 0x80, 0x5e, 0x82, 0x9c, 0xe9, 0x4a, 0xf5, 0x2b, 0x8d, 0x99, 0x9d, 0xed, 0xf9,
+	0x5c, 0xb3, 0x95, 0x8e, 0xea, 0x9c, 0xe9, 0x8a, 0x82, 0xd1, 0xf5, 0x68, 0xc7, 0x7c, 0x70, 0x93,
+	0x3a, 0x5d, 0xde, 0x93, 0x16, 0x2c, 0xc6, 0x19, 0xb9, 0x95, 0x80, 0xe1, 0x14, 0xa6, 0xde, 0x03,
+	0xb8, 0x79, 0x29, 0xf2, 0xd2, 0x1b, 0x50, 0xe8, 0x71, 0xee, 0x1e, 0x36, 0x49, 0x24, 0x3d, 0x1e,
+	0xc4, 0xae, 0x58, 0xc1, 0x92, 0xa7, 0xfe, 0x6b, 0x0d, 0xd0, 0x5a, 0xdf, 0xe2, 0xa6, 0x41, 0x7c,
+	0x2e, 0x83, 0xb8, 0xe9, 0x74, 0x18, 0x7a, 0x09, 0x8a, 0xb2, 0xda, 0x52, 0x91, 0x11, 0x1d, 0xaa,
+	0x20, 0xcc, 0x03, 0x18, 0x7a, 0x13, 0x0a, 0x2e, 0x6b, 0x1f, 0x7a, 0xa0, 0x93, 0x4a, 0x5e, 0x71,
+	0xc4, 0xb0, 0xb6, 0x8f, 0x25, 0x5f, 0xfd, 0x03, 0x0d, 0xca, 0xd1, 0xc1, 0x96, 0x11, 0xc6, 0xbc,
+	0x20, 0x56, 0x8b, 0x49, 0x7c, 0x8f, 0xe3, 0x82, 0xab, 0x30, 0x0e, 0x38, 0x43, 0x97, 0xa1, 0xe4,
+	0x2a, 0x4b, 0xa8, 0x48, 0x3d, 0x13, 0x16, 0x7c, 0xa1, 0x85, 0x9e, 0x24, 0x9e, 0x71, 0x84, 0xad,
+	0xff, 0xa1, 0x08, 0xe3, 0xeb, 0x94, 0xbf, 0xc3, 0xbc, 0xfb, 0x2d, 0x66, 0x99, 0xc6, 0xc3, 0x13,
+	0xb8, 0xb2, 0x3a, 0x50, 0xf4, 0xfa, 0x16, 0x0d, 0x0d, 0xbc, 0x34, 0x74, 0xd6, 0x4a, 0xea, 0x8b,
+	0xfb, 0x16, 0x8d, 0xfd, 0x28, 0xde, 0x7c, 0x1c, 0xb0, 0x47, 0x57, 0x60, 0x92, 0xa4, 0x7a, 0xfc,
+	0x20, 0x61, 0x97, 0x65, 0x64, 0x4f, 0xa6, 0xdb, 0x7f, 0x1f, 0x67, 0x71, 0xd1, 0x39, 0x61, 0x54,
+	0x93, 0x79, 0xe2, 0x8a, 0x11, 0x3d, 0x91, 0xd6, 0xa8, 0x04, 0x06, 0x0d, 0xd6, 0x70, 0x04, 0x45,
+	0xe7, 0xa1, 0xc2, 0x4d, 0xea, 0x85, 0x10, 0x99, 0x8d, 0x8b, 0x8d, 0x29, 0x59, 0x9e, 0x24, 0xd6,
+	0x71, 0x0a, 0x0b, 0xf9, 0x50, 0xf6, 0x59, 0xdf, 0x33, 0x44, 0x06, 0x96, 0x7d, 0xd0, 0xd8, 0xe2,
+	0xf5, 0xa3, 0x99, 0x22, 0x8a, 0xba, 0x71, 0x91, 0x8f, 0x6f, 0x87, 0xcc, 0x71, 0x2c, 0x07, 0xbd,
+	0x0b, 0x93, 0xd4, 0xe9, 0x30, 0xcf, 0xa0, 0x36, 0x75, 0xf8, 0x9a, 0xb8, 0x3b, 0x46, 0x65, 0xc0,
+	0xbc, 0xae, 0x4c, 0x38, 0xb9, 0x92, 0x06, 0x3f, 0xd9, 0x9e, 0xbf, 0xb8, 0xcf, 0xac, 0xdf, 0x6b,
+	0x47, 0x93, 0xf7, 0x5a, 0x86, 0x12, 0x67, 0x25, 0x89, 0x40, 0x16, 0x16, 0xa8, 0x96, 0xd2, 0x81,
+	0x2c, 0x6c, 0x84, 0x25, 0x04, 0x5d, 0x82, 0x71, 0xd3, 0x21, 0xb2, 0x3f, 0x95, 0xae, 0xac, 0x96,
+	0xa5, 0xc3, 0xa6, 0x77, 0xb6, 0xe7, 0xc7, 0x9b, 0x49, 0x00, 0x4e, 0xe3, 0xe9, 0x7f, 0xd6, 0x60,
+	0x3a, 0x65, 0x8c, 0x13, 0x68, 0x9c, 0x36, 0xd3, 0x8d, 0xd3, 0x95, 0x23, 0x39, 0x6f, 0x8f, 0xd6,
+	0xe9, 0x5d, 0x38, 0x9d, 0x42, 0x13, 0xd7, 0xb3, 0xa8, 0x6e, 0xfb, 0x3e, 0xfa, 0x1a, 0x94, 0xc4,
+	0x35, 0xbd, 0x1e, 0xd7, 0xb1, 0x91, 0xb2, 0xeb, 0x6a, 0x1d, 0x47, 0x18, 0xa2, 0x57, 0x57, 0x9f,
+	0x05, 0x4c, 0xe6, 0xc8, 0x54, 0x92, 0xe8, 0xd5, 0x57, 0x23, 0x08, 0x4e, 0x60, 0xe9, 0x7f, 0xcc,
+	0x65, 0x8c, 0xda, 0xa2, 0x81, 0x8f, 0x48, 0x62, 0x18, 0xed, 0x57, 0xb5, 0xd8, 0x47, 0xc9, 0x29,
+	0xb5, 0x8f, 0xd3, 0x78, 0x88, 0x42, 0xc9, 0x74, 0xd5, 0x7c, 0x23, 0x30, 0xd9, 0xa5, 0xe1, 0xaf,
+	0x31, 0x49, 0x1f, 0xef, 0x34, 0x1a, 0x6c, 0x44, 0xac, 0xd1, 0x3c, 0x14, 0x3b, 0x6f, 0xb7, 0x9d,
+	0xf0, 0xb0, 0x97, 0x85, 0x4d, 0xaf, 0xbf, 0x7e, 0x6d, 0xdd, 0xc7, 0xc1, 0x3a, 0xe2, 0x62, 0x6c,
+	0x71, 0x9b, 0x7a, 0x5b, 0xa6, 0x41, 0xc3, 0xca, 0xec, 0x3b, 0xc3, 0x6a, 0xa2, 0xe8, 0x13, 0x65,
+	0x63, 0x3c, 0xf8, 0x08, 0x79, 0xe3, 0x84, 0x1c, 0x31, 0xc1, 0x78, 0x7e, 0xf7, 0xe3, 0x8a, 0x2e,
+	0x40, 0x41, 0x14, 0x34, 0xca, 0x8b, 0x2f, 0x46, 0xe7, 0xe2, 0xa1, 0x2b, 0x8e, 0x5f, 0xda, 0x05,
+	0x62, 0x11, 0x4b, 0xf4, 0xa1, 0x5b, 0x94, 0xe8, 0x22, 0xc9, 0x1f, 0x54, 0x8c, 0x15, 0x8e, 0x52,
+	0x8c, 0x7d, 0x34, 0x92, 0x89, 0x1a, 0x71, 0x42, 0xd1, 0x6b, 0x50, 0x6e, 0x9b, 0x1e, 0x35, 0x64,
+	0xf8, 0x05, 0x1b, 0x9d, 0x0b, 0x95, 0xbd, 0x16, 0x02, 0x9e, 0x24, 0x5f, 0x70, 0x4c, 0x80, 0x0c,
+	0x28, 0x74, 0x3c, 0x66, 0xab, 0x52, 0xff, 0x68, 0x37, 0x86, 0x08, 0xe2, 0x78, 0xf3, 0xd7, 0x3d,
+	0x66, 0x63, 0xc9, 0x1c, 0xdd, 0x83, 0x1c, 0x67, 0xd5, 0xfc, 0x71, 0x89, 0x00, 0x25, 0x22, 0xb7,
+	0xc1, 0x70, 0x8e, 0x33, 0x11, 0xfe, 0x7e, 0x3a, 0xe8, 0x2e, 0x1d, 0x32, 0xe8, 0xe2, 0xf0, 0x8f,
+	0x22, 0x2d, 0x62, 0x2d, 0xd2, 0x82, 0x9b, 0xb9, 0x88, 0xe2, 0x5a, 0x60, 0xe0, 0xea, 0xba, 0x0b,
+	0x23, 0x24, 0xf0, 0xc9, 0x88, 0xf4, 0xc9, 0xb7, 0x45, 0x75, 0xb8, 0x14, 0x3a, 0x63, 0xe1, 0x29,
+	0x33, 0xbf, 0xf0, 0x70, 0x40, 0x84, 0x15, 0x3b, 0xf4, 0x2a, 0x8c, 0x53, 0x87, 0x6c, 0x5a, 0xf4,
+	0x26, 0xeb, 0x76, 0x4d, 0xa7, 0x2b, 0xaf, 0x99, 0x52, 0xe3, 0x39, 0xa5, 0xcb, 0xf8, 0x4a, 0x12,
+	0x88, 0xd3, 0xb8, 0xbb, 0xdd, 0xdc, 0xa5, 0x21, 0x6e, 0xee, 0x30, 0xce, 0xcb, 0x7b, 0xc6, 0xf9,
+	0xdb, 0x30, 0x66, 0x45, 0x85, 0xa8, 0x5f, 0x05, 0xe9, 0x8e, 0x6f, 0x0d, 0xeb, 0x8e, 0xb8, 0x96,
+	0x8d, 0x27, 0x98, 0xf1, 0x9a, 0x8f, 0x93, 0x32, 0xf4, 0x5f, 0xe4, 0x01, 0xa5, 0x82, 0x24, 0x18,
+	0xbe, 0xbc, 0xaf, 0xc1, 0xb8, 0x93, 0x5c, 0xae, 0x6a, 0xc7, 0x5a, 0x0a, 0x44, 0x06, 0x4f, 0xc3,
+	0xd3, 0x32, 0x07, 0x86, 0x33, 0xb9, 0x67, 0x3d, 0x9c, 0x41, 0xef, 0x69, 0x30, 0x25, 0xca, 0xb4,
+	0x8d, 0xf4, 0x4c, 0xe8, 0x20, 0x3f, 0x64, 0xc4, 0xe2, 0x0c, 0x87, 0x78, 0x66, 0x91, 0x85, 0xe0,
+	0x01, 0x69, 0x62, 0x0e, 0x36, 0x33, 0xe0, 0x91, 0xfe, 0x49, 0x7c, 0x76, 0xb0, 0xa0, 0x28, 0x2e,
+	0xe6, 0xf0, 0x1a, 0x5c, 0x3d, 0x92, 0xaf, 0xe3, 0x92, 0x20, 0xae, 0x21, 0xc4, 0x9a, 0x8f, 0x03,
+	0x21, 0xfa, 0x02, 0x8c, 0xa7, 0x1a, 0xfd, 0x83, 0xa7, 0x5f, 0xfa, 0xbf, 0x4a, 0x30, 0x15, 0xf2,
+	0xf5, 0x6f, 0xf7, 0x6d, 0x9b, 0x78, 0x27, 0xd1, 0x19, 0xfc, 0x54, 0x83, 0xc9, 0x64, 0x60, 0x9a,
+	0x91, 0x89, 0x1a, 0x47, 0x32, 0x51, 0x10, 0x1b, 0xa7, 0xc3, 0x12, 0x77, 0x3d, 0x2d, 0x02, 0x67,
+	0x65, 0xa2, 0xdf, 0x69, 0x70, 0x26, 0x90, 0xa2, 0x3e, 0x4b, 0x65, 0x28, 0xaa, 0xf9, 0x63, 0x53,
+	0xea, 0xcb, 0x4a, 0xa9, 0x33, 0x4b, 0xfb, 0xc8, 0xc3, 0xfb, 0x6a, 0x83, 0x7e, 0xa5, 0xc1, 0x73,
+	0x01, 0x42, 0x56, 0xcf, 0xc2, 0xb1, 0xe9, 0xf9, 0x25, 0xa5, 0xe7, 0x73, 0x4b, 0xbb, 0x09, 0xc2,
+	0xbb, 0xcb, 0x17, 0x3d, 0x8e, 0x1d, 0x76, 0xe1, 0xd5, 0xe2, 0xe1, 0x94, 0x19, 0x6c, 0xe3, 0xe3,
+	0x32, 0x27, 0x82, 0xe1, 0x58, 0x0e, 0x32, 0xa1, 0x44, 0xe5, 0x78, 0x9b, 0xfa, 0xd5, 0x91, 0xa3,
+	0x7c, 0xd3, 0x08, 0x76, 0x1e, 0x5d, 0x9f, 0x2b, 0x8a, 0x29, 0x8e, 0xd8, 0xa3, 0x8f, 0x34, 0x98,
+	0x25, 0x6d, 0xdb, 0x74, 0xb2, 0x86, 0x1f, 0x3d, 0x36, 0xc3, 0x87, 0x9d, 0xfc, 0xec, 0xd2, 0x2e,
+	0x72, 0xf0, 0xae, 0xd2, 0x65, 0xfc, 0x6e, 0x12, 0x9f, 0x5a, 0xa6, 0x43, 0x77, 0x23, 0xab, 0x96,
+	0x8e, 0x4d, 0xbd, 0x28, 0x7e, 0x1b, 0xfb, 0xc8, 0xc3, 0xfb, 0x6a, 0xa3, 0xdf, 0x83, 0xd9, 0x16,
+	0xe9, 0x9a, 0x8e, 0xec, 0x3a, 0x56, 0x29, 0xbf, 0xe5, 0x8a, 0x07, 0x3f, 0x18, 0xbe, 0x75, 0x83,
+	0x3c, 0x95, 0x4f, 0x0e, 0xdf, 0xba, 0x14, 0x4b, 0x88, 0x98, 0xe7, 0x58, 0xa6, 0x6d, 0x72, 0xd5,
+	0xd0, 0x44, 0xf9, 0xef, 0xa6, 0x58, 0xc4, 0x01, 0x4c, 0x27, 0x50, 0x49, 0xce, 0x64, 0x9e, 0xc5,
+	0xf0, 0xff, 0xc3, 0x1c, 0x8c, 0xaa, 0x5a, 0x0c, 0x9d, 0x4f, 0x0c, 0x63, 0x02, 0x11, 0xd5, 0x83,
+	0x07, 0x31, 0x68, 0x5d, 0x8d, 0x81, 0x72, 0x07, 0x24, 0x56, 0xf1, 0x3f, 0xaf, 0x5a, 0xf0, 0x3f,
+	0xaf, 0x5a, 0xd3, 0xe1, 0xb7, 0xbc, 0xdb, 0xdc, 0x33, 0x9d, 0x6e, 0xa3, 0x94, 0x19, 0x1a, 0x7d,
+	0x05, 0x46, 0xa9, 0x23, 0x27, 0x4c, 0xb2, 0xa2, 0x2d, 0x36, 0xc6, 0x76, 0xb6, 0xe7, 0x47, 0x57,
+	0x82, 0x25, 0x1c, 0xc2, 0xc4, 0x90, 0xc3, 0x34, 0x6c, 0x57, 0x74, 0x15, 0xb2, 0xea, 0x2f, 0x06,
+	0x43, 0x8e, 0xe6, 0xf2, 0x5a, 0x4b, 0xac, 0xe1, 0x08, 0x1a, 0x62, 0x2e, 0x87, 0xe3, 0xe6, 0x04,
+	0xa6, 0x58, 0xc3, 0x11, 0x54, 0xa7, 0x30, 0x95, 0xed, 0x8e, 0x9e, 0x85, 0xcd, 0x3f, 0xcb, 0x41,
+	0x55, 0xdd, 0xe7, 0xcb, 0x41, 0xb0, 0x9e, 0x64, 0x73, 0x2c, 0x3e, 0x64, 0x0b, 0x43, 0x2f, 0x7b,
+	0x94, 0x70, 0x1a, 0xcc, 0xaf, 0x4b, 0x71, 0x19, 0xd8, 0x8a, 0x41, 0x38, 0x89, 0x87, 0xae, 0xc2,
+	0x44, 0xc7, 0x62, 0xef, 0xf8, 0x4d, 0xc7, 0xe7, 0xc4, 0xb2, 0x68, 0xd0, 0x6c, 0x95, 0x1a, 0xcf,
+	0x2b, 0xca, 0x89, 0xeb, 0x29, 0x28, 0xce, 0x60, 0xa3, 0x55, 0x98, 0xe6, 0xc4, 0xeb, 0x52, 0x7e,
+	0xc7, 0xf1, 0x28, 0x31, 0x7a, 0xa2, 0x6c, 0x96, 0xfe, 0x28, 0xc5, 0x9f, 0x24, 0x37, 0xb2, 0x08,
+	0x78, 0x90, 0x06, 0x7d, 0x15, 0x46, 0x6d, 0xea, 0xfb, 0xe1, 0x47, 0xf8, 0x72, 0x63, 0x52, 0x91,
+	0x8f, 0xae, 0x05, 0xcb, 0x38, 0x84, 0x8b, 0xff, 0x0f, 0xce, 0xa6, 0x2d, 0x7d, 0x62, 0x95, 0x92,
+	0x9d, 0xae, 0x94, 0x6e, 0x0c, 0x9b, 0xb1, 0xf6, 0x0a, 0x90, 0xdd, 0x4b, 0xa5, 0xc6, 0xc6, 0xa3,
+	0xc7, 0x73, 0xa7, 0x3e, 0x7e, 0x3c, 0x77, 0xea, 0xd3, 0xc7, 0x73, 0xa7, 0xde, 0xdb, 0x99, 0xd3,
+	0x1e, 0xed, 0xcc, 0x69, 0x1f, 0xef, 0xcc, 0x69, 0x9f, 0xee, 0xcc, 0x69, 0x7f, 0xdd, 0x99, 0xd3,
+	0x7e, 0xf9, 0xb7, 0xb9, 0x53, 0x6f, 0xd4, 0x86, 0xfb, 0xf3, 0xeb, 0x7f, 0x06, 0x00, 0x7f, 0x25,
+	0x64, 0x8d, 0x2d, 0x2b, 0x00, 0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -1655,6 +1824,49 @@ func (m *EgressGroupPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EgressStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EgressStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EgressStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.UID)
+	copy(dAtA[i:], m.UID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.UID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExternalEntityReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1855,6 +2067,44 @@ func (m *GroupReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Host)
+	copy(dAtA[i:], m.Host)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IPBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1935,6 +2185,41 @@ func (m *IPNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *L7Protocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *L7Protocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MulticastGroupInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2033,6 +2318,25 @@ func (m *NetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InactiveRules) > 0 {
+		for iNdEx := len(m.InactiveRules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InactiveRules[iNdEx])
+			copy(dAtA[i:], m.InactiveRules[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.InactiveRules[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.Tier)
+	copy(dAtA[i:], m.Tier)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tier)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.EnforcementMode)
+	copy(dAtA[i:], m.EnforcementMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EnforcementMode)))
+	i--
+	dAtA[i] = 0x3a
 	if m.SourceRef != nil {
 		{
 			size, err := m.SourceRef.MarshalToSizedBuffer(dAtA[:i])
@@ -2302,6 +2606,20 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.L7Protocols) > 0 {
+		for iNdEx := len(m.L7Protocols) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.L7Protocols[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	i -= len(m.Name)
 	copy(dAtA[i:], m.Name)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
@@ -2528,10 +2846,10 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Multicast) > 0 {
-		for iNdEx := len(m.Multicast) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BaselineAdminNetworkPolicies) > 0 {
+		for iNdEx := len(m.BaselineAdminNetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Multicast[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BaselineAdminNetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2539,13 +2857,13 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.AntreaNetworkPolicies) > 0 {
-		for iNdEx := len(m.AntreaNetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AdminNetworkPolicies) > 0 {
+		for iNdEx := len(m.AdminNetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AntreaNetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AdminNetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2553,13 +2871,13 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.AntreaClusterNetworkPolicies) > 0 {
-		for iNdEx := len(m.AntreaClusterNetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Egresses) > 0 {
+		for iNdEx := len(m.Egresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AntreaClusterNetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Egresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2567,13 +2885,13 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.NetworkPolicies) > 0 {
-		for iNdEx := len(m.NetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Multicast) > 0 {
+		for iNdEx := len(m.Multicast) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.NetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Multicast[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2581,7 +2899,49 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AntreaNetworkPolicies) > 0 {
+		for iNdEx := len(m.AntreaNetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AntreaNetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AntreaClusterNetworkPolicies) > 0 {
+		for iNdEx := len(m.AntreaClusterNetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AntreaClusterNetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NetworkPolicies) > 0 {
+		for iNdEx := len(m.NetworkPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
 	{
@@ -2749,6 +3109,113 @@ func (m *ServiceReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrafficControlNodeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrafficControlNodeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficControlNodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i--
+	if m.TargetUnreachable {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.FlowsInstalled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.PortCreated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.NodeName)
+	copy(dAtA[i:], m.NodeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TrafficControlStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrafficControlStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficControlStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -2957,6 +3424,21 @@ func (m *EgressGroupPatch) Size() (n int) {
 	return n
 }
 
+func (m *EgressStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.UID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ExternalEntityReference) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3035,6 +3517,21 @@ func (m *GroupReference) Size() (n int) {
 	return n
 }
 
+func (m *HTTPProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *IPBlock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3066,6 +3563,19 @@ func (m *IPNet) Size() (n int) {
 	return n
 }
 
+func (m *L7Protocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *MulticastGroupInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3127,6 +3637,16 @@ func (m *NetworkPolicy) Size() (n int) {
 		l = m.SourceRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.EnforcementMode)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tier)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.InactiveRules) > 0 {
+		for _, s := range m.InactiveRules {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3241,6 +3761,12 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.L7Protocols) > 0 {
+		for _, e := range m.L7Protocols {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3323,6 +3849,24 @@ func (m *NodeStatsSummary) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Egresses) > 0 {
+		for _, e := range m.Egresses {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.AdminNetworkPolicies) > 0 {
+		for _, e := range m.AdminNetworkPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.BaselineAdminNetworkPolicies) > 0 {
+		for _, e := range m.BaselineAdminNetworkPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3389,6 +3933,40 @@ func (m *ServiceReference) Size() (n int) {
 	return n
 }
 
+func (m *TrafficControlNodeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Generation))
+	n += 2
+	n += 2
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TrafficControlStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3582,6 +4160,18 @@ func (this *EgressGroupPatch) String() string {
 	}, "")
 	return s
 }
+func (this *EgressStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EgressStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`UID:` + fmt.Sprintf("%v", this.UID) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TrafficStats), "TrafficStats", "v1alpha1.TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ExternalEntityReference) String() string {
 	if this == nil {
 		return "nil"
@@ -3640,6 +4230,18 @@ func (this *GroupReference) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPProtocol{`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *IPBlock) String() string {
 	if this == nil {
 		return "nil"
@@ -3667,6 +4269,16 @@ func (this *IPNet) String() string {
 	}, "")
 	return s
 }
+func (this *L7Protocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&L7Protocol{`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MulticastGroupInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -3711,6 +4323,9 @@ func (this *NetworkPolicy) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`TierPriority:` + valueToStringGenerated(this.TierPriority) + `,`,
 		`SourceRef:` + strings.Replace(this.SourceRef.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1) + `,`,
+		`EnforcementMode:` + fmt.Sprintf("%v", this.EnforcementMode) + `,`,
+		`Tier:` + fmt.Sprintf("%v", this.Tier) + `,`,
+		`InactiveRules:` + fmt.Sprintf("%v", this.InactiveRules) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3787,6 +4402,11 @@ func (this *NetworkPolicyRule) String() string {
 		repeatedStringForServices += strings.Replace(strings.Replace(f.String(), "Service", "Service", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForServices += "}"
+	repeatedStringForL7Protocols := "[]L7Protocol{"
+	for _, f := range this.L7Protocols {
+		repeatedStringForL7Protocols += strings.Replace(strings.Replace(f.String(), "L7Protocol", "L7Protocol", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForL7Protocols += "}"
 	s := strings.Join([]string{`&NetworkPolicyRule{`,
 		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
 		`From:` + strings.Replace(strings.Replace(this.From.String(), "NetworkPolicyPeer", "NetworkPolicyPeer", 1), `&`, ``, 1) + `,`,
@@ -3797,6 +4417,7 @@ func (this *NetworkPolicyRule) String() string {
 		`EnableLogging:` + fmt.Sprintf("%v", this.EnableLogging) + `,`,
 		`AppliedToGroups:` + fmt.Sprintf("%v", this.AppliedToGroups) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3868,12 +4489,30 @@ func (this *NodeStatsSummary) String() string {
 		repeatedStringForMulticast += strings.Replace(strings.Replace(f.String(), "MulticastGroupInfo", "MulticastGroupInfo", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForMulticast += "}"
+	repeatedStringForEgresses := "[]EgressStats{"
+	for _, f := range this.Egresses {
+		repeatedStringForEgresses += strings.Replace(strings.Replace(f.String(), "EgressStats", "EgressStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForEgresses += "}"
+	repeatedStringForAdminNetworkPolicies := "[]NetworkPolicyStats{"
+	for _, f := range this.AdminNetworkPolicies {
+		repeatedStringForAdminNetworkPolicies += strings.Replace(strings.Replace(f.String(), "NetworkPolicyStats", "NetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAdminNetworkPolicies += "}"
+	repeatedStringForBaselineAdminNetworkPolicies := "[]NetworkPolicyStats{"
+	for _, f := range this.BaselineAdminNetworkPolicies {
+		repeatedStringForBaselineAdminNetworkPolicies += strings.Replace(strings.Replace(f.String(), "NetworkPolicyStats", "NetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForBaselineAdminNetworkPolicies += "}"
 	s := strings.Join([]string{`&NodeStatsSummary{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NetworkPolicies:` + repeatedStringForNetworkPolicies + `,`,
 		`AntreaClusterNetworkPolicies:` + repeatedStringForAntreaClusterNetworkPolicies + `,`,
 		`AntreaNetworkPolicies:` + repeatedStringForAntreaNetworkPolicies + `,`,
 		`Multicast:` + repeatedStringForMulticast + `,`,
+		`Egresses:` + repeatedStringForEgresses + `,`,
+		`AdminNetworkPolicies:` + repeatedStringForAdminNetworkPolicies + `,`,
+		`BaselineAdminNetworkPolicies:` + repeatedStringForBaselineAdminNetworkPolicies + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3925,12 +4564,43 @@ func (this *ServiceReference) String() string {
 	}, "")
 	return s
 }
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *TrafficControlNodeStatus) String() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
+	s := strings.Join([]string{`&TrafficControlNodeStatus{`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`PortCreated:` + fmt.Sprintf("%v", this.PortCreated) + `,`,
+		`FlowsInstalled:` + fmt.Sprintf("%v", this.FlowsInstalled) + `,`,
+		`TargetUnreachable:` + fmt.Sprintf("%v", this.TargetUnreachable) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TrafficControlStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNodes := "[]TrafficControlNodeStatus{"
+	for _, f := range this.Nodes {
+		repeatedStringForNodes += strings.Replace(strings.Replace(f.String(), "TrafficControlNodeStatus", "TrafficControlNodeStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNodes += "}"
+	s := strings.Join([]string{`&TrafficControlStatus{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Nodes:` + repeatedStringForNodes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
 func (m *AddressGroup) Unmarshal(dAtA []byte) error {
@@ -5296,6 +5966,153 @@ func (m *EgressGroupPatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EgressStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UID = k8s_io_apimachinery_pkg_types.UID(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5897,7 +6714,7 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPBlock) Unmarshal(dAtA []byte) error {
+func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5920,17 +6737,17 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5940,30 +6757,29 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CIDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Except", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5973,25 +6789,55 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Except = append(m.Except, IPNet{})
-			if err := m.Except[len(m.Except)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6014,7 +6860,7 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPNet) Unmarshal(dAtA []byte) error {
+func (m *IPBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6037,17 +6883,17 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6057,31 +6903,30 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = append(m.IP[:0], dAtA[iNdEx:postIndex]...)
-			if m.IP == nil {
-				m.IP = []byte{}
+			if err := m.CIDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Except", wireType)
 			}
-			m.PrefixLength = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6091,12 +6936,216 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrefixLength |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Except = append(m.Except, IPNet{})
+			if err := m.Except[len(m.Except)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = append(m.IP[:0], dAtA[iNdEx:postIndex]...)
+			if m.IP == nil {
+				m.IP = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+			}
+			m.PrefixLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefixLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *L7Protocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPProtocol{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
@@ -6562,61 +7611,11 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6626,12 +7625,158 @@ func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnforcementMode = antrea_io_antrea_pkg_apis_crd_v1alpha1.EnforcementMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveRules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveRules = append(m.InactiveRules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkPolicyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkPolicyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			postIndex := iNdEx + msglen
@@ -7458,6 +8603,40 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L7Protocols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L7Protocols = append(m.L7Protocols, L7Protocol{})
+			if err := m.L7Protocols[len(m.L7Protocols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -8026,61 +9205,11 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PaginationGetOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PaginationGetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egresses", wireType)
 			}
-			m.Page = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8090,16 +9219,31 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Limit = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Egresses = append(m.Egresses, EgressStats{})
+			if err := m.Egresses[len(m.Egresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminNetworkPolicies", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8109,14 +9253,151 @@ func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminNetworkPolicies = append(m.AdminNetworkPolicies, NetworkPolicyStats{})
+			if err := m.AdminNetworkPolicies[len(m.AdminNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaselineAdminNetworkPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaselineAdminNetworkPolicies = append(m.BaselineAdminNetworkPolicies, NetworkPolicyStats{})
+			if err := m.BaselineAdminNetworkPolicies[len(m.BaselineAdminNetworkPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaginationGetOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaginationGetOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaginationGetOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -8542,6 +9823,316 @@ func (m *ServiceReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TrafficControlNodeStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrafficControlNodeStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrafficControlNodeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortCreated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PortCreated = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowsInstalled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FlowsInstalled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUnreachable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetUnreachable = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrafficControlStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrafficControlStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrafficControlStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, TrafficControlNodeStatus{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenerated(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
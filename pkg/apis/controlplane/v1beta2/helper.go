@@ -17,7 +17,8 @@ package v1beta2
 import "fmt"
 
 func (r *NetworkPolicyReference) ToString() string {
-	if r.Type == AntreaClusterNetworkPolicy {
+	switch r.Type {
+	case AntreaClusterNetworkPolicy, AdminNetworkPolicy, BaselineAdminNetworkPolicy:
 		return fmt.Sprintf("%s:%s", r.Type, r.Name)
 	}
 	return fmt.Sprintf("%s:%s/%s", r.Type, r.Namespace, r.Name)
@@ -76,6 +76,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&EgressGroup{},
 		&EgressGroupPatch{},
 		&EgressGroupList{},
+		&TrafficControlStatus{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
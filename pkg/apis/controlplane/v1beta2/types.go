@@ -180,6 +180,8 @@ const (
 	K8sNetworkPolicy           NetworkPolicyType = "K8sNetworkPolicy"
 	AntreaClusterNetworkPolicy NetworkPolicyType = "AntreaClusterNetworkPolicy"
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
+	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
+	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
 )
 
 type NetworkPolicyReference struct {
@@ -215,6 +217,15 @@ type NetworkPolicy struct {
 	TierPriority *int32 `json:"tierPriority,omitempty" protobuf:"varint,5,opt,name=tierPriority"`
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference `json:"sourceRef,omitempty" protobuf:"bytes,6,opt,name=sourceRef"`
+	// EnforcementMode specifies whether the Drop and Reject rules of this policy are enforced or
+	// only audited. An empty value means Enforce.
+	EnforcementMode crdv1alpha1.EnforcementMode `json:"enforcementMode,omitempty" protobuf:"bytes,7,opt,name=enforcementMode,casttype=antrea.io/antrea/pkg/apis/crd/v1alpha1.EnforcementMode"`
+	// Tier is the name of the Tier associated with this Network Policy.
+	// The Tier will remain empty for K8s NetworkPolicy.
+	Tier string `json:"tier,omitempty" protobuf:"bytes,8,opt,name=tier"`
+	// InactiveRules is a list of names of the rules which are not included in Rules
+	// because the current time is outside of their schedules.
+	InactiveRules []string `json:"inactiveRules,omitempty" protobuf:"bytes,9,rep,name=inactiveRules"`
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -253,6 +264,8 @@ type NetworkPolicyRule struct {
 	// Name describes the intention of this rule.
 	// Name should be unique within the policy.
 	Name string `json:"name,omitempty" protobuf:"bytes,9,opt,name=name"`
+	// L7Protocols is a list of application layer protocols which should be matched.
+	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -291,6 +304,24 @@ type Service struct {
 	ICMPCode *int32 `json:"icmpCode,omitempty" protobuf:"bytes,5,opt,name=icmpCode"`
 }
 
+// L7Protocol defines application layer protocol to match.
+type L7Protocol struct {
+	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+}
+
+// HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
+// If all fields are not provided, this matches all HTTP requests.
+type HTTPProtocol struct {
+	// Host represents the hostname present in the URI or the HTTP Host header to match.
+	// It does not contain the port associated with the host.
+	Host string `json:"host,omitempty" protobuf:"bytes,1,opt,name=host"`
+	// Method represents the HTTP method to match.
+	// It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Path represents the URI path to match (Ex. "/index.html", "/admin").
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
@@ -344,6 +375,12 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats `json:"antreaNetworkPolicies,omitempty" protobuf:"bytes,4,rep,name=antreaNetworkPolicies"`
 	// Multicast group information collected from the Node.
 	Multicast []MulticastGroupInfo `json:"multicast,omitempty" protobuf:"bytes,5,rep,name=multicast"`
+	// The TrafficStats of Egresses collected from the Node.
+	Egresses []EgressStats `json:"egresses,omitempty" protobuf:"bytes,6,rep,name=egresses"`
+	// The TrafficStats of AdminNetworkPolicies collected from the Node.
+	AdminNetworkPolicies []NetworkPolicyStats `json:"adminNetworkPolicies,omitempty" protobuf:"bytes,7,rep,name=adminNetworkPolicies"`
+	// The TrafficStats of BaselineAdminNetworkPolicies collected from the Node.
+	BaselineAdminNetworkPolicies []NetworkPolicyStats `json:"baselineAdminNetworkPolicies,omitempty" protobuf:"bytes,8,rep,name=baselineAdminNetworkPolicies"`
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -364,6 +401,16 @@ type NetworkPolicyStats struct {
 	RuleTrafficStats []statsv1alpha1.RuleTrafficStats `json:"ruleTrafficStats,omitempty" protobuf:"bytes,3,rep,name=ruleTrafficStats"`
 }
 
+// EgressStats contains the information and traffic stats of an Egress.
+type EgressStats struct {
+	// The name of the Egress.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// The UID of the Egress.
+	UID types.UID `json:"uid,omitempty" protobuf:"bytes,2,opt,name=uid,casttype=k8s.io/apimachinery/pkg/types.UID"`
+	// The stats of the Egress.
+	TrafficStats statsv1alpha1.TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,3,opt,name=trafficStats"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NetworkPolicyStatus is the status of a NetworkPolicy.
@@ -434,3 +481,33 @@ type EgressGroupList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []EgressGroup `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrafficControlStatus is the status of a TrafficControl. It's used by the antrea-agents to report the realization
+// status of a TrafficControl to the antrea-controller.
+type TrafficControlStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Nodes contains statuses produced on a list of Nodes.
+	Nodes []TrafficControlNodeStatus `json:"nodes,omitempty" protobuf:"bytes,2,rep,name=nodes"`
+}
+
+// TrafficControlNodeStatus is the status of a TrafficControl on a Node.
+type TrafficControlNodeStatus struct {
+	// The name of the Node that produces the status.
+	NodeName string `json:"nodeName,omitempty" protobuf:"bytes,1,opt,name=nodeName"`
+	// The generation realized by the Node.
+	Generation int64 `json:"generation,omitempty" protobuf:"varint,2,opt,name=generation"`
+	// Whether the target port and the return port (if any) have been created.
+	PortCreated bool `json:"portCreated,omitempty" protobuf:"varint,3,opt,name=portCreated"`
+	// Whether the flows of the TrafficControl have been installed.
+	FlowsInstalled bool `json:"flowsInstalled,omitempty" protobuf:"varint,4,opt,name=flowsInstalled"`
+	// Whether the target port cannot be attached to the OVS bridge, e.g. the target device doesn't exist.
+	TargetUnreachable bool `json:"targetUnreachable,omitempty" protobuf:"varint,5,opt,name=targetUnreachable"`
+	// A human-readable message indicating why the TrafficControl is not realized, if any.
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
@@ -139,6 +139,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressStats)(nil), (*controlplane.EgressStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_EgressStats_To_controlplane_EgressStats(a.(*EgressStats), b.(*controlplane.EgressStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.EgressStats)(nil), (*EgressStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_EgressStats_To_v1beta2_EgressStats(a.(*controlplane.EgressStats), b.(*EgressStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExternalEntityReference)(nil), (*controlplane.ExternalEntityReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ExternalEntityReference_To_controlplane_ExternalEntityReference(a.(*ExternalEntityReference), b.(*controlplane.ExternalEntityReference), scope)
 	}); err != nil {
@@ -179,6 +189,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPProtocol)(nil), (*HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(a.(*controlplane.HTTPProtocol), b.(*HTTPProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPBlock)(nil), (*controlplane.IPBlock)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPBlock_To_controlplane_IPBlock(a.(*IPBlock), b.(*controlplane.IPBlock), scope)
 	}); err != nil {
@@ -199,6 +219,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*L7Protocol)(nil), (*controlplane.L7Protocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(a.(*L7Protocol), b.(*controlplane.L7Protocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.L7Protocol)(nil), (*L7Protocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_L7Protocol_To_v1beta2_L7Protocol(a.(*controlplane.L7Protocol), b.(*L7Protocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MulticastGroupInfo)(nil), (*controlplane.MulticastGroupInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_MulticastGroupInfo_To_controlplane_MulticastGroupInfo(a.(*MulticastGroupInfo), b.(*controlplane.MulticastGroupInfo), scope)
 	}); err != nil {
@@ -359,6 +389,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TrafficControlNodeStatus)(nil), (*controlplane.TrafficControlNodeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_TrafficControlNodeStatus_To_controlplane_TrafficControlNodeStatus(a.(*TrafficControlNodeStatus), b.(*controlplane.TrafficControlNodeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.TrafficControlNodeStatus)(nil), (*TrafficControlNodeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_TrafficControlNodeStatus_To_v1beta2_TrafficControlNodeStatus(a.(*controlplane.TrafficControlNodeStatus), b.(*TrafficControlNodeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficControlStatus)(nil), (*controlplane.TrafficControlStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_TrafficControlStatus_To_controlplane_TrafficControlStatus(a.(*TrafficControlStatus), b.(*controlplane.TrafficControlStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.TrafficControlStatus)(nil), (*TrafficControlStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_TrafficControlStatus_To_v1beta2_TrafficControlStatus(a.(*controlplane.TrafficControlStatus), b.(*TrafficControlStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*PaginationGetOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_url_Values_To_v1beta2_PaginationGetOptions(a.(*url.Values), b.(*PaginationGetOptions), scope)
 	}); err != nil {
@@ -861,6 +911,30 @@ func Convert_controlplane_EgressGroupPatch_To_v1beta2_EgressGroupPatch(in *contr
 	return autoConvert_controlplane_EgressGroupPatch_To_v1beta2_EgressGroupPatch(in, out, s)
 }
 
+func autoConvert_v1beta2_EgressStats_To_controlplane_EgressStats(in *EgressStats, out *controlplane.EgressStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UID = types.UID(in.UID)
+	out.TrafficStats = in.TrafficStats
+	return nil
+}
+
+// Convert_v1beta2_EgressStats_To_controlplane_EgressStats is an autogenerated conversion function.
+func Convert_v1beta2_EgressStats_To_controlplane_EgressStats(in *EgressStats, out *controlplane.EgressStats, s conversion.Scope) error {
+	return autoConvert_v1beta2_EgressStats_To_controlplane_EgressStats(in, out, s)
+}
+
+func autoConvert_controlplane_EgressStats_To_v1beta2_EgressStats(in *controlplane.EgressStats, out *EgressStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.UID = types.UID(in.UID)
+	out.TrafficStats = in.TrafficStats
+	return nil
+}
+
+// Convert_controlplane_EgressStats_To_v1beta2_EgressStats is an autogenerated conversion function.
+func Convert_controlplane_EgressStats_To_v1beta2_EgressStats(in *controlplane.EgressStats, out *EgressStats, s conversion.Scope) error {
+	return autoConvert_controlplane_EgressStats_To_v1beta2_EgressStats(in, out, s)
+}
+
 func autoConvert_v1beta2_ExternalEntityReference_To_controlplane_ExternalEntityReference(in *ExternalEntityReference, out *controlplane.ExternalEntityReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Namespace = in.Namespace
@@ -957,6 +1031,30 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Method = in.Method
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol is an autogenerated conversion function.
+func Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.HTTPProtocol, out *HTTPProtocol, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Method = in.Method
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol is an autogenerated conversion function.
+func Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.HTTPProtocol, out *HTTPProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_IPBlock_To_controlplane_IPBlock(in *IPBlock, out *controlplane.IPBlock, s conversion.Scope) error {
 	if err := Convert_v1beta2_IPNet_To_controlplane_IPNet(&in.CIDR, &out.CIDR, s); err != nil {
 		return err
@@ -1005,6 +1103,26 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 	return autoConvert_controlplane_IPNet_To_v1beta2_IPNet(in, out, s)
 }
 
+func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
+	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
+	return nil
+}
+
+// Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol is an autogenerated conversion function.
+func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in, out, s)
+}
+
+func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
+	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
+	return nil
+}
+
+// Convert_controlplane_L7Protocol_To_v1beta2_L7Protocol is an autogenerated conversion function.
+func Convert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
+	return autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in, out, s)
+}
+
 func autoConvert_v1beta2_MulticastGroupInfo_To_controlplane_MulticastGroupInfo(in *MulticastGroupInfo, out *controlplane.MulticastGroupInfo, s conversion.Scope) error {
 	out.Group = in.Group
 	out.Pods = *(*[]controlplane.PodReference)(unsafe.Pointer(&in.Pods))
@@ -1068,6 +1186,9 @@ func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *Network
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1alpha1.EnforcementMode(in.EnforcementMode)
+	out.Tier = in.Tier
+	out.InactiveRules = *(*[]string)(unsafe.Pointer(&in.InactiveRules))
 	return nil
 }
 
@@ -1093,6 +1214,9 @@ func autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *control
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1alpha1.EnforcementMode(in.EnforcementMode)
+	out.Tier = in.Tier
+	out.InactiveRules = *(*[]string)(unsafe.Pointer(&in.InactiveRules))
 	return nil
 }
 
@@ -1231,6 +1355,7 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.EnableLogging = in.EnableLogging
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.Name = in.Name
+	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	return nil
 }
 
@@ -1253,6 +1378,7 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.Action = (*v1alpha1.RuleAction)(unsafe.Pointer(in.Action))
 	out.EnableLogging = in.EnableLogging
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
+	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	return nil
 }
 
@@ -1337,6 +1463,9 @@ func autoConvert_v1beta2_NodeStatsSummary_To_controlplane_NodeStatsSummary(in *N
 	out.AntreaClusterNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]controlplane.MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.Egresses = *(*[]controlplane.EgressStats)(unsafe.Pointer(&in.Egresses))
+	out.AdminNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AdminNetworkPolicies))
+	out.BaselineAdminNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.BaselineAdminNetworkPolicies))
 	return nil
 }
 
@@ -1351,6 +1480,9 @@ func autoConvert_controlplane_NodeStatsSummary_To_v1beta2_NodeStatsSummary(in *c
 	out.AntreaClusterNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.Egresses = *(*[]EgressStats)(unsafe.Pointer(&in.Egresses))
+	out.AdminNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AdminNetworkPolicies))
+	out.BaselineAdminNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.BaselineAdminNetworkPolicies))
 	return nil
 }
 
@@ -1477,3 +1609,55 @@ func autoConvert_controlplane_ServiceReference_To_v1beta2_ServiceReference(in *c
 func Convert_controlplane_ServiceReference_To_v1beta2_ServiceReference(in *controlplane.ServiceReference, out *ServiceReference, s conversion.Scope) error {
 	return autoConvert_controlplane_ServiceReference_To_v1beta2_ServiceReference(in, out, s)
 }
+
+func autoConvert_v1beta2_TrafficControlNodeStatus_To_controlplane_TrafficControlNodeStatus(in *TrafficControlNodeStatus, out *controlplane.TrafficControlNodeStatus, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	out.Generation = in.Generation
+	out.PortCreated = in.PortCreated
+	out.FlowsInstalled = in.FlowsInstalled
+	out.TargetUnreachable = in.TargetUnreachable
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta2_TrafficControlNodeStatus_To_controlplane_TrafficControlNodeStatus is an autogenerated conversion function.
+func Convert_v1beta2_TrafficControlNodeStatus_To_controlplane_TrafficControlNodeStatus(in *TrafficControlNodeStatus, out *controlplane.TrafficControlNodeStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_TrafficControlNodeStatus_To_controlplane_TrafficControlNodeStatus(in, out, s)
+}
+
+func autoConvert_controlplane_TrafficControlNodeStatus_To_v1beta2_TrafficControlNodeStatus(in *controlplane.TrafficControlNodeStatus, out *TrafficControlNodeStatus, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	out.Generation = in.Generation
+	out.PortCreated = in.PortCreated
+	out.FlowsInstalled = in.FlowsInstalled
+	out.TargetUnreachable = in.TargetUnreachable
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_controlplane_TrafficControlNodeStatus_To_v1beta2_TrafficControlNodeStatus is an autogenerated conversion function.
+func Convert_controlplane_TrafficControlNodeStatus_To_v1beta2_TrafficControlNodeStatus(in *controlplane.TrafficControlNodeStatus, out *TrafficControlNodeStatus, s conversion.Scope) error {
+	return autoConvert_controlplane_TrafficControlNodeStatus_To_v1beta2_TrafficControlNodeStatus(in, out, s)
+}
+
+func autoConvert_v1beta2_TrafficControlStatus_To_controlplane_TrafficControlStatus(in *TrafficControlStatus, out *controlplane.TrafficControlStatus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Nodes = *(*[]controlplane.TrafficControlNodeStatus)(unsafe.Pointer(&in.Nodes))
+	return nil
+}
+
+// Convert_v1beta2_TrafficControlStatus_To_controlplane_TrafficControlStatus is an autogenerated conversion function.
+func Convert_v1beta2_TrafficControlStatus_To_controlplane_TrafficControlStatus(in *TrafficControlStatus, out *controlplane.TrafficControlStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_TrafficControlStatus_To_controlplane_TrafficControlStatus(in, out, s)
+}
+
+func autoConvert_controlplane_TrafficControlStatus_To_v1beta2_TrafficControlStatus(in *controlplane.TrafficControlStatus, out *TrafficControlStatus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Nodes = *(*[]TrafficControlNodeStatus)(unsafe.Pointer(&in.Nodes))
+	return nil
+}
+
+// Convert_controlplane_TrafficControlStatus_To_v1beta2_TrafficControlStatus is an autogenerated conversion function.
+func Convert_controlplane_TrafficControlStatus_To_v1beta2_TrafficControlStatus(in *controlplane.TrafficControlStatus, out *TrafficControlStatus, s conversion.Scope) error {
+	return autoConvert_controlplane_TrafficControlStatus_To_v1beta2_TrafficControlStatus(in, out, s)
+}
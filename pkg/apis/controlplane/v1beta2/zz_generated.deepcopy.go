@@ -384,6 +384,23 @@ func (in *EgressGroupPatch) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressStats) DeepCopyInto(out *EgressStats) {
+	*out = *in
+	out.TrafficStats = in.TrafficStats
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressStats.
+func (in *EgressStats) DeepCopy() *EgressStats {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalEntityReference) DeepCopyInto(out *ExternalEntityReference) {
 	*out = *in
@@ -514,6 +531,22 @@ func (in IPAddress) DeepCopy() IPAddress {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProtocol) DeepCopyInto(out *HTTPProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProtocol.
+func (in *HTTPProtocol) DeepCopy() *HTTPProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlock) DeepCopyInto(out *IPBlock) {
 	*out = *in
@@ -559,6 +592,27 @@ func (in *IPNet) DeepCopy() *IPNet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPProtocol)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L7Protocol.
+func (in *L7Protocol) DeepCopy() *L7Protocol {
+	if in == nil {
+		return nil
+	}
+	out := new(L7Protocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MulticastGroupInfo) DeepCopyInto(out *MulticastGroupInfo) {
 	*out = *in
@@ -628,6 +682,11 @@ func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
 		*out = new(NetworkPolicyReference)
 		**out = **in
 	}
+	if in.InactiveRules != nil {
+		in, out := &in.InactiveRules, &out.InactiveRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -774,6 +833,13 @@ func (in *NetworkPolicyRule) DeepCopyInto(out *NetworkPolicyRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.L7Protocols != nil {
+		in, out := &in.L7Protocols, &out.L7Protocols
+		*out = make([]L7Protocol, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -890,6 +956,25 @@ func (in *NodeStatsSummary) DeepCopyInto(out *NodeStatsSummary) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Egresses != nil {
+		in, out := &in.Egresses, &out.Egresses
+		*out = make([]EgressStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdminNetworkPolicies != nil {
+		in, out := &in.AdminNetworkPolicies, &out.AdminNetworkPolicies
+		*out = make([]NetworkPolicyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BaselineAdminNetworkPolicies != nil {
+		in, out := &in.BaselineAdminNetworkPolicies, &out.BaselineAdminNetworkPolicies
+		*out = make([]NetworkPolicyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1008,3 +1093,50 @@ func (in *ServiceReference) DeepCopy() *ServiceReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficControlNodeStatus) DeepCopyInto(out *TrafficControlNodeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficControlNodeStatus.
+func (in *TrafficControlNodeStatus) DeepCopy() *TrafficControlNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficControlNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficControlStatus) DeepCopyInto(out *TrafficControlStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]TrafficControlNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficControlStatus.
+func (in *TrafficControlStatus) DeepCopy() *TrafficControlStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficControlStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficControlStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
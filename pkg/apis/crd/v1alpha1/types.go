@@ -38,6 +38,10 @@ const (
 	ComponentRouting       TraceflowComponent = "Routing"
 	ComponentNetworkPolicy TraceflowComponent = "NetworkPolicy"
 	ComponentForwarding    TraceflowComponent = "Forwarding"
+	ComponentEgress        TraceflowComponent = "Egress"
+	// ComponentMulticlusterGateway is the multi-cluster Gateway of a member
+	// cluster, through which the packet reaches a peer member cluster.
+	ComponentMulticlusterGateway TraceflowComponent = "MulticlusterGateway"
 )
 
 type TraceflowAction string
@@ -51,6 +55,14 @@ const (
 	// ActionForwardedOutOfOverlay indicates that the packet has been forwarded out of the network
 	// managed by Antrea. This indicates that the Traceflow request can be considered complete.
 	ActionForwardedOutOfOverlay TraceflowAction = "ForwardedOutOfOverlay"
+	// ActionMarkedForSNAT indicates that the packet has been marked to be SNAT'd to the Egress IP by the Egress Node.
+	ActionMarkedForSNAT TraceflowAction = "MarkedForSNAT"
+	// ActionForwardedToEgressNode indicates that the packet has been tunnelled to the remote Egress Node that holds
+	// the Egress IP.
+	ActionForwardedToEgressNode TraceflowAction = "ForwardedToEgressNode"
+	// ActionForwardedToRemoteCluster indicates that the packet has been forwarded by the multi-cluster Gateway to
+	// the Gateway of a peer member cluster.
+	ActionForwardedToRemoteCluster TraceflowAction = "ForwardedToRemoteCluster"
 )
 
 // List the supported protocols and their codes in traceflow.
@@ -124,6 +136,13 @@ type TraceflowSpec struct {
 	// Timeout specifies the timeout of the Traceflow in seconds. Defaults
 	// to 20 seconds if not set.
 	Timeout uint16 `json:"timeout,omitempty"`
+	// SampleCount specifies the maximum number of packets to capture in a
+	// live-traffic Traceflow. Each sampled packet is the first packet of a
+	// different connection that matches the packet spec. When it is larger
+	// than 1, the Traceflow keeps sampling packets until SampleCount packets
+	// are traced or the Traceflow times out, and the results are reported
+	// in Samples and PacketCounts of the status. Defaults to 1 if not set.
+	SampleCount int32 `json:"sampleCount,omitempty"`
 }
 
 // Source describes the source spec of the traceflow.
@@ -132,9 +151,14 @@ type Source struct {
 	Namespace string `json:"namespace,omitempty"`
 	// Pod is the source pod.
 	Pod string `json:"pod,omitempty"`
-	// IP is the source IPv4 or IPv6 address. IP as the source is supported
-	// only for live-traffic Traceflow.
+	// IP is the source IPv4 or IPv6 address. In a live-traffic Traceflow, it
+	// matches the source of the captured packet. In a regular Traceflow, it
+	// is the address of an external client, and Node must be set.
 	IP string `json:"ip,omitempty"`
+	// Node is the Node the packet from the external client IP enters the
+	// cluster from. The packet is injected into OVS from the Antrea gateway
+	// of the Node. It can be set only for a regular Traceflow with IP set.
+	Node string `json:"node,omitempty"`
 }
 
 // Destination describes the destination spec of the traceflow.
@@ -235,11 +259,56 @@ type TraceflowStatus struct {
 	Results []NodeResult `json:"results,omitempty"`
 	// CapturedPacket is the captured packet in live-traffic Traceflow.
 	CapturedPacket *Packet `json:"capturedPacket,omitempty"`
+	// Samples are the packets captured in a live-traffic Traceflow with
+	// SampleCount larger than 1, with the observations on each Node.
+	Samples []TraceflowSample `json:"samples,omitempty"`
+	// PacketCounts aggregates the results of the sampled packets.
+	PacketCounts *TraceflowPacketCounts `json:"packetCounts,omitempty"`
+}
+
+// TraceflowSample is a packet sampled in a live-traffic Traceflow.
+type TraceflowSample struct {
+	// Packet is the sampled packet, as captured on the first Node.
+	Packet Packet `json:"packet,omitempty"`
+	// Results is the collection of the observations of the packet on
+	// different Nodes.
+	Results []NodeResult `json:"results,omitempty"`
+}
+
+// TraceflowPacketCounts describes the number of sampled packets by their
+// final action.
+type TraceflowPacketCounts struct {
+	// Sampled is the number of sampled packets.
+	Sampled int32 `json:"sampled,omitempty"`
+	// Delivered is the number of packets delivered to the destination.
+	Delivered int32 `json:"delivered,omitempty"`
+	// Forwarded is the number of packets forwarded out of the cluster
+	// network.
+	Forwarded int32 `json:"forwarded,omitempty"`
+	// Dropped is the number of packets dropped or rejected.
+	Dropped int32 `json:"dropped,omitempty"`
+	// NetworkPolicies is the number of packets dropped or rejected by each
+	// NetworkPolicy.
+	NetworkPolicies []NetworkPolicyPacketCount `json:"networkPolicies,omitempty"`
+}
+
+// NetworkPolicyPacketCount describes the number of sampled packets dropped
+// or rejected by a NetworkPolicy.
+type NetworkPolicyPacketCount struct {
+	// NetworkPolicy is the combination of Namespace and NetworkPolicyName.
+	NetworkPolicy string `json:"networkPolicy,omitempty"`
+	// Action is the action applied to the packets, Dropped or Rejected.
+	Action TraceflowAction `json:"action,omitempty"`
+	// Count is the number of packets.
+	Count int32 `json:"count,omitempty"`
 }
 
 type NodeResult struct {
 	// Node is the node of the observation.
 	Node string `json:"node,omitempty" yaml:"node,omitempty"`
+	// ClusterID is the ID of the member cluster the Node belongs to. It is
+	// only set in a Traceflow whose destination is a multi-cluster Service.
+	ClusterID string `json:"clusterID,omitempty" yaml:"clusterID,omitempty"`
 	// Role of the node like sender, receiver, etc.
 	Role string `json:"role,omitempty" yaml:"role,omitempty"`
 	// Timestamp is the timestamp of the observations on the node.
@@ -270,6 +339,10 @@ type Observation struct {
 	TranslatedDstIP string `json:"translatedDstIP,omitempty" yaml:"translatedDstIP,omitempty"`
 	// TunnelDstIP is the tunnel destination IP.
 	TunnelDstIP string `json:"tunnelDstIP,omitempty" yaml:"tunnelDstIP,omitempty"`
+	// Egress is the name of the Egress applied to the packet.
+	Egress string `json:"egress,omitempty" yaml:"egress,omitempty"`
+	// EgressNode is the name of the Node that holds the Egress IP.
+	EgressNode string `json:"egressNode,omitempty" yaml:"egressNode,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -320,6 +393,10 @@ type NetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// EnforcementMode specifies whether the Drop and Reject rules of the policy
+	// are enforced or only audited. Defaults to Enforce.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
@@ -345,6 +422,9 @@ type NetworkPolicyStatus struct {
 	CurrentNodesRealized int32 `json:"currentNodesRealized"`
 	// The total number of nodes that should realize the NetworkPolicy.
 	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
+	// The names of the rules which are not enforced currently because the current
+	// time is outside of their schedules.
+	InactiveRules []string `json:"inactiveRules,omitempty"`
 }
 
 // Rule describes the traffic allowed to/from the workloads selected by
@@ -361,6 +441,12 @@ type Rule struct {
 	// empty, this rule matches all protocols supported.
 	// +optional
 	Protocols []NetworkPolicyProtocol `json:"protocols,omitempty"`
+	// Set of layer 7 protocols matched by the rule. If this field is set, the
+	// matched traffic is redirected to the layer 7 engine of the Antrea Agent,
+	// which allows the requests matching any of the protocols and drops the
+	// others. It can only be used in a rule with Allow action.
+	// +optional
+	L7Protocols []L7Protocol `json:"l7Protocols,omitempty"`
 	// Rule is matched if traffic originates from workloads selected by
 	// this field. If this field is empty, this rule matches all sources.
 	// +optional
@@ -389,6 +475,36 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []NetworkPolicyPeer `json:"appliedTo,omitempty"`
+	// Schedule restricts the times at which this rule is enforced. If this field
+	// is not set, the rule is always enforced.
+	// +optional
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
+}
+
+// RuleSchedule describes the times at which a rule is enforced, as a list of
+// time windows repeated every day or on some days of the week.
+type RuleSchedule struct {
+	// TimeZone is the IANA name of the time zone in which the time windows are
+	// interpreted, e.g. "America/New_York". Defaults to "UTC".
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Windows is the list of time windows during which the rule is enforced.
+	// The rule is enforced if the current time falls within any of them.
+	Windows []TimeWindow `json:"windows"`
+}
+
+// TimeWindow describes a window of time which repeats every day, or on the
+// selected days of the week.
+type TimeWindow struct {
+	// Start is the time at which the window begins, in the format of "HH:MM".
+	Start string `json:"start"`
+	// End is the time at which the window ends, in the format of "HH:MM". If End
+	// is not later than Start, the window ends on the next day.
+	End string `json:"end"`
+	// DaysOfWeek are the days on which the window begins, e.g. "Monday". If this
+	// field is empty, the window begins every day.
+	// +optional
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
 }
 
 // NetworkPolicyPeer describes the grouping selector of workloads.
@@ -445,7 +561,10 @@ type NetworkPolicyPeer struct {
 	// +optional
 	ServiceAccount *NamespacedName `json:"serviceAccount,omitempty"`
 	// Select certain Nodes which match the label selector.
-	// A NodeSelector cannot be set in AppliedTo field or set with any other selector.
+	// A NodeSelector cannot be set with any other selector. It can only be set in
+	// the AppliedTo field of ClusterNetworkPolicies when the NodeNetworkPolicy
+	// feature is enabled, in which case the rules are applied to the host network
+	// traffic of the selected Nodes.
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
 }
@@ -467,6 +586,12 @@ type IPBlock struct {
 	// CIDR is a string representing the IP Block
 	// Valid examples are "192.168.1.1/24".
 	CIDR string `json:"cidr"`
+	// Except is a slice of CIDRs that should not be included within the IP Block.
+	// Valid examples are "192.168.1.1/24". Except values will be rejected if they
+	// are not strict subsets of the CIDR range. Except is only supported in the
+	// peers of the rules of Antrea-native policies.
+	// +optional
+	Except []string `json:"except,omitempty"`
 }
 
 // NetworkPolicyPort describes the port and protocol to match in a rule.
@@ -503,6 +628,19 @@ const (
 	RuleActionReject RuleAction = "Reject"
 )
 
+// EnforcementMode describes how the rules of a policy are enforced.
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce indicates that the traffic matching a rule is
+	// handled according to the rule's action.
+	EnforcementModeEnforce EnforcementMode = "Enforce"
+	// EnforcementModeAudit indicates that the traffic matching a Drop or Reject
+	// rule is allowed and logged instead of being dropped or rejected, which can
+	// be used to evaluate the impact of a policy before enforcing it.
+	EnforcementModeAudit EnforcementMode = "Audit"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type NetworkPolicyList struct {
@@ -553,6 +691,10 @@ type ClusterNetworkPolicySpec struct {
 	// field within a Rule.
 	// +optional
 	Egress []Rule `json:"egress,omitempty"`
+	// EnforcementMode specifies whether the Drop and Reject rules of the policy
+	// are enforced or only audited. Defaults to Enforce.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -618,3 +760,24 @@ type ICMPProtocol struct {
 	ICMPType *int32 `json:"icmpType,omitempty"`
 	ICMPCode *int32 `json:"icmpCode,omitempty"`
 }
+
+// L7Protocol defines the layer 7 protocols matched by a rule. All fields should
+// be used as a standalone field.
+type L7Protocol struct {
+	HTTP *HTTPProtocol `json:"http,omitempty"`
+}
+
+// HTTPProtocol matches HTTP requests with specific host, method, and path. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all HTTP requests.
+type HTTPProtocol struct {
+	// Host represents the hostname present in the URI or the HTTP Host header
+	// to match. It does not contain the port associated with the host. "*" can
+	// be used as a wildcard, e.g. "*.example.com".
+	Host string `json:"host,omitempty"`
+	// Method represents the HTTP method to match, e.g. "GET".
+	Method string `json:"method,omitempty"`
+	// Path represents the URI path to match. "*" can be used as a wildcard,
+	// e.g. "/api/v1/*".
+	Path string `json:"path,omitempty"`
+}
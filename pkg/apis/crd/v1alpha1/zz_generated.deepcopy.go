@@ -32,7 +32,7 @@ func (in *ClusterNetworkPolicy) DeepCopyInto(out *ClusterNetworkPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -140,6 +140,22 @@ func (in *Destination) DeepCopy() *Destination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProtocol) DeepCopyInto(out *HTTPProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProtocol.
+func (in *HTTPProtocol) DeepCopy() *HTTPProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ICMPEchoRequestHeader) DeepCopyInto(out *ICMPEchoRequestHeader) {
 	*out = *in
@@ -185,6 +201,11 @@ func (in *ICMPProtocol) DeepCopy() *ICMPProtocol {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlock) DeepCopyInto(out *IPBlock) {
 	*out = *in
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -235,6 +256,27 @@ func (in *IPv6Header) DeepCopy() *IPv6Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPProtocol)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L7Protocol.
+func (in *L7Protocol) DeepCopy() *L7Protocol {
+	if in == nil {
+		return nil
+	}
+	out := new(L7Protocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -257,7 +299,7 @@ func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -312,13 +354,29 @@ func (in *NetworkPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyPacketCount) DeepCopyInto(out *NetworkPolicyPacketCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyPacketCount.
+func (in *NetworkPolicyPacketCount) DeepCopy() *NetworkPolicyPacketCount {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyPacketCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyPeer) DeepCopyInto(out *NetworkPolicyPeer) {
 	*out = *in
 	if in.IPBlock != nil {
 		in, out := &in.IPBlock, &out.IPBlock
 		*out = new(IPBlock)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.PodSelector != nil {
 		in, out := &in.PodSelector, &out.PodSelector
@@ -455,6 +513,11 @@ func (in *NetworkPolicySpec) DeepCopy() *NetworkPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyStatus) DeepCopyInto(out *NetworkPolicyStatus) {
 	*out = *in
+	if in.InactiveRules != nil {
+		in, out := &in.InactiveRules, &out.InactiveRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -566,6 +629,13 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.L7Protocols != nil {
+		in, out := &in.L7Protocols, &out.L7Protocols
+		*out = make([]L7Protocol, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.From != nil {
 		in, out := &in.From, &out.From
 		*out = make([]NetworkPolicyPeer, len(*in))
@@ -592,6 +662,11 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(RuleSchedule)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -605,6 +680,29 @@ func (in *Rule) DeepCopy() *Rule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSchedule) DeepCopyInto(out *RuleSchedule) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]TimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSchedule.
+func (in *RuleSchedule) DeepCopy() *RuleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Source) DeepCopyInto(out *Source) {
 	*out = *in
@@ -713,6 +811,27 @@ func (in *TierSpec) DeepCopy() *TierSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeWindow) DeepCopyInto(out *TimeWindow) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeWindow.
+func (in *TimeWindow) DeepCopy() *TimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Traceflow) DeepCopyInto(out *Traceflow) {
 	*out = *in
@@ -774,6 +893,51 @@ func (in *TraceflowList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowPacketCounts) DeepCopyInto(out *TraceflowPacketCounts) {
+	*out = *in
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = make([]NetworkPolicyPacketCount, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowPacketCounts.
+func (in *TraceflowPacketCounts) DeepCopy() *TraceflowPacketCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowPacketCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TraceflowSample) DeepCopyInto(out *TraceflowSample) {
+	*out = *in
+	in.Packet.DeepCopyInto(&out.Packet)
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TraceflowSample.
+func (in *TraceflowSample) DeepCopy() *TraceflowSample {
+	if in == nil {
+		return nil
+	}
+	out := new(TraceflowSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraceflowSpec) DeepCopyInto(out *TraceflowSpec) {
 	*out = *in
@@ -812,6 +976,18 @@ func (in *TraceflowStatus) DeepCopyInto(out *TraceflowStatus) {
 		*out = new(Packet)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]TraceflowSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PacketCounts != nil {
+		in, out := &in.PacketCounts, &out.PacketCounts
+		*out = new(TraceflowPacketCounts)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
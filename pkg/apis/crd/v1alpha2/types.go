@@ -202,6 +202,42 @@ type Egress struct {
 type EgressStatus struct {
 	// The name of the Node that holds the Egress IP.
 	EgressNode string `json:"egressNode"`
+	// EgressIPs lists the Node that holds each IP of an Egress using multiple IPs. It's empty if the Egress uses a
+	// single EgressIP, in which case EgressNode is used.
+	EgressIPs []EgressIPStatus `json:"egressIPs,omitempty"`
+	// Conditions of the Egress.
+	Conditions []EgressCondition `json:"conditions,omitempty"`
+}
+
+type EgressConditionType string
+
+const (
+	// EgressIPAllocated means the Egress IPs have been allocated from the ExternalIPPool. It's set by antrea-controller and
+	// only applies to Egresses that specify an ExternalIPPool.
+	EgressIPAllocated EgressConditionType = "IPAllocated"
+	// EgressIPAssigned means all Egress IPs have been assigned to Nodes. It's set by the antrea-agent that claims an Egress
+	// IP, and its reason is "Failover" if the IP was previously held by another Node.
+	EgressIPAssigned EgressConditionType = "Assigned"
+	// EgressNodeUnavailable means no Node selected by the ExternalIPPool is available to hold an Egress IP.
+	EgressNodeUnavailable EgressConditionType = "NodeUnavailable"
+)
+
+type EgressCondition struct {
+	Type               EgressConditionType `json:"type"`
+	Status             v1.ConditionStatus  `json:"status"`
+	LastTransitionTime metav1.Time         `json:"lastTransitionTime,omitempty"`
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
+}
+
+// EgressIPStatus represents the assignment of an Egress IP.
+type EgressIPStatus struct {
+	// The Egress IP.
+	EgressIP string `json:"egressIP"`
+	// The name of the Node that holds the Egress IP.
+	EgressNode string `json:"egressNode"`
 }
 
 // EgressSpec defines the desired state for Egress.
@@ -213,11 +249,30 @@ type EgressSpec struct {
 	// If ExternalIPPool is non-empty, it can be empty and will be assigned by Antrea automatically.
 	// If both ExternalIPPool and EgressIP are non-empty, the IP must be in the pool.
 	EgressIP string `json:"egressIP,omitempty"`
+	// EgressIPs specifies multiple SNAT IP addresses for the selected workloads. The selected Pods are distributed
+	// across the IPs by hashing, and the IPs are distributed across the Nodes selected by ExternalIPPool.
+	// It is mutually exclusive with EgressIP.
+	// If ExternalIPPool is non-empty, it can be empty and NumEgressIPs IPs will be assigned by Antrea automatically.
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	// NumEgressIPs specifies the number of IPs that should be allocated from ExternalIPPool to EgressIPs.
+	// It can only be set when ExternalIPPool is non-empty and EgressIP is empty.
+	NumEgressIPs int32 `json:"numEgressIPs,omitempty"`
 	// ExternalIPPool specifies the IP Pool that the EgressIP should be allocated from.
 	// If it is empty, the specified EgressIP must be assigned to a Node manually.
 	// If it is non-empty, the EgressIP will be assigned to a Node specified by the pool automatically and will failover
 	// to a different Node when the Node becomes unreachable.
 	ExternalIPPool string `json:"externalIPPool"`
+	// Bandwidth specifies the rate limit of the traffic sent through each Egress IP.
+	// It is enforced by the Node the Egress IP is assigned to.
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+}
+
+// Bandwidth specifies the rate limit of traffic.
+type Bandwidth struct {
+	// Rate specifies the maximum rate of the traffic in bits per second, e.g. 500k, 10M, 1G.
+	Rate string `json:"rate"`
+	// Burst specifies the maximum burst size of the traffic in bits, e.g. 500k, 10M, 1G.
+	Burst string `json:"burst"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -392,7 +447,6 @@ type IPPoolList struct {
 
 // +genclient
 // +genclient:nonNamespaced
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TrafficControl allows mirroring or redirecting the traffic Pods send or receive. It enables users to monitor and
@@ -405,6 +459,9 @@ type TrafficControl struct {
 
 	// Specification of the desired behavior of TrafficControl.
 	Spec TrafficControlSpec `json:"spec"`
+
+	// Most recently observed status of the TrafficControl.
+	Status TrafficControlStatus `json:"status"`
 }
 
 type TrafficControlSpec struct {
@@ -504,6 +561,44 @@ type ERSPANTunnel struct {
 	HardwareID *int32 `json:"hardwareID,omitempty"`
 }
 
+// TrafficControlStatus represents the current status of a TrafficControl.
+type TrafficControlStatus struct {
+	// The generation observed by Antrea.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// The number of Nodes that have realized the TrafficControl.
+	CurrentNodesRealized int32 `json:"currentNodesRealized"`
+	// The total number of Nodes that should realize the TrafficControl, i.e. the Nodes running the selected Pods.
+	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
+	// The number of Pods selected by the TrafficControl.
+	SelectedPods int32 `json:"selectedPods"`
+	// Conditions of the TrafficControl.
+	Conditions []TrafficControlCondition `json:"conditions,omitempty"`
+}
+
+type TrafficControlConditionType string
+
+const (
+	// TrafficControlPortCreated means the target port and the return port (if any) have been created on all Nodes that
+	// should realize the TrafficControl.
+	TrafficControlPortCreated TrafficControlConditionType = "PortCreated"
+	// TrafficControlFlowsInstalled means the flows of the TrafficControl have been installed on all Nodes that should
+	// realize the TrafficControl.
+	TrafficControlFlowsInstalled TrafficControlConditionType = "FlowsInstalled"
+	// TrafficControlTargetUnreachable means the target port cannot be attached on some Nodes, e.g. the target device
+	// doesn't exist.
+	TrafficControlTargetUnreachable TrafficControlConditionType = "TargetUnreachable"
+)
+
+type TrafficControlCondition struct {
+	Type               TrafficControlConditionType `json:"type"`
+	Status             v1.ConditionStatus          `json:"status"`
+	LastTransitionTime metav1.Time                 `json:"lastTransitionTime,omitempty"`
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message string `json:"message,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type TrafficControlList struct {
@@ -56,6 +56,22 @@ func (in *AppliedTo) DeepCopy() *AppliedTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bandwidth) DeepCopyInto(out *Bandwidth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bandwidth.
+func (in *Bandwidth) DeepCopy() *Bandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(Bandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterGroup) DeepCopyInto(out *ClusterGroup) {
 	*out = *in
@@ -159,7 +175,7 @@ func (in *Egress) DeepCopyInto(out *Egress) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -181,6 +197,39 @@ func (in *Egress) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressCondition) DeepCopyInto(out *EgressCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressCondition.
+func (in *EgressCondition) DeepCopy() *EgressCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressIPStatus) DeepCopyInto(out *EgressIPStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPStatus.
+func (in *EgressIPStatus) DeepCopy() *EgressIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressList) DeepCopyInto(out *EgressList) {
 	*out = *in
@@ -218,6 +267,16 @@ func (in *EgressList) DeepCopyObject() runtime.Object {
 func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
 	*out = *in
 	in.AppliedTo.DeepCopyInto(&out.AppliedTo)
+	if in.EgressIPs != nil {
+		in, out := &in.EgressIPs, &out.EgressIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(Bandwidth)
+		**out = **in
+	}
 	return
 }
 
@@ -234,6 +293,18 @@ func (in *EgressSpec) DeepCopy() *EgressSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressStatus) DeepCopyInto(out *EgressStatus) {
 	*out = *in
+	if in.EgressIPs != nil {
+		in, out := &in.EgressIPs, &out.EgressIPs
+		*out = make([]EgressIPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]EgressCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -519,12 +590,14 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	if in.IPBlock != nil {
 		in, out := &in.IPBlock, &out.IPBlock
 		*out = new(v1alpha1.IPBlock)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.IPBlocks != nil {
 		in, out := &in.IPBlocks, &out.IPBlocks
 		*out = make([]v1alpha1.IPBlock, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ServiceReference != nil {
 		in, out := &in.ServiceReference, &out.ServiceReference
@@ -861,6 +934,7 @@ func (in *TrafficControl) DeepCopyInto(out *TrafficControl) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -882,6 +956,23 @@ func (in *TrafficControl) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficControlCondition) DeepCopyInto(out *TrafficControlCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficControlCondition.
+func (in *TrafficControlCondition) DeepCopy() *TrafficControlCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficControlCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficControlList) DeepCopyInto(out *TrafficControlList) {
 	*out = *in
@@ -984,6 +1075,29 @@ func (in *TrafficControlSpec) DeepCopy() *TrafficControlSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficControlStatus) DeepCopyInto(out *TrafficControlStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]TrafficControlCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficControlStatus.
+func (in *TrafficControlStatus) DeepCopy() *TrafficControlStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficControlStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UDPTunnel) DeepCopyInto(out *UDPTunnel) {
 	*out = *in
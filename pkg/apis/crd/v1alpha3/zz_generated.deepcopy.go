@@ -119,7 +119,9 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	if in.IPBlocks != nil {
 		in, out := &in.IPBlocks, &out.IPBlocks
 		*out = make([]v1alpha1.IPBlock, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ServiceReference != nil {
 		in, out := &in.ServiceReference, &out.ServiceReference
@@ -52,6 +52,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyStatsList{},
 		&MulticastGroup{},
 		&MulticastGroupList{},
+		&EgressStats{},
+		&EgressStatsList{},
+		&AdminNetworkPolicyStats{},
+		&AdminNetworkPolicyStatsList{},
+		&BaselineAdminNetworkPolicyStats{},
+		&BaselineAdminNetworkPolicyStatsList{},
 	)
 	return nil
 }
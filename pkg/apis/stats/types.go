@@ -20,6 +20,29 @@ import (
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// AdminNetworkPolicyStats is the statistics of an AdminNetworkPolicy.
+type AdminNetworkPolicyStats struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	// The traffic stats of the AdminNetworkPolicy.
+	TrafficStats TrafficStats
+	// The traffic stats of the AdminNetworkPolicy rules.
+	RuleTrafficStats []RuleTrafficStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminNetworkPolicyStatsList is a list of AdminNetworkPolicyStats.
+type AdminNetworkPolicyStatsList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// List of AdminNetworkPolicyStats.
+	Items []AdminNetworkPolicyStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // AntreaClusterNetworkPolicyStats is the statistics of a Antrea ClusterNetworkPolicy.
 type AntreaClusterNetworkPolicyStats struct {
 	metav1.TypeMeta
@@ -65,6 +88,51 @@ type AntreaNetworkPolicyStatsList struct {
 	Items []AntreaNetworkPolicyStats
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BaselineAdminNetworkPolicyStats is the statistics of a BaselineAdminNetworkPolicy.
+type BaselineAdminNetworkPolicyStats struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+	// The traffic stats of the BaselineAdminNetworkPolicy.
+	TrafficStats TrafficStats
+	// The traffic stats of the BaselineAdminNetworkPolicy rules.
+	RuleTrafficStats []RuleTrafficStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BaselineAdminNetworkPolicyStatsList is a list of BaselineAdminNetworkPolicyStats.
+type BaselineAdminNetworkPolicyStatsList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// List of BaselineAdminNetworkPolicyStats.
+	Items []BaselineAdminNetworkPolicyStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressStats is the statistics of an Egress.
+type EgressStats struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// The traffic stats of the Egress.
+	TrafficStats TrafficStats
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressStatsList is a list of EgressStats.
+type EgressStatsList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	// List of EgressStats.
+	Items []EgressStats
+}
+
 // PodReference represents a Pod Reference.
 type PodReference struct {
 	// The name of this Pod.
@@ -40,10 +40,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AdminNetworkPolicyStats) Reset()      { *m = AdminNetworkPolicyStats{} }
+func (*AdminNetworkPolicyStats) ProtoMessage() {}
+func (*AdminNetworkPolicyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{0}
+}
+func (m *AdminNetworkPolicyStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminNetworkPolicyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdminNetworkPolicyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminNetworkPolicyStats.Merge(m, src)
+}
+func (m *AdminNetworkPolicyStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminNetworkPolicyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminNetworkPolicyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminNetworkPolicyStats proto.InternalMessageInfo
+
+func (m *AdminNetworkPolicyStatsList) Reset()      { *m = AdminNetworkPolicyStatsList{} }
+func (*AdminNetworkPolicyStatsList) ProtoMessage() {}
+func (*AdminNetworkPolicyStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{1}
+}
+func (m *AdminNetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdminNetworkPolicyStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdminNetworkPolicyStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminNetworkPolicyStatsList.Merge(m, src)
+}
+func (m *AdminNetworkPolicyStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdminNetworkPolicyStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminNetworkPolicyStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminNetworkPolicyStatsList proto.InternalMessageInfo
+
 func (m *AntreaClusterNetworkPolicyStats) Reset()      { *m = AntreaClusterNetworkPolicyStats{} }
 func (*AntreaClusterNetworkPolicyStats) ProtoMessage() {}
 func (*AntreaClusterNetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{0}
+	return fileDescriptor_91b517c6fa558473, []int{2}
 }
 func (m *AntreaClusterNetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,7 +127,7 @@ var xxx_messageInfo_AntreaClusterNetworkPolicyStats proto.InternalMessageInfo
 func (m *AntreaClusterNetworkPolicyStatsList) Reset()      { *m = AntreaClusterNetworkPolicyStatsList{} }
 func (*AntreaClusterNetworkPolicyStatsList) ProtoMessage() {}
 func (*AntreaClusterNetworkPolicyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{1}
+	return fileDescriptor_91b517c6fa558473, []int{3}
 }
 func (m *AntreaClusterNetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -99,7 +155,7 @@ var xxx_messageInfo_AntreaClusterNetworkPolicyStatsList proto.InternalMessageInf
 func (m *AntreaNetworkPolicyStats) Reset()      { *m = AntreaNetworkPolicyStats{} }
 func (*AntreaNetworkPolicyStats) ProtoMessage() {}
 func (*AntreaNetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{2}
+	return fileDescriptor_91b517c6fa558473, []int{4}
 }
 func (m *AntreaNetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,7 +183,7 @@ var xxx_messageInfo_AntreaNetworkPolicyStats proto.InternalMessageInfo
 func (m *AntreaNetworkPolicyStatsList) Reset()      { *m = AntreaNetworkPolicyStatsList{} }
 func (*AntreaNetworkPolicyStatsList) ProtoMessage() {}
 func (*AntreaNetworkPolicyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{3}
+	return fileDescriptor_91b517c6fa558473, []int{5}
 }
 func (m *AntreaNetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,10 +208,122 @@ func (m *AntreaNetworkPolicyStatsList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AntreaNetworkPolicyStatsList proto.InternalMessageInfo
 
+func (m *BaselineAdminNetworkPolicyStats) Reset()      { *m = BaselineAdminNetworkPolicyStats{} }
+func (*BaselineAdminNetworkPolicyStats) ProtoMessage() {}
+func (*BaselineAdminNetworkPolicyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{6}
+}
+func (m *BaselineAdminNetworkPolicyStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaselineAdminNetworkPolicyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BaselineAdminNetworkPolicyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaselineAdminNetworkPolicyStats.Merge(m, src)
+}
+func (m *BaselineAdminNetworkPolicyStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaselineAdminNetworkPolicyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaselineAdminNetworkPolicyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaselineAdminNetworkPolicyStats proto.InternalMessageInfo
+
+func (m *BaselineAdminNetworkPolicyStatsList) Reset()      { *m = BaselineAdminNetworkPolicyStatsList{} }
+func (*BaselineAdminNetworkPolicyStatsList) ProtoMessage() {}
+func (*BaselineAdminNetworkPolicyStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{7}
+}
+func (m *BaselineAdminNetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaselineAdminNetworkPolicyStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BaselineAdminNetworkPolicyStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaselineAdminNetworkPolicyStatsList.Merge(m, src)
+}
+func (m *BaselineAdminNetworkPolicyStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaselineAdminNetworkPolicyStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaselineAdminNetworkPolicyStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaselineAdminNetworkPolicyStatsList proto.InternalMessageInfo
+
+func (m *EgressStats) Reset()      { *m = EgressStats{} }
+func (*EgressStats) ProtoMessage() {}
+func (*EgressStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{8}
+}
+func (m *EgressStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressStats.Merge(m, src)
+}
+func (m *EgressStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressStats proto.InternalMessageInfo
+
+func (m *EgressStatsList) Reset()      { *m = EgressStatsList{} }
+func (*EgressStatsList) ProtoMessage() {}
+func (*EgressStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{9}
+}
+func (m *EgressStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EgressStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EgressStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EgressStatsList.Merge(m, src)
+}
+func (m *EgressStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EgressStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EgressStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EgressStatsList proto.InternalMessageInfo
+
 func (m *MulticastGroup) Reset()      { *m = MulticastGroup{} }
 func (*MulticastGroup) ProtoMessage() {}
 func (*MulticastGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{4}
+	return fileDescriptor_91b517c6fa558473, []int{10}
 }
 func (m *MulticastGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +351,7 @@ var xxx_messageInfo_MulticastGroup proto.InternalMessageInfo
 func (m *MulticastGroupList) Reset()      { *m = MulticastGroupList{} }
 func (*MulticastGroupList) ProtoMessage() {}
 func (*MulticastGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{5}
+	return fileDescriptor_91b517c6fa558473, []int{11}
 }
 func (m *MulticastGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +379,7 @@ var xxx_messageInfo_MulticastGroupList proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{6}
+	return fileDescriptor_91b517c6fa558473, []int{12}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +407,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatsList) Reset()      { *m = NetworkPolicyStatsList{} }
 func (*NetworkPolicyStatsList) ProtoMessage() {}
 func (*NetworkPolicyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{7}
+	return fileDescriptor_91b517c6fa558473, []int{13}
 }
 func (m *NetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +435,7 @@ var xxx_messageInfo_NetworkPolicyStatsList proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{8}
+	return fileDescriptor_91b517c6fa558473, []int{14}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +463,7 @@ var xxx_messageInfo_PodReference proto.InternalMessageInfo
 func (m *RuleTrafficStats) Reset()      { *m = RuleTrafficStats{} }
 func (*RuleTrafficStats) ProtoMessage() {}
 func (*RuleTrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{9}
+	return fileDescriptor_91b517c6fa558473, []int{15}
 }
 func (m *RuleTrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +491,7 @@ var xxx_messageInfo_RuleTrafficStats proto.InternalMessageInfo
 func (m *TrafficStats) Reset()      { *m = TrafficStats{} }
 func (*TrafficStats) ProtoMessage() {}
 func (*TrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{10}
+	return fileDescriptor_91b517c6fa558473, []int{16}
 }
 func (m *TrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,10 +517,16 @@ func (m *TrafficStats) XXX_DiscardUnknown() {
 var xxx_messageInfo_TrafficStats proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*AdminNetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AdminNetworkPolicyStats")
+	proto.RegisterType((*AdminNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AdminNetworkPolicyStatsList")
 	proto.RegisterType((*AntreaClusterNetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaClusterNetworkPolicyStats")
 	proto.RegisterType((*AntreaClusterNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaClusterNetworkPolicyStatsList")
 	proto.RegisterType((*AntreaNetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaNetworkPolicyStats")
 	proto.RegisterType((*AntreaNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaNetworkPolicyStatsList")
+	proto.RegisterType((*BaselineAdminNetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.BaselineAdminNetworkPolicyStats")
+	proto.RegisterType((*BaselineAdminNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.BaselineAdminNetworkPolicyStatsList")
+	proto.RegisterType((*EgressStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.EgressStats")
+	proto.RegisterType((*EgressStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.EgressStatsList")
 	proto.RegisterType((*MulticastGroup)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroup")
 	proto.RegisterType((*MulticastGroupList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroupList")
 	proto.RegisterType((*NetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NetworkPolicyStats")
@@ -367,54 +541,60 @@ func init() {
 }
 
 var fileDescriptor_91b517c6fa558473 = []byte{
-	// 704 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6b, 0x13, 0x4d,
-	0x18, 0xcf, 0x34, 0x2d, 0x6d, 0xa6, 0x79, 0xdf, 0xb7, 0xef, 0x20, 0x12, 0x8a, 0x6c, 0x4b, 0x7a,
-	0x89, 0xa0, 0xb3, 0xb6, 0x48, 0x29, 0xe2, 0xc5, 0xf5, 0x20, 0x05, 0x1b, 0xc3, 0xd4, 0x83, 0x88,
-	0xa2, 0x93, 0xcd, 0x64, 0x33, 0x26, 0xbb, 0xb3, 0xec, 0xcc, 0x56, 0x7a, 0xeb, 0x07, 0xf0, 0xe0,
-	0xa7, 0xf0, 0xb3, 0xf4, 0x58, 0x6f, 0xf5, 0x52, 0x6c, 0x44, 0xf0, 0x2a, 0x5e, 0x3c, 0xca, 0xcc,
-	0x6e, 0xba, 0xd9, 0x86, 0xd2, 0xed, 0x25, 0x1e, 0xf4, 0x94, 0x9d, 0xe7, 0xdf, 0xef, 0xf7, 0x3c,
-	0xcf, 0x6f, 0x86, 0xc0, 0x2d, 0x1a, 0xa8, 0x88, 0x51, 0xcc, 0x85, 0x9d, 0x7c, 0xd9, 0x61, 0xdf,
-	0xb3, 0x69, 0xc8, 0xa5, 0x2d, 0x15, 0x55, 0xd2, 0xde, 0x5b, 0xa7, 0x83, 0xb0, 0x47, 0xd7, 0x6d,
-	0x8f, 0x05, 0x2c, 0xa2, 0x8a, 0x75, 0x70, 0x18, 0x09, 0x25, 0x50, 0x23, 0x89, 0x7f, 0xc5, 0x05,
-	0x4e, 0x6b, 0x84, 0x7d, 0x0f, 0xeb, 0x4c, 0x6c, 0x32, 0xf1, 0x28, 0x73, 0xf9, 0xb6, 0xc7, 0x55,
-	0x2f, 0x6e, 0x63, 0x57, 0xf8, 0xb6, 0x27, 0x3c, 0x61, 0x9b, 0x02, 0xed, 0xb8, 0x6b, 0x4e, 0xe6,
-	0x60, 0xbe, 0x92, 0xc2, 0xcb, 0x77, 0xfb, 0x5b, 0xd2, 0xf0, 0x09, 0xb9, 0x4f, 0xdd, 0x1e, 0x0f,
-	0x58, 0xb4, 0x9f, 0xb1, 0xf2, 0x99, 0xa2, 0xf6, 0xde, 0x04, 0x9d, 0x65, 0xfb, 0xa2, 0xac, 0x28,
-	0x0e, 0x14, 0xf7, 0xd9, 0x44, 0xc2, 0xe6, 0x65, 0x09, 0xd2, 0xed, 0x31, 0x9f, 0x9e, 0xcf, 0xab,
-	0xff, 0x9c, 0x81, 0x2b, 0x0f, 0x4c, 0xc3, 0x0f, 0x07, 0xb1, 0x54, 0x2c, 0x6a, 0x32, 0xf5, 0x56,
-	0x44, 0xfd, 0x96, 0x18, 0x70, 0x77, 0x7f, 0x57, 0xb7, 0x8e, 0x5e, 0xc3, 0x05, 0xcd, 0xb3, 0x43,
-	0x15, 0xad, 0x81, 0x55, 0xd0, 0x58, 0xdc, 0xb8, 0x83, 0x13, 0x38, 0x3c, 0x0e, 0x97, 0x4d, 0x4c,
-	0x47, 0xe3, 0xbd, 0x75, 0xfc, 0xa4, 0xfd, 0x86, 0xb9, 0x6a, 0x87, 0x29, 0xea, 0xa0, 0xc3, 0x93,
-	0x95, 0xd2, 0xf0, 0x64, 0x05, 0x66, 0x36, 0x72, 0x56, 0x15, 0x85, 0xb0, 0xaa, 0x22, 0xda, 0xed,
-	0x72, 0xd7, 0x20, 0xd6, 0x66, 0x0c, 0xca, 0x26, 0x2e, 0xba, 0x14, 0xfc, 0x74, 0x2c, 0xdb, 0xb9,
-	0x96, 0x62, 0x55, 0xc7, 0xad, 0x24, 0x87, 0x80, 0x0e, 0x00, 0x5c, 0x8a, 0xe2, 0x01, 0x1b, 0x0f,
-	0xa9, 0x95, 0x57, 0xcb, 0x8d, 0xc5, 0x8d, 0x7b, 0xc5, 0x61, 0xc9, 0xb9, 0x0a, 0x4e, 0x2d, 0x85,
-	0x5e, 0x3a, 0xef, 0x21, 0x13, 0x68, 0xf5, 0x1f, 0x00, 0xae, 0x5d, 0x32, 0xfa, 0xc7, 0x5c, 0x2a,
-	0xf4, 0x62, 0x62, 0xfc, 0xb8, 0xd8, 0xf8, 0x75, 0xb6, 0x19, 0xfe, 0x52, 0xca, 0x6a, 0x61, 0x64,
-	0x19, 0x1b, 0x7d, 0x00, 0xe7, 0xb8, 0x62, 0xbe, 0x9e, 0xb9, 0x6e, 0x7e, 0xbb, 0x78, 0xf3, 0x97,
-	0x70, 0x77, 0xfe, 0x49, 0x51, 0xe7, 0xb6, 0x75, 0x7d, 0x92, 0xc0, 0xd4, 0xbf, 0xcf, 0xc0, 0x5a,
-	0x92, 0xf9, 0x57, 0x69, 0xd3, 0x52, 0xda, 0x57, 0x00, 0x6f, 0x5c, 0x34, 0xf3, 0x29, 0x48, 0xcc,
-	0xcb, 0x4b, 0xcc, 0xb9, 0xaa, 0xc4, 0x8a, 0x6b, 0x0b, 0xc0, 0x7f, 0x77, 0xe2, 0x81, 0xe2, 0x2e,
-	0x95, 0xea, 0x51, 0x24, 0xe2, 0x70, 0x0a, 0x8a, 0x5a, 0x83, 0x73, 0x9e, 0x86, 0x32, 0x52, 0xaa,
-	0x64, 0xcc, 0x0c, 0x3e, 0x49, 0x7c, 0xe8, 0x19, 0x9c, 0x0d, 0x45, 0x67, 0xb4, 0xf7, 0x2b, 0xc8,
-	0xad, 0x25, 0x3a, 0x84, 0x75, 0x59, 0xc4, 0x02, 0x97, 0x39, 0xd5, 0xb4, 0xf6, 0x6c, 0x4b, 0x74,
-	0x24, 0x31, 0x15, 0xeb, 0x1f, 0x01, 0x44, 0xf9, 0x9e, 0xa7, 0xb0, 0xd1, 0x97, 0xf9, 0x8d, 0x6e,
-	0x15, 0xef, 0x27, 0x4f, 0xf5, 0x82, 0x3d, 0x7e, 0x03, 0x10, 0xfd, 0x19, 0xaf, 0x43, 0xfd, 0x13,
-	0x80, 0xd7, 0x7f, 0xcb, 0xa5, 0xa4, 0xf9, 0x15, 0xde, 0x2f, 0xde, 0x63, 0xe1, 0xeb, 0x48, 0x61,
-	0x75, 0x5c, 0xbe, 0x68, 0x15, 0xce, 0x06, 0xd4, 0x67, 0xa6, 0x99, 0x4a, 0x26, 0xe6, 0x26, 0xf5,
-	0x19, 0x31, 0x1e, 0x64, 0xc3, 0x8a, 0xfe, 0x95, 0x21, 0x75, 0x59, 0x7a, 0x9f, 0xfe, 0x4f, 0xc3,
-	0x2a, 0xcd, 0x91, 0x83, 0x64, 0x31, 0xf5, 0x0f, 0x00, 0x4e, 0x3c, 0x80, 0x05, 0x70, 0xa6, 0xbf,
-	0xe7, 0x77, 0x00, 0xe6, 0xdc, 0xe8, 0x26, 0x9c, 0x0f, 0xa9, 0xdb, 0x67, 0x4a, 0x1a, 0x9e, 0x65,
-	0xe7, 0xbf, 0xb4, 0xca, 0x7c, 0x2b, 0x31, 0x93, 0x91, 0x5f, 0xbf, 0x30, 0xed, 0x7d, 0xc5, 0x12,
-	0x9a, 0xe5, 0x6c, 0xd8, 0x8e, 0x36, 0x92, 0xc4, 0x87, 0x6e, 0xc1, 0x05, 0xc9, 0xa4, 0xe4, 0x22,
-	0xd0, 0xaf, 0x8c, 0x8e, 0x3b, 0xdb, 0xfe, 0x6e, 0x6a, 0x27, 0x67, 0x11, 0x4e, 0xf3, 0xf0, 0xd4,
-	0x2a, 0x1d, 0x9d, 0x5a, 0xa5, 0xe3, 0x53, 0xab, 0x74, 0x30, 0xb4, 0xc0, 0xe1, 0xd0, 0x02, 0x47,
-	0x43, 0x0b, 0x1c, 0x0f, 0x2d, 0xf0, 0x79, 0x68, 0x81, 0xf7, 0x5f, 0xac, 0xd2, 0xf3, 0x46, 0xd1,
-	0xbf, 0xd3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x73, 0x1f, 0x1a, 0xcb, 0x79, 0x0b, 0x00, 0x00,
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0xce, 0x24, 0xad, 0xda, 0x4c, 0x73, 0x6f, 0x7b, 0xad, 0x2b, 0x88, 0x0a, 0x72, 0xab, 0x74,
+	0x13, 0x24, 0xb0, 0x69, 0x05, 0x55, 0x85, 0xd8, 0xd4, 0x08, 0xa1, 0x4a, 0x34, 0x44, 0x2e, 0x0b,
+	0x54, 0x81, 0x60, 0xe2, 0x4c, 0x9c, 0x21, 0xb1, 0xc7, 0xf2, 0x4c, 0x8a, 0xba, 0xeb, 0x03, 0xb0,
+	0xe0, 0x29, 0x78, 0x96, 0x4a, 0x6c, 0xca, 0xae, 0x6c, 0x2a, 0x92, 0x0a, 0x89, 0x25, 0x88, 0x0d,
+	0x4b, 0xe4, 0xb1, 0x53, 0xdb, 0x8d, 0x4c, 0x9c, 0x8d, 0x41, 0xd0, 0x55, 0xec, 0x39, 0x3f, 0xdf,
+	0x77, 0xce, 0xf9, 0x7c, 0x46, 0x0a, 0xdc, 0x40, 0x36, 0x77, 0x31, 0x52, 0x08, 0x55, 0xfd, 0x27,
+	0xd5, 0xe9, 0x98, 0x2a, 0x72, 0x08, 0x53, 0x19, 0x47, 0x9c, 0xa9, 0x7b, 0xab, 0xa8, 0xeb, 0xb4,
+	0xd1, 0xaa, 0x6a, 0x62, 0x1b, 0xbb, 0x88, 0xe3, 0xa6, 0xe2, 0xb8, 0x94, 0x53, 0xa9, 0xea, 0xfb,
+	0x3f, 0x27, 0x54, 0x09, 0x72, 0x38, 0x1d, 0x53, 0xf1, 0x22, 0x15, 0x11, 0xa9, 0x0c, 0x23, 0x17,
+	0x6f, 0x98, 0x84, 0xb7, 0x7b, 0x0d, 0xc5, 0xa0, 0x96, 0x6a, 0x52, 0x93, 0xaa, 0x22, 0x41, 0xa3,
+	0xd7, 0x12, 0x6f, 0xe2, 0x45, 0x3c, 0xf9, 0x89, 0x17, 0x6f, 0x75, 0x36, 0x98, 0xe0, 0xe3, 0x10,
+	0x0b, 0x19, 0x6d, 0x62, 0x63, 0x77, 0x3f, 0x64, 0x65, 0x61, 0x8e, 0xd4, 0xbd, 0x11, 0x3a, 0x8b,
+	0x6a, 0x52, 0x94, 0xdb, 0xb3, 0x39, 0xb1, 0xf0, 0x48, 0xc0, 0xfa, 0xb8, 0x00, 0x66, 0xb4, 0xb1,
+	0x85, 0xce, 0xc7, 0x55, 0xbe, 0xe4, 0xe1, 0xe5, 0xcd, 0xa6, 0x45, 0xec, 0x1a, 0xe6, 0xaf, 0xa8,
+	0xdb, 0xa9, 0xd3, 0x2e, 0x31, 0xf6, 0x77, 0x38, 0xe2, 0x4c, 0x7a, 0x01, 0x67, 0x3d, 0x7e, 0x4d,
+	0xc4, 0x51, 0x19, 0x2c, 0x83, 0xea, 0xdc, 0xda, 0x4d, 0xc5, 0x87, 0x51, 0xa2, 0x30, 0x61, 0xa7,
+	0x3c, 0x6f, 0x65, 0x6f, 0x55, 0x79, 0xd4, 0x78, 0x89, 0x0d, 0xbe, 0x8d, 0x39, 0xd2, 0xa4, 0xc3,
+	0x93, 0xa5, 0xdc, 0xe0, 0x64, 0x09, 0x86, 0x67, 0xfa, 0x59, 0x56, 0xc9, 0x81, 0x25, 0xee, 0xa2,
+	0x56, 0x8b, 0x18, 0x02, 0xb1, 0x9c, 0x17, 0x28, 0xeb, 0x4a, 0xda, 0x61, 0x28, 0x8f, 0x23, 0xd1,
+	0xda, 0xff, 0x01, 0x56, 0x29, 0x7a, 0xaa, 0xc7, 0x10, 0xa4, 0x03, 0x00, 0x17, 0xdc, 0x5e, 0x17,
+	0x47, 0x5d, 0xca, 0x85, 0xe5, 0x42, 0x75, 0x6e, 0xed, 0x4e, 0x7a, 0x58, 0xfd, 0x5c, 0x06, 0xad,
+	0x1c, 0x40, 0x2f, 0x9c, 0xb7, 0xe8, 0x23, 0x68, 0x95, 0x53, 0x00, 0xaf, 0x24, 0xb4, 0xfc, 0x21,
+	0x61, 0x5c, 0x7a, 0x3a, 0xd2, 0x76, 0x25, 0x5d, 0xdb, 0xbd, 0x68, 0xd1, 0xf4, 0x85, 0x80, 0xcd,
+	0xec, 0xf0, 0x24, 0xd2, 0xf2, 0x16, 0x9c, 0x26, 0x1c, 0x5b, 0x5e, 0xaf, 0xbd, 0xa2, 0x37, 0xd3,
+	0x17, 0x9d, 0xc0, 0x59, 0xfb, 0x27, 0x40, 0x9b, 0xde, 0xf2, 0xf2, 0xea, 0x7e, 0xfa, 0xca, 0xf7,
+	0x3c, 0x5c, 0xda, 0x14, 0x09, 0xef, 0x75, 0x7b, 0x8c, 0x63, 0xf7, 0x42, 0x60, 0x59, 0x09, 0xec,
+	0x1b, 0x80, 0x2b, 0x63, 0x5a, 0x9f, 0x81, 0xd0, 0xec, 0xb8, 0xd0, 0xb6, 0x26, 0x10, 0xda, 0xcf,
+	0xb9, 0x27, 0x08, 0xee, 0x6b, 0x1e, 0x96, 0xfd, 0xc8, 0x0b, 0xa5, 0x65, 0xa5, 0xb4, 0x4f, 0x00,
+	0x5e, 0x4d, 0xea, 0x79, 0x06, 0x12, 0x33, 0xe3, 0x12, 0xd3, 0x26, 0x95, 0xd8, 0x44, 0xcb, 0x4c,
+	0x43, 0x0c, 0x77, 0x89, 0x8d, 0x2f, 0x6e, 0xcb, 0xcc, 0x97, 0xd9, 0x98, 0xd6, 0xff, 0xd6, 0xcb,
+	0x6c, 0x0c, 0xf7, 0x04, 0xc1, 0xf5, 0x01, 0x9c, 0xbb, 0x6f, 0xba, 0x98, 0xb1, 0x3f, 0x56, 0x5c,
+	0x95, 0x77, 0x00, 0xce, 0x47, 0x6a, 0xcc, 0x60, 0x8a, 0xbb, 0xf1, 0x29, 0xde, 0x4e, 0x5f, 0x5c,
+	0x84, 0x67, 0xd2, 0xf5, 0x03, 0xe0, 0xbf, 0xdb, 0xbd, 0x2e, 0x27, 0x06, 0x62, 0xfc, 0x81, 0x4b,
+	0x7b, 0x4e, 0x06, 0x43, 0x5b, 0x81, 0xd3, 0xa6, 0x07, 0x25, 0xa6, 0x55, 0x0c, 0x99, 0x09, 0x7c,
+	0xdd, 0xb7, 0x49, 0x4f, 0xe0, 0x94, 0x43, 0x9b, 0xc3, 0xef, 0x76, 0x82, 0x89, 0xd6, 0x69, 0x53,
+	0xc7, 0x2d, 0xec, 0x62, 0xdb, 0xc0, 0x5a, 0x29, 0xc8, 0x3d, 0x55, 0xa7, 0x4d, 0xa6, 0x8b, 0x8c,
+	0x95, 0xf7, 0x00, 0x4a, 0xf1, 0x9a, 0x33, 0x18, 0xe2, 0xb3, 0xf8, 0x10, 0x37, 0xd2, 0xd7, 0x13,
+	0xa7, 0x9a, 0x30, 0xc7, 0xcf, 0x00, 0x4a, 0x7f, 0xc7, 0x76, 0xaf, 0x7c, 0x00, 0xf0, 0xd2, 0x2f,
+	0xd9, 0xa6, 0x28, 0x3e, 0xc2, 0xbb, 0xe9, 0x6b, 0x4c, 0xbd, 0x40, 0x11, 0x2c, 0x45, 0xe5, 0x2b,
+	0x2d, 0xc3, 0x29, 0x1b, 0x59, 0x58, 0x14, 0x53, 0x0c, 0xc5, 0x5c, 0x43, 0x16, 0xd6, 0x85, 0x45,
+	0x52, 0x61, 0xd1, 0xfb, 0x65, 0x0e, 0x32, 0x70, 0xf0, 0x3d, 0xfd, 0x17, 0xb8, 0x15, 0x6b, 0x43,
+	0x83, 0x1e, 0xfa, 0x54, 0xde, 0x02, 0x38, 0x72, 0x81, 0xa5, 0xc0, 0xc9, 0x7e, 0xce, 0xaf, 0x01,
+	0x8c, 0x99, 0xa5, 0x6b, 0x70, 0xc6, 0x41, 0x46, 0x07, 0x73, 0x26, 0x78, 0x16, 0xb4, 0xf9, 0x20,
+	0xcb, 0x4c, 0xdd, 0x3f, 0xd6, 0x87, 0x76, 0x6f, 0xc3, 0x34, 0xf6, 0x39, 0xf6, 0x69, 0x16, 0xc2,
+	0x66, 0x6b, 0xde, 0xa1, 0xee, 0xdb, 0xa4, 0xeb, 0x70, 0x96, 0x61, 0xc6, 0x08, 0xb5, 0xbd, 0x2d,
+	0xe3, 0xf9, 0x9d, 0x4d, 0x7f, 0x27, 0x38, 0xd7, 0xcf, 0x3c, 0xb4, 0xda, 0x61, 0x5f, 0xce, 0x1d,
+	0xf5, 0xe5, 0xdc, 0x71, 0x5f, 0xce, 0x1d, 0x0c, 0x64, 0x70, 0x38, 0x90, 0xc1, 0xd1, 0x40, 0x06,
+	0xc7, 0x03, 0x19, 0x7c, 0x1c, 0xc8, 0xe0, 0xcd, 0xa9, 0x9c, 0xdb, 0xad, 0xa6, 0xfd, 0x2b, 0xe7,
+	0xc7, 0x00, 0x23, 0x93, 0xf2, 0x33, 0xf5, 0x11, 0x00, 0x00,
 }
 
-func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
+func (m *AdminNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -424,12 +604,12 @@ func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AntreaClusterNetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *AdminNetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AntreaClusterNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AdminNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -471,7 +651,7 @@ func (m *AntreaClusterNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *AntreaClusterNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
+func (m *AdminNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -481,12 +661,12 @@ func (m *AntreaClusterNetworkPolicyStatsList) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AntreaClusterNetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AdminNetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AntreaClusterNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AdminNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -518,7 +698,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *AntreaNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
+func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -528,12 +708,12 @@ func (m *AntreaNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AntreaNetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *AntreaClusterNetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AntreaNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AntreaClusterNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -575,7 +755,7 @@ func (m *AntreaNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *AntreaNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
+func (m *AntreaClusterNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -585,12 +765,12 @@ func (m *AntreaNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AntreaNetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AntreaClusterNetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AntreaNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AntreaClusterNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -622,7 +802,7 @@ func (m *AntreaNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MulticastGroup) Marshal() (dAtA []byte, err error) {
+func (m *AntreaNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -632,20 +812,20 @@ func (m *MulticastGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MulticastGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *AntreaNetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MulticastGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AntreaNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Pods) > 0 {
-		for iNdEx := len(m.Pods) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.RuleTrafficStats) > 0 {
+		for iNdEx := len(m.RuleTrafficStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Pods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RuleTrafficStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -656,9 +836,14 @@ func (m *MulticastGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	i -= len(m.Group)
-	copy(dAtA[i:], m.Group)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
 	{
@@ -674,7 +859,7 @@ func (m *MulticastGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MulticastGroupList) Marshal() (dAtA []byte, err error) {
+func (m *AntreaNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -684,12 +869,12 @@ func (m *MulticastGroupList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MulticastGroupList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AntreaNetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MulticastGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AntreaNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -721,7 +906,7 @@ func (m *MulticastGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NetworkPolicyStats) Marshal() (dAtA []byte, err error) {
+func (m *BaselineAdminNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -731,16 +916,30 @@ func (m *NetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaselineAdminNetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaselineAdminNetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RuleTrafficStats) > 0 {
+		for iNdEx := len(m.RuleTrafficStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RuleTrafficStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -764,7 +963,7 @@ func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
+func (m *BaselineAdminNetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -774,12 +973,12 @@ func (m *NetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaselineAdminNetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaselineAdminNetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -811,7 +1010,7 @@ func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *PodReference) Marshal() (dAtA []byte, err error) {
+func (m *EgressStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -821,30 +1020,40 @@ func (m *PodReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *EgressStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EgressStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Namespace)
-	copy(dAtA[i:], m.Namespace)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
+func (m *EgressStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -854,18 +1063,32 @@ func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RuleTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *EgressStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EgressStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -873,16 +1096,11 @@ func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TrafficStats) Marshal() (dAtA []byte, err error) {
+func (m *MulticastGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -892,348 +1110,803 @@ func (m *TrafficStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TrafficStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *MulticastGroup) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MulticastGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Sessions))
-	i--
-	dAtA[i] = 0x18
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Bytes))
-	i--
-	dAtA[i] = 0x10
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Packets))
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AntreaClusterNetworkPolicyStats) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Pods) > 0 {
+		for iNdEx := len(m.Pods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TrafficStats.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.RuleTrafficStats) > 0 {
-		for _, e := range m.RuleTrafficStats {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Group)
+	copy(dAtA[i:], m.Group)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *AntreaClusterNetworkPolicyStatsList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *MulticastGroupList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AntreaNetworkPolicyStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TrafficStats.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.RuleTrafficStats) > 0 {
-		for _, e := range m.RuleTrafficStats {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *MulticastGroupList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AntreaNetworkPolicyStatsList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MulticastGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
-}
-
-func (m *MulticastGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Group)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Pods) > 0 {
-		for _, e := range m.Pods {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MulticastGroupList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *NetworkPolicyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NetworkPolicyStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TrafficStats.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *NetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetworkPolicyStatsList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PodReference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RuleTrafficStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TrafficStats.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *NetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TrafficStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.Packets))
-	n += 1 + sovGenerated(uint64(m.Bytes))
-	n += 1 + sovGenerated(uint64(m.Sessions))
-	return n
-}
-
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AntreaClusterNetworkPolicyStats) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	repeatedStringForRuleTrafficStats := "[]RuleTrafficStats{"
-	for _, f := range this.RuleTrafficStats {
-		repeatedStringForRuleTrafficStats += strings.Replace(strings.Replace(f.String(), "RuleTrafficStats", "RuleTrafficStats", 1), `&`, ``, 1) + ","
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForRuleTrafficStats += "}"
-	s := strings.Join([]string{`&AntreaClusterNetworkPolicyStats{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
-		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *AntreaClusterNetworkPolicyStatsList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]AntreaClusterNetworkPolicyStats{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AntreaClusterNetworkPolicyStats", "AntreaClusterNetworkPolicyStats", 1), `&`, ``, 1) + ","
+
+func (m *PodReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&AntreaClusterNetworkPolicyStatsList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *AntreaNetworkPolicyStats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PodReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForRuleTrafficStats := "[]RuleTrafficStats{"
-	for _, f := range this.RuleTrafficStats {
-		repeatedStringForRuleTrafficStats += strings.Replace(strings.Replace(f.String(), "RuleTrafficStats", "RuleTrafficStats", 1), `&`, ``, 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *RuleTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForRuleTrafficStats += "}"
-	s := strings.Join([]string{`&AntreaNetworkPolicyStats{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
-		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *AntreaNetworkPolicyStatsList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *TrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems := "[]AntreaNetworkPolicyStats{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AntreaNetworkPolicyStats", "AntreaNetworkPolicyStats", 1), `&`, ``, 1) + ","
+	return dAtA[:n], nil
+}
+
+func (m *TrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Sessions))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Bytes))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Packets))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&AntreaNetworkPolicyStatsList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	dAtA[offset] = uint8(v)
+	return base
 }
-func (this *MulticastGroup) String() string {
-	if this == nil {
-		return "nil"
+func (m *AdminNetworkPolicyStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPods := "[]PodReference{"
-	for _, f := range this.Pods {
-		repeatedStringForPods += strings.Replace(strings.Replace(f.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RuleTrafficStats) > 0 {
+		for _, e := range m.RuleTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForPods += "}"
-	s := strings.Join([]string{`&MulticastGroup{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
-		`Pods:` + repeatedStringForPods + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *MulticastGroupList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AdminNetworkPolicyStatsList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]MulticastGroup{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MulticastGroup", "MulticastGroup", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&MulticastGroupList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *NetworkPolicyStats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AntreaClusterNetworkPolicyStats) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&NetworkPolicyStats{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NetworkPolicyStatsList) String() string {
-	if this == nil {
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RuleTrafficStats) > 0 {
+		for _, e := range m.RuleTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AntreaClusterNetworkPolicyStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AntreaNetworkPolicyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RuleTrafficStats) > 0 {
+		for _, e := range m.RuleTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AntreaNetworkPolicyStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BaselineAdminNetworkPolicyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RuleTrafficStats) > 0 {
+		for _, e := range m.RuleTrafficStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BaselineAdminNetworkPolicyStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EgressStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EgressStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MulticastGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Pods) > 0 {
+		for _, e := range m.Pods {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MulticastGroupList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkPolicyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NetworkPolicyStatsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PodReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RuleTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Packets))
+	n += 1 + sovGenerated(uint64(m.Bytes))
+	n += 1 + sovGenerated(uint64(m.Sessions))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AdminNetworkPolicyStats) String() string {
+	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]NetworkPolicyStats{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NetworkPolicyStats", "NetworkPolicyStats", 1), `&`, ``, 1) + ","
+	repeatedStringForRuleTrafficStats := "[]RuleTrafficStats{"
+	for _, f := range this.RuleTrafficStats {
+		repeatedStringForRuleTrafficStats += strings.Replace(strings.Replace(f.String(), "RuleTrafficStats", "RuleTrafficStats", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&NetworkPolicyStatsList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
+	repeatedStringForRuleTrafficStats += "}"
+	s := strings.Join([]string{`&AdminNetworkPolicyStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PodReference) String() string {
+func (this *AdminNetworkPolicyStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]AdminNetworkPolicyStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AdminNetworkPolicyStats", "AdminNetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&AdminNetworkPolicyStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AntreaClusterNetworkPolicyStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRuleTrafficStats := "[]RuleTrafficStats{"
+	for _, f := range this.RuleTrafficStats {
+		repeatedStringForRuleTrafficStats += strings.Replace(strings.Replace(f.String(), "RuleTrafficStats", "RuleTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRuleTrafficStats += "}"
+	s := strings.Join([]string{`&AntreaClusterNetworkPolicyStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AntreaClusterNetworkPolicyStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]AntreaClusterNetworkPolicyStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AntreaClusterNetworkPolicyStats", "AntreaClusterNetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&AntreaClusterNetworkPolicyStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AntreaNetworkPolicyStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRuleTrafficStats := "[]RuleTrafficStats{"
+	for _, f := range this.RuleTrafficStats {
+		repeatedStringForRuleTrafficStats += strings.Replace(strings.Replace(f.String(), "RuleTrafficStats", "RuleTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRuleTrafficStats += "}"
+	s := strings.Join([]string{`&AntreaNetworkPolicyStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AntreaNetworkPolicyStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]AntreaNetworkPolicyStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AntreaNetworkPolicyStats", "AntreaNetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&AntreaNetworkPolicyStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BaselineAdminNetworkPolicyStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRuleTrafficStats := "[]RuleTrafficStats{"
+	for _, f := range this.RuleTrafficStats {
+		repeatedStringForRuleTrafficStats += strings.Replace(strings.Replace(f.String(), "RuleTrafficStats", "RuleTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRuleTrafficStats += "}"
+	s := strings.Join([]string{`&BaselineAdminNetworkPolicyStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`RuleTrafficStats:` + repeatedStringForRuleTrafficStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BaselineAdminNetworkPolicyStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]BaselineAdminNetworkPolicyStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "BaselineAdminNetworkPolicyStats", "BaselineAdminNetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&BaselineAdminNetworkPolicyStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EgressStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EgressStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EgressStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]EgressStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "EgressStats", "EgressStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&EgressStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPods := "[]PodReference{"
+	for _, f := range this.Pods {
+		repeatedStringForPods += strings.Replace(strings.Replace(f.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPods += "}"
+	s := strings.Join([]string{`&MulticastGroup{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Pods:` + repeatedStringForPods + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastGroupList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MulticastGroup{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MulticastGroup", "MulticastGroup", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MulticastGroupList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkPolicyStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkPolicyStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkPolicyStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NetworkPolicyStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NetworkPolicyStats", "NetworkPolicyStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NetworkPolicyStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PodReference) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -1272,10 +1945,544 @@ func valueToStringGenerated(v interface{}) string {
 	if rv.IsNil() {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AdminNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminNetworkPolicyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
+			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AdminNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminNetworkPolicyStatsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AdminNetworkPolicyStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
+			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AntreaClusterNetworkPolicyStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1298,10 +2505,10 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: AntreaNetworkPolicyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AntreaNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1425,7 +2632,7 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1448,10 +2655,10 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1516,7 +2723,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AntreaClusterNetworkPolicyStats{})
+			m.Items = append(m.Items, AntreaNetworkPolicyStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1542,7 +2749,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *BaselineAdminNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1565,10 +2772,10 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaselineAdminNetworkPolicyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaselineAdminNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1692,7 +2899,7 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *BaselineAdminNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1715,10 +2922,10 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaselineAdminNetworkPolicyStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaselineAdminNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1783,7 +2990,240 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AntreaNetworkPolicyStats{})
+			m.Items = append(m.Items, BaselineAdminNetworkPolicyStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EgressStatsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EgressStatsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EgressStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, EgressStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
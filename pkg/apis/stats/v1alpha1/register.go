@@ -49,6 +49,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NetworkPolicyStatsList{},
 		&MulticastGroup{},
 		&MulticastGroupList{},
+		&EgressStats{},
+		&EgressStatsList{},
+		&AdminNetworkPolicyStats{},
+		&AdminNetworkPolicyStatsList{},
+		&BaselineAdminNetworkPolicyStats{},
+		&BaselineAdminNetworkPolicyStatsList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -18,6 +18,33 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// +genclient
+// +resourceName=adminnetworkpolicystats
+// +genclient:readonly
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminNetworkPolicyStats is the statistics of an AdminNetworkPolicy.
+type AdminNetworkPolicyStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// The traffic stats of the AdminNetworkPolicy.
+	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+	// The traffic stats of the AdminNetworkPolicy, from rule perspective.
+	RuleTrafficStats []RuleTrafficStats `json:"ruleTrafficStats,omitempty" protobuf:"bytes,3,rep,name=ruleTrafficStats"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AdminNetworkPolicyStatsList is a list of AdminNetworkPolicyStats.
+type AdminNetworkPolicyStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of AdminNetworkPolicyStats.
+	Items []AdminNetworkPolicyStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +genclient
 // +resourceName=antreaclusternetworkpolicystats
 // +genclient:readonly
@@ -71,6 +98,58 @@ type AntreaNetworkPolicyStatsList struct {
 	Items []AntreaNetworkPolicyStats `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +genclient
+// +resourceName=baselineadminnetworkpolicystats
+// +genclient:readonly
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BaselineAdminNetworkPolicyStats is the statistics of a BaselineAdminNetworkPolicy.
+type BaselineAdminNetworkPolicyStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// The traffic stats of the BaselineAdminNetworkPolicy.
+	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+	// The traffic stats of the BaselineAdminNetworkPolicy, from rule perspective.
+	RuleTrafficStats []RuleTrafficStats `json:"ruleTrafficStats,omitempty" protobuf:"bytes,3,rep,name=ruleTrafficStats"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BaselineAdminNetworkPolicyStatsList is a list of BaselineAdminNetworkPolicyStats.
+type BaselineAdminNetworkPolicyStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of BaselineAdminNetworkPolicyStats.
+	Items []BaselineAdminNetworkPolicyStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +resourceName=egressstats
+// +genclient:readonly
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressStats is the statistics of an Egress.
+type EgressStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// The traffic stats of the Egress.
+	TrafficStats TrafficStats `json:"trafficStats,omitempty" protobuf:"bytes,2,opt,name=trafficStats"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressStatsList is a list of EgressStats.
+type EgressStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of EgressStats.
+	Items []EgressStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
 // +genclient
 // +resourceName=networkpolicystats
 // +genclient:readonly
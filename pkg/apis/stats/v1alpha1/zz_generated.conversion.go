@@ -34,6 +34,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdminNetworkPolicyStats)(nil), (*stats.AdminNetworkPolicyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdminNetworkPolicyStats_To_stats_AdminNetworkPolicyStats(a.(*AdminNetworkPolicyStats), b.(*stats.AdminNetworkPolicyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.AdminNetworkPolicyStats)(nil), (*AdminNetworkPolicyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_AdminNetworkPolicyStats_To_v1alpha1_AdminNetworkPolicyStats(a.(*stats.AdminNetworkPolicyStats), b.(*AdminNetworkPolicyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AdminNetworkPolicyStatsList)(nil), (*stats.AdminNetworkPolicyStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdminNetworkPolicyStatsList_To_stats_AdminNetworkPolicyStatsList(a.(*AdminNetworkPolicyStatsList), b.(*stats.AdminNetworkPolicyStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.AdminNetworkPolicyStatsList)(nil), (*AdminNetworkPolicyStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_AdminNetworkPolicyStatsList_To_v1alpha1_AdminNetworkPolicyStatsList(a.(*stats.AdminNetworkPolicyStatsList), b.(*AdminNetworkPolicyStatsList), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AntreaClusterNetworkPolicyStats)(nil), (*stats.AntreaClusterNetworkPolicyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AntreaClusterNetworkPolicyStats_To_stats_AntreaClusterNetworkPolicyStats(a.(*AntreaClusterNetworkPolicyStats), b.(*stats.AntreaClusterNetworkPolicyStats), scope)
 	}); err != nil {
@@ -74,6 +94,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BaselineAdminNetworkPolicyStats)(nil), (*stats.BaselineAdminNetworkPolicyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BaselineAdminNetworkPolicyStats_To_stats_BaselineAdminNetworkPolicyStats(a.(*BaselineAdminNetworkPolicyStats), b.(*stats.BaselineAdminNetworkPolicyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.BaselineAdminNetworkPolicyStats)(nil), (*BaselineAdminNetworkPolicyStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_BaselineAdminNetworkPolicyStats_To_v1alpha1_BaselineAdminNetworkPolicyStats(a.(*stats.BaselineAdminNetworkPolicyStats), b.(*BaselineAdminNetworkPolicyStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BaselineAdminNetworkPolicyStatsList)(nil), (*stats.BaselineAdminNetworkPolicyStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BaselineAdminNetworkPolicyStatsList_To_stats_BaselineAdminNetworkPolicyStatsList(a.(*BaselineAdminNetworkPolicyStatsList), b.(*stats.BaselineAdminNetworkPolicyStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.BaselineAdminNetworkPolicyStatsList)(nil), (*BaselineAdminNetworkPolicyStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_BaselineAdminNetworkPolicyStatsList_To_v1alpha1_BaselineAdminNetworkPolicyStatsList(a.(*stats.BaselineAdminNetworkPolicyStatsList), b.(*BaselineAdminNetworkPolicyStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressStats)(nil), (*stats.EgressStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressStats_To_stats_EgressStats(a.(*EgressStats), b.(*stats.EgressStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.EgressStats)(nil), (*EgressStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_EgressStats_To_v1alpha1_EgressStats(a.(*stats.EgressStats), b.(*EgressStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressStatsList)(nil), (*stats.EgressStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressStatsList_To_stats_EgressStatsList(a.(*EgressStatsList), b.(*stats.EgressStatsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*stats.EgressStatsList)(nil), (*EgressStatsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_stats_EgressStatsList_To_v1alpha1_EgressStatsList(a.(*stats.EgressStatsList), b.(*EgressStatsList), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MulticastGroup)(nil), (*stats.MulticastGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MulticastGroup_To_stats_MulticastGroup(a.(*MulticastGroup), b.(*stats.MulticastGroup), scope)
 	}); err != nil {
@@ -147,6 +207,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AdminNetworkPolicyStats_To_stats_AdminNetworkPolicyStats(in *AdminNetworkPolicyStats, out *stats.AdminNetworkPolicyStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	out.RuleTrafficStats = *(*[]stats.RuleTrafficStats)(unsafe.Pointer(&in.RuleTrafficStats))
+	return nil
+}
+
+// Convert_v1alpha1_AdminNetworkPolicyStats_To_stats_AdminNetworkPolicyStats is an autogenerated conversion function.
+func Convert_v1alpha1_AdminNetworkPolicyStats_To_stats_AdminNetworkPolicyStats(in *AdminNetworkPolicyStats, out *stats.AdminNetworkPolicyStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdminNetworkPolicyStats_To_stats_AdminNetworkPolicyStats(in, out, s)
+}
+
+func autoConvert_stats_AdminNetworkPolicyStats_To_v1alpha1_AdminNetworkPolicyStats(in *stats.AdminNetworkPolicyStats, out *AdminNetworkPolicyStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	out.RuleTrafficStats = *(*[]RuleTrafficStats)(unsafe.Pointer(&in.RuleTrafficStats))
+	return nil
+}
+
+// Convert_stats_AdminNetworkPolicyStats_To_v1alpha1_AdminNetworkPolicyStats is an autogenerated conversion function.
+func Convert_stats_AdminNetworkPolicyStats_To_v1alpha1_AdminNetworkPolicyStats(in *stats.AdminNetworkPolicyStats, out *AdminNetworkPolicyStats, s conversion.Scope) error {
+	return autoConvert_stats_AdminNetworkPolicyStats_To_v1alpha1_AdminNetworkPolicyStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_AdminNetworkPolicyStatsList_To_stats_AdminNetworkPolicyStatsList(in *AdminNetworkPolicyStatsList, out *stats.AdminNetworkPolicyStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]stats.AdminNetworkPolicyStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_AdminNetworkPolicyStatsList_To_stats_AdminNetworkPolicyStatsList is an autogenerated conversion function.
+func Convert_v1alpha1_AdminNetworkPolicyStatsList_To_stats_AdminNetworkPolicyStatsList(in *AdminNetworkPolicyStatsList, out *stats.AdminNetworkPolicyStatsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdminNetworkPolicyStatsList_To_stats_AdminNetworkPolicyStatsList(in, out, s)
+}
+
+func autoConvert_stats_AdminNetworkPolicyStatsList_To_v1alpha1_AdminNetworkPolicyStatsList(in *stats.AdminNetworkPolicyStatsList, out *AdminNetworkPolicyStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AdminNetworkPolicyStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_stats_AdminNetworkPolicyStatsList_To_v1alpha1_AdminNetworkPolicyStatsList is an autogenerated conversion function.
+func Convert_stats_AdminNetworkPolicyStatsList_To_v1alpha1_AdminNetworkPolicyStatsList(in *stats.AdminNetworkPolicyStatsList, out *AdminNetworkPolicyStatsList, s conversion.Scope) error {
+	return autoConvert_stats_AdminNetworkPolicyStatsList_To_v1alpha1_AdminNetworkPolicyStatsList(in, out, s)
+}
+
 func autoConvert_v1alpha1_AntreaClusterNetworkPolicyStats_To_stats_AntreaClusterNetworkPolicyStats(in *AntreaClusterNetworkPolicyStats, out *stats.AntreaClusterNetworkPolicyStats, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
@@ -247,6 +357,104 @@ func Convert_stats_AntreaNetworkPolicyStatsList_To_v1alpha1_AntreaNetworkPolicyS
 	return autoConvert_stats_AntreaNetworkPolicyStatsList_To_v1alpha1_AntreaNetworkPolicyStatsList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BaselineAdminNetworkPolicyStats_To_stats_BaselineAdminNetworkPolicyStats(in *BaselineAdminNetworkPolicyStats, out *stats.BaselineAdminNetworkPolicyStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	out.RuleTrafficStats = *(*[]stats.RuleTrafficStats)(unsafe.Pointer(&in.RuleTrafficStats))
+	return nil
+}
+
+// Convert_v1alpha1_BaselineAdminNetworkPolicyStats_To_stats_BaselineAdminNetworkPolicyStats is an autogenerated conversion function.
+func Convert_v1alpha1_BaselineAdminNetworkPolicyStats_To_stats_BaselineAdminNetworkPolicyStats(in *BaselineAdminNetworkPolicyStats, out *stats.BaselineAdminNetworkPolicyStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BaselineAdminNetworkPolicyStats_To_stats_BaselineAdminNetworkPolicyStats(in, out, s)
+}
+
+func autoConvert_stats_BaselineAdminNetworkPolicyStats_To_v1alpha1_BaselineAdminNetworkPolicyStats(in *stats.BaselineAdminNetworkPolicyStats, out *BaselineAdminNetworkPolicyStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	out.RuleTrafficStats = *(*[]RuleTrafficStats)(unsafe.Pointer(&in.RuleTrafficStats))
+	return nil
+}
+
+// Convert_stats_BaselineAdminNetworkPolicyStats_To_v1alpha1_BaselineAdminNetworkPolicyStats is an autogenerated conversion function.
+func Convert_stats_BaselineAdminNetworkPolicyStats_To_v1alpha1_BaselineAdminNetworkPolicyStats(in *stats.BaselineAdminNetworkPolicyStats, out *BaselineAdminNetworkPolicyStats, s conversion.Scope) error {
+	return autoConvert_stats_BaselineAdminNetworkPolicyStats_To_v1alpha1_BaselineAdminNetworkPolicyStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_BaselineAdminNetworkPolicyStatsList_To_stats_BaselineAdminNetworkPolicyStatsList(in *BaselineAdminNetworkPolicyStatsList, out *stats.BaselineAdminNetworkPolicyStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]stats.BaselineAdminNetworkPolicyStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_BaselineAdminNetworkPolicyStatsList_To_stats_BaselineAdminNetworkPolicyStatsList is an autogenerated conversion function.
+func Convert_v1alpha1_BaselineAdminNetworkPolicyStatsList_To_stats_BaselineAdminNetworkPolicyStatsList(in *BaselineAdminNetworkPolicyStatsList, out *stats.BaselineAdminNetworkPolicyStatsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BaselineAdminNetworkPolicyStatsList_To_stats_BaselineAdminNetworkPolicyStatsList(in, out, s)
+}
+
+func autoConvert_stats_BaselineAdminNetworkPolicyStatsList_To_v1alpha1_BaselineAdminNetworkPolicyStatsList(in *stats.BaselineAdminNetworkPolicyStatsList, out *BaselineAdminNetworkPolicyStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]BaselineAdminNetworkPolicyStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_stats_BaselineAdminNetworkPolicyStatsList_To_v1alpha1_BaselineAdminNetworkPolicyStatsList is an autogenerated conversion function.
+func Convert_stats_BaselineAdminNetworkPolicyStatsList_To_v1alpha1_BaselineAdminNetworkPolicyStatsList(in *stats.BaselineAdminNetworkPolicyStatsList, out *BaselineAdminNetworkPolicyStatsList, s conversion.Scope) error {
+	return autoConvert_stats_BaselineAdminNetworkPolicyStatsList_To_v1alpha1_BaselineAdminNetworkPolicyStatsList(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressStats_To_stats_EgressStats(in *EgressStats, out *stats.EgressStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TrafficStats_To_stats_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_EgressStats_To_stats_EgressStats is an autogenerated conversion function.
+func Convert_v1alpha1_EgressStats_To_stats_EgressStats(in *EgressStats, out *stats.EgressStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressStats_To_stats_EgressStats(in, out, s)
+}
+
+func autoConvert_stats_EgressStats_To_v1alpha1_EgressStats(in *stats.EgressStats, out *EgressStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_stats_TrafficStats_To_v1alpha1_TrafficStats(&in.TrafficStats, &out.TrafficStats, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_stats_EgressStats_To_v1alpha1_EgressStats is an autogenerated conversion function.
+func Convert_stats_EgressStats_To_v1alpha1_EgressStats(in *stats.EgressStats, out *EgressStats, s conversion.Scope) error {
+	return autoConvert_stats_EgressStats_To_v1alpha1_EgressStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressStatsList_To_stats_EgressStatsList(in *EgressStatsList, out *stats.EgressStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]stats.EgressStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_EgressStatsList_To_stats_EgressStatsList is an autogenerated conversion function.
+func Convert_v1alpha1_EgressStatsList_To_stats_EgressStatsList(in *EgressStatsList, out *stats.EgressStatsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressStatsList_To_stats_EgressStatsList(in, out, s)
+}
+
+func autoConvert_stats_EgressStatsList_To_v1alpha1_EgressStatsList(in *stats.EgressStatsList, out *EgressStatsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]EgressStats)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_stats_EgressStatsList_To_v1alpha1_EgressStatsList is an autogenerated conversion function.
+func Convert_stats_EgressStatsList_To_v1alpha1_EgressStatsList(in *stats.EgressStatsList, out *EgressStatsList, s conversion.Scope) error {
+	return autoConvert_stats_EgressStatsList_To_v1alpha1_EgressStatsList(in, out, s)
+}
+
 func autoConvert_v1alpha1_MulticastGroup_To_stats_MulticastGroup(in *MulticastGroup, out *stats.MulticastGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Group = in.Group
@@ -23,6 +23,71 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminNetworkPolicyStats) DeepCopyInto(out *AdminNetworkPolicyStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.TrafficStats = in.TrafficStats
+	if in.RuleTrafficStats != nil {
+		in, out := &in.RuleTrafficStats, &out.RuleTrafficStats
+		*out = make([]RuleTrafficStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminNetworkPolicyStats.
+func (in *AdminNetworkPolicyStats) DeepCopy() *AdminNetworkPolicyStats {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminNetworkPolicyStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdminNetworkPolicyStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminNetworkPolicyStatsList) DeepCopyInto(out *AdminNetworkPolicyStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AdminNetworkPolicyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminNetworkPolicyStatsList.
+func (in *AdminNetworkPolicyStatsList) DeepCopy() *AdminNetworkPolicyStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminNetworkPolicyStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdminNetworkPolicyStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AntreaClusterNetworkPolicyStats) DeepCopyInto(out *AntreaClusterNetworkPolicyStats) {
 	*out = *in
@@ -153,6 +218,131 @@ func (in *AntreaNetworkPolicyStatsList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaselineAdminNetworkPolicyStats) DeepCopyInto(out *BaselineAdminNetworkPolicyStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.TrafficStats = in.TrafficStats
+	if in.RuleTrafficStats != nil {
+		in, out := &in.RuleTrafficStats, &out.RuleTrafficStats
+		*out = make([]RuleTrafficStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaselineAdminNetworkPolicyStats.
+func (in *BaselineAdminNetworkPolicyStats) DeepCopy() *BaselineAdminNetworkPolicyStats {
+	if in == nil {
+		return nil
+	}
+	out := new(BaselineAdminNetworkPolicyStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BaselineAdminNetworkPolicyStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaselineAdminNetworkPolicyStatsList) DeepCopyInto(out *BaselineAdminNetworkPolicyStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BaselineAdminNetworkPolicyStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaselineAdminNetworkPolicyStatsList.
+func (in *BaselineAdminNetworkPolicyStatsList) DeepCopy() *BaselineAdminNetworkPolicyStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(BaselineAdminNetworkPolicyStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BaselineAdminNetworkPolicyStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressStats) DeepCopyInto(out *EgressStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.TrafficStats = in.TrafficStats
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressStats.
+func (in *EgressStats) DeepCopy() *EgressStats {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressStatsList) DeepCopyInto(out *EgressStatsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EgressStats, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressStatsList.
+func (in *EgressStatsList) DeepCopy() *EgressStatsList {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressStatsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressStatsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MulticastGroup) DeepCopyInto(out *MulticastGroup) {
 	*out = *in
@@ -38,18 +38,24 @@ import (
 	system "antrea.io/antrea/pkg/apis/system/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/certificate"
 	"antrea.io/antrea/pkg/apiserver/handlers/endpoint"
+	externalippoolhandler "antrea.io/antrea/pkg/apiserver/handlers/externalippool"
 	"antrea.io/antrea/pkg/apiserver/handlers/featuregates"
 	"antrea.io/antrea/pkg/apiserver/handlers/loglevel"
+	"antrea.io/antrea/pkg/apiserver/handlers/policyevaluation"
 	"antrea.io/antrea/pkg/apiserver/handlers/webhook"
 	"antrea.io/antrea/pkg/apiserver/registry/controlplane/egressgroup"
 	"antrea.io/antrea/pkg/apiserver/registry/controlplane/nodestatssummary"
+	"antrea.io/antrea/pkg/apiserver/registry/controlplane/trafficcontrolstatus"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/addressgroup"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/appliedtogroup"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/clustergroupmember"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/groupassociation"
 	"antrea.io/antrea/pkg/apiserver/registry/networkpolicy/networkpolicy"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/adminnetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreaclusternetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreanetworkpolicystats"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/baselineadminnetworkpolicystats"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/egressstats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/networkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/system/controllerinfo"
 	"antrea.io/antrea/pkg/apiserver/registry/system/supportbundle"
@@ -60,6 +66,7 @@ import (
 	controllernetworkpolicy "antrea.io/antrea/pkg/controller/networkpolicy"
 	"antrea.io/antrea/pkg/controller/querier"
 	"antrea.io/antrea/pkg/controller/stats"
+	"antrea.io/antrea/pkg/controller/trafficcontrol"
 	"antrea.io/antrea/pkg/features"
 )
 
@@ -87,19 +94,20 @@ func init() {
 
 // ExtraConfig holds custom apiserver config.
 type ExtraConfig struct {
-	k8sClient                     kubernetes.Interface
-	addressGroupStore             storage.Interface
-	appliedToGroupStore           storage.Interface
-	networkPolicyStore            storage.Interface
-	egressGroupStore              storage.Interface
-	controllerQuerier             querier.ControllerQuerier
-	endpointQuerier               controllernetworkpolicy.EndpointQuerier
-	networkPolicyController       *controllernetworkpolicy.NetworkPolicyController
-	egressController              *egress.EgressController
-	externalIPPoolController      *externalippool.ExternalIPPoolController
-	caCertController              *certificate.CACertController
-	statsAggregator               *stats.Aggregator
-	networkPolicyStatusController *controllernetworkpolicy.StatusController
+	k8sClient                      kubernetes.Interface
+	addressGroupStore              storage.Interface
+	appliedToGroupStore            storage.Interface
+	networkPolicyStore             storage.Interface
+	egressGroupStore               storage.Interface
+	controllerQuerier              querier.ControllerQuerier
+	endpointQuerier                controllernetworkpolicy.EndpointQuerier
+	networkPolicyController        *controllernetworkpolicy.NetworkPolicyController
+	egressController               *egress.EgressController
+	externalIPPoolController       *externalippool.ExternalIPPoolController
+	caCertController               *certificate.CACertController
+	statsAggregator                *stats.Aggregator
+	networkPolicyStatusController  *controllernetworkpolicy.StatusController
+	trafficControlStatusController *trafficcontrol.StatusController
 }
 
 // Config defines the config for Antrea apiserver.
@@ -139,22 +147,24 @@ func NewConfig(
 	networkPolicyStatusController *controllernetworkpolicy.StatusController,
 	endpointQuerier controllernetworkpolicy.EndpointQuerier,
 	npController *controllernetworkpolicy.NetworkPolicyController,
-	egressController *egress.EgressController) *Config {
+	egressController *egress.EgressController,
+	trafficControlStatusController *trafficcontrol.StatusController) *Config {
 	return &Config{
 		genericConfig: genericConfig,
 		extraConfig: ExtraConfig{
-			k8sClient:                     k8sClient,
-			addressGroupStore:             addressGroupStore,
-			appliedToGroupStore:           appliedToGroupStore,
-			networkPolicyStore:            networkPolicyStore,
-			egressGroupStore:              egressGroupStore,
-			caCertController:              caCertController,
-			statsAggregator:               statsAggregator,
-			controllerQuerier:             controllerQuerier,
-			endpointQuerier:               endpointQuerier,
-			networkPolicyController:       npController,
-			networkPolicyStatusController: networkPolicyStatusController,
-			egressController:              egressController,
+			k8sClient:                      k8sClient,
+			addressGroupStore:              addressGroupStore,
+			appliedToGroupStore:            appliedToGroupStore,
+			networkPolicyStore:             networkPolicyStore,
+			egressGroupStore:               egressGroupStore,
+			caCertController:               caCertController,
+			statsAggregator:                statsAggregator,
+			controllerQuerier:              controllerQuerier,
+			endpointQuerier:                endpointQuerier,
+			networkPolicyController:        npController,
+			networkPolicyStatusController:  networkPolicyStatusController,
+			egressController:               egressController,
+			trafficControlStatusController: trafficControlStatusController,
 		},
 	}
 }
@@ -172,6 +182,7 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	groupAssociationStorage := groupassociation.NewREST(c.extraConfig.networkPolicyController)
 	nodeStatsSummaryStorage := nodestatssummary.NewREST(c.extraConfig.statsAggregator)
 	egressGroupStorage := egressgroup.NewREST(c.extraConfig.egressGroupStore)
+	trafficControlStatusStorage := trafficcontrolstatus.NewREST(c.extraConfig.trafficControlStatusController)
 	cpGroup := genericapiserver.NewDefaultAPIGroupInfo(controlplane.GroupName, Scheme, parameterCodec, Codecs)
 	cpv1beta2Storage := map[string]rest.Storage{}
 	cpv1beta2Storage["addressgroups"] = addressGroupStorage
@@ -182,6 +193,7 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	cpv1beta2Storage["groupassociations"] = groupAssociationStorage
 	cpv1beta2Storage["clustergroupmembers"] = clusterGroupMembershipStorage
 	cpv1beta2Storage["egressgroups"] = egressGroupStorage
+	cpv1beta2Storage["trafficcontrolstatuses"] = trafficControlStatusStorage
 	cpGroup.VersionedResourcesStorageMap["v1beta2"] = cpv1beta2Storage
 
 	systemGroup := genericapiserver.NewDefaultAPIGroupInfo(system.GroupName, Scheme, metav1.ParameterCodec, Codecs)
@@ -197,6 +209,9 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	statsStorage["networkpolicystats"] = networkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["antreaclusternetworkpolicystats"] = antreaclusternetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["antreanetworkpolicystats"] = antreanetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["egressstats"] = egressstats.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["adminnetworkpolicystats"] = adminnetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["baselineadminnetworkpolicystats"] = baselineadminnetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsGroup.VersionedResourcesStorageMap["v1alpha1"] = statsStorage
 
 	groups := []*genericapiserver.APIGroupInfo{&cpGroup, &systemGroup, &statsGroup}
@@ -258,6 +273,7 @@ func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 	s.Handler.NonGoRestfulMux.HandleFunc("/loglevel", loglevel.HandleFunc())
 	s.Handler.NonGoRestfulMux.HandleFunc("/featuregates", featuregates.HandleFunc(c.k8sClient))
 	s.Handler.NonGoRestfulMux.HandleFunc("/endpoint", endpoint.HandleFunc(c.endpointQuerier))
+	s.Handler.NonGoRestfulMux.HandleFunc("/policyevaluation", policyevaluation.HandleFunc(c.endpointQuerier))
 	// Webhook to mutate Namespace labels and add its metadata.name as a label
 	s.Handler.NonGoRestfulMux.HandleFunc("/mutate/namespace", webhook.HandleMutationLabels())
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
@@ -274,6 +290,12 @@ func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 		s.Handler.NonGoRestfulMux.HandleFunc("/validate/acnp", webhook.HandlerForValidateFunc(v.Validate))
 		s.Handler.NonGoRestfulMux.HandleFunc("/validate/anp", webhook.HandlerForValidateFunc(v.Validate))
 		s.Handler.NonGoRestfulMux.HandleFunc("/validate/clustergroup", webhook.HandlerForValidateFunc(v.Validate))
+		// AdminNetworkPolicies and BaselineAdminNetworkPolicies are only validated when they are
+		// enforced by Antrea. The webhook ignores failures, so the policies are still admitted
+		// when the handler is not installed.
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			s.Handler.NonGoRestfulMux.HandleFunc("/validate/adminnetworkpolicy", webhook.HandlerForValidateFunc(v.Validate))
+		}
 
 		// Install handlers for CRD conversion between versions
 		s.Handler.NonGoRestfulMux.HandleFunc("/convert/clustergroup", webhook.HandleCRDConversion(controllernetworkpolicy.ConvertClusterGroupCRD))
@@ -287,6 +309,7 @@ func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) || features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
 		s.Handler.NonGoRestfulMux.HandleFunc("/validate/externalippool", webhook.HandlerForValidateFunc(c.externalIPPoolController.ValidateExternalIPPool))
+		s.Handler.NonGoRestfulMux.HandleFunc("/externalippools", externalippoolhandler.HandleFunc(c.externalIPPoolController))
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) {
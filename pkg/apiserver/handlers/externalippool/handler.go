@@ -0,0 +1,91 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalippool
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/controller/externalippool"
+)
+
+// Response describes the usage of an ExternalIPPool and the IPs allocated from it.
+type Response struct {
+	Name        string      `json:"name"`
+	Total       int         `json:"total"`
+	Used        int         `json:"used"`
+	IPAddresses []IPAddress `json:"ipAddresses,omitempty"`
+}
+
+// IPAddress describes an IP allocated from an ExternalIPPool and the resource it is allocated to.
+type IPAddress struct {
+	IP    string `json:"ip"`
+	Owner Owner  `json:"owner"`
+}
+
+// Owner is the resource an IP is allocated to, e.g. an Egress or a Service.
+type Owner struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// HandleFunc returns the function which can handle queries issued by the 'antctl get externalippool' command.
+// The usage and the IP allocations are read from the IP allocators of the ExternalIPPools, so all the allocated IPs
+// are listed regardless of the size of the pools. An ExternalIPPool can be selected with the "name" query parameter.
+func HandleFunc(q externalippool.ExternalIPPoolQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("name")
+		infos, err := q.QueryExternalIPPools(name)
+		if err != nil {
+			if errors.Is(err, externalippool.ErrExternalIPPoolNotFound) {
+				http.Error(w, "ExternalIPPool "+name+" not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		result := make([]Response, 0, len(infos))
+		for _, info := range infos {
+			result = append(result, newResponse(info))
+		}
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			klog.ErrorS(err, "Error when encoding ExternalIPPools to json")
+		}
+	}
+}
+
+func newResponse(info externalippool.ExternalIPPoolInfo) Response {
+	resp := Response{
+		Name:  info.Name,
+		Total: info.Total,
+		Used:  info.Used,
+	}
+	for _, allocation := range info.Allocations {
+		resp.IPAddresses = append(resp.IPAddresses, IPAddress{
+			IP: allocation.IP.String(),
+			Owner: Owner{
+				Kind:      allocation.ObjectReference.Kind,
+				Namespace: allocation.ObjectReference.Namespace,
+				Name:      allocation.ObjectReference.Name,
+			},
+		})
+	}
+	return resp
+}
@@ -0,0 +1,123 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalippool
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"antrea.io/antrea/pkg/controller/externalippool"
+)
+
+type fakeQuerier struct {
+	pools []externalippool.ExternalIPPoolInfo
+}
+
+func (q *fakeQuerier) QueryExternalIPPools(poolName string) ([]externalippool.ExternalIPPoolInfo, error) {
+	if poolName == "" {
+		return q.pools, nil
+	}
+	for _, pool := range q.pools {
+		if pool.Name == poolName {
+			return []externalippool.ExternalIPPoolInfo{pool}, nil
+		}
+	}
+	return nil, externalippool.ErrExternalIPPoolNotFound
+}
+
+func TestHandleFunc(t *testing.T) {
+	q := &fakeQuerier{
+		pools: []externalippool.ExternalIPPoolInfo{
+			{
+				Name:  "eip1",
+				Total: 10,
+				Used:  2,
+				Allocations: []externalippool.IPAllocation{
+					{
+						ObjectReference: corev1.ObjectReference{Kind: "Egress", Name: "egress1"},
+						IPPoolName:      "eip1",
+						IP:              net.ParseIP("10.10.10.2"),
+					},
+					{
+						ObjectReference: corev1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"},
+						IPPoolName:      "eip1",
+						IP:              net.ParseIP("10.10.10.3"),
+					},
+				},
+			},
+			{
+				Name:  "eip2",
+				Total: 5,
+			},
+		},
+	}
+	eip1Response := Response{
+		Name:  "eip1",
+		Total: 10,
+		Used:  2,
+		IPAddresses: []IPAddress{
+			{IP: "10.10.10.2", Owner: Owner{Kind: "Egress", Name: "egress1"}},
+			{IP: "10.10.10.3", Owner: Owner{Kind: "Service", Namespace: "ns1", Name: "svc1"}},
+		},
+	}
+	eip2Response := Response{Name: "eip2", Total: 5}
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedStatus   int
+		expectedResponse []Response
+	}{
+		{
+			name:             "all ExternalIPPools",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []Response{eip1Response, eip2Response},
+		},
+		{
+			name:             "ExternalIPPool by name",
+			query:            "?name=eip1",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []Response{eip1Response},
+		},
+		{
+			name:           "ExternalIPPool not found",
+			query:          "?name=eip3",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := HandleFunc(q)
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var received []Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+			assert.Equal(t, tt.expectedResponse, received)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyevaluation
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+const defaultNamespace = "default"
+
+// HandleFunc creates a http.HandlerFunc which uses an EndpointQuerier to evaluate the
+// NetworkPolicies applied to the traffic described by the query parameters.
+func HandleFunc(eq networkpolicy.EndpointQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		request, err := newPolicyEvaluationRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response, err := eq.EvaluatePolicies(request)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+			} else if errors.IsBadRequest(err) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+			} else {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, "failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+func newPolicyEvaluationRequest(r *http.Request) (*networkpolicy.PolicyEvaluationRequest, error) {
+	query := r.URL.Query()
+	src, dst := query.Get("source"), query.Get("destination")
+	if src == "" || dst == "" {
+		return nil, fmt.Errorf("source and destination must be provided")
+	}
+	request := &networkpolicy.PolicyEvaluationRequest{
+		Protocol: controlplane.ProtocolTCP,
+	}
+	var err error
+	if request.Source, err = parseEndpoint(src); err != nil {
+		return nil, fmt.Errorf("invalid source: %v", err)
+	}
+	if request.Destination, err = parseEndpoint(dst); err != nil {
+		return nil, fmt.Errorf("invalid destination: %v", err)
+	}
+	if protocol := query.Get("protocol"); protocol != "" {
+		request.Protocol = controlplane.Protocol(strings.ToUpper(protocol))
+	}
+	switch request.Protocol {
+	case controlplane.ProtocolTCP, controlplane.ProtocolUDP, controlplane.ProtocolSCTP:
+		port, err := strconv.ParseUint(query.Get("port"), 10, 16)
+		if err != nil || port == 0 {
+			return nil, fmt.Errorf("a valid destination port must be provided for protocol %s", request.Protocol)
+		}
+		request.Port = int32(port)
+	case controlplane.ProtocolICMP:
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s", request.Protocol)
+	}
+	return request, nil
+}
+
+// parseEndpoint parses an endpoint in the format of Namespace/Name, Name (in the default
+// Namespace), or IP.
+func parseEndpoint(endpoint string) (networkpolicy.EvaluationEndpoint, error) {
+	if ip := net.ParseIP(endpoint); ip != nil {
+		return networkpolicy.EvaluationEndpoint{IP: ip}, nil
+	}
+	parts := strings.Split(endpoint, "/")
+	switch {
+	case len(parts) == 1:
+		return networkpolicy.EvaluationEndpoint{Namespace: defaultNamespace, Name: parts[0]}, nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return networkpolicy.EvaluationEndpoint{Namespace: parts[0], Name: parts[1]}, nil
+	default:
+		return networkpolicy.EvaluationEndpoint{}, fmt.Errorf("%s is not in the format of Namespace/Name, Name or IP", endpoint)
+	}
+}
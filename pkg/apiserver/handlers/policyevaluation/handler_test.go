@@ -0,0 +1,161 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyevaluation
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+	queriermock "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestPolicyEvaluationQuery(t *testing.T) {
+	response := &networkpolicy.PolicyEvaluationResponse{
+		Source:      "Pod ns1/pod1",
+		Destination: "Pod ns2/pod2",
+		Protocol:    "TCP",
+		Port:        80,
+		Verdict:     crdv1alpha1.RuleActionDrop,
+		Egress: &networkpolicy.DirectionEvaluation{
+			Endpoint: "Pod ns1/pod1",
+			Action:   crdv1alpha1.RuleActionAllow,
+			Reason:   networkpolicy.EvaluationReasonNoRuleMatched,
+		},
+		Ingress: &networkpolicy.DirectionEvaluation{
+			Endpoint:          "Pod ns2/pod2",
+			Action:            crdv1alpha1.RuleActionDrop,
+			Reason:            networkpolicy.EvaluationReasonK8sIsolation,
+			IsolatingPolicies: []networkpolicy.PolicyRef{{Namespace: "ns2", Name: "np1"}},
+		},
+	}
+	tests := []struct {
+		name             string
+		query            string
+		expectedRequest  *networkpolicy.PolicyEvaluationRequest
+		mockResponse     *networkpolicy.PolicyEvaluationResponse
+		mockErr          error
+		expectedStatus   int
+		expectedResponse *networkpolicy.PolicyEvaluationResponse
+	}{
+		{
+			name:  "Pod to Pod",
+			query: "?source=ns1/pod1&destination=ns2/pod2&port=80",
+			expectedRequest: &networkpolicy.PolicyEvaluationRequest{
+				Source:      networkpolicy.EvaluationEndpoint{Namespace: "ns1", Name: "pod1"},
+				Destination: networkpolicy.EvaluationEndpoint{Namespace: "ns2", Name: "pod2"},
+				Protocol:    controlplane.ProtocolTCP,
+				Port:        80,
+			},
+			mockResponse:     response,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: response,
+		},
+		{
+			name:  "Pod in default Namespace to IP with ICMP",
+			query: "?source=pod1&destination=10.0.0.1&protocol=icmp",
+			expectedRequest: &networkpolicy.PolicyEvaluationRequest{
+				Source:      networkpolicy.EvaluationEndpoint{Namespace: "default", Name: "pod1"},
+				Destination: networkpolicy.EvaluationEndpoint{IP: net.ParseIP("10.0.0.1")},
+				Protocol:    controlplane.ProtocolICMP,
+			},
+			mockResponse:     response,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: response,
+		},
+		{
+			name:           "Missing destination",
+			query:          "?source=ns1/pod1&port=80",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing port",
+			query:          "?source=ns1/pod1&destination=ns2/pod2&protocol=UDP",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid port",
+			query:          "?source=ns1/pod1&destination=ns2/pod2&port=65536",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unsupported protocol",
+			query:          "?source=ns1/pod1&destination=ns2/pod2&protocol=GRE&port=80",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid endpoint",
+			query:          "?source=ns1/pod1/foo&destination=ns2/pod2&port=80",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Endpoint not found",
+			query: "?source=ns1/pod1&destination=ns2/pod2&port=80",
+			expectedRequest: &networkpolicy.PolicyEvaluationRequest{
+				Source:      networkpolicy.EvaluationEndpoint{Namespace: "ns1", Name: "pod1"},
+				Destination: networkpolicy.EvaluationEndpoint{Namespace: "ns2", Name: "pod2"},
+				Protocol:    controlplane.ProtocolTCP,
+				Port:        80,
+			},
+			mockErr:        errors.NewNotFound(schema.GroupResource{Resource: "Pod"}, "ns1/pod1"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "No Pod endpoint",
+			query: "?source=10.0.0.1&destination=10.0.0.2&port=80",
+			expectedRequest: &networkpolicy.PolicyEvaluationRequest{
+				Source:      networkpolicy.EvaluationEndpoint{IP: net.ParseIP("10.0.0.1")},
+				Destination: networkpolicy.EvaluationEndpoint{IP: net.ParseIP("10.0.0.2")},
+				Protocol:    controlplane.ProtocolTCP,
+				Port:        80,
+			},
+			mockErr:        errors.NewBadRequest("one of source and destination must be a Pod"),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockQuerier := queriermock.NewMockEndpointQuerier(mockCtrl)
+			if tt.expectedRequest != nil {
+				mockQuerier.EXPECT().EvaluatePolicies(tt.expectedRequest).Return(tt.mockResponse, tt.mockErr)
+			}
+			handler := HandleFunc(mockQuerier)
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var received networkpolicy.PolicyEvaluationResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+			assert.Equal(t, tt.expectedResponse, &received)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trafficcontrolstatus
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+)
+
+// statusCollector is the interface required by the handler.
+type statusCollector interface {
+	UpdateStatus(status *controlplane.TrafficControlStatus) error
+}
+
+type REST struct {
+	collector statusCollector
+}
+
+var (
+	_ rest.Creater = &REST{}
+	_ rest.Scoper  = &REST{}
+)
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(c statusCollector) *REST {
+	return &REST{c}
+}
+
+func (r *REST) New() runtime.Object {
+	return &controlplane.TrafficControlStatus{}
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *v1.CreateOptions) (runtime.Object, error) {
+	status, ok := obj.(*controlplane.TrafficControlStatus)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("not a TrafficControlStatus object: %T", obj))
+	}
+	if err := r.collector.UpdateStatus(status); err != nil {
+		return nil, err
+	}
+	// a valid runtime.Object must be returned, otherwise the client would throw error.
+	return &controlplane.TrafficControlStatus{}, nil
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
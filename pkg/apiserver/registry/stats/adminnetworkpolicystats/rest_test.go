@@ -0,0 +1,288 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package adminnetworkpolicystats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+type fakeStatsProvider struct {
+	stats map[string]statsv1alpha1.AdminNetworkPolicyStats
+}
+
+func (p *fakeStatsProvider) ListAdminNetworkPolicyStats() []statsv1alpha1.AdminNetworkPolicyStats {
+	list := make([]statsv1alpha1.AdminNetworkPolicyStats, 0, len(p.stats))
+	for _, m := range p.stats {
+		list = append(list, m)
+	}
+	return list
+}
+
+func (p *fakeStatsProvider) GetAdminNetworkPolicyStats(name string) (*statsv1alpha1.AdminNetworkPolicyStats, bool) {
+	m, exists := p.stats[name]
+	if !exists {
+		return nil, false
+	}
+	return &m, true
+}
+
+func TestRESTGet(t *testing.T) {
+	tests := []struct {
+		name                      string
+		networkPolicyStatsEnabled bool
+		antreaPolicyEnabled       bool
+		adminNPEnabled            bool
+		stats                     map[string]statsv1alpha1.AdminNetworkPolicyStats
+		policy                    string
+		expectedObj               runtime.Object
+		expectedErr               bool
+	}{
+		{
+			name:                      "NetworkPolicyStats feature disabled",
+			networkPolicyStatsEnabled: false,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			expectedObj:               &statsv1alpha1.AdminNetworkPolicyStats{},
+			expectedErr:               false,
+		},
+		{
+			name:                      "AntreaPolicy feature disabled",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       false,
+			adminNPEnabled:            true,
+			expectedObj:               &statsv1alpha1.AdminNetworkPolicyStats{},
+			expectedErr:               false,
+		},
+		{
+			name:                      "AdminNetworkPolicy feature disabled",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            false,
+			expectedObj:               &statsv1alpha1.AdminNetworkPolicyStats{},
+			expectedErr:               false,
+		},
+		{
+			name:                      "policy not found",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			stats: map[string]statsv1alpha1.AdminNetworkPolicyStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			policy:      "bar",
+			expectedErr: true,
+		},
+		{
+			name:                      "policy found",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			stats: map[string]statsv1alpha1.AdminNetworkPolicyStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			policy: "foo",
+			expectedObj: &statsv1alpha1.AdminNetworkPolicyStats{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+			},
+			expectedErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, tt.networkPolicyStatsEnabled)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, tt.antreaPolicyEnabled)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AdminNetworkPolicy, tt.adminNPEnabled)()
+
+			r := &REST{
+				statsProvider: &fakeStatsProvider{stats: tt.stats},
+			}
+			actualObj, err := r.Get(context.TODO(), tt.policy, &metav1.GetOptions{})
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedObj, actualObj)
+		})
+	}
+}
+
+func TestRESTList(t *testing.T) {
+	tests := []struct {
+		name                      string
+		networkPolicyStatsEnabled bool
+		antreaPolicyEnabled       bool
+		adminNPEnabled            bool
+		labelSelector             labels.Selector
+		stats                     map[string]statsv1alpha1.AdminNetworkPolicyStats
+		expectedObj               runtime.Object
+		expectedErr               bool
+	}{
+		{
+			name:                      "NetworkPolicyStats feature disabled",
+			networkPolicyStatsEnabled: false,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			expectedObj:               &statsv1alpha1.AdminNetworkPolicyStatsList{},
+			expectedErr:               false,
+		},
+		{
+			name:                      "AntreaPolicy feature disabled",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       false,
+			adminNPEnabled:            true,
+			expectedObj:               &statsv1alpha1.AdminNetworkPolicyStatsList{},
+			expectedErr:               false,
+		},
+		{
+			name:                      "AdminNetworkPolicy feature disabled",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            false,
+			expectedObj:               &statsv1alpha1.AdminNetworkPolicyStatsList{},
+			expectedErr:               false,
+		},
+		{
+			name:                      "empty stats",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			stats:                     map[string]statsv1alpha1.AdminNetworkPolicyStats{},
+			expectedObj: &statsv1alpha1.AdminNetworkPolicyStatsList{
+				Items: []statsv1alpha1.AdminNetworkPolicyStats{},
+			},
+			expectedErr: false,
+		},
+		{
+			name:                      "a few stats",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			stats: map[string]statsv1alpha1.AdminNetworkPolicyStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+				"bar": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "bar",
+					},
+				},
+			},
+			expectedObj: &statsv1alpha1.AdminNetworkPolicyStatsList{
+				Items: []statsv1alpha1.AdminNetworkPolicyStats{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "foo",
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "bar",
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name:                      "label selector selecting nothing",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			labelSelector:             labels.Nothing(),
+			stats: map[string]statsv1alpha1.AdminNetworkPolicyStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			expectedObj: &statsv1alpha1.AdminNetworkPolicyStatsList{
+				Items: []statsv1alpha1.AdminNetworkPolicyStats{},
+			},
+			expectedErr: false,
+		},
+		{
+			name:                      "label selector selecting everything",
+			networkPolicyStatsEnabled: true,
+			antreaPolicyEnabled:       true,
+			adminNPEnabled:            true,
+			labelSelector:             labels.Everything(),
+			stats: map[string]statsv1alpha1.AdminNetworkPolicyStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			expectedObj: &statsv1alpha1.AdminNetworkPolicyStatsList{
+				Items: []statsv1alpha1.AdminNetworkPolicyStats{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "foo",
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, tt.networkPolicyStatsEnabled)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, tt.antreaPolicyEnabled)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AdminNetworkPolicy, tt.adminNPEnabled)()
+
+			r := &REST{
+				statsProvider: &fakeStatsProvider{stats: tt.stats},
+			}
+			actualObj, err := r.List(context.TODO(), &internalversion.ListOptions{LabelSelector: tt.labelSelector})
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.expectedObj == nil {
+				assert.Nil(t, actualObj)
+			} else {
+				assert.ElementsMatch(t, tt.expectedObj.(*statsv1alpha1.AdminNetworkPolicyStatsList).Items, actualObj.(*statsv1alpha1.AdminNetworkPolicyStatsList).Items)
+			}
+		})
+	}
+}
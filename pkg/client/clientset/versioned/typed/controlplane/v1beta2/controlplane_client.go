@@ -33,6 +33,7 @@ type ControlplaneV1beta2Interface interface {
 	GroupAssociationsGetter
 	NetworkPoliciesGetter
 	NodeStatsSummariesGetter
+	TrafficControlStatusesGetter
 }
 
 // ControlplaneV1beta2Client is used to interact with features provided by the controlplane.antrea.io group.
@@ -68,6 +69,10 @@ func (c *ControlplaneV1beta2Client) NodeStatsSummaries() NodeStatsSummaryInterfa
 	return newNodeStatsSummaries(c)
 }
 
+func (c *ControlplaneV1beta2Client) TrafficControlStatuses() TrafficControlStatusInterface {
+	return newTrafficControlStatuses(c)
+}
+
 // NewForConfig creates a new ControlplaneV1beta2Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
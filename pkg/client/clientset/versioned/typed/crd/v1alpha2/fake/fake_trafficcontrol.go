@@ -94,6 +94,17 @@ func (c *FakeTrafficControls) Update(ctx context.Context, trafficControl *v1alph
 	return obj.(*v1alpha2.TrafficControl), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTrafficControls) UpdateStatus(ctx context.Context, trafficControl *v1alpha2.TrafficControl, opts v1.UpdateOptions) (*v1alpha2.TrafficControl, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(trafficcontrolsResource, "status", trafficControl), &v1alpha2.TrafficControl{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.TrafficControl), err
+}
+
 // Delete takes name of the trafficControl and deletes it. Returns an error if one occurs.
 func (c *FakeTrafficControls) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
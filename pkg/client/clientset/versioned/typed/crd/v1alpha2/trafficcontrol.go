@@ -38,6 +38,7 @@ type TrafficControlsGetter interface {
 type TrafficControlInterface interface {
 	Create(ctx context.Context, trafficControl *v1alpha2.TrafficControl, opts v1.CreateOptions) (*v1alpha2.TrafficControl, error)
 	Update(ctx context.Context, trafficControl *v1alpha2.TrafficControl, opts v1.UpdateOptions) (*v1alpha2.TrafficControl, error)
+	UpdateStatus(ctx context.Context, trafficControl *v1alpha2.TrafficControl, opts v1.UpdateOptions) (*v1alpha2.TrafficControl, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha2.TrafficControl, error)
@@ -126,6 +127,21 @@ func (c *trafficControls) Update(ctx context.Context, trafficControl *v1alpha2.T
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *trafficControls) UpdateStatus(ctx context.Context, trafficControl *v1alpha2.TrafficControl, opts v1.UpdateOptions) (result *v1alpha2.TrafficControl, err error) {
+	result = &v1alpha2.TrafficControl{}
+	err = c.client.Put().
+		Resource("trafficcontrols").
+		Name(trafficControl.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(trafficControl).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the trafficControl and deletes it. Returns an error if one occurs.
 func (c *trafficControls) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
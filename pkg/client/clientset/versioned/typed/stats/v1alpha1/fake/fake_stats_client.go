@@ -26,6 +26,10 @@ type FakeStatsV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeStatsV1alpha1) AdminNetworkPolicyStats() v1alpha1.AdminNetworkPolicyStatsInterface {
+	return &FakeAdminNetworkPolicyStats{c}
+}
+
 func (c *FakeStatsV1alpha1) AntreaClusterNetworkPolicyStats() v1alpha1.AntreaClusterNetworkPolicyStatsInterface {
 	return &FakeAntreaClusterNetworkPolicyStats{c}
 }
@@ -34,6 +38,14 @@ func (c *FakeStatsV1alpha1) AntreaNetworkPolicyStats(namespace string) v1alpha1.
 	return &FakeAntreaNetworkPolicyStats{c, namespace}
 }
 
+func (c *FakeStatsV1alpha1) BaselineAdminNetworkPolicyStats() v1alpha1.BaselineAdminNetworkPolicyStatsInterface {
+	return &FakeBaselineAdminNetworkPolicyStats{c}
+}
+
+func (c *FakeStatsV1alpha1) EgressStats() v1alpha1.EgressStatsInterface {
+	return &FakeEgressStats{c}
+}
+
 func (c *FakeStatsV1alpha1) MulticastGroups() v1alpha1.MulticastGroupInterface {
 	return &FakeMulticastGroups{c}
 }
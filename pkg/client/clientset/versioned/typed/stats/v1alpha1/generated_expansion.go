@@ -16,10 +16,16 @@
 
 package v1alpha1
 
+type AdminNetworkPolicyStatsExpansion interface{}
+
 type AntreaClusterNetworkPolicyStatsExpansion interface{}
 
 type AntreaNetworkPolicyStatsExpansion interface{}
 
+type BaselineAdminNetworkPolicyStatsExpansion interface{}
+
+type EgressStatsExpansion interface{}
+
 type MulticastGroupExpansion interface{}
 
 type NetworkPolicyStatsExpansion interface{}
@@ -26,8 +26,11 @@ import (
 
 type StatsV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AdminNetworkPolicyStatsGetter
 	AntreaClusterNetworkPolicyStatsGetter
 	AntreaNetworkPolicyStatsGetter
+	BaselineAdminNetworkPolicyStatsGetter
+	EgressStatsGetter
 	MulticastGroupsGetter
 	NetworkPolicyStatsGetter
 }
@@ -37,6 +40,10 @@ type StatsV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *StatsV1alpha1Client) AdminNetworkPolicyStats() AdminNetworkPolicyStatsInterface {
+	return newAdminNetworkPolicyStats(c)
+}
+
 func (c *StatsV1alpha1Client) AntreaClusterNetworkPolicyStats() AntreaClusterNetworkPolicyStatsInterface {
 	return newAntreaClusterNetworkPolicyStats(c)
 }
@@ -45,6 +52,14 @@ func (c *StatsV1alpha1Client) AntreaNetworkPolicyStats(namespace string) AntreaN
 	return newAntreaNetworkPolicyStats(c, namespace)
 }
 
+func (c *StatsV1alpha1Client) BaselineAdminNetworkPolicyStats() BaselineAdminNetworkPolicyStatsInterface {
+	return newBaselineAdminNetworkPolicyStats(c)
+}
+
+func (c *StatsV1alpha1Client) EgressStats() EgressStatsInterface {
+	return newEgressStats(c)
+}
+
 func (c *StatsV1alpha1Client) MulticastGroups() MulticastGroupInterface {
 	return newMulticastGroups(c)
 }
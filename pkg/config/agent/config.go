@@ -131,6 +131,13 @@ type AgentConfig struct {
 	// "udp" L4 transport protocols.
 	// Defaults to "flow-aggregator.flow-aggregator.svc:4739:tcp".
 	FlowCollectorAddr string `yaml:"flowCollectorAddr,omitempty"`
+	// Enable sharding of the flow records across all the replicas of the Flow Aggregator.
+	// Each flow record is sent to the replica selected by hashing its flow key, so that the
+	// records of a connection exported by the source and destination Nodes are received by
+	// the same replica. When enabled, FlowCollectorAddr must be the DNS name of the Flow
+	// Aggregator Service, with format "<NAME>.<NAMESPACE>.svc".
+	// Defaults to false.
+	FlowCollectorSharding bool `yaml:"flowCollectorSharding,omitempty"`
 	// Provide flow poll interval in format "0s". This determines how often flow
 	// exporter dumps connections in conntrack module. Flow poll interval should
 	// be greater than or equal to 1s(one second).
@@ -152,6 +159,12 @@ type AgentConfig struct {
 	// Defaults to "15s". Valid time units are "ns", "us" (or "µs"), "ms", "s",
 	// "m", "h".
 	IdleFlowExportTimeout string `yaml:"idleFlowExportTimeout,omitempty"`
+	// Enable the collection of TCP metrics (smoothed round-trip time and number of
+	// retransmissions) for the connections of the local Pods, which are added to the flow
+	// records. The metrics are read from the TCP sockets of the Pods. Only supported on
+	// Linux Nodes.
+	// Defaults to false.
+	FlowExporterTCPMetrics bool `yaml:"flowExporterTCPMetrics,omitempty"`
 	// Deprecated. Use the NodePortLocal config options instead.
 	NPLPortRange string `yaml:"nplPortRange,omitempty"`
 	// NodePortLocal (NPL) configuration options.
@@ -188,6 +201,10 @@ type AgentConfig struct {
 	AntreaProxy AntreaProxyConfig `yaml:"antreaProxy,omitempty"`
 	// Egress related configurations.
 	Egress EgressConfig `yaml:"egress"`
+	// Antrea-native policy audit logging configuration options.
+	AuditLogging AuditLoggingConfig `yaml:"auditLogging,omitempty"`
+	// FQDN policy rules configuration options.
+	FQDNPolicy FQDNPolicyConfig `yaml:"fqdnPolicy,omitempty"`
 }
 
 type AntreaProxyConfig struct {
@@ -242,3 +259,39 @@ type MulticastConfig struct {
 type EgressConfig struct {
 	ExceptCIDRs []string `yaml:"exceptCIDRs,omitempty"`
 }
+
+type AuditLoggingConfig struct {
+	// The format of the audit log records. Supported values are "text" and "json".
+	// Defaults to "text".
+	Format string `yaml:"format,omitempty"`
+	// Syslog contains the options used to stream the audit log records to a syslog server,
+	// in addition to the local audit log file.
+	Syslog AuditLoggingSyslogConfig `yaml:"syslog,omitempty"`
+}
+
+type FQDNPolicyConfig struct {
+	// The minimum TTL, in seconds, of the IPs resolved for the FQDNs selected by FQDN policy rules.
+	// The IPs of DNS records with a lower TTL are kept for MinTTL seconds. Defaults to 0, which
+	// means that the TTL of the DNS records is used.
+	MinTTL uint32 `yaml:"minTTL,omitempty"`
+	// The duration for which an IP which is no longer returned for a FQDN is still selected by
+	// FQDN policy rules after its TTL expired. It prevents disrupting the connections established
+	// to the IP when the DNS records of the FQDN rotate. Defaults to "60s".
+	IPExpirationGracePeriod string `yaml:"ipExpirationGracePeriod,omitempty"`
+}
+
+type AuditLoggingSyslogConfig struct {
+	// Enable streaming the audit log records to a syslog server.
+	Enable bool `yaml:"enable,omitempty"`
+	// Provide the address of the syslog server, as a host:port pair.
+	Address string `yaml:"address,omitempty"`
+	// The transport protocol used to send the records to the syslog server. Supported values
+	// are "udp", "tcp" and "tls". Defaults to "udp".
+	Transport string `yaml:"transport,omitempty"`
+	// Path to the CA certificate used to verify the syslog server when the transport is
+	// "tls". The system CA pool is used if not set.
+	CACertFile string `yaml:"caCertFile,omitempty"`
+	// The number of records which can be queued for the syslog server. When the queue is
+	// full, new records are dropped from the syslog stream. Defaults to 1024.
+	BufferSize int `yaml:"bufferSize,omitempty"`
+}
@@ -14,8 +14,12 @@
 
 package config
 
-import (
-	"antrea.io/antrea/pkg/flowaggregator"
+type AggregatorTransportProtocol string
+
+const (
+	AggregatorTransportProtocolTCP AggregatorTransportProtocol = "TCP"
+	AggregatorTransportProtocolTLS AggregatorTransportProtocol = "TLS"
+	AggregatorTransportProtocolUDP AggregatorTransportProtocol = "UDP"
 )
 
 type FlowAggregatorConfig struct {
@@ -36,10 +40,13 @@ type FlowAggregatorConfig struct {
 	InactiveFlowRecordTimeout string `yaml:"inactiveFlowRecordTimeout,omitempty"`
 	// Transport protocol over which the aggregator collects IPFIX records from all Agents.
 	// Defaults to "tls"
-	AggregatorTransportProtocol flowaggregator.AggregatorTransportProtocol `yaml:"aggregatorTransportProtocol,omitempty"`
+	AggregatorTransportProtocol AggregatorTransportProtocol `yaml:"aggregatorTransportProtocol,omitempty"`
 	// Provide DNS name or IP address of flow aggregator for generating TLS certificate.
 	// Defaults to "flow-aggregator.flow-aggregator.svc"
 	FlowAggregatorAddress string `yaml:"flowAggregatorAddress,omitempty"`
+	// highAvailability contains configuration options for running multiple replicas of the
+	// Flow Aggregator.
+	HighAvailability HighAvailabilityConfig `yaml:"highAvailability,omitempty"`
 	// recordContents enables configuring some fields in the flow records. Fields can be
 	// excluded to reduce record size.
 	RecordContents RecordContentsConfig `yaml:"recordContents,omitempty"`
@@ -49,6 +56,21 @@ type FlowAggregatorConfig struct {
 	FlowCollector FlowCollectorConfig `yaml:"flowCollector,omitempty"`
 	// clickHouse contains ClickHouse related configuration options.
 	ClickHouse ClickHouseConfig `yaml:"clickHouse,omitempty"`
+	// kafka contains Kafka related configuration options.
+	Kafka KafkaConfig `yaml:"kafka,omitempty"`
+	// s3Uploader contains configuration options for uploading flow records to AWS S3 or an
+	// S3-compatible object storage.
+	S3Uploader S3UploaderConfig `yaml:"s3Uploader,omitempty"`
+	// flowLogger contains configuration options for writing flow records to a local log file.
+	FlowLogger FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+}
+
+type HighAvailabilityConfig struct {
+	// Enable is the switch to enable running multiple replicas of the Flow Aggregator. The
+	// Flow Exporters must shard the flow records across the replicas (flowCollectorSharding
+	// in the antrea-agent config), so that the records of a connection exported by the source
+	// and destination Nodes are received by the same replica. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
 }
 
 type RecordContentsConfig struct {
@@ -81,6 +103,14 @@ type FlowCollectorConfig struct {
 	// Provide format for records sent to the configured flow collector. Supported formats are IPFIX and JSON.
 	// Defaults to "IPFIX"
 	RecordFormat string `yaml:"recordFormat,omitempty"`
+	// IncludeDestinationFQDN determines whether the destinationFQDN Information Element is
+	// included in the records sent to the flow collector. The collector must be able to decode
+	// this Antrea Information Element. Defaults to false.
+	IncludeDestinationFQDN bool `yaml:"includeDestinationFQDN,omitempty"`
+	// IncludeTCPMetrics determines whether the tcpSmoothedRTT and tcpRetransmissions
+	// Information Elements are included in the records sent to the flow collector. The
+	// collector must be able to decode these Antrea Information Elements. Defaults to false.
+	IncludeTCPMetrics bool `yaml:"includeTCPMetrics,omitempty"`
 }
 
 type ClickHouseConfig struct {
@@ -100,3 +130,111 @@ type ClickHouseConfig struct {
 	// Min value allowed is "1s".
 	CommitInterval string `yaml:"commitInterval,omitempty"`
 }
+
+type KafkaConfig struct {
+	// Enable is the switch to enable exporting flow records to Kafka.
+	Enable bool `yaml:"enable,omitempty"`
+	// Brokers is the list of Kafka brokers used to bootstrap the producer, as strings with
+	// format <host>:<port>.
+	Brokers []string `yaml:"brokers,omitempty"`
+	// Topic is the Kafka topic the flow records are produced to. The topic must exist.
+	// Defaults to "flows".
+	Topic string `yaml:"topic,omitempty"`
+	// RecordFormat is the format of the Kafka messages, with one flow record per message.
+	// Supported formats are JSON and Protobuf. Defaults to "JSON".
+	RecordFormat string `yaml:"recordFormat,omitempty"`
+	// RequiredAcks is the number of acknowledgements the Kafka leader must receive before
+	// responding: 0 (no response), 1 (leader only) or -1 (all in-sync replicas). Defaults
+	// to 1.
+	RequiredAcks *int16 `yaml:"requiredAcks,omitempty"`
+	// MaxBatchSize is the maximum number of flow records produced in a single request.
+	// Defaults to 1000.
+	MaxBatchSize int `yaml:"maxBatchSize,omitempty"`
+	// FlushInterval is the maximum interval between two produce requests. Defaults to "1s".
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	FlushInterval string `yaml:"flushInterval,omitempty"`
+	// TLS contains the TLS configuration of the connections to the Kafka brokers.
+	TLS KafkaTLSConfig `yaml:"tls,omitempty"`
+	// SASL contains the SASL authentication configuration of the connections to the Kafka
+	// brokers. The credentials are read from the KAFKA_SASL_USERNAME and KAFKA_SASL_PASSWORD
+	// environment variables.
+	SASL KafkaSASLConfig `yaml:"sasl,omitempty"`
+}
+
+type KafkaTLSConfig struct {
+	// Enable is the switch to enable TLS for the connections to the Kafka brokers.
+	Enable bool `yaml:"enable,omitempty"`
+	// CACertPath is the path to the CA certificate used to verify the certificates of the
+	// brokers. Defaults to "", which means that the system CA certificates are used.
+	CACertPath string `yaml:"caCertPath,omitempty"`
+	// CertPath and KeyPath are the paths to the client certificate and key, which are only
+	// required when the brokers require TLS client authentication.
+	CertPath string `yaml:"certPath,omitempty"`
+	KeyPath  string `yaml:"keyPath,omitempty"`
+	// InsecureSkipVerify disables the verification of the certificates of the brokers. It
+	// should only be used for testing. Defaults to false.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify,omitempty"`
+}
+
+type KafkaSASLConfig struct {
+	// Enable is the switch to enable SASL authentication.
+	Enable bool `yaml:"enable,omitempty"`
+	// Mechanism is the SASL mechanism. Supported mechanisms are PLAIN, SCRAM-SHA-256 and
+	// SCRAM-SHA-512. Defaults to "PLAIN".
+	Mechanism string `yaml:"mechanism,omitempty"`
+}
+
+type S3UploaderConfig struct {
+	// Enable is the switch to enable exporting flow records to AWS S3 or an S3-compatible
+	// object storage.
+	Enable bool `yaml:"enable,omitempty"`
+	// Endpoint is the URL of the S3 API endpoint. It must be set when using an S3-compatible
+	// object storage (e.g. MinIO). Defaults to "https://s3.<region>.amazonaws.com".
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// ForcePathStyle makes the uploader use path-style URLs (<endpoint>/<bucket>/<key>)
+	// instead of virtual-hosted-style URLs, which is required by most S3-compatible object
+	// storages. Defaults to false.
+	ForcePathStyle bool `yaml:"forcePathStyle,omitempty"`
+	// BucketName is the name of the bucket the flow records are uploaded to. The bucket must
+	// exist.
+	BucketName string `yaml:"bucketName,omitempty"`
+	// BucketPrefix is the prefix ("folder") of the uploaded objects in the bucket.
+	BucketPrefix string `yaml:"bucketPrefix,omitempty"`
+	// Region is the region of the bucket. Defaults to "us-west-2".
+	Region string `yaml:"region,omitempty"`
+	// CACertPath is the path to the CA certificate used to verify the certificate of the
+	// endpoint, e.g. for an S3-compatible object storage with a self-signed certificate.
+	// Defaults to "", which means that the system CA certificates are used.
+	CACertPath string `yaml:"caCertPath,omitempty"`
+	// RecordFormat is the format of the uploaded files. Supported formats are CSV and Parquet.
+	// Defaults to "CSV".
+	RecordFormat string `yaml:"recordFormat,omitempty"`
+	// Compress enables compression of the uploaded files: CSV files are gzip-compressed, and
+	// the columns of Parquet files are compressed with Snappy. Defaults to true.
+	Compress *bool `yaml:"compress,omitempty"`
+	// MaxRecordsPerFile is the maximum number of flow records per uploaded file. Defaults to
+	// 1000000.
+	MaxRecordsPerFile int32 `yaml:"maxRecordsPerFile,omitempty"`
+	// UploadInterval is the interval between two uploads. Defaults to "60s". Valid time
+	// units are "ns", "us" (or "µs"), "ms", "s", "m", "h". Min value allowed is "1s".
+	UploadInterval string `yaml:"uploadInterval,omitempty"`
+}
+
+type FlowLoggerConfig struct {
+	// Enable is the switch to enable writing flow records to a local log file.
+	Enable bool `yaml:"enable,omitempty"`
+	// Path is the path to the local log file. Defaults to "/tmp/antrea-flows.log".
+	Path string `yaml:"path,omitempty"`
+	// MaxSize is the maximum size in MB of the log file before it gets rotated. Defaults to
+	// 100.
+	MaxSize int32 `yaml:"maxSize,omitempty"`
+	// MaxBackups is the maximum number of old log files to retain. If set to 0, all log files
+	// will be retained (unless MaxAge causes them to be deleted). Defaults to 3.
+	MaxBackups *int32 `yaml:"maxBackups,omitempty"`
+	// MaxAge is the maximum number of days to retain old log files based on the timestamp
+	// encoded in their filename. If set to 0, old log files are not removed based on age.
+	// Defaults to 0.
+	MaxAge int32 `yaml:"maxAge,omitempty"`
+	// Compress enables gzip compression of rotated log files. Defaults to true.
+	Compress *bool `yaml:"compress,omitempty"`
+}
@@ -17,6 +17,7 @@ package egress
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -30,6 +31,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
@@ -57,14 +60,41 @@ const (
 	egressGroupType grouping.GroupType = "egressGroup"
 
 	externalIPPoolIndex = "externalIPPool"
+
+	// Reasons of the IPAllocated condition.
+	reasonIPAllocated            = "Allocated"
+	reasonExternalIPPoolNotFound = "ExternalIPPoolNotFound"
+	reasonIPPoolExhausted        = "IPPoolExhausted"
+	reasonIPUnavailable          = "IPUnavailable"
 )
 
+// ipAllocationError is returned when the Egress IPs cannot be allocated from the ExternalIPPool. Its reason is
+// reported in the IPAllocated condition of the Egress.
+type ipAllocationError struct {
+	reason string
+	err    error
+}
+
+func (e *ipAllocationError) Error() string {
+	return e.err.Error()
+}
+
+func newIPAllocationError(reason string, format string, args ...interface{}) *ipAllocationError {
+	return &ipAllocationError{reason: reason, err: fmt.Errorf(format, args...)}
+}
+
 // ipAllocation contains the IP and the IP Pool which allocates it.
 type ipAllocation struct {
 	ip     net.IP
 	ipPool string
 }
 
+// ipsAllocation contains the IPs and the IP Pool which allocates them.
+type ipsAllocation struct {
+	ips    []net.IP
+	ipPool string
+}
+
 // EgressController is responsible for synchronizing the EgressGroups selected by Egresses.
 type EgressController struct {
 	crdClient clientset.Interface
@@ -75,6 +105,9 @@ type EgressController struct {
 	// changed and to release the IP after the Egress is removed.
 	ipAllocationMap   map[string]*ipAllocation
 	ipAllocationMutex sync.RWMutex
+	// ipsAllocationMap is the counterpart of ipAllocationMap for Egresses using multiple IPs.
+	ipsAllocationMap   map[string]*ipsAllocation
+	ipsAllocationMutex sync.RWMutex
 
 	egressInformer egressinformers.EgressInformer
 	egressLister   egresslisters.EgressLister
@@ -89,6 +122,8 @@ type EgressController struct {
 	groupingInterface grouping.Interface
 	// Added as a member to the struct to allow injection for testing.
 	groupingInterfaceSynced func() bool
+	// eventRecorder records the Events of IP allocation.
+	eventRecorder record.EventRecorder
 }
 
 // NewEgressController returns a new *EgressController.
@@ -96,7 +131,8 @@ func NewEgressController(crdClient clientset.Interface,
 	groupingInterface grouping.Interface,
 	egressInformer egressinformers.EgressInformer,
 	externalIPAllocator externalippool.ExternalIPAllocator,
-	egressGroupStore storage.Interface) *EgressController {
+	egressGroupStore storage.Interface,
+	eventRecorder record.EventRecorder) *EgressController {
 	c := &EgressController{
 		crdClient:               crdClient,
 		egressInformer:          egressInformer,
@@ -108,7 +144,9 @@ func NewEgressController(crdClient clientset.Interface,
 		groupingInterface:       groupingInterface,
 		groupingInterfaceSynced: groupingInterface.HasSynced,
 		ipAllocationMap:         map[string]*ipAllocation{},
+		ipsAllocationMap:        map[string]*ipsAllocation{},
 		externalIPAllocator:     externalIPAllocator,
+		eventRecorder:           eventRecorder,
 	}
 	// Add handlers for Group events and Egress events.
 	c.groupingInterface.AddEventHandler(egressGroupType, c.enqueueEgressGroup)
@@ -159,29 +197,51 @@ func (c *EgressController) Run(stopCh <-chan struct{}) {
 // restoreIPAllocations restores the existing EgressIPs of Egresses and records the successful ones in ipAllocationMap.
 func (c *EgressController) restoreIPAllocations(egresses []*egressv1alpha2.Egress) {
 	var previousIPAllocations []externalippool.IPAllocation
+	multiIPEgresses := sets.NewString()
 	for _, egress := range egresses {
 		// Ignore Egress that is not associated to ExternalIPPool or doesn't have EgressIP assigned.
-		if egress.Spec.ExternalIPPool == "" || egress.Spec.EgressIP == "" {
+		if egress.Spec.ExternalIPPool == "" {
 			continue
 		}
-		ip := net.ParseIP(egress.Spec.EgressIP)
-		allocation := externalippool.IPAllocation{
-			ObjectReference: v1.ObjectReference{
-				Name: egress.Name,
-				Kind: egress.Kind,
-			},
-			IPPoolName: egress.Spec.ExternalIPPool,
-			IP:         ip,
+		if isMultiIPEgress(egress) {
+			multiIPEgresses.Insert(egress.Name)
+		}
+		for _, ipStr := range getEgressIPs(egress) {
+			allocation := externalippool.IPAllocation{
+				ObjectReference: newIPOwner(egress.Name),
+				IPPoolName:      egress.Spec.ExternalIPPool,
+				IP:              net.ParseIP(ipStr),
+			}
+			previousIPAllocations = append(previousIPAllocations, allocation)
 		}
-		previousIPAllocations = append(previousIPAllocations, allocation)
 	}
 	succeededAllocations := c.externalIPAllocator.RestoreIPAllocations(previousIPAllocations)
 	for _, alloc := range succeededAllocations {
-		c.setIPAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
+		if multiIPEgresses.Has(alloc.ObjectReference.Name) {
+			c.addIPsAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
+		} else {
+			c.setIPAllocation(alloc.ObjectReference.Name, alloc.IP, alloc.IPPoolName)
+		}
 		klog.InfoS("Restored EgressIP", "egress", alloc.ObjectReference.Name, "ip", alloc.IP, "pool", alloc.IPPoolName)
 	}
 }
 
+// isMultiIPEgress returns whether the Egress uses EgressIPs instead of EgressIP.
+func isMultiIPEgress(egress *egressv1alpha2.Egress) bool {
+	return len(egress.Spec.EgressIPs) > 0 || egress.Spec.NumEgressIPs > 0
+}
+
+// getEgressIPs returns the IPs specified in the Egress, regardless of whether it uses EgressIP or EgressIPs.
+func getEgressIPs(egress *egressv1alpha2.Egress) []string {
+	if len(egress.Spec.EgressIPs) > 0 {
+		return egress.Spec.EgressIPs
+	}
+	if egress.Spec.EgressIP != "" {
+		return []string{egress.Spec.EgressIP}
+	}
+	return nil
+}
+
 func (c *EgressController) egressGroupWorker() {
 	for c.processNextEgressGroupWorkItem() {
 	}
@@ -232,6 +292,42 @@ func (c *EgressController) setIPAllocation(egressName string, ip net.IP, poolNam
 	}
 }
 
+func (c *EgressController) getIPsAllocation(egressName string) ([]net.IP, string, bool) {
+	c.ipsAllocationMutex.RLock()
+	defer c.ipsAllocationMutex.RUnlock()
+	allocation, exists := c.ipsAllocationMap[egressName]
+	if !exists {
+		return nil, "", false
+	}
+	return allocation.ips, allocation.ipPool, true
+}
+
+func (c *EgressController) deleteIPsAllocation(egressName string) {
+	c.ipsAllocationMutex.Lock()
+	defer c.ipsAllocationMutex.Unlock()
+	delete(c.ipsAllocationMap, egressName)
+}
+
+func (c *EgressController) setIPsAllocation(egressName string, ips []net.IP, poolName string) {
+	c.ipsAllocationMutex.Lock()
+	defer c.ipsAllocationMutex.Unlock()
+	c.ipsAllocationMap[egressName] = &ipsAllocation{
+		ips:    ips,
+		ipPool: poolName,
+	}
+}
+
+func (c *EgressController) addIPsAllocation(egressName string, ip net.IP, poolName string) {
+	c.ipsAllocationMutex.Lock()
+	defer c.ipsAllocationMutex.Unlock()
+	allocation, exists := c.ipsAllocationMap[egressName]
+	if !exists {
+		allocation = &ipsAllocation{ipPool: poolName}
+		c.ipsAllocationMap[egressName] = allocation
+	}
+	allocation.ips = append(allocation.ips, ip)
+}
+
 // syncEgressIP is responsible for releasing stale EgressIP and allocating new EgressIP for an Egress if applicable.
 func (c *EgressController) syncEgressIP(egress *egressv1alpha2.Egress) (net.IP, error) {
 	prevIP, prevIPPool, exists := c.getIPAllocation(egress.Name)
@@ -258,7 +354,7 @@ func (c *EgressController) syncEgressIP(egress *egressv1alpha2.Egress) (net.IP,
 				return nil, err
 			}
 		}
-		return nil, fmt.Errorf("ExternalIPPool %s not exists", egress.Spec.ExternalIPPool)
+		return nil, newIPAllocationError(reasonExternalIPPoolNotFound, "ExternalIPPool %s not exists", egress.Spec.ExternalIPPool)
 	}
 
 	var ip net.IP
@@ -267,14 +363,14 @@ func (c *EgressController) syncEgressIP(egress *egressv1alpha2.Egress) (net.IP,
 	// TODO: Use validation webhook to ensure the requested IP matches the pool.
 	if egress.Spec.EgressIP != "" {
 		ip = net.ParseIP(egress.Spec.EgressIP)
-		if err := c.externalIPAllocator.UpdateIPAllocation(egress.Spec.ExternalIPPool, ip); err != nil {
-			return nil, fmt.Errorf("error when allocating IP %v for Egress %s from ExternalIPPool %s: %v", ip, egress.Name, egress.Spec.ExternalIPPool, err)
+		if err := c.externalIPAllocator.UpdateIPAllocation(egress.Spec.ExternalIPPool, ip, newIPOwner(egress.Name)); err != nil {
+			return nil, newIPAllocationError(reasonIPUnavailable, "error when allocating IP %v for Egress %s from ExternalIPPool %s: %v", ip, egress.Name, egress.Spec.ExternalIPPool, err)
 		}
 	} else {
 		var err error
 		// User doesn't specify the Egress IP, allocate one.
-		if ip, err = c.externalIPAllocator.AllocateIPFromPool(egress.Spec.ExternalIPPool); err != nil {
-			return nil, err
+		if ip, err = c.externalIPAllocator.AllocateIPFromPool(egress.Spec.ExternalIPPool, newIPOwner(egress.Name)); err != nil {
+			return nil, newAllocateIPFromPoolError(egress, err)
 		}
 		if err = c.updateEgressIP(egress, ip.String()); err != nil {
 			if rerr := c.externalIPAllocator.ReleaseIP(egress.Spec.ExternalIPPool, ip); rerr != nil &&
@@ -286,9 +382,140 @@ func (c *EgressController) syncEgressIP(egress *egressv1alpha2.Egress) (net.IP,
 	}
 	c.setIPAllocation(egress.Name, ip, egress.Spec.ExternalIPPool)
 	klog.InfoS("Allocated EgressIP", "egress", egress.Name, "ip", ip, "pool", egress.Spec.ExternalIPPool)
+	c.eventRecorder.Eventf(egress, v1.EventTypeNormal, "IPAllocated", "Allocated EgressIP %s from ExternalIPPool %s", ip, egress.Spec.ExternalIPPool)
 	return ip, nil
 }
 
+// newIPOwner returns the reference to the Egress which is used as the owner of its IPs in ExternalIPPools.
+func newIPOwner(egressName string) v1.ObjectReference {
+	return v1.ObjectReference{Kind: "Egress", Name: egressName}
+}
+
+// newAllocateIPFromPoolError converts the error returned by AllocateIPFromPool to an ipAllocationError. The pool can
+// only fail to allocate an IP when it doesn't exist or all of its IPs have been allocated.
+func newAllocateIPFromPoolError(egress *egressv1alpha2.Egress, err error) *ipAllocationError {
+	if err == externalippool.ErrExternalIPPoolNotFound {
+		return newIPAllocationError(reasonExternalIPPoolNotFound, "ExternalIPPool %s not exists", egress.Spec.ExternalIPPool)
+	}
+	return newIPAllocationError(reasonIPPoolExhausted, "error when allocating IP for Egress %s from ExternalIPPool %s: %v", egress.Name, egress.Spec.ExternalIPPool, err)
+}
+
+// syncEgressIPs is the counterpart of syncEgressIP for Egresses using multiple IPs. It releases stale EgressIPs and
+// allocates new EgressIPs until the Egress has NumEgressIPs IPs if applicable.
+func (c *EgressController) syncEgressIPs(egress *egressv1alpha2.Egress) ([]net.IP, error) {
+	desiredNum := len(egress.Spec.EgressIPs)
+	if egress.Spec.ExternalIPPool != "" && egress.Spec.NumEgressIPs > 0 {
+		desiredNum = int(egress.Spec.NumEgressIPs)
+	}
+	prevIPs, prevIPPool, exists := c.getIPsAllocation(egress.Name)
+	if exists {
+		// The EgressIPs and the ExternalIPPool don't change, do nothing.
+		if ipsEqual(prevIPs, egress.Spec.EgressIPs) && len(prevIPs) == desiredNum && prevIPPool == egress.Spec.ExternalIPPool && c.externalIPAllocator.IPPoolExists(egress.Spec.ExternalIPPool) {
+			return prevIPs, nil
+		}
+		// Either EgressIPs or ExternalIPPool changes, release the previous ones first. The IPs that are still
+		// specified will be allocated again below.
+		if err := c.releaseEgressIPs(egress.Name, prevIPs, prevIPPool); err != nil {
+			return nil, err
+		}
+	}
+
+	// Skip allocating EgressIPs if ExternalIPPool is not specified and return whatever user specifies.
+	if egress.Spec.ExternalIPPool == "" {
+		return parseIPs(egress.Spec.EgressIPs), nil
+	}
+
+	if !c.externalIPAllocator.IPPoolExists(egress.Spec.ExternalIPPool) {
+		// The IP pool has been deleted, reclaim the IPs from the Egress API.
+		if len(egress.Spec.EgressIPs) > 0 {
+			if err := c.updateEgressIPs(egress, nil); err != nil {
+				return nil, err
+			}
+		}
+		return nil, newIPAllocationError(reasonExternalIPPoolNotFound, "ExternalIPPool %s not exists", egress.Spec.ExternalIPPool)
+	}
+
+	var ips []net.IP
+	releaseIPs := func() {
+		for _, ip := range ips {
+			if err := c.externalIPAllocator.ReleaseIP(egress.Spec.ExternalIPPool, ip); err != nil &&
+				err != externalippool.ErrExternalIPPoolNotFound {
+				klog.ErrorS(err, "Failed to release IP", "ip", ip, "pool", egress.Spec.ExternalIPPool)
+			}
+		}
+	}
+	// Keep the IPs that are already specified, up to the desired number.
+	for _, ipStr := range egress.Spec.EgressIPs {
+		if len(ips) == desiredNum {
+			break
+		}
+		ip := net.ParseIP(ipStr)
+		if err := c.externalIPAllocator.UpdateIPAllocation(egress.Spec.ExternalIPPool, ip, newIPOwner(egress.Name)); err != nil {
+			releaseIPs()
+			return nil, newIPAllocationError(reasonIPUnavailable, "error when allocating IP %v for Egress %s from ExternalIPPool %s: %v", ip, egress.Name, egress.Spec.ExternalIPPool, err)
+		}
+		ips = append(ips, ip)
+	}
+	// Allocate the missing IPs.
+	for len(ips) < desiredNum {
+		ip, err := c.externalIPAllocator.AllocateIPFromPool(egress.Spec.ExternalIPPool, newIPOwner(egress.Name))
+		if err != nil {
+			releaseIPs()
+			return nil, newAllocateIPFromPoolError(egress, err)
+		}
+		ips = append(ips, ip)
+	}
+	if !ipsEqual(ips, egress.Spec.EgressIPs) {
+		if err := c.updateEgressIPs(egress, ips); err != nil {
+			releaseIPs()
+			return nil, err
+		}
+	}
+	c.setIPsAllocation(egress.Name, ips, egress.Spec.ExternalIPPool)
+	klog.InfoS("Allocated EgressIPs", "egress", egress.Name, "ips", ips, "pool", egress.Spec.ExternalIPPool)
+	c.eventRecorder.Eventf(egress, v1.EventTypeNormal, "IPAllocated", "Allocated EgressIPs %v from ExternalIPPool %s", ips, egress.Spec.ExternalIPPool)
+	return ips, nil
+}
+
+func parseIPs(ipStrs []string) []net.IP {
+	ips := make([]net.IP, 0, len(ipStrs))
+	for _, ipStr := range ipStrs {
+		ips = append(ips, net.ParseIP(ipStr))
+	}
+	return ips
+}
+
+// ipsEqual returns whether the IPs are the same as the IP strings, in the same order.
+func ipsEqual(ips []net.IP, ipStrs []string) bool {
+	if len(ips) != len(ipStrs) {
+		return false
+	}
+	for i := range ips {
+		if ips[i].String() != ipStrs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// updateEgressIPs updates the Egress's EgressIPs in Kubernetes API.
+func (c *EgressController) updateEgressIPs(egress *egressv1alpha2.Egress, ips []net.IP) error {
+	var egressIPs []string
+	for _, ip := range ips {
+		egressIPs = append(egressIPs, ip.String())
+	}
+	patch := map[string]interface{}{
+		"spec": map[string][]string{
+			"egressIPs": egressIPs,
+		},
+	}
+	patchBytes, _ := json.Marshal(patch)
+	if _, err := c.crdClient.CrdV1alpha2().Egresses().Patch(context.TODO(), egress.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error when updating EgressIPs for Egress %s: %v", egress.Name, err)
+	}
+	return nil
+}
+
 // updateEgressIP updates the Egress's EgressIP in Kubernetes API.
 func (c *EgressController) updateEgressIP(egress *egressv1alpha2.Egress, ip string) error {
 	var egressIPPtr *string
@@ -324,6 +551,24 @@ func (c *EgressController) releaseEgressIP(egressName string, egressIP net.IP, p
 	return nil
 }
 
+// releaseEgressIPs removes the Egress's ipsAllocation in the cache and releases the IPs to the pool.
+func (c *EgressController) releaseEgressIPs(egressName string, egressIPs []net.IP, poolName string) error {
+	for _, egressIP := range egressIPs {
+		if err := c.externalIPAllocator.ReleaseIP(poolName, egressIP); err != nil {
+			if err == externalippool.ErrExternalIPPoolNotFound {
+				// Ignore the error since the external IP Pool could be deleted.
+				klog.Warningf("Failed to release IP %s because IP Pool %s does not exist", egressIP, poolName)
+				break
+			}
+			klog.ErrorS(err, "Failed to release IP", "ip", egressIP, "pool", poolName)
+			return err
+		}
+		klog.InfoS("Released EgressIP", "egress", egressName, "ip", egressIP, "pool", poolName)
+	}
+	c.deleteIPsAllocation(egressName)
+	return nil
+}
+
 func (c *EgressController) syncEgress(key string) error {
 	startTime := time.Now()
 	defer func() {
@@ -337,12 +582,35 @@ func (c *EgressController) syncEgress(key string) error {
 		if prevIP, prevIPPool, exists := c.getIPAllocation(key); exists {
 			c.releaseEgressIP(key, prevIP, prevIPPool)
 		}
+		if prevIPs, prevIPPool, exists := c.getIPsAllocation(key); exists {
+			c.releaseEgressIPs(key, prevIPs, prevIPPool)
+		}
 		return nil
 	}
 
-	if _, err := c.syncEgressIP(egress); err != nil {
+	// Release the allocation of the other mode first if the Egress switches between EgressIP and EgressIPs.
+	var allocErr error
+	if isMultiIPEgress(egress) {
+		if prevIP, prevIPPool, exists := c.getIPAllocation(key); exists {
+			if err := c.releaseEgressIP(key, prevIP, prevIPPool); err != nil {
+				return err
+			}
+		}
+		_, allocErr = c.syncEgressIPs(egress)
+	} else {
+		if prevIPs, prevIPPool, exists := c.getIPsAllocation(key); exists {
+			if err := c.releaseEgressIPs(key, prevIPs, prevIPPool); err != nil {
+				return err
+			}
+		}
+		_, allocErr = c.syncEgressIP(egress)
+	}
+	if err := c.syncIPAllocatedCondition(egress, allocErr); err != nil {
 		return err
 	}
+	if allocErr != nil {
+		return allocErr
+	}
 
 	egressGroupObj, found, _ := c.egressGroupStore.Get(key)
 	if !found {
@@ -388,6 +656,93 @@ func (c *EgressController) syncEgress(key string) error {
 	return nil
 }
 
+// syncIPAllocatedCondition updates the IPAllocated condition of the Egress according to the result of its IP
+// allocation, and records an Event if the allocation fails. It does nothing for Egresses not using ExternalIPPool.
+func (c *EgressController) syncIPAllocatedCondition(egress *egressv1alpha2.Egress, allocErr error) error {
+	if egress.Spec.ExternalIPPool == "" {
+		return nil
+	}
+	condition := egressv1alpha2.EgressCondition{Type: egressv1alpha2.EgressIPAllocated}
+	var ipAllocErr *ipAllocationError
+	if allocErr == nil {
+		condition.Status = v1.ConditionTrue
+		condition.Reason = reasonIPAllocated
+		condition.Message = fmt.Sprintf("Egress IPs are allocated from ExternalIPPool %s", egress.Spec.ExternalIPPool)
+	} else if errors.As(allocErr, &ipAllocErr) {
+		condition.Status = v1.ConditionFalse
+		condition.Reason = ipAllocErr.reason
+		condition.Message = ipAllocErr.Error()
+	} else {
+		// Other errors, e.g. the failure of updating the Egress, are transient. Keep the condition unchanged.
+		return nil
+	}
+	transitioned, err := c.updateEgressCondition(egress, condition)
+	if err != nil {
+		return err
+	}
+	// Only emit the event when the allocation starts failing, not every time it's retried.
+	if transitioned && condition.Status == v1.ConditionFalse {
+		c.eventRecorder.Event(egress, v1.EventTypeWarning, "IPAllocationFailed", condition.Message)
+	}
+	return nil
+}
+
+// updateEgressCondition sets the provided condition in the Egress's status. The LastTransitionTime of the condition
+// is only updated when its status, reason or message changes. It returns whether the status of the condition has
+// changed, i.e. whether the condition has transitioned.
+func (c *EgressController) updateEgressCondition(egress *egressv1alpha2.Egress, condition egressv1alpha2.EgressCondition) (bool, error) {
+	if !setEgressCondition(egress.Status.DeepCopy(), condition) {
+		return false, nil
+	}
+	var transitioned bool
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Get the latest Egress as its spec may have been updated by the IP allocation.
+		toUpdate, err := c.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), egress.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		transitioned = getEgressConditionStatus(&toUpdate.Status, condition.Type) != condition.Status
+		if !setEgressCondition(&toUpdate.Status, condition) {
+			transitioned = false
+			return nil
+		}
+		_, err = c.crdClient.CrdV1alpha2().Egresses().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
+		return err
+	}); err != nil {
+		return false, fmt.Errorf("error when updating condition %s of Egress %s: %v", condition.Type, egress.Name, err)
+	}
+	return transitioned, nil
+}
+
+// getEgressConditionStatus returns the status of the condition of the given type, or ConditionUnknown if the
+// condition is not set.
+func getEgressConditionStatus(status *egressv1alpha2.EgressStatus, conditionType egressv1alpha2.EgressConditionType) v1.ConditionStatus {
+	for _, condition := range status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status
+		}
+	}
+	return v1.ConditionUnknown
+}
+
+// setEgressCondition sets the condition in the status and returns whether the status is changed.
+func setEgressCondition(status *egressv1alpha2.EgressStatus, condition egressv1alpha2.EgressCondition) bool {
+	condition.LastTransitionTime = metav1.Now()
+	for i := range status.Conditions {
+		existing := &status.Conditions[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status == condition.Status && existing.Reason == condition.Reason && existing.Message == condition.Message {
+			return false
+		}
+		*existing = condition
+		return true
+	}
+	status.Conditions = append(status.Conditions, condition)
+	return true
+}
+
 func (c *EgressController) enqueueEgressGroup(key string) {
 	klog.V(4).Infof("Adding new key %s to EgressGroup queue", key)
 	c.queue.Add(key)
@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
@@ -147,7 +148,7 @@ func newController(objects, crdObjects []runtime.Object) *egressController {
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Core().V1().Namespaces(),
 		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
-	controller := NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPAllocator, egressGroupStore)
+	controller := NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPAllocator, egressGroupStore, record.NewFakeRecorder(100))
 	return &egressController{
 		controller,
 		client,
@@ -723,3 +724,241 @@ func checkExternalIPPoolUsed(t *testing.T, controller *egressController, poolNam
 	})
 	assert.NoError(t, err)
 }
+
+func TestSyncEgressIPs(t *testing.T) {
+	tests := []struct {
+		name                       string
+		existingEgresses           []*v1alpha2.Egress
+		inputEgress                *v1alpha2.Egress
+		expectedEgressIPs          []string
+		expectedExternalIPPoolUsed int
+		expectErr                  bool
+	}{
+		{
+			name: "Egress with NumEgressIPs and empty EgressIPs",
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1alpha2.EgressSpec{
+					NumEgressIPs:   3,
+					ExternalIPPool: "ipPoolA",
+				},
+			},
+			expectedEgressIPs:          []string{"1.1.1.1", "1.1.1.2", "1.1.1.3"},
+			expectedExternalIPPoolUsed: 3,
+		},
+		{
+			name: "Egress with increased NumEgressIPs",
+			existingEgresses: []*v1alpha2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: v1alpha2.EgressSpec{
+						EgressIPs:      []string{"1.1.1.5"},
+						NumEgressIPs:   1,
+						ExternalIPPool: "ipPoolA",
+					},
+				},
+			},
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1alpha2.EgressSpec{
+					EgressIPs:      []string{"1.1.1.5"},
+					NumEgressIPs:   2,
+					ExternalIPPool: "ipPoolA",
+				},
+			},
+			expectedEgressIPs:          []string{"1.1.1.5", "1.1.1.1"},
+			expectedExternalIPPoolUsed: 2,
+		},
+		{
+			name: "Egress with decreased NumEgressIPs",
+			existingEgresses: []*v1alpha2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: v1alpha2.EgressSpec{
+						EgressIPs:      []string{"1.1.1.5", "1.1.1.6", "1.1.1.7"},
+						NumEgressIPs:   3,
+						ExternalIPPool: "ipPoolA",
+					},
+				},
+			},
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1alpha2.EgressSpec{
+					EgressIPs:      []string{"1.1.1.5", "1.1.1.6", "1.1.1.7"},
+					NumEgressIPs:   1,
+					ExternalIPPool: "ipPoolA",
+				},
+			},
+			expectedEgressIPs:          []string{"1.1.1.5"},
+			expectedExternalIPPoolUsed: 1,
+		},
+		{
+			name: "Egress with conflicting EgressIPs",
+			existingEgresses: []*v1alpha2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: v1alpha2.EgressSpec{
+						EgressIP:       "1.1.1.2",
+						ExternalIPPool: "ipPoolA",
+					},
+				},
+			},
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+				Spec: v1alpha2.EgressSpec{
+					EgressIPs:      []string{"1.1.1.1", "1.1.1.2"},
+					ExternalIPPool: "ipPoolA",
+				},
+			},
+			expectedExternalIPPoolUsed: 1,
+			expectErr:                  true,
+		},
+		{
+			name: "Egress with EgressIPs and empty ExternalIPPool",
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1alpha2.EgressSpec{
+					EgressIPs: []string{"10.10.10.10", "10.10.10.11"},
+				},
+			},
+			expectedEgressIPs:          []string{"10.10.10.10", "10.10.10.11"},
+			expectedExternalIPPoolUsed: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			externalIPPool := newExternalIPPool("ipPoolA", "1.1.1.0/24", "", "")
+			controller := newController(nil, []runtime.Object{tt.inputEgress, externalIPPool})
+			controller.informerFactory.Start(stopCh)
+			controller.crdInformerFactory.Start(stopCh)
+			controller.informerFactory.WaitForCacheSync(stopCh)
+			controller.crdInformerFactory.WaitForCacheSync(stopCh)
+			go controller.externalIPAllocator.Run(stopCh)
+			require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+			controller.restoreIPAllocations(tt.existingEgresses)
+			gotEgressIPs, err := controller.syncEgressIPs(tt.inputEgress)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Empty(t, gotEgressIPs)
+			} else {
+				require.NoError(t, err)
+				assert.True(t, ipsEqual(gotEgressIPs, tt.expectedEgressIPs), "Got unexpected EgressIPs %v", gotEgressIPs)
+				egress, err := controller.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), tt.inputEgress.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedEgressIPs, egress.Spec.EgressIPs)
+			}
+			checkExternalIPPoolUsed(t, controller, externalIPPool.Name, tt.expectedExternalIPPoolUsed)
+		})
+	}
+}
+
+func TestSyncIPAllocatedCondition(t *testing.T) {
+	tests := []struct {
+		name              string
+		existingEgresses  []*v1alpha2.Egress
+		inputEgress       *v1alpha2.Egress
+		expectedStatus    v1.ConditionStatus
+		expectedReason    string
+		expectedEventType string
+	}{
+		{
+			name: "IP allocated",
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       v1alpha2.EgressSpec{ExternalIPPool: "ipPoolA"},
+			},
+			expectedStatus:    v1.ConditionTrue,
+			expectedReason:    reasonIPAllocated,
+			expectedEventType: v1.EventTypeNormal,
+		},
+		{
+			name: "ExternalIPPool not found",
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec:       v1alpha2.EgressSpec{ExternalIPPool: "ipPoolB"},
+			},
+			expectedStatus:    v1.ConditionFalse,
+			expectedReason:    reasonExternalIPPoolNotFound,
+			expectedEventType: v1.EventTypeWarning,
+		},
+		{
+			name: "ExternalIPPool exhausted",
+			existingEgresses: []*v1alpha2.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec:       v1alpha2.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "ipPoolA"},
+				},
+			},
+			inputEgress: &v1alpha2.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+				Spec:       v1alpha2.EgressSpec{ExternalIPPool: "ipPoolA"},
+			},
+			expectedStatus:    v1.ConditionFalse,
+			expectedReason:    reasonIPPoolExhausted,
+			expectedEventType: v1.EventTypeWarning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			externalIPPool := newExternalIPPool("ipPoolA", "", "1.1.1.1", "1.1.1.1")
+			controller := newController(nil, []runtime.Object{tt.inputEgress, externalIPPool})
+			eventRecorder := record.NewFakeRecorder(10)
+			controller.eventRecorder = eventRecorder
+			controller.informerFactory.Start(stopCh)
+			controller.crdInformerFactory.Start(stopCh)
+			controller.informerFactory.WaitForCacheSync(stopCh)
+			controller.crdInformerFactory.WaitForCacheSync(stopCh)
+			go controller.externalIPAllocator.Run(stopCh)
+			require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+			controller.restoreIPAllocations(tt.existingEgresses)
+
+			err := controller.syncEgress(tt.inputEgress.Name)
+			if tt.expectedStatus == v1.ConditionTrue {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			egress, err := controller.crdClient.CrdV1alpha2().Egresses().Get(context.TODO(), tt.inputEgress.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Len(t, egress.Status.Conditions, 1)
+			condition := egress.Status.Conditions[0]
+			assert.Equal(t, v1alpha2.EgressIPAllocated, condition.Type)
+			assert.Equal(t, tt.expectedStatus, condition.Status)
+			assert.Equal(t, tt.expectedReason, condition.Reason)
+			select {
+			case event := <-eventRecorder.Events:
+				assert.Contains(t, event, tt.expectedEventType)
+			default:
+				t.Errorf("Expected an Event of type %s", tt.expectedEventType)
+			}
+
+			// The Warning Event should not be emitted again when the allocation is retried.
+			if tt.expectedStatus == v1.ConditionFalse {
+				require.Error(t, controller.syncEgress(tt.inputEgress.Name))
+				select {
+				case event := <-eventRecorder.Events:
+					t.Errorf("Expected no Event, got %s", event)
+				default:
+				}
+			}
+		})
+	}
+}
+
+func TestSetEgressCondition(t *testing.T) {
+	oldTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	status := &v1alpha2.EgressStatus{
+		Conditions: []v1alpha2.EgressCondition{
+			{Type: v1alpha2.EgressIPAllocated, Status: v1.ConditionTrue, Reason: reasonIPAllocated, LastTransitionTime: oldTime},
+		},
+	}
+	assert.False(t, setEgressCondition(status, v1alpha2.EgressCondition{Type: v1alpha2.EgressIPAllocated, Status: v1.ConditionTrue, Reason: reasonIPAllocated}))
+	assert.Equal(t, oldTime, status.Conditions[0].LastTransitionTime)
+	assert.True(t, setEgressCondition(status, v1alpha2.EgressCondition{Type: v1alpha2.EgressIPAllocated, Status: v1.ConditionFalse, Reason: reasonIPPoolExhausted}))
+	assert.Equal(t, v1.ConditionFalse, status.Conditions[0].Status)
+	assert.True(t, status.Conditions[0].LastTransitionTime.After(oldTime.Time))
+}
@@ -18,9 +18,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"reflect"
 
 	admv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
@@ -47,23 +50,44 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 
 	shouldAllow := func(oldEgress, newEgress *crdv1alpha2.Egress) (bool, string) {
-		// Allow it if EgressIP and ExternalIPPool don't change.
-		if newEgress.Spec.EgressIP == oldEgress.Spec.EgressIP && newEgress.Spec.ExternalIPPool == oldEgress.Spec.ExternalIPPool {
-			return true, ""
+		if newEgress.Spec.EgressIP != "" && len(newEgress.Spec.EgressIPs) > 0 {
+			return false, "egressIP and egressIPs cannot be set at the same time"
+		}
+		if newEgress.Spec.NumEgressIPs > 0 && (newEgress.Spec.ExternalIPPool == "" || newEgress.Spec.EgressIP != "") {
+			return false, "numEgressIPs can only be set when externalIPPool is set and egressIP is empty"
+		}
+		if newEgress.Spec.Bandwidth != nil {
+			if allowed, msg := validateBandwidth(newEgress.Spec.Bandwidth); !allowed {
+				return false, msg
+			}
 		}
-		// Only validate whether the specified Egress IP is in the Pool when they are both set.
-		if newEgress.Spec.EgressIP == "" || newEgress.Spec.ExternalIPPool == "" {
+		// Allow it if EgressIP, EgressIPs and ExternalIPPool don't change.
+		if newEgress.Spec.EgressIP == oldEgress.Spec.EgressIP && reflect.DeepEqual(newEgress.Spec.EgressIPs, oldEgress.Spec.EgressIPs) &&
+			newEgress.Spec.ExternalIPPool == oldEgress.Spec.ExternalIPPool {
 			return true, ""
 		}
-		ip := net.ParseIP(newEgress.Spec.EgressIP)
-		if ip == nil {
-			return false, fmt.Sprintf("IP %s is not valid", newEgress.Spec.EgressIP)
+		egressIPs := getEgressIPs(newEgress)
+		ips := sets.NewString()
+		for _, egressIP := range egressIPs {
+			if net.ParseIP(egressIP) == nil {
+				return false, fmt.Sprintf("IP %s is not valid", egressIP)
+			}
+			if ips.Has(egressIP) {
+				return false, fmt.Sprintf("IP %s is duplicate", egressIP)
+			}
+			ips.Insert(egressIP)
+		}
+		// Only validate whether the specified Egress IPs are in the Pool when they are both set.
+		if len(egressIPs) == 0 || newEgress.Spec.ExternalIPPool == "" {
+			return true, ""
 		}
 		if !c.externalIPAllocator.IPPoolExists(newEgress.Spec.ExternalIPPool) {
 			return false, fmt.Sprintf("ExternalIPPool %s does not exist", newEgress.Spec.ExternalIPPool)
 		}
-		if !c.externalIPAllocator.IPPoolHasIP(newEgress.Spec.ExternalIPPool, ip) {
-			return false, fmt.Sprintf("IP %s is not within the IP range", newEgress.Spec.EgressIP)
+		for _, egressIP := range egressIPs {
+			if !c.externalIPAllocator.IPPoolHasIP(newEgress.Spec.ExternalIPPool, net.ParseIP(egressIP)) {
+				return false, fmt.Sprintf("IP %s is not within the IP range", egressIP)
+			}
 		}
 		return true, ""
 	}
@@ -92,6 +116,25 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 }
 
+// validateBandwidth checks that the rate and the burst of the Bandwidth are valid quantities which are not less than
+// 1k, as OVS meters enforcing them use kilobits as the unit.
+func validateBandwidth(bandwidth *crdv1alpha2.Bandwidth) (bool, string) {
+	validate := func(field, value string) (bool, string) {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return false, fmt.Sprintf("bandwidth %s %s is not valid: %v", field, value, err)
+		}
+		if quantity.Value() < 1000 {
+			return false, fmt.Sprintf("bandwidth %s %s must not be less than 1k", field, value)
+		}
+		return true, ""
+	}
+	if allowed, msg := validate("rate", bandwidth.Rate); !allowed {
+		return false, msg
+	}
+	return validate("burst", bandwidth.Burst)
+}
+
 func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 	return &admv1.AdmissionResponse{
 		Result: &metav1.Status{
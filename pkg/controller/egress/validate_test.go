@@ -33,6 +33,17 @@ func marshal(object runtime.Object) []byte {
 	return raw
 }
 
+func newMultiIPEgress(name, externalIPPool string, numEgressIPs int32, egressIPs ...string) *crdv1alpha2.Egress {
+	return &crdv1alpha2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: crdv1alpha2.EgressSpec{
+			EgressIPs:      egressIPs,
+			NumEgressIPs:   numEgressIPs,
+			ExternalIPPool: externalIPPool,
+		},
+	}
+}
+
 func TestEgressControllerValidateEgress(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -120,6 +131,109 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 			},
 			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
 		},
+		{
+			name:                   "Requesting EgressIPs out of range should not be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newMultiIPEgress("foo", "bar", 0, "10.10.10.1", "10.10.11.1"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.11.1 is not within the IP range",
+				},
+			},
+		},
+		{
+			name:                   "Requesting duplicate EgressIPs should not be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newMultiIPEgress("foo", "bar", 0, "10.10.10.1", "10.10.10.1"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.10.1 is duplicate",
+				},
+			},
+		},
+		{
+			name:                   "Requesting normal EgressIPs should be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newMultiIPEgress("foo", "bar", 2, "10.10.10.1", "10.10.10.2"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Setting both EgressIP and EgressIPs should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1alpha2.Egress {
+					egress := newMultiIPEgress("foo", "", 0, "10.10.10.1")
+					egress.Spec.EgressIP = "10.10.10.2"
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "egressIP and egressIPs cannot be set at the same time",
+				},
+			},
+		},
+		{
+			name: "Setting NumEgressIPs without ExternalIPPool should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newMultiIPEgress("foo", "", 2))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "numEgressIPs can only be set when externalIPPool is set and egressIP is empty",
+				},
+			},
+		},
+		{
+			name: "Setting valid Bandwidth should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1alpha2.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil)
+					egress.Spec.Bandwidth = &crdv1alpha2.Bandwidth{Rate: "10M", Burst: "500k"}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Setting Bandwidth rate less than 1k should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(func() *crdv1alpha2.Egress {
+					egress := newEgress("foo", "10.10.10.1", "", nil, nil)
+					egress.Spec.Bandwidth = &crdv1alpha2.Bandwidth{Rate: "500", Burst: "500k"}
+					return egress
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "bandwidth rate 500 must not be less than 1k",
+				},
+			},
+		},
 		{
 			name: "DELETE operation should be allowed",
 			request: &admv1.AdmissionRequest{
@@ -15,10 +15,12 @@
 package externalippool
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -79,21 +81,41 @@ type ExternalIPAllocator interface {
 	// RestoreIPAllocations is used to restore the previous allocated IPs after controller restarts. It will return the
 	// succeeded IP Allocations.
 	RestoreIPAllocations(allocations []IPAllocation) []IPAllocation
-	// AllocateIPFromPool allocates an IP from the given IP pool.
-	AllocateIPFromPool(externalIPPool string) (net.IP, error)
+	// AllocateIPFromPool allocates an IP from the given IP pool to the given owner.
+	AllocateIPFromPool(externalIPPool string, owner corev1.ObjectReference) (net.IP, error)
 	// IPPoolExists checks whether the IP pool exists.
 	IPPoolExists(externalIPPool string) bool
 	// IPPoolHasIP checks whether the IP pool contains the given IP.
 	IPPoolHasIP(externalIPPool string, ip net.IP) bool
-	// UpdateIPAllocation marks the IP in the specified ExternalIPPool as occupied.
-	UpdateIPAllocation(externalIPPool string, ip net.IP) error
+	// UpdateIPAllocation marks the IP in the specified ExternalIPPool as occupied by the given owner.
+	UpdateIPAllocation(externalIPPool string, ip net.IP, owner corev1.ObjectReference) error
 	// ReleaseIP releases the IP to the IP pool.
 	ReleaseIP(externalIPPool string, ip net.IP) error
 	// HasSynced indicates ExternalIPAllocator has finished syncing all ExternalIPPool resources.
 	HasSynced() bool
 }
 
+// ExternalIPPoolInfo contains the usage of an ExternalIPPool and the IPs allocated from it.
+type ExternalIPPoolInfo struct {
+	// Name is the name of the ExternalIPPool.
+	Name string
+	// Total is the number of IPs in the ExternalIPPool.
+	Total int
+	// Used is the number of IPs allocated from the ExternalIPPool.
+	Used int
+	// Allocations are the IPs allocated from the ExternalIPPool and their owners, sorted by IP.
+	Allocations []IPAllocation
+}
+
+// ExternalIPPoolQuerier queries the usage and the IP allocations of ExternalIPPools.
+type ExternalIPPoolQuerier interface {
+	// QueryExternalIPPools returns the given ExternalIPPool, or all ExternalIPPools sorted by name if poolName is
+	// empty. It returns ErrExternalIPPoolNotFound if the given ExternalIPPool doesn't exist.
+	QueryExternalIPPools(poolName string) ([]ExternalIPPoolInfo, error)
+}
+
 var _ ExternalIPAllocator = (*ExternalIPPoolController)(nil)
+var _ ExternalIPPoolQuerier = (*ExternalIPPoolController)(nil)
 
 // ExternalIPPoolController is responsible for synchronizing the ExternalIPPool resources.
 type ExternalIPPoolController struct {
@@ -105,6 +127,10 @@ type ExternalIPPoolController struct {
 	ipAllocatorMap   map[string]ipallocator.MultiIPAllocator
 	ipAllocatorMutex sync.RWMutex
 
+	// ipOwnerMap is a map from ExternalIPPool name to a map from allocated IP to the owner of the IP.
+	ipOwnerMap   map[string]map[string]corev1.ObjectReference
+	ipOwnerMutex sync.RWMutex
+
 	// ipAllocatorInitialized stores a boolean value, which tracks if the ipAllocatorMap has been initialized
 	// with the full list of ExternalIPPool.
 	ipAllocatorInitialized *atomic.Value
@@ -126,6 +152,7 @@ func NewExternalIPPoolController(crdClient clientset.Interface, externalIPPoolIn
 		queue:                      workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "externalIPPool"),
 		ipAllocatorInitialized:     &atomic.Value{},
 		ipAllocatorMap:             make(map[string]ipallocator.MultiIPAllocator),
+		ipOwnerMap:                 make(map[string]map[string]corev1.ObjectReference),
 	}
 	externalIPPoolInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
@@ -151,7 +178,7 @@ func (c *ExternalIPPoolController) AddEventHandler(handler ExternalIPPoolEventHa
 func (c *ExternalIPPoolController) RestoreIPAllocations(allocations []IPAllocation) []IPAllocation {
 	var succeeded []IPAllocation
 	for _, allocation := range allocations {
-		if err := c.UpdateIPAllocation(allocation.IPPoolName, allocation.IP); err != nil {
+		if err := c.UpdateIPAllocation(allocation.IPPoolName, allocation.IP, allocation.ObjectReference); err != nil {
 			klog.ErrorS(err, "Failed to restore IP allocation", "ip", allocation.IP, "ipPool", allocation.IPPoolName)
 		} else {
 			succeeded = append(succeeded, allocation)
@@ -252,6 +279,58 @@ func (c *ExternalIPPoolController) deleteIPAllocator(poolName string) {
 	c.ipAllocatorMutex.Lock()
 	defer c.ipAllocatorMutex.Unlock()
 	delete(c.ipAllocatorMap, poolName)
+
+	c.ipOwnerMutex.Lock()
+	defer c.ipOwnerMutex.Unlock()
+	delete(c.ipOwnerMap, poolName)
+}
+
+// setIPOwner records the owner of the given IP allocated from the given IP pool.
+func (c *ExternalIPPoolController) setIPOwner(poolName string, ip net.IP, owner corev1.ObjectReference) {
+	c.ipOwnerMutex.Lock()
+	defer c.ipOwnerMutex.Unlock()
+	owners, exists := c.ipOwnerMap[poolName]
+	if !exists {
+		owners = make(map[string]corev1.ObjectReference)
+		c.ipOwnerMap[poolName] = owners
+	}
+	owners[ip.String()] = owner
+}
+
+// deleteIPOwner deletes the owner of the given IP allocated from the given IP pool.
+func (c *ExternalIPPoolController) deleteIPOwner(poolName string, ip net.IP) {
+	c.ipOwnerMutex.Lock()
+	defer c.ipOwnerMutex.Unlock()
+	owners, exists := c.ipOwnerMap[poolName]
+	if !exists {
+		return
+	}
+	delete(owners, ip.String())
+	if len(owners) == 0 {
+		delete(c.ipOwnerMap, poolName)
+	}
+}
+
+// getIPAllocations returns the IPs allocated from the given IP pool and their owners, sorted by IP.
+func (c *ExternalIPPoolController) getIPAllocations(poolName string) []IPAllocation {
+	c.ipOwnerMutex.RLock()
+	defer c.ipOwnerMutex.RUnlock()
+	owners := c.ipOwnerMap[poolName]
+	if len(owners) == 0 {
+		return nil
+	}
+	allocations := make([]IPAllocation, 0, len(owners))
+	for ip, owner := range owners {
+		allocations = append(allocations, IPAllocation{
+			ObjectReference: owner,
+			IPPoolName:      poolName,
+			IP:              net.ParseIP(ip),
+		})
+	}
+	sort.Slice(allocations, func(i, j int) bool {
+		return bytes.Compare(allocations[i].IP.To16(), allocations[j].IP.To16()) < 0
+	})
+	return allocations
 }
 
 // getIPAllocator gets the IP allocator of the given IP pool.
@@ -262,8 +341,8 @@ func (c *ExternalIPPoolController) getIPAllocator(poolName string) (ipallocator.
 	return ipAllocator, exists
 }
 
-// AllocateIPFromPool allocates an IP from the the given IP pool.
-func (c *ExternalIPPoolController) AllocateIPFromPool(ipPoolName string) (net.IP, error) {
+// AllocateIPFromPool allocates an IP from the the given IP pool to the given owner.
+func (c *ExternalIPPoolController) AllocateIPFromPool(ipPoolName string, owner corev1.ObjectReference) (net.IP, error) {
 	c.handlersWaitGroup.Wait()
 	ipAllocator, exists := c.getIPAllocator(ipPoolName)
 	if !exists {
@@ -273,12 +352,13 @@ func (c *ExternalIPPoolController) AllocateIPFromPool(ipPoolName string) (net.IP
 	if err != nil {
 		return ip, err
 	}
+	c.setIPOwner(ipPoolName, ip, owner)
 	c.queue.Add(ipPoolName)
 	return ip, nil
 }
 
-// UpdateIPAllocation sets the IP in the specified ExternalIPPool.
-func (c *ExternalIPPoolController) UpdateIPAllocation(poolName string, ip net.IP) error {
+// UpdateIPAllocation sets the IP in the specified ExternalIPPool as occupied by the given owner.
+func (c *ExternalIPPoolController) UpdateIPAllocation(poolName string, ip net.IP, owner corev1.ObjectReference) error {
 	ipAllocator, exists := c.getIPAllocator(poolName)
 	if !exists {
 		return ErrExternalIPPoolNotFound
@@ -287,6 +367,7 @@ func (c *ExternalIPPoolController) UpdateIPAllocation(poolName string, ip net.IP
 	if err != nil {
 		return err
 	}
+	c.setIPOwner(poolName, ip, owner)
 	c.queue.Add(poolName)
 	return nil
 }
@@ -339,10 +420,48 @@ func (c *ExternalIPPoolController) ReleaseIP(poolName string, ip net.IP) error {
 	if err := allocator.Release(ip); err != nil {
 		return err
 	}
+	c.deleteIPOwner(poolName, ip)
 	c.queue.Add(poolName)
 	return nil
 }
 
+// QueryExternalIPPools returns the usage and the IP allocations of the given ExternalIPPool, or of all ExternalIPPools
+// if poolName is empty. The information is read from the IP allocators, so it includes all the allocated IPs, unlike
+// the status of the ExternalIPPool resources which only reports the usage.
+func (c *ExternalIPPoolController) QueryExternalIPPools(poolName string) ([]ExternalIPPoolInfo, error) {
+	c.ipAllocatorMutex.RLock()
+	var poolNames []string
+	if poolName != "" {
+		if _, exists := c.ipAllocatorMap[poolName]; !exists {
+			c.ipAllocatorMutex.RUnlock()
+			return nil, ErrExternalIPPoolNotFound
+		}
+		poolNames = []string{poolName}
+	} else {
+		for name := range c.ipAllocatorMap {
+			poolNames = append(poolNames, name)
+		}
+		sort.Strings(poolNames)
+	}
+	c.ipAllocatorMutex.RUnlock()
+
+	infos := make([]ExternalIPPoolInfo, 0, len(poolNames))
+	for _, name := range poolNames {
+		ipAllocator, exists := c.getIPAllocator(name)
+		// The ExternalIPPool may have been deleted in the meantime.
+		if !exists {
+			continue
+		}
+		infos = append(infos, ExternalIPPoolInfo{
+			Name:        name,
+			Total:       ipAllocator.Total(),
+			Used:        ipAllocator.Used(),
+			Allocations: c.getIPAllocations(name),
+		})
+	}
+	return infos, nil
+}
+
 func (c *ExternalIPPoolController) IPPoolHasIP(poolName string, ip net.IP) bool {
 	allocator, exists := c.getIPAllocator(poolName)
 	if !exists {
@@ -16,6 +16,7 @@ package externalippool
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"sort"
 	"testing"
@@ -141,9 +142,9 @@ func TestAllocateIPFromPool(t *testing.T) {
 			go controller.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
 			for _, alloc := range tt.allocatedIP {
-				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip)))
+				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip), v1.ObjectReference{}))
 			}
-			ipGot, err := controller.AllocateIPFromPool(tt.allocateFrom)
+			ipGot, err := controller.AllocateIPFromPool(tt.allocateFrom, v1.ObjectReference{})
 			assert.Equal(t, tt.expectError, err != nil)
 			assert.Equal(t, net.ParseIP(tt.expectedIP), ipGot)
 			for idx, pool := range tt.ipPools {
@@ -219,7 +220,7 @@ func TestReleaseIP(t *testing.T) {
 			go controller.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
 			for _, alloc := range tt.allocatedIP {
-				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip)))
+				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip), v1.ObjectReference{}))
 			}
 			err := controller.ReleaseIP(tt.ipPoolToRelease, net.ParseIP(tt.ipToRelease))
 			assert.Equal(t, tt.expectError, err != nil)
@@ -340,7 +341,7 @@ func TestConsumersRestoreIPAllocation(t *testing.T) {
 		}
 		restored := controller.RestoreIPAllocations(allocatedIPs)
 		assert.Equal(t, allocatedIPs, restored)
-		ip, err := controller.AllocateIPFromPool("eip1")
+		ip, err := controller.AllocateIPFromPool("eip1", v1.ObjectReference{})
 		assert.NoError(t, err)
 		allocatedIPCh <- ip.String()
 	}()
@@ -353,7 +354,7 @@ func TestConsumersRestoreIPAllocation(t *testing.T) {
 		}
 		restored := controller.RestoreIPAllocations(allocatedIPs)
 		assert.Equal(t, allocatedIPs, restored)
-		ip, err := controller.AllocateIPFromPool("eip1")
+		ip, err := controller.AllocateIPFromPool("eip1", v1.ObjectReference{})
 		assert.NoError(t, err)
 		allocatedIPCh <- ip.String()
 	}()
@@ -468,6 +469,71 @@ func checkExternalIPPoolStatus(t *testing.T, controller *controller, poolName st
 	assert.NoError(t, err)
 }
 
+func TestQueryExternalIPPools(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	eip1 := newExternalIPPool("eip1", "", "10.10.10.2", "10.10.10.10")
+	eip2 := newExternalIPPool("eip2", "10.10.11.0/24", "", "")
+	controller := newController([]runtime.Object{eip1, eip2})
+	controller.crdInformerFactory.Start(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+	go controller.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
+
+	egressOwner := v1.ObjectReference{Kind: "Egress", Name: "egress1"}
+	serviceOwner := v1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"}
+	require.NoError(t, controller.UpdateIPAllocation("eip1", net.ParseIP("10.10.10.10"), serviceOwner))
+	ip, err := controller.AllocateIPFromPool("eip1", egressOwner)
+	require.NoError(t, err)
+	assert.Equal(t, net.ParseIP("10.10.10.2"), ip)
+	// More IPs than the ones allocated from eip1 are allocated from eip2, all of them must be listed.
+	var eip2Allocations []IPAllocation
+	for i := 1; i <= 150; i++ {
+		ip := net.ParseIP(fmt.Sprintf("10.10.11.%d", i))
+		owner := v1.ObjectReference{Kind: "Egress", Name: fmt.Sprintf("egress-%d", i)}
+		require.NoError(t, controller.UpdateIPAllocation("eip2", ip, owner))
+		eip2Allocations = append(eip2Allocations, IPAllocation{ObjectReference: owner, IPPoolName: "eip2", IP: ip})
+	}
+
+	infos, err := controller.QueryExternalIPPools("")
+	require.NoError(t, err)
+	assert.Equal(t, []ExternalIPPoolInfo{
+		{
+			Name:  "eip1",
+			Total: 9,
+			Used:  2,
+			Allocations: []IPAllocation{
+				{ObjectReference: egressOwner, IPPoolName: "eip1", IP: net.ParseIP("10.10.10.2")},
+				{ObjectReference: serviceOwner, IPPoolName: "eip1", IP: net.ParseIP("10.10.10.10")},
+			},
+		},
+		{
+			Name:        "eip2",
+			Total:       254,
+			Used:        150,
+			Allocations: eip2Allocations,
+		},
+	}, infos)
+
+	require.NoError(t, controller.ReleaseIP("eip1", net.ParseIP("10.10.10.2")))
+	infos, err = controller.QueryExternalIPPools("eip1")
+	require.NoError(t, err)
+	assert.Equal(t, []ExternalIPPoolInfo{
+		{
+			Name:  "eip1",
+			Total: 9,
+			Used:  1,
+			Allocations: []IPAllocation{
+				{ObjectReference: serviceOwner, IPPoolName: "eip1", IP: net.ParseIP("10.10.10.10")},
+			},
+		},
+	}, infos)
+	checkExternalIPPoolStatus(t, controller, "eip1", antreacrds.ExternalIPPoolUsage{Total: 9, Used: 1})
+
+	_, err = controller.QueryExternalIPPools("eip3")
+	assert.ErrorIs(t, err, ErrExternalIPPoolNotFound)
+}
+
 func TestExternalIPPoolController_RestoreIPAllocations(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -621,7 +687,7 @@ func TestExternalIPPoolController_RestoreIPAllocations(t *testing.T) {
 			go controller.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
 			for _, alloc := range tt.allocations {
-				err := controller.UpdateIPAllocation(alloc.IPPoolName, alloc.IP)
+				err := controller.UpdateIPAllocation(alloc.IPPoolName, alloc.IP, alloc.ObjectReference)
 				require.NoError(t, err)
 			}
 			succeeded := controller.RestoreIPAllocations(tt.allocationsToRestore)
@@ -70,6 +70,8 @@ type Interface interface {
 	GetGroupsForPod(namespace, name string) (map[GroupType][]string, bool)
 	// GetGroupsForExternalEntity returns the groups that select the given ExternalEntity.
 	GetGroupsForExternalEntity(namespace, name string) (map[GroupType][]string, bool)
+	// GetPod returns the Pod with the given Namespace and name if it's in the index.
+	GetPod(namespace, name string) (*v1.Pod, bool)
 	// AddPod adds or updates a Pod to the index. If any existing groups are affected, eventHandlers will be called with
 	// the affected groups.
 	AddPod(pod *v1.Pod)
@@ -261,6 +263,19 @@ func (i *GroupEntityIndex) GetGroupsForExternalEntity(namespace, name string) (m
 	return i.getGroups(externalEntityType, namespace, name)
 }
 
+func (i *GroupEntityIndex) GetPod(namespace, name string) (*v1.Pod, bool) {
+	eKey := getEntityItemKeyByName(podEntityType, namespace, name)
+
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	eItem, exists := i.entityItems[eKey]
+	if !exists {
+		return nil, false
+	}
+	return eItem.entity.(*v1.Pod), true
+}
+
 func (i *GroupEntityIndex) getGroups(entityType entityType, namespace, name string) (map[GroupType][]string, bool) {
 	eKey := getEntityItemKeyByName(entityType, namespace, name)
 
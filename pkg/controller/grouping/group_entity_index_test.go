@@ -275,6 +275,21 @@ func TestGroupEntityIndexGetGroups(t *testing.T) {
 	}
 }
 
+func TestGroupEntityIndexGetPod(t *testing.T) {
+	index := NewGroupEntityIndex()
+	index.AddPod(podFoo1)
+	index.AddExternalEntity(eeFoo2)
+
+	pod, found := index.GetPod(podFoo1.Namespace, podFoo1.Name)
+	assert.True(t, found)
+	assert.Equal(t, podFoo1, pod)
+	_, found = index.GetPod(podFoo1InOtherNamespace.Namespace, podFoo1InOtherNamespace.Name)
+	assert.False(t, found)
+	// ExternalEntities are not returned.
+	_, found = index.GetPod(eeFoo2.Namespace, eeFoo2.Name)
+	assert.False(t, found)
+}
+
 func TestGroupEntityIndexUpdateGroup(t *testing.T) {
 	index := NewGroupEntityIndex()
 	pods := []*v1.Pod{podFoo1, podFoo2, podBar1, podFoo1InOtherNamespace}
@@ -117,6 +117,20 @@ var (
 		Help:           "The total number of actual status updates performed for Antrea ClusterNetworkPolicy Custom Resources",
 		StabilityLevel: metrics.ALPHA,
 	})
+	AdminNetworkPolicyStatusUpdates = metrics.NewCounter(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "adminnp_status_updates",
+		Help:           "The total number of actual status updates performed for AdminNetworkPolicy resources",
+		StabilityLevel: metrics.ALPHA,
+	})
+	BaselineAdminNetworkPolicyStatusUpdates = metrics.NewCounter(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "banp_status_updates",
+		Help:           "The total number of actual status updates performed for BaselineAdminNetworkPolicy resources",
+		StabilityLevel: metrics.ALPHA,
+	})
 )
 
 // Initialize Prometheus metrics collection.
@@ -156,4 +170,10 @@ func InitializePrometheusMetrics() {
 	if err := legacyregistry.Register(AntreaClusterNetworkPolicyStatusUpdates); err != nil {
 		klog.Errorf("Failed to register antrea_controller_acnp_status_updates with Prometheus: %s", err.Error())
 	}
+	if err := legacyregistry.Register(AdminNetworkPolicyStatusUpdates); err != nil {
+		klog.Errorf("Failed to register antrea_controller_adminnp_status_updates with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(BaselineAdminNetworkPolicyStatusUpdates); err != nil {
+		klog.Errorf("Failed to register antrea_controller_banp_status_updates with Prometheus: %s", err.Error())
+	}
 }
@@ -0,0 +1,515 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	npav1alpha1 "antrea.io/antrea/third_party/network-policy-api/apis/v1alpha1"
+)
+
+var (
+	// AdminNetworkPolicyGVR is the GroupVersionResource of AdminNetworkPolicies, used to create
+	// the dynamic informer of AdminNetworkPolicies.
+	AdminNetworkPolicyGVR = npav1alpha1.SchemeGroupVersion.WithResource("adminnetworkpolicies")
+	// BaselineAdminNetworkPolicyGVR is the GroupVersionResource of BaselineAdminNetworkPolicies,
+	// used to create the dynamic informer of BaselineAdminNetworkPolicies.
+	BaselineAdminNetworkPolicyGVR = npav1alpha1.SchemeGroupVersion.WithResource("baselineadminnetworkpolicies")
+)
+
+// CheckAdminNetworkPolicyAPIs checks that the AdminNetworkPolicy and BaselineAdminNetworkPolicy
+// APIs are served by the K8s apiserver, i.e. that their CRDs are installed. Otherwise the informers
+// of these resources would never be synced.
+func CheckAdminNetworkPolicyAPIs(discoveryClient discovery.DiscoveryInterface) error {
+	groupVersion := npav1alpha1.SchemeGroupVersion.String()
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(groupVersion)
+	if err != nil {
+		return fmt.Errorf("error getting the resources of API %s, the CRDs of the API must be installed: %v", groupVersion, err)
+	}
+	resources := sets.NewString()
+	for _, resource := range resourceList.APIResources {
+		resources.Insert(resource.Name)
+	}
+	for _, gvr := range []schema.GroupVersionResource{AdminNetworkPolicyGVR, BaselineAdminNetworkPolicyGVR} {
+		if !resources.Has(gvr.Resource) {
+			return fmt.Errorf("resource %s of API %s is not found, its CRD must be installed", gvr.Resource, groupVersion)
+		}
+	}
+	return nil
+}
+
+// adminPolicyRule is the common representation of the rules of AdminNetworkPolicies and
+// BaselineAdminNetworkPolicies, whose action has been converted to an Antrea rule action.
+type adminPolicyRule struct {
+	name   string
+	action crdv1alpha1.RuleAction
+	peers  []npav1alpha1.AdminNetworkPolicyPeer
+	ports  *[]npav1alpha1.AdminNetworkPolicyPort
+}
+
+// fromUnstructured converts an object received from a dynamic informer, which may be wrapped in
+// a tombstone, to the provided typed object.
+func fromUnstructured(obj interface{}, into interface{}) error {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("invalid type: %T", obj)
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), into)
+}
+
+func toAdminNetworkPolicy(obj interface{}) (*npav1alpha1.AdminNetworkPolicy, error) {
+	anp := &npav1alpha1.AdminNetworkPolicy{}
+	if err := fromUnstructured(obj, anp); err != nil {
+		return nil, err
+	}
+	return anp, nil
+}
+
+func toBaselineAdminNetworkPolicy(obj interface{}) (*npav1alpha1.BaselineAdminNetworkPolicy, error) {
+	banp := &npav1alpha1.BaselineAdminNetworkPolicy{}
+	if err := fromUnstructured(obj, banp); err != nil {
+		return nil, err
+	}
+	return banp, nil
+}
+
+// addAdminNP receives AdminNetworkPolicy ADD events and creates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) addAdminNP(obj interface{}) {
+	defer n.heartbeat("addAdminNP")
+	anp, err := toAdminNetworkPolicy(obj)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when adding AdminNetworkPolicy")
+		return
+	}
+	klog.InfoS("Processing AdminNetworkPolicy ADD event", "adminNetworkPolicy", anp.Name)
+	n.createInternalNetworkPolicy(n.processAdminNetworkPolicy(anp))
+}
+
+// updateAdminNP receives AdminNetworkPolicy UPDATE events and updates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) updateAdminNP(old, cur interface{}) {
+	defer n.heartbeat("updateAdminNP")
+	oldANP, err := toAdminNetworkPolicy(old)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when updating AdminNetworkPolicy")
+		return
+	}
+	curANP, err := toAdminNetworkPolicy(cur)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when updating AdminNetworkPolicy")
+		return
+	}
+	// The status updates of the AdminNetworkPolicy don't need to be processed.
+	if oldANP.Generation == curANP.Generation {
+		return
+	}
+	klog.InfoS("Processing AdminNetworkPolicy UPDATE event", "adminNetworkPolicy", curANP.Name)
+	n.updateInternalNetworkPolicy(n.processAdminNetworkPolicy(curANP))
+}
+
+// deleteAdminNP receives AdminNetworkPolicy DELETED events and deletes resources
+// which can be consumed by agents to delete corresponding rules on the Nodes.
+func (n *NetworkPolicyController) deleteAdminNP(old interface{}) {
+	anp, err := toAdminNetworkPolicy(old)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when deleting AdminNetworkPolicy")
+		return
+	}
+	defer n.heartbeat("deleteAdminNP")
+	klog.InfoS("Processing AdminNetworkPolicy DELETE event", "adminNetworkPolicy", anp.Name)
+	n.deleteInternalNetworkPolicy(internalNetworkPolicyKeyFunc(anp))
+}
+
+// addBANP receives BaselineAdminNetworkPolicy ADD events and creates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) addBANP(obj interface{}) {
+	defer n.heartbeat("addBANP")
+	banp, err := toBaselineAdminNetworkPolicy(obj)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when adding BaselineAdminNetworkPolicy")
+		return
+	}
+	klog.InfoS("Processing BaselineAdminNetworkPolicy ADD event", "baselineAdminNetworkPolicy", banp.Name)
+	n.createInternalNetworkPolicy(n.processBaselineAdminNetworkPolicy(banp))
+}
+
+// updateBANP receives BaselineAdminNetworkPolicy UPDATE events and updates resources
+// which can be consumed by agents to configure corresponding rules on the Nodes.
+func (n *NetworkPolicyController) updateBANP(old, cur interface{}) {
+	defer n.heartbeat("updateBANP")
+	oldBANP, err := toBaselineAdminNetworkPolicy(old)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when updating BaselineAdminNetworkPolicy")
+		return
+	}
+	curBANP, err := toBaselineAdminNetworkPolicy(cur)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when updating BaselineAdminNetworkPolicy")
+		return
+	}
+	// The status updates of the BaselineAdminNetworkPolicy don't need to be processed.
+	if oldBANP.Generation == curBANP.Generation {
+		return
+	}
+	klog.InfoS("Processing BaselineAdminNetworkPolicy UPDATE event", "baselineAdminNetworkPolicy", curBANP.Name)
+	n.updateInternalNetworkPolicy(n.processBaselineAdminNetworkPolicy(curBANP))
+}
+
+// deleteBANP receives BaselineAdminNetworkPolicy DELETED events and deletes resources
+// which can be consumed by agents to delete corresponding rules on the Nodes.
+func (n *NetworkPolicyController) deleteBANP(old interface{}) {
+	banp, err := toBaselineAdminNetworkPolicy(old)
+	if err != nil {
+		klog.ErrorS(err, "Error decoding object when deleting BaselineAdminNetworkPolicy")
+		return
+	}
+	defer n.heartbeat("deleteBANP")
+	klog.InfoS("Processing BaselineAdminNetworkPolicy DELETE event", "baselineAdminNetworkPolicy", banp.Name)
+	n.deleteInternalNetworkPolicy(internalNetworkPolicyKeyFunc(banp))
+}
+
+// createInternalNetworkPolicy adds a new internal NetworkPolicy to the store and enqueues it.
+func (n *NetworkPolicyController) createInternalNetworkPolicy(internalNP *antreatypes.NetworkPolicy) {
+	klog.V(2).Infof("Creating new internal NetworkPolicy %s for %s", internalNP.Name, internalNP.SourceRef.ToString())
+	n.internalNetworkPolicyStore.Create(internalNP)
+	n.enqueueInternalNetworkPolicy(internalNP.Name)
+}
+
+// updateInternalNetworkPolicy replaces an existing internal NetworkPolicy in the store, enqueues
+// it and cleans up the groups which are no longer referenced.
+func (n *NetworkPolicyController) updateInternalNetworkPolicy(curInternalNP *antreatypes.NetworkPolicy) {
+	klog.V(2).Infof("Updating existing internal NetworkPolicy %s for %s", curInternalNP.Name, curInternalNP.SourceRef.ToString())
+	key := curInternalNP.Name
+	// Lock access to internal NetworkPolicy store such that concurrent access
+	// to an internal NetworkPolicy is not allowed. This will avoid the
+	// case in which an Update to an internal NetworkPolicy object may
+	// cause the SpanMeta member to be overridden with stale SpanMeta members
+	// from an older internal NetworkPolicy.
+	n.internalNetworkPolicyMutex.Lock()
+	oldInternalNPObj, exists, _ := n.internalNetworkPolicyStore.Get(key)
+	if !exists {
+		n.internalNetworkPolicyStore.Create(curInternalNP)
+		n.internalNetworkPolicyMutex.Unlock()
+		n.enqueueInternalNetworkPolicy(key)
+		return
+	}
+	oldInternalNP := oldInternalNPObj.(*antreatypes.NetworkPolicy)
+	// Must preserve old internal NetworkPolicy Span.
+	curInternalNP.SpanMeta = oldInternalNP.SpanMeta
+	n.internalNetworkPolicyStore.Update(curInternalNP)
+	n.internalNetworkPolicyMutex.Unlock()
+	// Enqueue addressGroup keys to update their Node span.
+	for _, rule := range curInternalNP.Rules {
+		for _, addrGroupName := range rule.From.AddressGroups {
+			n.enqueueAddressGroup(addrGroupName)
+		}
+		for _, addrGroupName := range rule.To.AddressGroups {
+			n.enqueueAddressGroup(addrGroupName)
+		}
+	}
+	n.enqueueInternalNetworkPolicy(key)
+	for _, atg := range oldInternalNP.AppliedToGroups {
+		// Delete the old AppliedToGroup object if it is not referenced
+		// by any internal NetworkPolicy.
+		n.deleteDereferencedAppliedToGroup(atg)
+	}
+	n.deleteDereferencedAddressGroups(oldInternalNP)
+}
+
+// deleteInternalNetworkPolicy deletes an internal NetworkPolicy from the store and cleans up the
+// groups which are no longer referenced.
+func (n *NetworkPolicyController) deleteInternalNetworkPolicy(key string) {
+	n.internalNetworkPolicyMutex.Lock()
+	oldInternalNPObj, exists, _ := n.internalNetworkPolicyStore.Get(key)
+	if !exists {
+		n.internalNetworkPolicyMutex.Unlock()
+		return
+	}
+	oldInternalNP := oldInternalNPObj.(*antreatypes.NetworkPolicy)
+	klog.V(2).Infof("Deleting internal NetworkPolicy %s for %s", oldInternalNP.Name, oldInternalNP.SourceRef.ToString())
+	err := n.internalNetworkPolicyStore.Delete(key)
+	n.internalNetworkPolicyMutex.Unlock()
+	if err != nil {
+		klog.Errorf("Error deleting internal NetworkPolicy %s: %v", key, err)
+		return
+	}
+	for _, atg := range oldInternalNP.AppliedToGroups {
+		n.deleteDereferencedAppliedToGroup(atg)
+	}
+	n.deleteDereferencedAddressGroups(oldInternalNP)
+}
+
+// processAdminNetworkPolicy creates an internal NetworkPolicy instance corresponding to the
+// AdminNetworkPolicy object. The policy is enforced in a dedicated Tier, which is evaluated
+// after all the Antrea Tiers and before K8s NetworkPolicies. The Pass action delegates the
+// decision to K8s NetworkPolicies.
+func (n *NetworkPolicyController) processAdminNetworkPolicy(anp *npav1alpha1.AdminNetworkPolicy) *antreatypes.NetworkPolicy {
+	toRuleAction := func(action npav1alpha1.AdminNetworkPolicyRuleAction) (crdv1alpha1.RuleAction, error) {
+		switch action {
+		case npav1alpha1.AdminNetworkPolicyRuleActionAllow:
+			return crdv1alpha1.RuleActionAllow, nil
+		case npav1alpha1.AdminNetworkPolicyRuleActionDeny:
+			return crdv1alpha1.RuleActionDrop, nil
+		case npav1alpha1.AdminNetworkPolicyRuleActionPass:
+			return crdv1alpha1.RuleActionPass, nil
+		}
+		return "", fmt.Errorf("unsupported action %s", action)
+	}
+	var errs []error
+	var ingress, egress []adminPolicyRule
+	for _, r := range anp.Spec.Ingress {
+		action, err := toRuleAction(r.Action)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ingress rule %q: %v", r.Name, err))
+			continue
+		}
+		ingress = append(ingress, adminPolicyRule{name: r.Name, action: action, peers: r.From, ports: r.Ports})
+	}
+	for _, r := range anp.Spec.Egress {
+		action, err := toRuleAction(r.Action)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("egress rule %q: %v", r.Name, err))
+			continue
+		}
+		egress = append(egress, adminPolicyRule{name: r.Name, action: action, peers: r.To, ports: r.Ports})
+	}
+	internalNP := n.processAdminPolicy(anp, anp.Spec.Subject, ingress, egress, errs)
+	internalNP.SourceRef.Type = controlplane.AdminNetworkPolicy
+	priority := float64(anp.Spec.Priority)
+	internalNP.Priority = &priority
+	tierPriority := AdminNetworkPolicyTierPriority
+	internalNP.TierPriority = &tierPriority
+	internalNP.Tier = adminNetworkPolicyTierName
+	return internalNP
+}
+
+// processBaselineAdminNetworkPolicy creates an internal NetworkPolicy instance corresponding to
+// the BaselineAdminNetworkPolicy object. The policy is enforced in a dedicated Tier, which is
+// evaluated after K8s NetworkPolicies and the baseline Tier.
+func (n *NetworkPolicyController) processBaselineAdminNetworkPolicy(banp *npav1alpha1.BaselineAdminNetworkPolicy) *antreatypes.NetworkPolicy {
+	toRuleAction := func(action npav1alpha1.BaselineAdminNetworkPolicyRuleAction) (crdv1alpha1.RuleAction, error) {
+		switch action {
+		case npav1alpha1.BaselineAdminNetworkPolicyRuleActionAllow:
+			return crdv1alpha1.RuleActionAllow, nil
+		case npav1alpha1.BaselineAdminNetworkPolicyRuleActionDeny:
+			return crdv1alpha1.RuleActionDrop, nil
+		}
+		return "", fmt.Errorf("unsupported action %s", action)
+	}
+	var errs []error
+	var ingress, egress []adminPolicyRule
+	for _, r := range banp.Spec.Ingress {
+		action, err := toRuleAction(r.Action)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("ingress rule %q: %v", r.Name, err))
+			continue
+		}
+		ingress = append(ingress, adminPolicyRule{name: r.Name, action: action, peers: r.From, ports: r.Ports})
+	}
+	for _, r := range banp.Spec.Egress {
+		action, err := toRuleAction(r.Action)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("egress rule %q: %v", r.Name, err))
+			continue
+		}
+		egress = append(egress, adminPolicyRule{name: r.Name, action: action, peers: r.To, ports: r.Ports})
+	}
+	internalNP := n.processAdminPolicy(banp, banp.Spec.Subject, ingress, egress, errs)
+	internalNP.SourceRef.Type = controlplane.BaselineAdminNetworkPolicy
+	// There is at most one BaselineAdminNetworkPolicy in the cluster, so the priority of the
+	// policy within its Tier doesn't matter.
+	priority := float64(0)
+	internalNP.Priority = &priority
+	tierPriority := BaselineAdminNetworkPolicyTierPriority
+	internalNP.TierPriority = &tierPriority
+	internalNP.Tier = baselineAdminNetworkPolicyTierName
+	return internalNP
+}
+
+// processAdminPolicy creates an internal NetworkPolicy instance from the subject and the rules
+// shared by AdminNetworkPolicies and BaselineAdminNetworkPolicies. The type, priorities and Tier
+// of the returned policy must be set by the caller. A rule which has a peer that cannot be
+// translated is skipped entirely, as ignoring only the peer could make the rule match more
+// traffic than expected. The translation errors are reported in SyncError, along with the errs
+// already encountered by the caller.
+func (n *NetworkPolicyController) processAdminPolicy(policy metav1.Object, subject npav1alpha1.AdminNetworkPolicySubject,
+	ingress, egress []adminPolicyRule, errs []error) *antreatypes.NetworkPolicy {
+	atgNamesSet := sets.String{}
+	if atg, err := n.createAppliedToGroupForSubject(subject); err != nil {
+		errs = append(errs, err)
+	} else {
+		atgNamesSet.Insert(atg)
+	}
+	var rules []controlplane.NetworkPolicyRule
+	processRules := func(adminRules []adminPolicyRule, direction controlplane.Direction) {
+		for idx, adminRule := range adminRules {
+			peers, err := toNetworkPolicyPeersForAdminPolicy(adminRule.peers)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s rule %q: %v", directionName(direction), adminRule.name, err))
+				continue
+			}
+			services, namedPortExists := toAntreaServicesForAdminPolicy(adminRule.ports)
+			action := adminRule.action
+			rule := controlplane.NetworkPolicyRule{
+				Direction: direction,
+				Services:  services,
+				Name:      adminRule.name,
+				Action:    &action,
+				Priority:  int32(idx),
+			}
+			peer := n.toAntreaPeerForCRD(peers, policy, direction, namedPortExists)
+			if direction == controlplane.DirectionIn {
+				rule.From = *peer
+			} else {
+				rule.To = *peer
+			}
+			rules = append(rules, rule)
+		}
+	}
+	processRules(ingress, controlplane.DirectionIn)
+	processRules(egress, controlplane.DirectionOut)
+	internalNetworkPolicy := &antreatypes.NetworkPolicy{
+		Name:       internalNetworkPolicyKeyFunc(policy),
+		Generation: policy.GetGeneration(),
+		SourceRef: &controlplane.NetworkPolicyReference{
+			Name: policy.GetName(),
+			UID:  policy.GetUID(),
+		},
+		UID:             policy.GetUID(),
+		AppliedToGroups: atgNamesSet.List(),
+		Rules:           rules,
+		SyncError:       utilerrors.NewAggregate(errs),
+	}
+	return internalNetworkPolicy
+}
+
+func directionName(direction controlplane.Direction) string {
+	if direction == controlplane.DirectionIn {
+		return "ingress"
+	}
+	return "egress"
+}
+
+// createAppliedToGroupForSubject creates an AppliedToGroup selecting the Pods of the subject of
+// an AdminNetworkPolicy or a BaselineAdminNetworkPolicy.
+func (n *NetworkPolicyController) createAppliedToGroupForSubject(subject npav1alpha1.AdminNetworkPolicySubject) (string, error) {
+	if subject.Pods != nil {
+		return n.createAppliedToGroup("", &subject.Pods.PodSelector, &subject.Pods.NamespaceSelector, nil, nil), nil
+	}
+	if subject.Namespaces != nil {
+		return n.createAppliedToGroup("", nil, subject.Namespaces, nil, nil), nil
+	}
+	return "", fmt.Errorf("subject must select either Namespaces or Pods")
+}
+
+// toNetworkPolicyPeersForAdminPolicy converts the peers of an AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy rule to the equivalent Antrea-native policy peers.
+func toNetworkPolicyPeersForAdminPolicy(adminPeers []npav1alpha1.AdminNetworkPolicyPeer) ([]crdv1alpha1.NetworkPolicyPeer, error) {
+	toNamespaceSelector := func(namespaces *npav1alpha1.NamespacedPeer) (*metav1.LabelSelector, error) {
+		if len(namespaces.SameLabels) > 0 || len(namespaces.NotSameLabels) > 0 {
+			return nil, fmt.Errorf("sameLabels and notSameLabels are not supported")
+		}
+		if namespaces.NamespaceSelector == nil {
+			return nil, fmt.Errorf("namespaceSelector must be set")
+		}
+		return namespaces.NamespaceSelector, nil
+	}
+	var peers []crdv1alpha1.NetworkPolicyPeer
+	for i := range adminPeers {
+		adminPeer := adminPeers[i]
+		switch {
+		case adminPeer.Pods != nil:
+			nsSelector, err := toNamespaceSelector(&adminPeer.Pods.Namespaces)
+			if err != nil {
+				return nil, err
+			}
+			peers = append(peers, crdv1alpha1.NetworkPolicyPeer{
+				PodSelector:       &adminPeer.Pods.PodSelector,
+				NamespaceSelector: nsSelector,
+			})
+		case adminPeer.Namespaces != nil:
+			nsSelector, err := toNamespaceSelector(adminPeer.Namespaces)
+			if err != nil {
+				return nil, err
+			}
+			peers = append(peers, crdv1alpha1.NetworkPolicyPeer{NamespaceSelector: nsSelector})
+		default:
+			return nil, fmt.Errorf("peer must select either Namespaces or Pods")
+		}
+	}
+	if len(peers) == 0 {
+		// An empty peer list would match all addresses.
+		return nil, fmt.Errorf("at least one peer must be set")
+	}
+	return peers, nil
+}
+
+// toAntreaServicesForAdminPolicy converts the ports of an AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy rule to a slice of Antrea Service objects. A bool is returned along
+// with the Service objects to indicate whether any named port exists.
+func toAntreaServicesForAdminPolicy(ports *[]npav1alpha1.AdminNetworkPolicyPort) ([]controlplane.Service, bool) {
+	if ports == nil {
+		return nil, false
+	}
+	var antreaServices []controlplane.Service
+	var namedPortExists bool
+	for _, port := range *ports {
+		switch {
+		case port.PortNumber != nil:
+			portNumber := intstr.FromInt(int(port.PortNumber.Port))
+			antreaServices = append(antreaServices, controlplane.Service{
+				Protocol: toAntreaProtocol(&port.PortNumber.Protocol),
+				Port:     &portNumber,
+			})
+		case port.PortRange != nil:
+			protocol := &port.PortRange.Protocol
+			if *protocol == "" {
+				protocol = nil
+			}
+			start, end := intstr.FromInt(int(port.PortRange.Start)), port.PortRange.End
+			antreaServices = append(antreaServices, controlplane.Service{
+				Protocol: toAntreaProtocol(protocol),
+				Port:     &start,
+				EndPort:  &end,
+			})
+		case port.NamedPort != nil:
+			namedPort := intstr.FromString(*port.NamedPort)
+			antreaServices = append(antreaServices, controlplane.Service{
+				Protocol: toAntreaProtocol(nil),
+				Port:     &namedPort,
+			})
+			namedPortExists = true
+		}
+	}
+	return antreaServices, namedPortExists
+}
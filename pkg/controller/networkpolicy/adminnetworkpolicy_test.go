@@ -0,0 +1,402 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	npav1alpha1 "antrea.io/antrea/third_party/network-policy-api/apis/v1alpha1"
+)
+
+func TestProcessAdminNetworkPolicy(t *testing.T) {
+	p10 := float64(10)
+	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
+	passAction := crdv1alpha1.RuleActionPass
+	protocolTCP := controlplane.ProtocolTCP
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	selectorC := metav1.LabelSelector{MatchLabels: map[string]string{"foo3": "bar3"}}
+	int1000 := intstr.FromInt(1000)
+	endPort := int32(2000)
+	tests := []struct {
+		name                    string
+		inputPolicy             *npav1alpha1.AdminNetworkPolicy
+		expectedPolicy          *antreatypes.NetworkPolicy
+		expectedSyncError       string
+		expectedAppliedToGroups int
+		expectedAddressGroups   int
+	}{
+		{
+			name: "rules-with-all-actions",
+			inputPolicy: &npav1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "adminNPA", UID: "uidA", Generation: 1},
+				Spec: npav1alpha1.AdminNetworkPolicySpec{
+					Priority: 10,
+					Subject: npav1alpha1.AdminNetworkPolicySubject{
+						Pods: &npav1alpha1.NamespacedPodSubject{
+							NamespaceSelector: selectorA,
+							PodSelector:       selectorB,
+						},
+					},
+					Ingress: []npav1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "pass-from-nsC",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionPass,
+							From: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorC}},
+							},
+						},
+						{
+							Name:   "deny-from-nsC",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionDeny,
+							From: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorC}},
+							},
+							Ports: &[]npav1alpha1.AdminNetworkPolicyPort{
+								{PortNumber: &npav1alpha1.Port{Protocol: v1.ProtocolTCP, Port: 80}},
+								{PortRange: &npav1alpha1.PortRange{Protocol: v1.ProtocolTCP, Start: 1000, End: 2000}},
+							},
+						},
+					},
+					Egress: []npav1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Name:   "allow-to-podsB",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionAllow,
+							To: []npav1alpha1.AdminNetworkPolicyPeer{
+								{
+									Pods: &npav1alpha1.NamespacedPodPeer{
+										Namespaces:  npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorC},
+										PodSelector: selectorB,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:        "uidA",
+				Name:       "uidA",
+				Generation: 1,
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AdminNetworkPolicy,
+					Name: "adminNPA",
+					UID:  "uidA",
+				},
+				Priority:     &p10,
+				TierPriority: &AdminNetworkPolicyTierPriority,
+				Tier:         adminNetworkPolicyTierName,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorC, nil, nil).NormalizedName)},
+						},
+						Name:     "pass-from-nsC",
+						Priority: 0,
+						Action:   &passAction,
+					},
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorC, nil, nil).NormalizedName)},
+						},
+						Services: []controlplane.Service{
+							{
+								Protocol: &protocolTCP,
+								Port:     &int80,
+							},
+							{
+								Protocol: &protocolTCP,
+								Port:     &int1000,
+								EndPort:  &endPort,
+							},
+						},
+						Name:     "deny-from-nsC",
+						Priority: 1,
+						Action:   &dropAction,
+					},
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, &selectorC, nil, nil).NormalizedName)},
+						},
+						Name:     "allow-to-podsB",
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, &selectorA, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   2,
+		},
+		{
+			name: "rule-with-unsupported-peer",
+			inputPolicy: &npav1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "adminNPB", UID: "uidB", Generation: 1},
+				Spec: npav1alpha1.AdminNetworkPolicySpec{
+					Priority: 10,
+					Subject: npav1alpha1.AdminNetworkPolicySubject{
+						Namespaces: &selectorA,
+					},
+					Ingress: []npav1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "allow-from-same-tenant",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &npav1alpha1.NamespacedPeer{SameLabels: []string{"tenant"}}},
+							},
+						},
+						{
+							Name:   "deny-from-nsC",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionDeny,
+							From: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorC}},
+							},
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:        "uidB",
+				Name:       "uidB",
+				Generation: 1,
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AdminNetworkPolicy,
+					Name: "adminNPB",
+					UID:  "uidB",
+				},
+				Priority:     &p10,
+				TierPriority: &AdminNetworkPolicyTierPriority,
+				Tier:         adminNetworkPolicyTierName,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorC, nil, nil).NormalizedName)},
+						},
+						Name:     "deny-from-nsC",
+						Priority: 1,
+						Action:   &dropAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName)},
+			},
+			expectedSyncError:       `ingress rule "allow-from-same-tenant": sameLabels and notSameLabels are not supported`,
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController()
+			actualPolicy := c.processAdminNetworkPolicy(tt.inputPolicy)
+			assert.Equal(t, tt.expectedPolicy.UID, actualPolicy.UID)
+			assert.Equal(t, tt.expectedPolicy.Name, actualPolicy.Name)
+			assert.Equal(t, tt.expectedPolicy.Generation, actualPolicy.Generation)
+			assert.Equal(t, tt.expectedPolicy.SourceRef, actualPolicy.SourceRef)
+			assert.Equal(t, tt.expectedPolicy.Priority, actualPolicy.Priority)
+			assert.Equal(t, tt.expectedPolicy.TierPriority, actualPolicy.TierPriority)
+			assert.Equal(t, tt.expectedPolicy.Tier, actualPolicy.Tier)
+			assert.ElementsMatch(t, tt.expectedPolicy.Rules, actualPolicy.Rules)
+			assert.ElementsMatch(t, tt.expectedPolicy.AppliedToGroups, actualPolicy.AppliedToGroups)
+			if tt.expectedSyncError == "" {
+				assert.NoError(t, actualPolicy.SyncError)
+			} else {
+				assert.EqualError(t, actualPolicy.SyncError, tt.expectedSyncError)
+			}
+			assert.Equal(t, tt.expectedAppliedToGroups, len(c.appliedToGroupStore.List()))
+			assert.Equal(t, tt.expectedAddressGroups, len(c.addressGroupStore.List()))
+		})
+	}
+}
+
+func TestProcessBaselineAdminNetworkPolicy(t *testing.T) {
+	p0 := float64(0)
+	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	banp := &npav1alpha1.BaselineAdminNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", UID: "uidA", Generation: 2},
+		Spec: npav1alpha1.BaselineAdminNetworkPolicySpec{
+			Subject: npav1alpha1.AdminNetworkPolicySubject{
+				Namespaces: &selectorA,
+			},
+			Ingress: []npav1alpha1.BaselineAdminNetworkPolicyIngressRule{
+				{
+					Name:   "allow-from-nsB",
+					Action: npav1alpha1.BaselineAdminNetworkPolicyRuleActionAllow,
+					From: []npav1alpha1.AdminNetworkPolicyPeer{
+						{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorB}},
+					},
+				},
+				{
+					Name:   "deny-from-all",
+					Action: npav1alpha1.BaselineAdminNetworkPolicyRuleActionDeny,
+					From: []npav1alpha1.AdminNetworkPolicyPeer{
+						{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &metav1.LabelSelector{}}},
+					},
+				},
+			},
+			Egress: []npav1alpha1.BaselineAdminNetworkPolicyEgressRule{
+				{
+					Name:   "no-peer",
+					Action: npav1alpha1.BaselineAdminNetworkPolicyRuleActionDeny,
+				},
+			},
+		},
+	}
+	expectedRules := []controlplane.NetworkPolicyRule{
+		{
+			Direction: controlplane.DirectionIn,
+			From: controlplane.NetworkPolicyPeer{
+				AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorB, nil, nil).NormalizedName)},
+			},
+			Name:     "allow-from-nsB",
+			Priority: 0,
+			Action:   &allowAction,
+		},
+		{
+			Direction: controlplane.DirectionIn,
+			From: controlplane.NetworkPolicyPeer{
+				AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &metav1.LabelSelector{}, nil, nil).NormalizedName)},
+			},
+			Name:     "deny-from-all",
+			Priority: 1,
+			Action:   &dropAction,
+		},
+	}
+
+	_, c := newController()
+	actualPolicy := c.processBaselineAdminNetworkPolicy(banp)
+	assert.Equal(t, "uidA", actualPolicy.Name)
+	assert.Equal(t, int64(2), actualPolicy.Generation)
+	assert.Equal(t, &controlplane.NetworkPolicyReference{
+		Type: controlplane.BaselineAdminNetworkPolicy,
+		Name: "default",
+		UID:  "uidA",
+	}, actualPolicy.SourceRef)
+	assert.Equal(t, &p0, actualPolicy.Priority)
+	assert.Equal(t, &BaselineAdminNetworkPolicyTierPriority, actualPolicy.TierPriority)
+	assert.Equal(t, baselineAdminNetworkPolicyTierName, actualPolicy.Tier)
+	assert.ElementsMatch(t, expectedRules, actualPolicy.Rules)
+	assert.ElementsMatch(t, []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName)}, actualPolicy.AppliedToGroups)
+	assert.EqualError(t, actualPolicy.SyncError, `egress rule "no-peer": at least one peer must be set`)
+}
+
+func TestAddUpdateDeleteAdminNetworkPolicy(t *testing.T) {
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	adminNP := &npav1alpha1.AdminNetworkPolicy{
+		TypeMeta:   metav1.TypeMeta{APIVersion: npav1alpha1.SchemeGroupVersion.String(), Kind: "AdminNetworkPolicy"},
+		ObjectMeta: metav1.ObjectMeta{Name: "adminNPA", UID: "uidA", Generation: 1},
+		Spec: npav1alpha1.AdminNetworkPolicySpec{
+			Priority: 10,
+			Subject: npav1alpha1.AdminNetworkPolicySubject{
+				Namespaces: &selectorA,
+			},
+			Ingress: []npav1alpha1.AdminNetworkPolicyIngressRule{
+				{
+					Action: npav1alpha1.AdminNetworkPolicyRuleActionDeny,
+					From: []npav1alpha1.AdminNetworkPolicyPeer{
+						{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorB}},
+					},
+				},
+			},
+		},
+	}
+	toUnstructured := func(obj runtime.Object) *unstructured.Unstructured {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		assert.NoError(t, err)
+		return &unstructured.Unstructured{Object: content}
+	}
+
+	_, c := newController()
+	c.addAdminNP(toUnstructured(adminNP))
+	assert.Equal(t, 1, len(c.internalNetworkPolicyStore.List()))
+	assert.Equal(t, 1, len(c.appliedToGroupStore.List()))
+	assert.Equal(t, 1, len(c.addressGroupStore.List()))
+
+	updatedAdminNP := adminNP.DeepCopy()
+	updatedAdminNP.Generation = 2
+	updatedAdminNP.Spec.Ingress[0].From[0].Namespaces.NamespaceSelector = &selectorA
+	c.updateAdminNP(toUnstructured(adminNP), toUnstructured(updatedAdminNP))
+	obj, exists, _ := c.internalNetworkPolicyStore.Get("uidA")
+	assert.True(t, exists)
+	assert.Equal(t, int64(2), obj.(*antreatypes.NetworkPolicy).Generation)
+	assert.Equal(t, []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName)},
+		obj.(*antreatypes.NetworkPolicy).Rules[0].From.AddressGroups)
+
+	c.deleteAdminNP(toUnstructured(updatedAdminNP))
+	assert.Empty(t, c.internalNetworkPolicyStore.List())
+	assert.Empty(t, c.appliedToGroupStore.List())
+	assert.Empty(t, c.addressGroupStore.List())
+}
+
+func TestCheckAdminNetworkPolicyAPIs(t *testing.T) {
+	groupVersion := npav1alpha1.SchemeGroupVersion.String()
+	tests := []struct {
+		name          string
+		resources     []*metav1.APIResourceList
+		expectedError string
+	}{
+		{
+			name: "CRDs installed",
+			resources: []*metav1.APIResourceList{{
+				GroupVersion: groupVersion,
+				APIResources: []metav1.APIResource{{Name: "adminnetworkpolicies"}, {Name: "baselineadminnetworkpolicies"}},
+			}},
+		},
+		{
+			name:          "API not found",
+			expectedError: "the CRDs of the API must be installed",
+		},
+		{
+			name: "BaselineAdminNetworkPolicy CRD not installed",
+			resources: []*metav1.APIResourceList{{
+				GroupVersion: groupVersion,
+				APIResources: []metav1.APIResource{{Name: "adminnetworkpolicies"}},
+			}},
+			expectedError: "resource baselineadminnetworkpolicies of API policy.networking.k8s.io/v1alpha1 is not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			client.Discovery().(*fakediscovery.FakeDiscovery).Resources = tt.resources
+			err := CheckAdminNetworkPolicyAPIs(client.Discovery())
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedError)
+			}
+		})
+	}
+}
@@ -54,6 +54,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 		},
 	}
 	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
 	protocolTCP := controlplane.ProtocolTCP
 	tests := []struct {
 		name                    string
@@ -116,6 +117,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -201,6 +203,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -294,6 +297,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction:       controlplane.DirectionIn,
@@ -375,6 +379,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -430,6 +435,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -487,6 +493,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -548,6 +555,7 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -570,6 +578,56 @@ func TestProcessAntreaNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   1,
 		},
+		{
+			name: "audit-enforcement-mode",
+			inputPolicy: &crdv1alpha1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns8", Name: "npH", UID: "uidH"},
+				Spec: crdv1alpha1.NetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &selectorA},
+					},
+					Priority: p10,
+					Ingress: []crdv1alpha1.Rule{
+						{
+							From: []crdv1alpha1.NetworkPolicyPeer{
+								{
+									PodSelector: &selectorB,
+								},
+							},
+							Action: &dropAction,
+						},
+					},
+					EnforcementMode: crdv1alpha1.EnforcementModeAudit,
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidH",
+				Name: "uidH",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type:      controlplane.AntreaNetworkPolicy,
+					Namespace: "ns8",
+					Name:      "npH",
+					UID:       "uidH",
+				},
+				Priority:     &p10,
+				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns8", &selectorB, nil, nil, nil).NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &dropAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("ns8", &selectorA, nil, nil, nil).NormalizedName)},
+				EnforcementMode: crdv1alpha1.EnforcementModeAudit,
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -637,6 +695,7 @@ func TestAddANP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -698,6 +757,7 @@ func TestAddANP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
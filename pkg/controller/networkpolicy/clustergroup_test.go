@@ -907,6 +907,7 @@ func TestSyncInternalGroup(t *testing.T) {
 		},
 		Priority:     &p10,
 		TierPriority: &DefaultTierPriority,
+		Tier:         "application",
 		Rules: []controlplane.NetworkPolicyRule{
 			{
 				Direction: controlplane.DirectionIn,
@@ -931,6 +932,7 @@ func TestSyncInternalGroup(t *testing.T) {
 		},
 		Priority:     &p20,
 		TierPriority: &DefaultTierPriority,
+		Tier:         "application",
 		Rules: []controlplane.NetworkPolicyRule{
 			{
 				Direction: controlplane.DirectionIn,
@@ -271,13 +271,30 @@ func (c *NetworkPolicyController) filterAGsFromNodeLabels(node *v1.Node) sets.St
 	return ags
 }
 
+func (c *NetworkPolicyController) filterATGsFromNodeLabels(node *v1.Node) sets.String {
+	atgs := sets.NewString()
+	appliedToGroupObjs, _ := c.appliedToGroupStore.GetByIndex(store.IsNodeAppliedToGroupIndex, "true")
+	for _, appliedToGroupObj := range appliedToGroupObjs {
+		appliedToGroup := appliedToGroupObj.(*antreatypes.AppliedToGroup)
+		nodeSelector := appliedToGroup.Selector.NodeSelector
+		if nodeSelector.Matches(labels.Set(node.GetLabels())) {
+			atgs.Insert(appliedToGroup.Name)
+		}
+	}
+	return atgs
+}
+
 func (c *NetworkPolicyController) addNode(obj interface{}) {
 	node := obj.(*v1.Node)
 	affectedAGs := c.filterAGsFromNodeLabels(node)
 	for key := range affectedAGs {
 		c.enqueueAddressGroup(key)
 	}
-	klog.V(2).InfoS("Processed Node CREATE event", "nodeName", node.Name, "affectedAGs", affectedAGs.Len())
+	affectedATGs := c.filterATGsFromNodeLabels(node)
+	for key := range affectedATGs {
+		c.enqueueAppliedToGroup(key)
+	}
+	klog.V(2).InfoS("Processed Node CREATE event", "nodeName", node.Name, "affectedAGs", affectedAGs.Len(), "affectedATGs", affectedATGs.Len())
 }
 
 func (c *NetworkPolicyController) deleteNode(obj interface{}) {
@@ -299,7 +316,12 @@ func (c *NetworkPolicyController) deleteNode(obj interface{}) {
 	for key := range affectedAGs {
 		c.enqueueAddressGroup(key)
 	}
-	klog.V(2).InfoS("Processed Node DELETE event", "nodeName", node.Name, "affectedAGs", affectedAGs.Len())
+	// enqueue affected appliedTo group
+	affectedATGs := c.filterATGsFromNodeLabels(node)
+	for key := range affectedATGs {
+		c.enqueueAppliedToGroup(key)
+	}
+	klog.V(2).InfoS("Processed Node DELETE event", "nodeName", node.Name, "affectedAGs", affectedAGs.Len(), "affectedATGs", affectedATGs.Len())
 }
 
 func nodeIPChanged(oldNode, newNode *v1.Node) (changed bool) {
@@ -319,18 +341,25 @@ func (c *NetworkPolicyController) updateNode(oldObj, newObj interface{}) {
 	}
 
 	affectedAGs := c.filterAGsFromNodeLabels(node)
+	affectedATGs := c.filterATGsFromNodeLabels(node)
 	if labelsChanged {
 		oldAGs := c.filterAGsFromNodeLabels(oldNode)
+		oldATGs := c.filterATGsFromNodeLabels(oldNode)
 		if ipChanged {
 			affectedAGs = utilsets.MergeString(affectedAGs, oldAGs)
+			affectedATGs = utilsets.MergeString(affectedATGs, oldATGs)
 		} else {
 			affectedAGs = utilsets.SymmetricDifferenceString(affectedAGs, oldAGs)
+			affectedATGs = utilsets.SymmetricDifferenceString(affectedATGs, oldATGs)
 		}
 	}
 	for ag := range affectedAGs {
 		c.enqueueAddressGroup(ag)
 	}
-	klog.V(2).InfoS("Processed Node UPDATE event", "nodeName", node.Name, "affectedAGs", affectedAGs.Len())
+	for atg := range affectedATGs {
+		c.enqueueAppliedToGroup(atg)
+	}
+	klog.V(2).InfoS("Processed Node UPDATE event", "nodeName", node.Name, "affectedAGs", affectedAGs.Len(), "affectedATGs", affectedATGs.Len())
 }
 
 // processClusterNetworkPolicy creates an internal NetworkPolicy instance
@@ -362,7 +391,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 	if hasPerNamespaceRule && len(cnp.Spec.AppliedTo) > 0 {
 		for _, at := range cnp.Spec.AppliedTo {
 			if at.ServiceAccount != nil {
-				atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil)
+				atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
 				atgNamesSet.Insert(atg)
 				clusterAppliedToAffectedNS = append(clusterAppliedToAffectedNS, at.ServiceAccount.Namespace)
 				atgForNamespace = append(atgForNamespace, atg)
@@ -370,7 +399,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 				affectedNS, selectors := n.getAffectedNamespacesForAppliedTo(at)
 				affectedNamespaceSelectors = append(affectedNamespaceSelectors, selectors...)
 				for _, ns := range affectedNS {
-					atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector)
+					atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector, nil)
 					atgNamesSet.Insert(atg)
 					clusterAppliedToAffectedNS = append(clusterAppliedToAffectedNS, ns)
 					atgForNamespace = append(atgForNamespace, atg)
@@ -379,9 +408,16 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 		}
 	}
 	var rules []controlplane.NetworkPolicyRule
+	var inactiveRules []string
+	ref := controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: cnp.Name}
 	processRules := func(cnpRules []crdv1alpha1.Rule, direction controlplane.Direction) {
 		for idx, cnpRule := range cnpRules {
+			if !n.isRuleActive(&cnpRule, ref) {
+				inactiveRules = append(inactiveRules, cnpRule.Name)
+				continue
+			}
 			services, namedPortExists := toAntreaServicesForCRD(cnpRule.Ports, cnpRule.Protocols)
+			l7Protocols := toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols)
 			clusterPeers, perNSPeers := splitPeersByScope(cnpRule, direction)
 			addRule := func(peer *controlplane.NetworkPolicyPeer, dir controlplane.Direction, ruleAppliedTos []string) {
 				rule := controlplane.NetworkPolicyRule{
@@ -392,6 +428,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 					Priority:        int32(idx),
 					EnableLogging:   cnpRule.EnableLogging,
 					AppliedToGroups: ruleAppliedTos,
+					L7Protocols:     l7Protocols,
 				}
 				if dir == controlplane.DirectionIn {
 					rule.From = *peer
@@ -428,7 +465,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 					// Create a rule for each affected Namespace of appliedTo at rule level
 					for _, at := range cnpRule.AppliedTo {
 						if at.ServiceAccount != nil {
-							atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil)
+							atg := n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
 							atgNamesSet.Insert(atg)
 							klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", atg, idx, cnp.Name)
 							addRule(n.toNamespacedPeerForCRD(perNSPeers, at.ServiceAccount.Namespace), direction, []string{atg})
@@ -436,7 +473,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 							affectedNS, selectors := n.getAffectedNamespacesForAppliedTo(at)
 							affectedNamespaceSelectors = append(affectedNamespaceSelectors, selectors...)
 							for _, ns := range affectedNS {
-								atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector)
+								atg := n.createAppliedToGroup(ns, at.PodSelector, nil, at.ExternalEntitySelector, nil)
 								atgNamesSet.Insert(atg)
 								klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", atg, idx, cnp.Name)
 								addRule(n.toNamespacedPeerForCRD(perNSPeers, ns), direction, []string{atg})
@@ -471,6 +508,9 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1alpha1.C
 		TierPriority:          &tierPriority,
 		AppliedToPerRule:      appliedToPerRule,
 		PerNamespaceSelectors: getUniqueNSSelectors(affectedNamespaceSelectors),
+		EnforcementMode:       cnp.Spec.EnforcementMode,
+		Tier:                  getTierName(cnp.Spec.Tier),
+		InactiveRules:         inactiveRules,
 	}
 	return internalNetworkPolicy
 }
@@ -511,9 +551,11 @@ func (n *NetworkPolicyController) processClusterAppliedTo(appliedTo []crdv1alpha
 		if at.Group != "" {
 			atg = n.processAppliedToGroupForCG(at.Group)
 		} else if at.ServiceAccount != nil {
-			atg = n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil)
+			atg = n.createAppliedToGroup(at.ServiceAccount.Namespace, serviceAccountNameToPodSelector(at.ServiceAccount.Name), nil, nil, nil)
+		} else if at.NodeSelector != nil {
+			atg = n.createAppliedToGroup("", nil, nil, nil, at.NodeSelector)
 		} else {
-			atg = n.createAppliedToGroup("", at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector)
+			atg = n.createAppliedToGroup("", at.PodSelector, at.NamespaceSelector, at.ExternalEntitySelector, nil)
 		}
 		if atg != "" {
 			appliedToGroupNames = append(appliedToGroupNames, atg)
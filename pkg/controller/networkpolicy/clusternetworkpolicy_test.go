@@ -149,6 +149,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -233,6 +234,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -318,6 +320,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &t10,
+				Tier:         "tier-A",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -391,6 +394,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -471,6 +475,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction:       controlplane.DirectionIn,
@@ -563,6 +568,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -656,6 +662,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction:       controlplane.DirectionIn,
@@ -747,6 +754,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction:       controlplane.DirectionIn,
@@ -867,6 +875,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction:       controlplane.DirectionIn,
@@ -941,6 +950,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -998,6 +1008,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -1054,6 +1065,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -1105,6 +1117,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -1168,6 +1181,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -1233,6 +1247,7 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionOut,
@@ -1350,6 +1365,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1408,6 +1424,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1467,6 +1484,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &emergencyTierPriority,
+				Tier:         "emergency",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1526,6 +1544,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &emergencyTierPriority,
+				Tier:         "emergency",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1600,6 +1619,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1684,6 +1704,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1757,6 +1778,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1830,6 +1852,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -1919,6 +1942,7 @@ func TestAddCNP(t *testing.T) {
 				},
 				Priority:     &p10,
 				TierPriority: &DefaultTierPriority,
+				Tier:         "application",
 				Rules: []controlplane.NetworkPolicyRule{
 					{
 						Direction: controlplane.DirectionIn,
@@ -65,6 +65,24 @@ func toAntreaServicesForCRD(npPorts []v1alpha1.NetworkPolicyPort, npProtocols []
 	return antreaServices, namedPortExists
 }
 
+// toAntreaL7ProtocolsForCRD converts a slice of v1alpha1.L7Protocol objects to
+// a slice of Antrea L7Protocol objects.
+func toAntreaL7ProtocolsForCRD(l7Protocols []v1alpha1.L7Protocol) []controlplane.L7Protocol {
+	var antreaL7Protocols []controlplane.L7Protocol
+	for _, l7p := range l7Protocols {
+		var antreaL7Protocol controlplane.L7Protocol
+		if l7p.HTTP != nil {
+			antreaL7Protocol.HTTP = &controlplane.HTTPProtocol{
+				Host:   l7p.HTTP.Host,
+				Method: l7p.HTTP.Method,
+				Path:   l7p.HTTP.Path,
+			}
+		}
+		antreaL7Protocols = append(antreaL7Protocols, antreaL7Protocol)
+	}
+	return antreaL7Protocols
+}
+
 // toAntreaIPBlockForCRD converts a v1alpha1.IPBlock to an Antrea IPBlock.
 func toAntreaIPBlockForCRD(ipBlock *v1alpha1.IPBlock) (*controlplane.IPBlock, error) {
 	// Convert the allowed IPBlock to networkpolicy.IPNet.
@@ -72,10 +90,18 @@ func toAntreaIPBlockForCRD(ipBlock *v1alpha1.IPBlock) (*controlplane.IPBlock, er
 	if err != nil {
 		return nil, err
 	}
+	exceptNets := []controlplane.IPNet{}
+	for _, exc := range ipBlock.Except {
+		// Convert the except IPBlock to networkpolicy.IPNet.
+		exceptNet, err := cidrStrToIPNet(exc)
+		if err != nil {
+			return nil, err
+		}
+		exceptNets = append(exceptNets, *exceptNet)
+	}
 	antreaIPBlock := &controlplane.IPBlock{
-		CIDR: *ipNet,
-		// secv1alpha.IPBlock does not have the Except slices.
-		Except: []controlplane.IPNet{},
+		CIDR:   *ipNet,
+		Except: exceptNets,
 	}
 	return antreaIPBlock, nil
 }
@@ -243,6 +269,18 @@ func (n *NetworkPolicyController) getTierPriority(tier string) int32 {
 	return t.Spec.Priority
 }
 
+// getTierName returns the name of the Tier CRD referred to by the input Tier name.
+// If the Tier name is empty, by default, the Application Tier is returned.
+func getTierName(tier string) string {
+	if tier == "" {
+		return defaultTierName
+	}
+	if staticTierSet.Has(tier) {
+		return strings.ToLower(tier)
+	}
+	return tier
+}
+
 // getNormalizedNameForSelector retrieves the normalized name for GroupSelector.
 // If the GroupSelector is nil, an empty string is returned.
 func getNormalizedNameForSelector(sel *antreatypes.GroupSelector) string {
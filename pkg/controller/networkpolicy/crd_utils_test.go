@@ -166,6 +166,31 @@ func TestToAntreaServicesForCRD(t *testing.T) {
 	}
 }
 
+func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
+	tables := []struct {
+		l7Protocols    []crdv1alpha1.L7Protocol
+		expL7Protocols []controlplane.L7Protocol
+	}{
+		{
+			l7Protocols:    nil,
+			expL7Protocols: nil,
+		},
+		{
+			l7Protocols: []crdv1alpha1.L7Protocol{
+				{HTTP: &crdv1alpha1.HTTPProtocol{Host: "*.foo.com", Method: "GET", Path: "/api/*"}},
+				{HTTP: &crdv1alpha1.HTTPProtocol{}},
+			},
+			expL7Protocols: []controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{Host: "*.foo.com", Method: "GET", Path: "/api/*"}},
+				{HTTP: &controlplane.HTTPProtocol{}},
+			},
+		},
+	}
+	for _, table := range tables {
+		assert.Equal(t, table.expL7Protocols, toAntreaL7ProtocolsForCRD(table.l7Protocols))
+	}
+}
+
 func TestToAntreaIPBlockForCRD(t *testing.T) {
 	expIPNet := controlplane.IPNet{
 		IP:           ipStrToIPAddress("10.0.0.0"),
@@ -185,6 +210,22 @@ func TestToAntreaIPBlockForCRD(t *testing.T) {
 			},
 			nil,
 		},
+		{
+			&crdv1alpha1.IPBlock{
+				CIDR:   "10.0.0.0/24",
+				Except: []string{"10.0.0.128/25"},
+			},
+			controlplane.IPBlock{
+				CIDR: expIPNet,
+				Except: []controlplane.IPNet{
+					{
+						IP:           ipStrToIPAddress("10.0.0.128"),
+						PrefixLength: 25,
+					},
+				},
+			},
+			nil,
+		},
 		{
 			&crdv1alpha1.IPBlock{
 				CIDR: "10.0.0.0",
@@ -192,6 +233,14 @@ func TestToAntreaIPBlockForCRD(t *testing.T) {
 			controlplane.IPBlock{},
 			fmt.Errorf("invalid format for IPBlock CIDR: 10.0.0.0"),
 		},
+		{
+			&crdv1alpha1.IPBlock{
+				CIDR:   "10.0.0.0/24",
+				Except: []string{"10.0.0.128"},
+			},
+			controlplane.IPBlock{},
+			fmt.Errorf("invalid format for IPBlock CIDR: 10.0.0.128"),
+		},
 	}
 	for _, table := range tables {
 		antreaIPBlock, err := toAntreaIPBlockForCRD(table.ipBlock)
@@ -210,6 +259,15 @@ func TestToAntreaIPBlockForCRD(t *testing.T) {
 		if table.expValue.CIDR.PrefixLength != ipNet.PrefixLength {
 			t.Errorf("Unexpected PrefixLength in Antrea IPBlock conversion. Expected %v, got %v", table.expValue.CIDR.PrefixLength, ipNet.PrefixLength)
 		}
+		if len(table.expValue.Except) != len(antreaIPBlock.Except) {
+			t.Errorf("Unexpected Except in Antrea IPBlock conversion. Expected %v, got %v", table.expValue.Except, antreaIPBlock.Except)
+			continue
+		}
+		for i, exceptNet := range antreaIPBlock.Except {
+			if bytes.Compare(exceptNet.IP, table.expValue.Except[i].IP) != 0 || exceptNet.PrefixLength != table.expValue.Except[i].PrefixLength {
+				t.Errorf("Unexpected Except in Antrea IPBlock conversion. Expected %v, got %v", table.expValue.Except[i], exceptNet)
+			}
+		}
 	}
 }
 
@@ -31,6 +31,9 @@ type EndpointQuerier interface {
 	// along with the list NetworkPolicies which select the provided Pod in one of their policy
 	// rules (ingress or egress).
 	QueryNetworkPolicies(namespace string, podName string) (*EndpointQueryResponse, error)
+	// EvaluatePolicies returns the effective action applied by NetworkPolicies to the traffic
+	// described by the request, along with the rules deciding it.
+	EvaluatePolicies(request *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error)
 }
 
 // endpointQuerier implements the EndpointQuerier interface
@@ -35,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	clientset "k8s.io/client-go/kubernetes"
@@ -43,6 +44,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	secv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
@@ -174,6 +176,18 @@ type NetworkPolicyController struct {
 	// once.
 	cgListerSynced cache.InformerSynced
 
+	// adminNPInformer is the dynamic informer of AdminNetworkPolicies. It's only set when the
+	// AdminNetworkPolicy feature gate is enabled.
+	adminNPInformer informers.GenericInformer
+	// adminNPListerSynced is a function which returns true if the AdminNetworkPolicy informer has been synced at least once.
+	adminNPListerSynced cache.InformerSynced
+
+	// banpInformer is the dynamic informer of BaselineAdminNetworkPolicies. It's only set when the
+	// AdminNetworkPolicy feature gate is enabled.
+	banpInformer informers.GenericInformer
+	// banpListerSynced is a function which returns true if the BaselineAdminNetworkPolicy informer has been synced at least once.
+	banpListerSynced cache.InformerSynced
+
 	nodeInformer coreinformers.NodeInformer
 	// nodeLister is able to list/get Nodes and is populated by the shared informer passed to
 	// NewNetworkPolicyController.
@@ -203,6 +217,13 @@ type NetworkPolicyController struct {
 	// internalGroupQueue maintains the networkpolicy.Group objects that needs to be
 	// synced.
 	internalGroupQueue workqueue.RateLimitingInterface
+	// ruleScheduleQueue maintains the references of the Antrea-native policies which
+	// need to be reprocessed at the next time one of their scheduled rules is activated or
+	// deactivated.
+	ruleScheduleQueue workqueue.DelayingInterface
+	// clock is used to evaluate the schedules of Antrea-native policy rules. Added as a member
+	// to the struct to allow injection for testing.
+	clock clock.Clock
 
 	// internalNetworkPolicyMutex protects the internalNetworkPolicyStore from
 	// concurrent access during updates to the internal NetworkPolicy object.
@@ -302,6 +323,8 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 	anpInformer secinformers.NetworkPolicyInformer,
 	tierInformer secinformers.TierInformer,
 	cgInformer crdv1a3informers.ClusterGroupInformer,
+	adminNPInformer informers.GenericInformer,
+	banpInformer informers.GenericInformer,
 	addressGroupStore storage.Interface,
 	appliedToGroupStore storage.Interface,
 	internalNetworkPolicyStore storage.Interface,
@@ -320,6 +343,8 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		addressGroupQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "addressGroup"),
 		internalNetworkPolicyQueue: workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalNetworkPolicy"),
 		internalGroupQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
+		ruleScheduleQueue:          workqueue.NewNamedDelayingQueue("ruleSchedule"),
+		clock:                      clock.RealClock{},
 		groupingInterface:          groupingInterface,
 		groupingInterfaceSynced:    groupingInterface.HasSynced,
 	}
@@ -412,6 +437,30 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 			},
 			resyncPeriod,
 		)
+		// Register the dynamic informers of AdminNetworkPolicies and BaselineAdminNetworkPolicies,
+		// whose CRDs may not be installed, only if the feature is enabled.
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			n.adminNPInformer = adminNPInformer
+			n.adminNPListerSynced = adminNPInformer.Informer().HasSynced
+			n.banpInformer = banpInformer
+			n.banpListerSynced = banpInformer.Informer().HasSynced
+			adminNPInformer.Informer().AddEventHandlerWithResyncPeriod(
+				cache.ResourceEventHandlerFuncs{
+					AddFunc:    n.addAdminNP,
+					UpdateFunc: n.updateAdminNP,
+					DeleteFunc: n.deleteAdminNP,
+				},
+				resyncPeriod,
+			)
+			banpInformer.Informer().AddEventHandlerWithResyncPeriod(
+				cache.ResourceEventHandlerFuncs{
+					AddFunc:    n.addBANP,
+					UpdateFunc: n.updateBANP,
+					DeleteFunc: n.deleteBANP,
+				},
+				resyncPeriod,
+			)
+		}
 	}
 	return n
 }
@@ -454,8 +503,8 @@ func getNormalizedUID(name string) string {
 }
 
 // createAppliedToGroup creates an AppliedToGroup object in store if it is not created already.
-func (n *NetworkPolicyController) createAppliedToGroup(npNsName string, pSel, nSel, eSel *metav1.LabelSelector) string {
-	groupSelector := antreatypes.NewGroupSelector(npNsName, pSel, nSel, eSel, nil)
+func (n *NetworkPolicyController) createAppliedToGroup(npNsName string, pSel, nSel, eSel, nodeSel *metav1.LabelSelector) string {
+	groupSelector := antreatypes.NewGroupSelector(npNsName, pSel, nSel, eSel, nodeSel)
 	appliedToGroupUID := getNormalizedUID(groupSelector.NormalizedName)
 	// Get or create a AppliedToGroup for the generated UID.
 	// Ignoring returned error (here and elsewhere in this file) as with the
@@ -472,7 +521,10 @@ func (n *NetworkPolicyController) createAppliedToGroup(npNsName string, pSel, nS
 	}
 	klog.V(2).Infof("Creating new AppliedToGroup %s with selector (%s)", newAppliedToGroup.Name, newAppliedToGroup.Selector.NormalizedName)
 	n.appliedToGroupStore.Create(newAppliedToGroup)
-	n.groupingInterface.AddGroup(appliedToGroupType, newAppliedToGroup.Name, groupSelector)
+	// Like AddressGroups, the members of an AppliedToGroup that selects Nodes are calculated via NodeLister directly.
+	if nodeSel == nil {
+		n.groupingInterface.AddGroup(appliedToGroupType, newAppliedToGroup.Name, groupSelector)
+	}
 	n.enqueueAppliedToGroup(appliedToGroupUID)
 	return appliedToGroupUID
 }
@@ -563,7 +615,7 @@ func toAntreaIPBlock(ipBlock *networkingv1.IPBlock) (*controlplane.IPBlock, erro
 // wherein, it will be either stored as a new Object in case of ADD event or
 // modified and store the updated instance, in case of an UPDATE event.
 func (n *NetworkPolicyController) processNetworkPolicy(np *networkingv1.NetworkPolicy) *antreatypes.NetworkPolicy {
-	appliedToGroupKey := n.createAppliedToGroup(np.Namespace, &np.Spec.PodSelector, nil, nil)
+	appliedToGroupKey := n.createAppliedToGroup(np.Namespace, &np.Spec.PodSelector, nil, nil, nil)
 	appliedToGroupNames := []string{appliedToGroupKey}
 	rules := make([]controlplane.NetworkPolicyRule, 0, len(np.Spec.Ingress)+len(np.Spec.Egress))
 	var ingressRuleExists, egressRuleExists bool
@@ -905,6 +957,7 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	defer n.addressGroupQueue.ShutDown()
 	defer n.internalNetworkPolicyQueue.ShutDown()
 	defer n.internalGroupQueue.ShutDown()
+	defer n.ruleScheduleQueue.ShutDown()
 
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
@@ -913,6 +966,9 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	// Only wait for cnpListerSynced and anpListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, n.cnpListerSynced, n.anpListerSynced, n.cgListerSynced)
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			cacheSyncs = append(cacheSyncs, n.adminNPListerSynced, n.banpListerSynced)
+		}
 	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
@@ -924,6 +980,10 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 		go wait.Until(n.internalNetworkPolicyWorker, time.Second, stopCh)
 		go wait.Until(n.internalGroupWorker, time.Second, stopCh)
 	}
+	// Only start the worker reprocessing rule schedules when AntreaPolicy feature gate is enabled.
+	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
+		go wait.Until(n.ruleScheduleWorker, time.Second, stopCh)
+	}
 	<-stopCh
 }
 
@@ -1239,6 +1299,15 @@ func (n *NetworkPolicyController) syncAppliedToGroup(key string) error {
 	memberSetByNode := make(map[string]controlplane.GroupMemberSet)
 	scheduledPodNum, scheduledExtEntityNum := 0, 0
 	appliedToGroup := appliedToGroupObj.(*antreatypes.AppliedToGroup)
+	if appliedToGroup.Selector.NodeSelector != nil {
+		// An AppliedToGroup that selects Nodes is dispatched to each selected Node, with the Node
+		// itself as the only member, so that the policies can be enforced on its host network.
+		nodes, _ := n.nodeLister.List(appliedToGroup.Selector.NodeSelector)
+		for _, node := range nodes {
+			memberSetByNode[node.Name] = controlplane.NewGroupMemberSet(nodeToGroupMember(node))
+			appGroupNodeNames.Insert(node.Name)
+		}
+	}
 	pods, externalEntities := n.getAppliedToWorkloads(appliedToGroup)
 	for _, pod := range pods {
 		if pod.Spec.NodeName == "" || pod.Spec.HostNetwork == true {
@@ -1385,6 +1454,8 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key string) error {
 		TierPriority:          internalNP.TierPriority,
 		AppliedToPerRule:      internalNP.AppliedToPerRule,
 		PerNamespaceSelectors: internalNP.PerNamespaceSelectors,
+		EnforcementMode:       internalNP.EnforcementMode,
+		Tier:                  internalNP.Tier,
 		SpanMeta:              antreatypes.SpanMeta{NodeNames: nodeNames},
 		Generation:            internalNP.Generation,
 	}
@@ -31,12 +31,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
@@ -114,6 +116,8 @@ func newController(objects ...runtime.Object) (*fake.Clientset, *networkPolicyCo
 		crdInformerFactory.Crd().V1alpha1().NetworkPolicies(),
 		crdInformerFactory.Crd().V1alpha1().Tiers(),
 		cgInformer,
+		nil,
+		nil,
 		addressGroupStore,
 		appliedToGroupStore,
 		internalNetworkPolicyStore,
@@ -189,6 +193,8 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		addressGroupQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "addressGroup"),
 		internalNetworkPolicyQueue: workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalNetworkPolicy"),
 		internalGroupQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
+		ruleScheduleQueue:          workqueue.NewNamedDelayingQueue("ruleSchedule"),
+		clock:                      clock.RealClock{},
 		groupingInterface:          groupEntityIndex,
 	}
 	npController.tierInformer.Informer().AddIndexers(tierIndexers)
@@ -3326,6 +3332,54 @@ func TestAddressGroupWithNodeSelector(t *testing.T) {
 	assert.False(t, groupMembers.Has(memberNode1))
 }
 
+func TestAppliedToGroupWithNodeSelector(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	_, c := newController()
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	go c.groupingController.Run(stopCh)
+	go c.groupingInterface.Run(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	cache.WaitForCacheSync(stopCh, c.groupingInterfaceSynced)
+
+	nodeSelectorA := metav1.LabelSelector{MatchLabels: map[string]string{"env": "pro"}}
+
+	fakeNode0 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "fakeNode0", Labels: nodeSelectorA.MatchLabels},
+		Status:     corev1.NodeStatus{Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "1.1.1.1"}}},
+	}
+	fakeNode1 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "fakeNode1"},
+		Status:     corev1.NodeStatus{Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: "1.1.1.2"}}},
+	}
+	for _, node := range []*corev1.Node{fakeNode0, fakeNode1} {
+		_, err := c.kubeClient.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		assert.NoError(t, wait.Poll(time.Millisecond*100, time.Second, func() (done bool, err error) {
+			newNode, err := c.nodeLister.Get(node.Name)
+			return reflect.DeepEqual(node, newNode), err
+		}))
+	}
+
+	atgID := c.createAppliedToGroup("", nil, nil, nil, &nodeSelectorA)
+	assert.NoError(t, c.syncAppliedToGroup(atgID))
+	atgObj, _, err := c.appliedToGroupStore.Get(atgID)
+	assert.NoError(t, err)
+	atg := atgObj.(*antreatypes.AppliedToGroup)
+	expectedMember := &controlplane.GroupMember{
+		Node: &controlplane.NodeReference{Name: "fakeNode0"},
+		IPs:  []controlplane.IPAddress{ipStrToIPAddress("1.1.1.1")},
+	}
+	assert.Equal(t, map[string]controlplane.GroupMemberSet{"fakeNode0": controlplane.NewGroupMemberSet(expectedMember)}, atg.GroupMemberByNode)
+	assert.Equal(t, sets.NewString("fakeNode0"), atg.SpanMeta.NodeNames)
+
+	// The AppliedToGroup should be affected by the Nodes whose labels match its selector.
+	assert.Equal(t, sets.NewString(atgID), c.filterATGsFromNodeLabels(fakeNode0))
+	assert.Equal(t, sets.NewString(), c.filterATGsFromNodeLabels(fakeNode1))
+}
+
 func getK8sNetworkPolicyObj() *networkingv1.NetworkPolicy {
 	ns := metav1.NamespaceDefault
 	npName := "testing-1"
@@ -0,0 +1,563 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	utilip "antrea.io/antrea/pkg/util/ip"
+)
+
+// EvaluationReason describes why a direction of the evaluated traffic gets its action.
+type EvaluationReason string
+
+const (
+	// EvaluationReasonRuleMatched means that the action is decided by the rule matching the
+	// traffic.
+	EvaluationReasonRuleMatched EvaluationReason = "RuleMatched"
+	// EvaluationReasonK8sIsolation means that the Pod is isolated by K8s NetworkPolicies in
+	// this direction and that no rule allows the traffic.
+	EvaluationReasonK8sIsolation EvaluationReason = "K8sIsolation"
+	// EvaluationReasonNoRuleMatched means that no rule matches the traffic, which is allowed
+	// by default.
+	EvaluationReasonNoRuleMatched EvaluationReason = "NoRuleMatched"
+)
+
+// PolicyEvaluationRequest describes the traffic to evaluate against NetworkPolicies.
+type PolicyEvaluationRequest struct {
+	// Source must be a Pod or an IP address.
+	Source EvaluationEndpoint
+	// Destination can be a Pod, a Service or an IP address.
+	Destination EvaluationEndpoint
+	// Protocol of the traffic, TCP is used if empty.
+	Protocol controlplane.Protocol
+	// Port is the destination port of the traffic. It is ignored for ICMP.
+	Port int32
+}
+
+// EvaluationEndpoint is either a Pod or a Service identified by its Namespace and name, or an
+// IP address if IP is set.
+type EvaluationEndpoint struct {
+	Namespace string
+	Name      string
+	IP        net.IP
+}
+
+// PolicyEvaluationResponse is the reply struct for antctl policy-evaluation queries.
+type PolicyEvaluationResponse struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Protocol    string `json:"protocol"`
+	Port        int32  `json:"port,omitempty"`
+	// Verdict is the effective action applied to the traffic.
+	Verdict crdv1alpha1.RuleAction `json:"verdict"`
+	// Egress is the evaluation of the egress rules applied to the source Pod. It is nil if
+	// the source is not a Pod.
+	Egress *DirectionEvaluation `json:"egress,omitempty"`
+	// Ingress is the evaluation of the ingress rules applied to the destination Pod. It is
+	// nil if the destination is not a Pod.
+	Ingress *DirectionEvaluation `json:"ingress,omitempty"`
+	// Notes lists the limitations of the evaluation, if any.
+	Notes []string `json:"notes,omitempty"`
+}
+
+// DirectionEvaluation is the result of the evaluation of the rules of one direction.
+type DirectionEvaluation struct {
+	// Endpoint is the Pod the evaluated rules are applied to.
+	Endpoint string                 `json:"endpoint"`
+	Action   crdv1alpha1.RuleAction `json:"action"`
+	Reason   EvaluationReason       `json:"reason"`
+	// Rule is the rule deciding the action, if any.
+	Rule *EvaluatedRule `json:"rule,omitempty"`
+	// IsolatingPolicies are the K8s NetworkPolicies isolating the Pod in this direction.
+	IsolatingPolicies []PolicyRef `json:"isolatingPolicies,omitempty"`
+}
+
+// EvaluatedRule identifies a rule deciding the action applied to the traffic.
+type EvaluatedRule struct {
+	PolicyRef
+	PolicyType cpv1beta.NetworkPolicyType `json:"policyType"`
+	Tier       string                     `json:"tier,omitempty"`
+	Direction  cpv1beta.Direction         `json:"direction"`
+	RuleIndex  int                        `json:"ruleIndex"`
+	RuleName   string                     `json:"ruleName,omitempty"`
+	// Action is the action of the rule. The Drop and Reject rules of policies in Audit mode
+	// never decide the action, they are only reported in the notes of the response.
+	Action          crdv1alpha1.RuleAction      `json:"action"`
+	EnforcementMode crdv1alpha1.EnforcementMode `json:"enforcementMode,omitempty"`
+}
+
+// evaluationEndpoint is an EvaluationEndpoint resolved to the object it refers to.
+type evaluationEndpoint struct {
+	pod     *v1.Pod
+	service *v1.Service
+	ips     []net.IP
+}
+
+func (e *evaluationEndpoint) String() string {
+	if e.pod != nil {
+		return fmt.Sprintf("Pod %s/%s", e.pod.Namespace, e.pod.Name)
+	}
+	if e.service != nil {
+		return fmt.Sprintf("Service %s/%s", e.service.Namespace, e.service.Name)
+	}
+	return fmt.Sprintf("IP %s", e.ips[0])
+}
+
+// evaluatedRule is a rule applied to the Pod of the evaluated direction.
+type evaluatedRule struct {
+	policy    *antreatypes.NetworkPolicy
+	rule      *controlplane.NetworkPolicyRule
+	ruleIndex int
+}
+
+// policyEvaluation holds the state of the evaluation of a request.
+type policyEvaluation struct {
+	controller *NetworkPolicyController
+	src        *evaluationEndpoint
+	dst        *evaluationEndpoint
+	protocol   controlplane.Protocol
+	port       int32
+	notes      []string
+}
+
+// EvaluatePolicies evaluates the traffic described by the request like the Antrea Agents
+// enforce NetworkPolicies: the egress rules applied to the source Pod and the ingress rules
+// applied to the destination Pod are evaluated in the order of Antrea-native policy Tiers,
+// K8s NetworkPolicies, and Antrea-native policies in the baseline Tier. The traffic is allowed
+// if it is allowed in both directions.
+func (eq *endpointQuerier) EvaluatePolicies(request *PolicyEvaluationRequest) (*PolicyEvaluationResponse, error) {
+	src, err := eq.resolveEvaluationEndpoint(&request.Source, false)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := eq.resolveEvaluationEndpoint(&request.Destination, true)
+	if err != nil {
+		return nil, err
+	}
+	if src.pod == nil && dst.pod == nil {
+		return nil, errors.NewBadRequest("one of source and destination must be a Pod")
+	}
+	protocol := request.Protocol
+	if protocol == "" {
+		protocol = controlplane.ProtocolTCP
+	}
+	e := &policyEvaluation{
+		controller: eq.networkPolicyController,
+		src:        src,
+		dst:        dst,
+		protocol:   protocol,
+		port:       request.Port,
+	}
+	response := &PolicyEvaluationResponse{
+		Source:      src.String(),
+		Destination: dst.String(),
+		Protocol:    string(protocol),
+		Verdict:     crdv1alpha1.RuleActionAllow,
+	}
+	if protocol != controlplane.ProtocolICMP {
+		response.Port = request.Port
+	}
+	if dst.service != nil {
+		e.addNote("The destination is a Service: only the egress rules selecting it with toServices are evaluated, " +
+			"specify a backend Pod of the Service as destination to evaluate the other rules")
+	}
+	if src.pod != nil {
+		response.Egress = e.evaluate(controlplane.DirectionOut)
+		response.Verdict = response.Egress.Action
+	}
+	if dst.pod != nil {
+		response.Ingress = e.evaluate(controlplane.DirectionIn)
+		if response.Verdict == crdv1alpha1.RuleActionAllow {
+			response.Verdict = response.Ingress.Action
+		}
+	}
+	response.Notes = e.notes
+	return response, nil
+}
+
+// resolveEvaluationEndpoint returns the Pod, or the Service if serviceAllowed is true and no
+// Pod is found, referred to by the provided endpoint.
+func (eq *endpointQuerier) resolveEvaluationEndpoint(endpoint *EvaluationEndpoint, serviceAllowed bool) (*evaluationEndpoint, error) {
+	if endpoint.IP != nil {
+		return &evaluationEndpoint{ips: []net.IP{endpoint.IP}}, nil
+	}
+	n := eq.networkPolicyController
+	if pod, found := n.groupingInterface.GetPod(endpoint.Namespace, endpoint.Name); found {
+		resolved := &evaluationEndpoint{pod: pod}
+		for _, podIP := range pod.Status.PodIPs {
+			if ip := net.ParseIP(podIP.IP); ip != nil {
+				resolved.ips = append(resolved.ips, ip)
+			}
+		}
+		return resolved, nil
+	}
+	key := endpoint.Namespace + "/" + endpoint.Name
+	if !serviceAllowed {
+		return nil, errors.NewNotFound(schema.GroupResource{Resource: "Pod"}, key)
+	}
+	// The Service lister is only available when the AntreaPolicy feature is enabled.
+	if n.serviceLister != nil {
+		service, err := n.serviceLister.Services(endpoint.Namespace).Get(endpoint.Name)
+		if err == nil {
+			return &evaluationEndpoint{service: service}, nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, errors.NewNotFound(schema.GroupResource{Resource: "Pod or Service"}, key)
+}
+
+// evaluate returns the result of the evaluation of the rules in the provided direction.
+func (e *policyEvaluation) evaluate(direction controlplane.Direction) *DirectionEvaluation {
+	target, peer := e.src, e.dst
+	if direction == controlplane.DirectionIn {
+		target, peer = e.dst, e.src
+	}
+	result := &DirectionEvaluation{Endpoint: target.String()}
+	var antreaRules, k8sRules, baselineRules []*evaluatedRule
+	var isolatingPolicies []PolicyRef
+	for _, obj := range e.controller.internalNetworkPolicyStore.List() {
+		policy := obj.(*antreatypes.NetworkPolicy)
+		isK8sPolicy := policy.SourceRef.Type == controlplane.K8sNetworkPolicy
+		isolating := false
+		// The index of K8s NetworkPolicy rules is their position among the rules of the
+		// same direction, while the Priority of Antrea-native policy rules is their index.
+		k8sRuleIndex := 0
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			if rule.Direction != direction {
+				continue
+			}
+			ruleIndex := int(rule.Priority)
+			if isK8sPolicy {
+				ruleIndex = k8sRuleIndex
+				k8sRuleIndex++
+			}
+			if !e.appliesTo(policy, rule, target.pod) {
+				continue
+			}
+			r := &evaluatedRule{policy: policy, rule: rule, ruleIndex: ruleIndex}
+			if isK8sPolicy {
+				isolating = true
+				k8sRules = append(k8sRules, r)
+			} else if policy.TierPriority != nil && *policy.TierPriority >= BaselineTierPriority {
+				baselineRules = append(baselineRules, r)
+			} else {
+				antreaRules = append(antreaRules, r)
+			}
+		}
+		if isolating {
+			isolatingPolicies = append(isolatingPolicies, toPolicyRef(policy))
+		}
+	}
+	sortEvaluatedRules(antreaRules)
+	sortEvaluatedRules(k8sRules)
+	sortEvaluatedRules(baselineRules)
+
+	for _, r := range antreaRules {
+		if !e.matchRule(r.rule, peer) || e.skipAuditedRule(r) {
+			continue
+		}
+		// A Pass rule delegates the decision to K8s NetworkPolicies and the baseline Tier.
+		if r.rule.Action != nil && *r.rule.Action == crdv1alpha1.RuleActionPass {
+			break
+		}
+		return e.ruleMatched(result, r)
+	}
+	// Traffic to Services is translated to one of their Endpoints before K8s NetworkPolicies
+	// are enforced, so they can only be evaluated when the destination is a Pod or an IP.
+	if e.dst.service != nil {
+		isolatingPolicies = nil
+	} else {
+		for _, r := range k8sRules {
+			if e.matchRule(r.rule, peer) {
+				return e.ruleMatched(result, r)
+			}
+		}
+	}
+	for _, r := range baselineRules {
+		if !e.matchRule(r.rule, peer) || (r.rule.Action != nil && *r.rule.Action == crdv1alpha1.RuleActionPass) || e.skipAuditedRule(r) {
+			continue
+		}
+		// Baseline rules cannot allow the traffic of Pods isolated by K8s NetworkPolicies.
+		if len(isolatingPolicies) > 0 && ruleAction(r) == crdv1alpha1.RuleActionAllow {
+			break
+		}
+		return e.ruleMatched(result, r)
+	}
+	if len(isolatingPolicies) > 0 {
+		result.Action = crdv1alpha1.RuleActionDrop
+		result.Reason = EvaluationReasonK8sIsolation
+		result.IsolatingPolicies = isolatingPolicies
+		return result
+	}
+	result.Action = crdv1alpha1.RuleActionAllow
+	result.Reason = EvaluationReasonNoRuleMatched
+	return result
+}
+
+// ruleMatched sets the action of the result to the one applied by the rule.
+func (e *policyEvaluation) ruleMatched(result *DirectionEvaluation, r *evaluatedRule) *DirectionEvaluation {
+	action := ruleAction(r)
+	result.Action = action
+	result.Reason = EvaluationReasonRuleMatched
+	result.Rule = &EvaluatedRule{
+		PolicyRef:       toPolicyRef(r.policy),
+		PolicyType:      cpv1beta.NetworkPolicyType(r.policy.SourceRef.Type),
+		Tier:            r.policy.Tier,
+		Direction:       cpv1beta.Direction(r.rule.Direction),
+		RuleIndex:       r.ruleIndex,
+		RuleName:        r.rule.Name,
+		Action:          action,
+		EnforcementMode: r.policy.EnforcementMode,
+	}
+	if len(r.rule.L7Protocols) > 0 && result.Action == crdv1alpha1.RuleActionAllow {
+		e.addNote(fmt.Sprintf("The %s rule %d of %s is a layer 7 rule, the traffic is further subject to its layer 7 filters",
+			r.rule.Direction, r.ruleIndex, r.policy.SourceRef.ToString()))
+	}
+	return result
+}
+
+// addNote adds a note to the response if it's not there yet.
+func (e *policyEvaluation) addNote(note string) {
+	for _, n := range e.notes {
+		if n == note {
+			return
+		}
+	}
+	e.notes = append(e.notes, note)
+}
+
+// ruleAction returns the action of the rule, K8s NetworkPolicy rules allowing the traffic.
+func ruleAction(r *evaluatedRule) crdv1alpha1.RuleAction {
+	if r.rule.Action == nil {
+		return crdv1alpha1.RuleActionAllow
+	}
+	return *r.rule.Action
+}
+
+// skipAuditedRule returns whether the rule matching the traffic is a Drop or Reject rule of a
+// policy in Audit mode, in which case it's only noted: like Antrea Agents, which only log the
+// traffic matching such rules, the evaluation continues with the rules of lower priorities.
+func (e *policyEvaluation) skipAuditedRule(r *evaluatedRule) bool {
+	action := ruleAction(r)
+	if r.policy.EnforcementMode != crdv1alpha1.EnforcementModeAudit ||
+		(action != crdv1alpha1.RuleActionDrop && action != crdv1alpha1.RuleActionReject) {
+		return false
+	}
+	e.addNote(fmt.Sprintf("The %s rule %d of %s would %s the traffic, but it is not enforced as the policy is in Audit mode",
+		r.rule.Direction, r.ruleIndex, r.policy.SourceRef.ToString(), strings.ToLower(string(action))))
+	return true
+}
+
+// appliesTo returns whether the rule of the policy is applied to the provided Pod.
+func (e *policyEvaluation) appliesTo(policy *antreatypes.NetworkPolicy, rule *controlplane.NetworkPolicyRule, pod *v1.Pod) bool {
+	groupNames := policy.AppliedToGroups
+	if len(rule.AppliedToGroups) > 0 {
+		groupNames = rule.AppliedToGroups
+	}
+	for _, groupName := range groupNames {
+		obj, found, _ := e.controller.appliedToGroupStore.Get(groupName)
+		if !found {
+			continue
+		}
+		for _, members := range obj.(*antreatypes.AppliedToGroup).GroupMemberByNode {
+			for _, member := range members {
+				if member.Pod != nil && member.Pod.Namespace == pod.Namespace && member.Pod.Name == pod.Name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// matchRule returns whether the rule matches the traffic, given the peer of the Pod the rule is
+// applied to.
+func (e *policyEvaluation) matchRule(rule *controlplane.NetworkPolicyRule, peer *evaluationEndpoint) bool {
+	rulePeer := &rule.From
+	if rule.Direction == controlplane.DirectionOut {
+		rulePeer = &rule.To
+	}
+	if !e.matchPeer(rulePeer, peer) {
+		if len(rulePeer.FQDNs) > 0 {
+			e.addNote(fmt.Sprintf("FQDNs %v of a %s rule are not evaluated as the addresses they resolve to are only known by Antrea Agents",
+				rulePeer.FQDNs, rule.Direction))
+		}
+		return false
+	}
+	return e.matchServices(rule.Services)
+}
+
+// matchPeer returns whether the endpoint is selected by the peer of a rule.
+func (e *policyEvaluation) matchPeer(rulePeer *controlplane.NetworkPolicyPeer, endpoint *evaluationEndpoint) bool {
+	if endpoint.service != nil {
+		for _, ref := range rulePeer.ToServices {
+			if ref.Namespace == endpoint.service.Namespace && ref.Name == endpoint.service.Name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, groupName := range rulePeer.AddressGroups {
+		obj, found, _ := e.controller.addressGroupStore.Get(groupName)
+		if !found {
+			continue
+		}
+		for _, member := range obj.(*antreatypes.AddressGroup).GroupMembers {
+			if groupMemberMatches(member, endpoint) {
+				return true
+			}
+		}
+	}
+	for _, ipBlock := range rulePeer.IPBlocks {
+		for _, ip := range endpoint.ips {
+			if ipBlockContains(&ipBlock, ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchServices returns whether the protocol and port of the traffic are matched by the
+// services of a rule. Named ports are resolved with the ports of the destination Pod.
+func (e *policyEvaluation) matchServices(services []controlplane.Service) bool {
+	if len(services) == 0 {
+		return true
+	}
+	for _, service := range services {
+		protocol := controlplane.ProtocolTCP
+		if service.Protocol != nil {
+			protocol = *service.Protocol
+		}
+		if protocol != e.protocol {
+			continue
+		}
+		if service.Port == nil || protocol == controlplane.ProtocolICMP {
+			return true
+		}
+		port, ok := e.resolvePort(*service.Port)
+		if !ok {
+			continue
+		}
+		endPort := port
+		if service.EndPort != nil {
+			endPort = *service.EndPort
+		}
+		if e.port >= port && e.port <= endPort {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvePort returns the number of the provided port, which is looked up in the container
+// ports of the destination Pod if it's a named port.
+func (e *policyEvaluation) resolvePort(port intstr.IntOrString) (int32, bool) {
+	if port.Type == intstr.Int {
+		return port.IntVal, true
+	}
+	if e.dst.pod == nil {
+		return 0, false
+	}
+	for _, container := range e.dst.pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			protocol := containerPort.Protocol
+			if protocol == "" {
+				protocol = v1.ProtocolTCP
+			}
+			if containerPort.Name == port.StrVal && string(protocol) == string(e.protocol) {
+				return containerPort.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// groupMemberMatches returns whether the GroupMember is the provided Pod or has one of the
+// provided IPs.
+func groupMemberMatches(member *controlplane.GroupMember, endpoint *evaluationEndpoint) bool {
+	if endpoint.pod != nil && member.Pod != nil {
+		return member.Pod.Namespace == endpoint.pod.Namespace && member.Pod.Name == endpoint.pod.Name
+	}
+	for _, memberIP := range member.IPs {
+		for _, ip := range endpoint.ips {
+			if net.IP(memberIP).Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ipBlockContains returns whether the IP is in the CIDR of the IPBlock and not in its excepted
+// CIDRs.
+func ipBlockContains(ipBlock *controlplane.IPBlock, ip net.IP) bool {
+	if !toNetIPNet(&ipBlock.CIDR).Contains(ip) {
+		return false
+	}
+	for i := range ipBlock.Except {
+		if toNetIPNet(&ipBlock.Except[i]).Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func toNetIPNet(ipNet *controlplane.IPNet) *net.IPNet {
+	return utilip.IPNetToNetIPNet(&cpv1beta.IPNet{IP: cpv1beta.IPAddress(ipNet.IP), PrefixLength: ipNet.PrefixLength})
+}
+
+func toPolicyRef(policy *antreatypes.NetworkPolicy) PolicyRef {
+	return PolicyRef{
+		Namespace: policy.SourceRef.Namespace,
+		Name:      policy.SourceRef.Name,
+		UID:       policy.SourceRef.UID,
+	}
+}
+
+// sortEvaluatedRules sorts rules in the order they are enforced: by Tier priority, policy
+// priority, and rule priority. The policy name is used to make the order of rules with the
+// same priorities deterministic.
+func sortEvaluatedRules(rules []*evaluatedRule) {
+	sort.SliceStable(rules, func(i, j int) bool {
+		pi, pj := rules[i].policy, rules[j].policy
+		if pi.TierPriority != nil && pj.TierPriority != nil && *pi.TierPriority != *pj.TierPriority {
+			return *pi.TierPriority < *pj.TierPriority
+		}
+		if pi.Priority != nil && pj.Priority != nil && *pi.Priority != *pj.Priority {
+			return *pi.Priority < *pj.Priority
+		}
+		if rules[i].rule.Priority != rules[j].rule.Priority {
+			return rules[i].rule.Priority < rules[j].rule.Priority
+		}
+		return pi.Name < pj.Name
+	})
+}
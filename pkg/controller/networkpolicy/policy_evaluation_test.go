@@ -0,0 +1,409 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newEvaluationPod(namespace, name, ip string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "container-1", Ports: ports}},
+			NodeName:   "node1",
+		},
+		Status: corev1.PodStatus{
+			PodIP:  ip,
+			PodIPs: []corev1.PodIP{{IP: ip}},
+		},
+	}
+}
+
+func newEvaluationGroupMember(pod *corev1.Pod, withIP bool) *controlplane.GroupMember {
+	member := &controlplane.GroupMember{Pod: &controlplane.PodReference{Namespace: pod.Namespace, Name: pod.Name}}
+	if withIP {
+		member.IPs = []controlplane.IPAddress{controlplane.IPAddress(net.ParseIP(pod.Status.PodIP))}
+	}
+	return member
+}
+
+func newEvaluationIPBlock(cidr string, except ...string) controlplane.IPBlock {
+	ipNet, _ := cidrStrToIPNet(cidr)
+	ipBlock := controlplane.IPBlock{CIDR: *ipNet}
+	for _, e := range except {
+		exceptNet, _ := cidrStrToIPNet(e)
+		ipBlock.Except = append(ipBlock.Except, *exceptNet)
+	}
+	return ipBlock
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	podClient := newEvaluationPod("ns1", "client", "10.0.1.1")
+	podWeb := newEvaluationPod("ns2", "web", "10.0.2.1", corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	podDB := newEvaluationPod("ns2", "db", "10.0.2.2")
+	svcWeb := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "svc-web"}}
+
+	securityOpsTierPriority, applicationTierPriority, baselineTierPriority := int32(100), DefaultTierPriority, BaselineTierPriority
+	priority1, priority2 := float64(1), float64(2)
+	allowAction, dropAction, rejectAction, passAction := crdv1alpha1.RuleActionAllow, crdv1alpha1.RuleActionDrop, crdv1alpha1.RuleActionReject, crdv1alpha1.RuleActionPass
+	protocolTCP := controlplane.ProtocolTCP
+	port22, port443, port5432, portHTTP := intstr.FromInt(22), intstr.FromInt(443), intstr.FromInt(5432), intstr.FromString("http")
+
+	appliedToGroups := []*antreatypes.AppliedToGroup{
+		{Name: "atg-client", GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(newEvaluationGroupMember(podClient, false))}},
+		{Name: "atg-web", GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(newEvaluationGroupMember(podWeb, false))}},
+		{Name: "atg-ns2", GroupMemberByNode: map[string]controlplane.GroupMemberSet{"node1": controlplane.NewGroupMemberSet(newEvaluationGroupMember(podWeb, false), newEvaluationGroupMember(podDB, false))}},
+	}
+	addressGroups := []*antreatypes.AddressGroup{
+		{Name: "ag-client", GroupMembers: controlplane.NewGroupMemberSet(newEvaluationGroupMember(podClient, true))},
+	}
+	policies := []*antreatypes.NetworkPolicy{
+		{
+			Name:            "acnp-pass",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp-pass", UID: "uid-acnp-pass"},
+			TierPriority:    &securityOpsTierPriority,
+			Tier:            "securityops",
+			Priority:        &priority1,
+			AppliedToGroups: []string{"atg-web"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag-client"}},
+				Services:  []controlplane.Service{{Protocol: &protocolTCP, Port: &port443}},
+				Action:    &passAction,
+			}},
+		},
+		{
+			Name:            "acnp-drop",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp-drop", UID: "uid-acnp-drop"},
+			TierPriority:    &applicationTierPriority,
+			Tier:            "application",
+			Priority:        &priority2,
+			AppliedToGroups: []string{"atg-ns2"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				From:      controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newEvaluationIPBlock("10.0.0.0/16", "10.0.1.0/24")}},
+				Name:      "drop-external",
+				Action:    &dropAction,
+			}},
+		},
+		{
+			Name:            "acnp-audit-drop",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp-audit-drop", UID: "uid-acnp-audit-drop"},
+			TierPriority:    &securityOpsTierPriority,
+			Tier:            "securityops",
+			Priority:        &priority1,
+			AppliedToGroups: []string{"atg-ns2"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				From:      controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newEvaluationIPBlock("10.0.4.0/24")}},
+				Action:    &dropAction,
+			}},
+			EnforcementMode: crdv1alpha1.EnforcementModeAudit,
+		},
+		{
+			Name:            "anp-audit",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: "ns2", Name: "anp-audit", UID: "uid-anp-audit"},
+			TierPriority:    &applicationTierPriority,
+			Tier:            "application",
+			Priority:        &priority1,
+			AppliedToGroups: []string{"atg-web"},
+			Rules: []controlplane.NetworkPolicyRule{
+				{
+					Direction: controlplane.DirectionOut,
+					To:        matchAllPeer,
+					Action:    &allowAction,
+				},
+				{
+					Direction: controlplane.DirectionIn,
+					From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag-client"}},
+					Services:  []controlplane.Service{{Protocol: &protocolTCP, Port: &port22}},
+					Action:    &rejectAction,
+				},
+			},
+			EnforcementMode: crdv1alpha1.EnforcementModeAudit,
+		},
+		{
+			Name:            "acnp-svc",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp-svc", UID: "uid-acnp-svc"},
+			TierPriority:    &applicationTierPriority,
+			Tier:            "application",
+			Priority:        &priority1,
+			AppliedToGroups: []string{"atg-client"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionOut,
+				To:        controlplane.NetworkPolicyPeer{ToServices: []controlplane.ServiceReference{{Namespace: "ns2", Name: "svc-web"}}},
+				Action:    &allowAction,
+			}},
+		},
+		{
+			Name:            "acnp-baseline",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp-baseline", UID: "uid-acnp-baseline"},
+			TierPriority:    &baselineTierPriority,
+			Tier:            "baseline",
+			Priority:        &priority1,
+			AppliedToGroups: []string{"atg-client"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionOut,
+				To:        controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newEvaluationIPBlock("10.0.2.0/24")}},
+				Services:  []controlplane.Service{{Protocol: &protocolTCP, Port: &port5432}},
+				Action:    &dropAction,
+			}},
+		},
+		{
+			Name:            "np-web",
+			SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns2", Name: "np-web", UID: "uid-np-web"},
+			AppliedToGroups: []string{"atg-web"},
+			Rules: []controlplane.NetworkPolicyRule{{
+				Direction: controlplane.DirectionIn,
+				From:      controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag-client"}},
+				Services:  []controlplane.Service{{Protocol: &protocolTCP, Port: &portHTTP}},
+				Priority:  defaultRulePriority,
+				Action:    &defaultAction,
+			}},
+		},
+	}
+
+	_, c := newController()
+	for _, pod := range []*corev1.Pod{podClient, podWeb, podDB} {
+		c.groupingInterface.AddPod(pod)
+	}
+	require.NoError(t, c.serviceStore.Add(svcWeb))
+	for _, group := range appliedToGroups {
+		require.NoError(t, c.appliedToGroupStore.Create(group))
+	}
+	for _, group := range addressGroups {
+		require.NoError(t, c.addressGroupStore.Create(group))
+	}
+	for _, policy := range policies {
+		require.NoError(t, c.internalNetworkPolicyStore.Create(policy))
+	}
+	querier := NewEndpointQuerier(c.NetworkPolicyController)
+
+	clientEndpoint := EvaluationEndpoint{Namespace: "ns1", Name: "client"}
+	webEndpoint := EvaluationEndpoint{Namespace: "ns2", Name: "web"}
+	noRuleMatched := func(endpoint string) *DirectionEvaluation {
+		return &DirectionEvaluation{Endpoint: endpoint, Action: crdv1alpha1.RuleActionAllow, Reason: EvaluationReasonNoRuleMatched}
+	}
+	tests := []struct {
+		name             string
+		request          *PolicyEvaluationRequest
+		expectedResponse *PolicyEvaluationResponse
+		expectedErr      func(error) bool
+	}{
+		{
+			name:    "allowed by K8s NetworkPolicy with named port",
+			request: &PolicyEvaluationRequest{Source: clientEndpoint, Destination: webEndpoint, Port: 8080},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "Pod ns1/client",
+				Destination: "Pod ns2/web",
+				Protocol:    "TCP",
+				Port:        8080,
+				Verdict:     crdv1alpha1.RuleActionAllow,
+				Egress:      noRuleMatched("Pod ns1/client"),
+				Ingress: &DirectionEvaluation{
+					Endpoint: "Pod ns2/web",
+					Action:   crdv1alpha1.RuleActionAllow,
+					Reason:   EvaluationReasonRuleMatched,
+					Rule: &EvaluatedRule{
+						PolicyRef:  PolicyRef{Namespace: "ns2", Name: "np-web", UID: "uid-np-web"},
+						PolicyType: cpv1beta.K8sNetworkPolicy,
+						Direction:  cpv1beta.DirectionIn,
+						RuleIndex:  0,
+						Action:     crdv1alpha1.RuleActionAllow,
+					},
+				},
+			},
+		},
+		{
+			name:    "passed and dropped by K8s isolation",
+			request: &PolicyEvaluationRequest{Source: clientEndpoint, Destination: webEndpoint, Protocol: controlplane.ProtocolTCP, Port: 443},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "Pod ns1/client",
+				Destination: "Pod ns2/web",
+				Protocol:    "TCP",
+				Port:        443,
+				Verdict:     crdv1alpha1.RuleActionDrop,
+				Egress:      noRuleMatched("Pod ns1/client"),
+				Ingress: &DirectionEvaluation{
+					Endpoint:          "Pod ns2/web",
+					Action:            crdv1alpha1.RuleActionDrop,
+					Reason:            EvaluationReasonK8sIsolation,
+					IsolatingPolicies: []PolicyRef{{Namespace: "ns2", Name: "np-web", UID: "uid-np-web"}},
+				},
+			},
+		},
+		{
+			name:    "Reject rule in Audit mode skipped and dropped by K8s isolation",
+			request: &PolicyEvaluationRequest{Source: clientEndpoint, Destination: webEndpoint, Protocol: controlplane.ProtocolTCP, Port: 22},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "Pod ns1/client",
+				Destination: "Pod ns2/web",
+				Protocol:    "TCP",
+				Port:        22,
+				Verdict:     crdv1alpha1.RuleActionDrop,
+				Egress:      noRuleMatched("Pod ns1/client"),
+				Ingress: &DirectionEvaluation{
+					Endpoint:          "Pod ns2/web",
+					Action:            crdv1alpha1.RuleActionDrop,
+					Reason:            EvaluationReasonK8sIsolation,
+					IsolatingPolicies: []PolicyRef{{Namespace: "ns2", Name: "np-web", UID: "uid-np-web"}},
+				},
+				Notes: []string{"The In rule 0 of AntreaNetworkPolicy:ns2/anp-audit would reject the traffic, but it is not enforced as the policy is in Audit mode"},
+			},
+		},
+		{
+			name:    "Drop rule in Audit mode skipped and dropped by lower priority Drop rule",
+			request: &PolicyEvaluationRequest{Source: EvaluationEndpoint{IP: net.ParseIP("10.0.4.1")}, Destination: EvaluationEndpoint{Namespace: "ns2", Name: "db"}, Protocol: controlplane.ProtocolUDP, Port: 53},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "IP 10.0.4.1",
+				Destination: "Pod ns2/db",
+				Protocol:    "UDP",
+				Port:        53,
+				Verdict:     crdv1alpha1.RuleActionDrop,
+				Ingress: &DirectionEvaluation{
+					Endpoint: "Pod ns2/db",
+					Action:   crdv1alpha1.RuleActionDrop,
+					Reason:   EvaluationReasonRuleMatched,
+					Rule: &EvaluatedRule{
+						PolicyRef:  PolicyRef{Name: "acnp-drop", UID: "uid-acnp-drop"},
+						PolicyType: cpv1beta.AntreaClusterNetworkPolicy,
+						Tier:       "application",
+						Direction:  cpv1beta.DirectionIn,
+						RuleIndex:  0,
+						RuleName:   "drop-external",
+						Action:     crdv1alpha1.RuleActionDrop,
+					},
+				},
+				Notes: []string{"The In rule 0 of AntreaClusterNetworkPolicy:acnp-audit-drop would drop the traffic, but it is not enforced as the policy is in Audit mode"},
+			},
+		},
+		{
+			name:    "dropped by IPBlock",
+			request: &PolicyEvaluationRequest{Source: EvaluationEndpoint{IP: net.ParseIP("10.0.3.1")}, Destination: EvaluationEndpoint{Namespace: "ns2", Name: "db"}, Protocol: controlplane.ProtocolUDP, Port: 53},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "IP 10.0.3.1",
+				Destination: "Pod ns2/db",
+				Protocol:    "UDP",
+				Port:        53,
+				Verdict:     crdv1alpha1.RuleActionDrop,
+				Ingress: &DirectionEvaluation{
+					Endpoint: "Pod ns2/db",
+					Action:   crdv1alpha1.RuleActionDrop,
+					Reason:   EvaluationReasonRuleMatched,
+					Rule: &EvaluatedRule{
+						PolicyRef:  PolicyRef{Name: "acnp-drop", UID: "uid-acnp-drop"},
+						PolicyType: cpv1beta.AntreaClusterNetworkPolicy,
+						Tier:       "application",
+						Direction:  cpv1beta.DirectionIn,
+						RuleIndex:  0,
+						RuleName:   "drop-external",
+						Action:     crdv1alpha1.RuleActionDrop,
+					},
+				},
+			},
+		},
+		{
+			name:    "dropped by baseline rule",
+			request: &PolicyEvaluationRequest{Source: clientEndpoint, Destination: EvaluationEndpoint{Namespace: "ns2", Name: "db"}, Port: 5432},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "Pod ns1/client",
+				Destination: "Pod ns2/db",
+				Protocol:    "TCP",
+				Port:        5432,
+				Verdict:     crdv1alpha1.RuleActionDrop,
+				Egress: &DirectionEvaluation{
+					Endpoint: "Pod ns1/client",
+					Action:   crdv1alpha1.RuleActionDrop,
+					Reason:   EvaluationReasonRuleMatched,
+					Rule: &EvaluatedRule{
+						PolicyRef:  PolicyRef{Name: "acnp-baseline", UID: "uid-acnp-baseline"},
+						PolicyType: cpv1beta.AntreaClusterNetworkPolicy,
+						Tier:       "baseline",
+						Direction:  cpv1beta.DirectionOut,
+						RuleIndex:  0,
+						Action:     crdv1alpha1.RuleActionDrop,
+					},
+				},
+				Ingress: noRuleMatched("Pod ns2/db"),
+			},
+		},
+		{
+			name:    "allowed by toServices rule",
+			request: &PolicyEvaluationRequest{Source: clientEndpoint, Destination: EvaluationEndpoint{Namespace: "ns2", Name: "svc-web"}, Port: 80},
+			expectedResponse: &PolicyEvaluationResponse{
+				Source:      "Pod ns1/client",
+				Destination: "Service ns2/svc-web",
+				Protocol:    "TCP",
+				Port:        80,
+				Verdict:     crdv1alpha1.RuleActionAllow,
+				Egress: &DirectionEvaluation{
+					Endpoint: "Pod ns1/client",
+					Action:   crdv1alpha1.RuleActionAllow,
+					Reason:   EvaluationReasonRuleMatched,
+					Rule: &EvaluatedRule{
+						PolicyRef:  PolicyRef{Name: "acnp-svc", UID: "uid-acnp-svc"},
+						PolicyType: cpv1beta.AntreaClusterNetworkPolicy,
+						Tier:       "application",
+						Direction:  cpv1beta.DirectionOut,
+						RuleIndex:  0,
+						Action:     crdv1alpha1.RuleActionAllow,
+					},
+				},
+				Notes: []string{"The destination is a Service: only the egress rules selecting it with toServices are evaluated, " +
+					"specify a backend Pod of the Service as destination to evaluate the other rules"},
+			},
+		},
+		{
+			name:        "source not found",
+			request:     &PolicyEvaluationRequest{Source: EvaluationEndpoint{Namespace: "ns2", Name: "svc-web"}, Destination: webEndpoint, Port: 80},
+			expectedErr: errors.IsNotFound,
+		},
+		{
+			name:        "destination not found",
+			request:     &PolicyEvaluationRequest{Source: clientEndpoint, Destination: EvaluationEndpoint{Namespace: "ns2", Name: "foo"}, Port: 80},
+			expectedErr: errors.IsNotFound,
+		},
+		{
+			name:        "no Pod endpoint",
+			request:     &PolicyEvaluationRequest{Source: EvaluationEndpoint{IP: net.ParseIP("10.0.3.1")}, Destination: EvaluationEndpoint{IP: net.ParseIP("10.0.3.2")}, Port: 80},
+			expectedErr: errors.IsBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response, err := querier.EvaluatePolicies(tt.request)
+			if tt.expectedErr != nil {
+				assert.True(t, tt.expectedErr(err), "Unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResponse, response)
+		})
+	}
+}
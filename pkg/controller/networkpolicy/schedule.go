@@ -0,0 +1,228 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// Embed the IANA time zone database so that the time zones of rule schedules can be
+	// loaded even if the database is not installed in the antrea-controller image.
+	_ "time/tzdata"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+const (
+	// scheduleTimeLayout is the layout of the start and end times of time windows.
+	scheduleTimeLayout = "15:04"
+	// maxScheduleLookAheadDays is the number of days after which there is necessarily a
+	// transition for a schedule which is not always active, as windows repeat every week.
+	maxScheduleLookAheadDays = 8
+)
+
+var daysOfWeek = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// timeWindow is the parsed form of crdv1alpha1.TimeWindow. start and end are offsets from
+// midnight, end is greater than start.
+type timeWindow struct {
+	start time.Duration
+	end   time.Duration
+	// days is nil if the window begins every day.
+	days map[time.Weekday]bool
+}
+
+// ruleSchedule is the parsed form of crdv1alpha1.RuleSchedule.
+type ruleSchedule struct {
+	location *time.Location
+	windows  []timeWindow
+}
+
+func parseScheduleTime(value string) (time.Duration, error) {
+	t, err := time.Parse(scheduleTimeLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, it must be in the format of HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// parseRuleSchedule validates a crdv1alpha1.RuleSchedule and converts it to a ruleSchedule.
+func parseRuleSchedule(schedule *crdv1alpha1.RuleSchedule) (*ruleSchedule, error) {
+	if len(schedule.Windows) == 0 {
+		return nil, fmt.Errorf("at least one time window must be set")
+	}
+	timeZone := schedule.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %v", schedule.TimeZone, err)
+	}
+	s := &ruleSchedule{location: location}
+	for _, w := range schedule.Windows {
+		start, err := parseScheduleTime(w.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseScheduleTime(w.End)
+		if err != nil {
+			return nil, err
+		}
+		// The window ends on the next day.
+		if end <= start {
+			end += 24 * time.Hour
+		}
+		window := timeWindow{start: start, end: end}
+		for _, day := range w.DaysOfWeek {
+			weekday, ok := daysOfWeek[strings.ToLower(day)]
+			if !ok {
+				return nil, fmt.Errorf("invalid day of week %q", day)
+			}
+			if window.days == nil {
+				window.days = map[time.Weekday]bool{}
+			}
+			window.days[weekday] = true
+		}
+		s.windows = append(s.windows, window)
+	}
+	return s, nil
+}
+
+// forEachOccurrence calls fn with the beginning and the end of each occurrence of the windows
+// which begins between the given numbers of days before and after the day of t. It stops when
+// fn returns false.
+func (s *ruleSchedule) forEachOccurrence(t time.Time, daysBefore, daysAfter int, fn func(start, end time.Time) bool) {
+	t = t.In(s.location)
+	for offset := -daysBefore; offset <= daysAfter; offset++ {
+		// time.Date normalizes the day, and takes care of the daylight saving time transitions.
+		year, month, day := t.Date()
+		for _, w := range s.windows {
+			midnight := time.Date(year, month, day+offset, 0, 0, 0, 0, s.location)
+			if w.days != nil && !w.days[midnight.Weekday()] {
+				continue
+			}
+			start := time.Date(year, month, day+offset, 0, 0, 0, int(w.start), s.location)
+			end := time.Date(year, month, day+offset, 0, 0, 0, int(w.end), s.location)
+			if !fn(start, end) {
+				return
+			}
+		}
+	}
+}
+
+// isActive returns whether t falls within any of the windows. The beginning of a window is
+// inclusive and its end is exclusive.
+func (s *ruleSchedule) isActive(t time.Time) bool {
+	active := false
+	// A window lasts less than 2 days, only the occurrences which begin the day before or the
+	// day of t may include it.
+	s.forEachOccurrence(t, 1, 0, func(start, end time.Time) bool {
+		if !t.Before(start) && t.Before(end) {
+			active = true
+			return false
+		}
+		return true
+	})
+	return active
+}
+
+// nextTransition returns the first time after t at which a window begins or ends. It returns
+// the zero time if there is no such time, which is only possible if the schedule doesn't have
+// any occurrence.
+func (s *ruleSchedule) nextTransition(t time.Time) time.Time {
+	var next time.Time
+	s.forEachOccurrence(t, 1, maxScheduleLookAheadDays, func(start, end time.Time) bool {
+		for _, transition := range []time.Time{start, end} {
+			if transition.After(t) && (next.IsZero() || transition.Before(next)) {
+				next = transition
+			}
+		}
+		return true
+	})
+	return next
+}
+
+// isRuleActive returns whether an Antrea-native policy rule is enforced at present according to
+// its schedule. If the rule has a schedule, the policy referred by ref is reprocessed at the next
+// time the rule is activated or deactivated.
+func (n *NetworkPolicyController) isRuleActive(rule *crdv1alpha1.Rule, ref controlplane.NetworkPolicyReference) bool {
+	if rule.Schedule == nil {
+		return true
+	}
+	schedule, err := parseRuleSchedule(rule.Schedule)
+	if err != nil {
+		// Invalid schedules are rejected by the validating webhook. Enforce the rule in case
+		// the webhook is bypassed.
+		klog.ErrorS(err, "Invalid schedule, the rule will always be enforced", "policy", ref.ToString(), "rule", rule.Name)
+		return true
+	}
+	now := n.clock.Now()
+	if next := schedule.nextTransition(now); !next.IsZero() {
+		// Clear the fields which are not used to look up the policy, so that the same policy
+		// is only queued once.
+		n.ruleScheduleQueue.AddAfter(controlplane.NetworkPolicyReference{
+			Type:      ref.Type,
+			Namespace: ref.Namespace,
+			Name:      ref.Name,
+		}, next.Sub(now))
+	}
+	return schedule.isActive(now)
+}
+
+func (n *NetworkPolicyController) ruleScheduleWorker() {
+	for n.processNextRuleScheduleWorkItem() {
+	}
+}
+
+// processNextRuleScheduleWorkItem reprocesses a policy of which a scheduled rule should be
+// activated or deactivated.
+func (n *NetworkPolicyController) processNextRuleScheduleWorkItem() bool {
+	obj, quit := n.ruleScheduleQueue.Get()
+	if quit {
+		return false
+	}
+	defer n.ruleScheduleQueue.Done(obj)
+
+	ref := obj.(controlplane.NetworkPolicyReference)
+	switch ref.Type {
+	case controlplane.AntreaClusterNetworkPolicy:
+		cnp, err := n.cnpLister.Get(ref.Name)
+		if err != nil {
+			klog.V(2).InfoS("ClusterNetworkPolicy not found, skip reprocessing its rule schedules", "name", ref.Name)
+			return true
+		}
+		n.reprocessCNP(cnp, false)
+	case controlplane.AntreaNetworkPolicy:
+		anp, err := n.anpLister.NetworkPolicies(ref.Namespace).Get(ref.Name)
+		if err != nil {
+			klog.V(2).InfoS("Antrea NetworkPolicy not found, skip reprocessing its rule schedules", "namespace", ref.Namespace, "name", ref.Name)
+			return true
+		}
+		n.reprocessANP(anp)
+	}
+	return true
+}
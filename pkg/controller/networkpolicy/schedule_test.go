@@ -0,0 +1,180 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestRuleSchedule(t *testing.T) {
+	tests := []struct {
+		name               string
+		schedule           crdv1alpha1.RuleSchedule
+		time               string
+		expectedActive     bool
+		expectedTransition string
+	}{
+		{
+			name: "before-window",
+			schedule: crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			time:               "2022-05-02T08:00:00Z",
+			expectedActive:     false,
+			expectedTransition: "2022-05-02T09:00:00Z",
+		},
+		{
+			name: "start-of-window",
+			schedule: crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			time:               "2022-05-02T09:00:00Z",
+			expectedActive:     true,
+			expectedTransition: "2022-05-02T17:00:00Z",
+		},
+		{
+			name: "end-of-window",
+			schedule: crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			time:               "2022-05-02T17:00:00Z",
+			expectedActive:     false,
+			expectedTransition: "2022-05-03T09:00:00Z",
+		},
+		{
+			name: "window-crossing-midnight",
+			schedule: crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "22:00", End: "06:00", DaysOfWeek: []string{"Sunday"}}},
+			},
+			// Monday.
+			time:               "2022-05-02T01:00:00Z",
+			expectedActive:     true,
+			expectedTransition: "2022-05-02T06:00:00Z",
+		},
+		{
+			name: "weekend",
+			schedule: crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00", DaysOfWeek: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}}},
+			},
+			// Saturday.
+			time:               "2022-05-07T10:00:00Z",
+			expectedActive:     false,
+			expectedTransition: "2022-05-09T09:00:00Z",
+		},
+		{
+			name: "time-zone",
+			schedule: crdv1alpha1.RuleSchedule{
+				TimeZone: "America/Los_Angeles",
+				Windows:  []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			// 10:00 in Los Angeles (PDT).
+			time:               "2022-05-02T17:00:00Z",
+			expectedActive:     true,
+			expectedTransition: "2022-05-03T00:00:00Z",
+		},
+		{
+			name: "multiple-windows",
+			schedule: crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{
+					{Start: "09:00", End: "12:00"},
+					{Start: "11:00", End: "14:00"},
+				},
+			},
+			time:               "2022-05-02T11:30:00Z",
+			expectedActive:     true,
+			expectedTransition: "2022-05-02T12:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := parseRuleSchedule(&tt.schedule)
+			require.NoError(t, err)
+			now := mustParseTime(t, tt.time)
+			assert.Equal(t, tt.expectedActive, schedule.isActive(now))
+			assert.True(t, mustParseTime(t, tt.expectedTransition).Equal(schedule.nextTransition(now)))
+		})
+	}
+}
+
+func TestProcessAntreaNetworkPolicyWithSchedule(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	anp := &crdv1alpha1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "npA", UID: "uidA"},
+		Spec: crdv1alpha1.NetworkPolicySpec{
+			AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+				{PodSelector: &selectorA},
+			},
+			Priority: 10,
+			Ingress: []crdv1alpha1.Rule{
+				{
+					Name:   "always",
+					From:   []crdv1alpha1.NetworkPolicyPeer{{PodSelector: &selectorB}},
+					Action: &allowAction,
+				},
+				{
+					Name:   "office-hours",
+					From:   []crdv1alpha1.NetworkPolicyPeer{{PodSelector: &selectorC}},
+					Action: &allowAction,
+					Schedule: &crdv1alpha1.RuleSchedule{
+						Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+					},
+				},
+			},
+		},
+	}
+	_, c := newController()
+	fakeClock := clocktesting.NewFakeClock(mustParseTime(t, "2022-05-02T08:00:00Z"))
+	c.clock = fakeClock
+	c.ruleScheduleQueue = workqueue.NewDelayingQueueWithCustomClock(fakeClock, "ruleSchedule")
+	defer c.ruleScheduleQueue.ShutDown()
+
+	internalNP := c.processAntreaNetworkPolicy(anp)
+	require.Len(t, internalNP.Rules, 1)
+	assert.Equal(t, "always", internalNP.Rules[0].Name)
+	assert.Equal(t, []string{"office-hours"}, internalNP.InactiveRules)
+
+	// The policy should be queued when the window begins.
+	assert.Equal(t, 0, c.ruleScheduleQueue.Len())
+	fakeClock.Step(time.Hour)
+	assert.Eventually(t, func() bool {
+		return c.ruleScheduleQueue.Len() == 1
+	}, time.Second, 10*time.Millisecond)
+	item, _ := c.ruleScheduleQueue.Get()
+	assert.Equal(t, controlplane.NetworkPolicyReference{Type: controlplane.AntreaNetworkPolicy, Namespace: "ns1", Name: "npA"}, item)
+	c.ruleScheduleQueue.Done(item)
+
+	internalNP = c.processAntreaNetworkPolicy(anp)
+	require.Len(t, internalNP.Rules, 2)
+	assert.Equal(t, "office-hours", internalNP.Rules[1].Name)
+	// The priority of a rule doesn't depend on whether the rules before it are active.
+	assert.Equal(t, int32(1), internalNP.Rules[1].Priority)
+	assert.Empty(t, internalNP.InactiveRules)
+}
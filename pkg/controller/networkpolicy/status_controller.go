@@ -16,15 +16,23 @@ package networkpolicy
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
@@ -38,13 +46,24 @@ import (
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	"antrea.io/antrea/pkg/controller/metrics"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/features"
 )
 
 const (
 	statusControllerName = "NetworkPolicyStatusController"
+
+	// adminPolicyConditionReady is the type of the condition reported in the status of
+	// AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+	adminPolicyConditionReady = "Ready"
+	// The reasons of the Ready condition.
+	adminPolicyReasonSyncError = "SyncError"
+	adminPolicyReasonPending   = "Pending"
+	adminPolicyReasonRealizing = "Realizing"
+	adminPolicyReasonRealized  = "Realized"
 )
 
-// StatusController is responsible for synchronizing the status of Antrea ClusterNetworkPolicy and Antrea NetworkPolicy.
+// StatusController is responsible for synchronizing the status of Antrea ClusterNetworkPolicy and Antrea NetworkPolicy,
+// as well as the status of AdminNetworkPolicy and BaselineAdminNetworkPolicy when the AdminNetworkPolicy feature is enabled.
 type StatusController struct {
 	// npControlInterface knows how to update Antrea NetworkPolicy status.
 	npControlInterface networkPolicyControlInterface
@@ -71,15 +90,29 @@ type StatusController struct {
 	anpLister crdlisters.NetworkPolicyLister
 	// anpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	anpListerSynced cache.InformerSynced
+	// adminNPListerSynced is a function which returns true if the AdminNetworkPolicies informer has been synced at least once.
+	adminNPListerSynced cache.InformerSynced
+	// banpListerSynced is a function which returns true if the BaselineAdminNetworkPolicies informer has been synced at least once.
+	banpListerSynced cache.InformerSynced
 }
 
-func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, cnpInformer crdinformers.ClusterNetworkPolicyInformer, anpInformer crdinformers.NetworkPolicyInformer) *StatusController {
+// NewStatusController creates a StatusController. dynamicClient, adminNPInformer and banpInformer are only used
+// when the AdminNetworkPolicy feature is enabled and can be nil otherwise.
+func NewStatusController(antreaClient antreaclientset.Interface,
+	dynamicClient dynamic.Interface,
+	internalNetworkPolicyStore storage.Interface,
+	cnpInformer crdinformers.ClusterNetworkPolicyInformer,
+	anpInformer crdinformers.NetworkPolicyInformer,
+	adminNPInformer informers.GenericInformer,
+	banpInformer informers.GenericInformer) *StatusController {
+	npControl := &networkPolicyControl{
+		antreaClient:  antreaClient,
+		dynamicClient: dynamicClient,
+		anpLister:     anpInformer.Lister(),
+		cnpLister:     cnpInformer.Lister(),
+	}
 	c := &StatusController{
-		npControlInterface: &networkPolicyControl{
-			antreaClient: antreaClient,
-			anpLister:    anpInformer.Lister(),
-			cnpLister:    cnpInformer.Lister(),
-		},
+		npControlInterface:         npControl,
 		queue:                      workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "networkpolicy"),
 		internalNetworkPolicyStore: internalNetworkPolicyStore,
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
@@ -105,13 +138,31 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		},
 		resyncPeriod,
 	)
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		npControl.adminNPLister = adminNPInformer.Lister()
+		npControl.banpLister = banpInformer.Lister()
+		c.adminNPListerSynced = adminNPInformer.Informer().HasSynced
+		c.banpListerSynced = banpInformer.Informer().HasSynced
+		adminNPInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				UpdateFunc: c.updateAdminNP,
+			},
+			resyncPeriod,
+		)
+		banpInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				UpdateFunc: c.updateBANP,
+			},
+			resyncPeriod,
+		)
+	}
 	return c
 }
 
 func (c *StatusController) updateCNP(old, cur interface{}) {
 	curCNP := cur.(*crdv1alpha1.ClusterNetworkPolicy)
 	oldCNP := old.(*crdv1alpha1.ClusterNetworkPolicy)
-	if oldCNP.Status == curCNP.Status {
+	if equality.Semantic.DeepEqual(oldCNP.Status, curCNP.Status) {
 		return
 	}
 	key := internalNetworkPolicyKeyFunc(oldCNP)
@@ -121,13 +172,49 @@ func (c *StatusController) updateCNP(old, cur interface{}) {
 func (c *StatusController) updateANP(old, cur interface{}) {
 	curANP := cur.(*crdv1alpha1.NetworkPolicy)
 	oldANP := old.(*crdv1alpha1.NetworkPolicy)
-	if oldANP.Status == curANP.Status {
+	if equality.Semantic.DeepEqual(oldANP.Status, curANP.Status) {
 		return
 	}
 	key := internalNetworkPolicyKeyFunc(oldANP)
 	c.queue.Add(key)
 }
 
+func (c *StatusController) updateAdminNP(old, cur interface{}) {
+	curAdminNP, err := toAdminNetworkPolicy(cur)
+	if err != nil {
+		klog.ErrorS(err, "Failed to convert object to AdminNetworkPolicy")
+		return
+	}
+	oldAdminNP, err := toAdminNetworkPolicy(old)
+	if err != nil {
+		klog.ErrorS(err, "Failed to convert object to AdminNetworkPolicy")
+		return
+	}
+	if equality.Semantic.DeepEqual(oldAdminNP.Status, curAdminNP.Status) {
+		return
+	}
+	key := internalNetworkPolicyKeyFunc(oldAdminNP)
+	c.queue.Add(key)
+}
+
+func (c *StatusController) updateBANP(old, cur interface{}) {
+	curBANP, err := toBaselineAdminNetworkPolicy(cur)
+	if err != nil {
+		klog.ErrorS(err, "Failed to convert object to BaselineAdminNetworkPolicy")
+		return
+	}
+	oldBANP, err := toBaselineAdminNetworkPolicy(old)
+	if err != nil {
+		klog.ErrorS(err, "Failed to convert object to BaselineAdminNetworkPolicy")
+		return
+	}
+	if equality.Semantic.DeepEqual(oldBANP.Status, curBANP.Status) {
+		return
+	}
+	key := internalNetworkPolicyKeyFunc(oldBANP)
+	c.queue.Add(key)
+}
+
 func (c *StatusController) UpdateStatus(status *controlplane.NetworkPolicyStatus) error {
 	key := status.Name
 	_, found, _ := c.internalNetworkPolicyStore.Get(key)
@@ -188,7 +275,11 @@ func (c *StatusController) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", statusControllerName)
 	defer klog.Infof("Shutting down %s", statusControllerName)
 
-	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, c.cnpListerSynced, c.anpListerSynced) {
+	cacheSyncs := []cache.InformerSynced{c.cnpListerSynced, c.anpListerSynced}
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		cacheSyncs = append(cacheSyncs, c.adminNPListerSynced, c.banpListerSynced)
+	}
+	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, cacheSyncs...) {
 		return
 	}
 
@@ -267,6 +358,9 @@ func (c *StatusController) syncHandler(key string) error {
 		return nil
 	}
 	internalNP := internalNPObj.(*antreatypes.NetworkPolicy)
+	if internalNP.SourceRef.Type == controlplane.AdminNetworkPolicy || internalNP.SourceRef.Type == controlplane.BaselineAdminNetworkPolicy {
+		return c.syncAdminPolicyStatus(key, internalNP)
+	}
 
 	// It means the NetworkPolicy hasn't been processed once. Set it to Pending to differentiate from NetworkPolicies
 	// that spans 0 Node.
@@ -274,25 +368,14 @@ func (c *StatusController) syncHandler(key string) error {
 		status := &crdv1alpha1.NetworkPolicyStatus{
 			Phase:              crdv1alpha1.NetworkPolicyPending,
 			ObservedGeneration: internalNP.Generation,
+			InactiveRules:      internalNP.InactiveRules,
 		}
 		if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
 			return c.npControlInterface.UpdateAntreaNetworkPolicyStatus(internalNP.SourceRef.Namespace, internalNP.SourceRef.Name, status)
 		}
 		return c.npControlInterface.UpdateAntreaClusterNetworkPolicyStatus(internalNP.SourceRef.Name, status)
 	}
-	desiredNodes := len(internalNP.SpanMeta.NodeNames)
-	currentNodes := 0
-	statuses := c.getNodeStatuses(key)
-	for _, status := range statuses {
-		// The node is no longer in the span of this policy, delete its status.
-		if !internalNP.NodeNames.Has(status.NodeName) {
-			c.deleteNodeStatus(key, status.NodeName)
-			continue
-		}
-		if status.Generation == internalNP.Generation {
-			currentNodes += 1
-		}
-	}
+	desiredNodes, currentNodes := c.countRealizedNodes(key, internalNP)
 
 	phase := crdv1alpha1.NetworkPolicyRealizing
 	if currentNodes == desiredNodes {
@@ -304,6 +387,7 @@ func (c *StatusController) syncHandler(key string) error {
 		ObservedGeneration:   internalNP.Generation,
 		CurrentNodesRealized: int32(currentNodes),
 		DesiredNodesRealized: int32(desiredNodes),
+		InactiveRules:        internalNP.InactiveRules,
 	}
 	klog.V(2).Infof("Updating NetworkPolicy %s status: %v", internalNP.SourceRef.ToString(), status)
 	if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
@@ -312,17 +396,74 @@ func (c *StatusController) syncHandler(key string) error {
 	return c.npControlInterface.UpdateAntreaClusterNetworkPolicyStatus(internalNP.SourceRef.Name, status)
 }
 
+// countRealizedNodes returns the number of Nodes in the span of the NetworkPolicy and the number of Nodes which have
+// realized the current generation of it.
+func (c *StatusController) countRealizedNodes(key string, internalNP *antreatypes.NetworkPolicy) (int, int) {
+	desiredNodes := len(internalNP.SpanMeta.NodeNames)
+	currentNodes := 0
+	statuses := c.getNodeStatuses(key)
+	for _, status := range statuses {
+		// The node is no longer in the span of this policy, delete its status.
+		if !internalNP.NodeNames.Has(status.NodeName) {
+			c.deleteNodeStatus(key, status.NodeName)
+			continue
+		}
+		if status.Generation == internalNP.Generation {
+			currentNodes += 1
+		}
+	}
+	return desiredNodes, currentNodes
+}
+
+// syncAdminPolicyStatus syncs the Ready condition of an AdminNetworkPolicy or a BaselineAdminNetworkPolicy. The
+// condition is False if the policy couldn't be translated entirely or hasn't been realized by all Nodes in its span.
+func (c *StatusController) syncAdminPolicyStatus(key string, internalNP *antreatypes.NetworkPolicy) error {
+	condition := &v1.Condition{
+		Type:               adminPolicyConditionReady,
+		ObservedGeneration: internalNP.Generation,
+	}
+	if internalNP.SyncError != nil {
+		condition.Status = v1.ConditionFalse
+		condition.Reason = adminPolicyReasonSyncError
+		condition.Message = internalNP.SyncError.Error()
+	} else if internalNP.SpanMeta.NodeNames == nil {
+		condition.Status = v1.ConditionFalse
+		condition.Reason = adminPolicyReasonPending
+		condition.Message = "The policy has not been processed yet"
+	} else {
+		desiredNodes, currentNodes := c.countRealizedNodes(key, internalNP)
+		condition.Message = fmt.Sprintf("The policy has been realized on %d of %d Nodes", currentNodes, desiredNodes)
+		if currentNodes == desiredNodes {
+			condition.Status = v1.ConditionTrue
+			condition.Reason = adminPolicyReasonRealized
+		} else {
+			condition.Status = v1.ConditionFalse
+			condition.Reason = adminPolicyReasonRealizing
+		}
+	}
+	klog.V(2).Infof("Updating NetworkPolicy %s condition: %v", internalNP.SourceRef.ToString(), condition)
+	if internalNP.SourceRef.Type == controlplane.AdminNetworkPolicy {
+		return c.npControlInterface.UpdateAdminNetworkPolicyStatus(internalNP.SourceRef.Name, condition)
+	}
+	return c.npControlInterface.UpdateBaselineAdminNetworkPolicyStatus(internalNP.SourceRef.Name, condition)
+}
+
 // networkPolicyControlInterface is an interface that knows how to update Antrea NetworkPolicy status.
 // It's created as an interface to allow testing.
 type networkPolicyControlInterface interface {
 	UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1alpha1.NetworkPolicyStatus) error
 	UpdateAntreaClusterNetworkPolicyStatus(name string, status *crdv1alpha1.NetworkPolicyStatus) error
+	UpdateAdminNetworkPolicyStatus(name string, condition *v1.Condition) error
+	UpdateBaselineAdminNetworkPolicyStatus(name string, condition *v1.Condition) error
 }
 
 type networkPolicyControl struct {
-	antreaClient antreaclientset.Interface
-	cnpLister    crdlisters.ClusterNetworkPolicyLister
-	anpLister    crdlisters.NetworkPolicyLister
+	antreaClient  antreaclientset.Interface
+	dynamicClient dynamic.Interface
+	cnpLister     crdlisters.ClusterNetworkPolicyLister
+	anpLister     crdlisters.NetworkPolicyLister
+	adminNPLister cache.GenericLister
+	banpLister    cache.GenericLister
 }
 
 func (c *networkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1alpha1.NetworkPolicyStatus) error {
@@ -331,7 +472,7 @@ func (c *networkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name s
 		klog.Infof("Didn't find the original Antrea NetworkPolicy %s/%s, skip updating status", namespace, name)
 		return nil
 	}
-	if anp.Status == *status {
+	if equality.Semantic.DeepEqual(anp.Status, *status) {
 		return nil
 	}
 
@@ -364,7 +505,7 @@ func (c *networkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name strin
 		return nil
 	}
 	// If the current status equals to the desired status, no need to update.
-	if cnp.Status == *status {
+	if equality.Semantic.DeepEqual(cnp.Status, *status) {
 		return nil
 	}
 
@@ -389,3 +530,90 @@ func (c *networkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name strin
 	metrics.AntreaClusterNetworkPolicyStatusUpdates.Inc()
 	return updateErr
 }
+
+func (c *networkPolicyControl) UpdateAdminNetworkPolicyStatus(name string, condition *v1.Condition) error {
+	obj, err := c.adminNPLister.Get(name)
+	if err != nil {
+		klog.Infof("Didn't find the original AdminNetworkPolicy %s, skip updating status", name)
+		return nil
+	}
+	adminNP, err := toAdminNetworkPolicy(obj)
+	if err != nil {
+		return err
+	}
+	conditions := adminNP.Status.DeepCopy().Conditions
+	meta.SetStatusCondition(&conditions, *condition)
+	// If the current conditions equal to the desired conditions, no need to update.
+	if equality.Semantic.DeepEqual(adminNP.Status.Conditions, conditions) {
+		return nil
+	}
+	if err := c.updateAdminPolicyStatus(AdminNetworkPolicyGVR, name, condition, func(u *unstructured.Unstructured) (interface{}, error) {
+		toUpdate, err := toAdminNetworkPolicy(u)
+		if err != nil {
+			return nil, err
+		}
+		meta.SetStatusCondition(&toUpdate.Status.Conditions, *condition)
+		return toUpdate, nil
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated AdminNetworkPolicy", "AdminNetworkPolicy", name)
+	metrics.AdminNetworkPolicyStatusUpdates.Inc()
+	return nil
+}
+
+func (c *networkPolicyControl) UpdateBaselineAdminNetworkPolicyStatus(name string, condition *v1.Condition) error {
+	obj, err := c.banpLister.Get(name)
+	if err != nil {
+		klog.Infof("Didn't find the original BaselineAdminNetworkPolicy %s, skip updating status", name)
+		return nil
+	}
+	banp, err := toBaselineAdminNetworkPolicy(obj)
+	if err != nil {
+		return err
+	}
+	conditions := banp.Status.DeepCopy().Conditions
+	meta.SetStatusCondition(&conditions, *condition)
+	// If the current conditions equal to the desired conditions, no need to update.
+	if equality.Semantic.DeepEqual(banp.Status.Conditions, conditions) {
+		return nil
+	}
+	if err := c.updateAdminPolicyStatus(BaselineAdminNetworkPolicyGVR, name, condition, func(u *unstructured.Unstructured) (interface{}, error) {
+		toUpdate, err := toBaselineAdminNetworkPolicy(u)
+		if err != nil {
+			return nil, err
+		}
+		meta.SetStatusCondition(&toUpdate.Status.Conditions, *condition)
+		return toUpdate, nil
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated BaselineAdminNetworkPolicy", "BaselineAdminNetworkPolicy", name)
+	metrics.BaselineAdminNetworkPolicyStatusUpdates.Inc()
+	return nil
+}
+
+// updateAdminPolicyStatus updates the status of the cluster-scoped resource identified by gvr and name with the
+// dynamic client. setCondition returns the typed object with the condition set, which is converted back to an
+// unstructured object before the update. The latest object is retrieved from kube-apiserver in case of conflict.
+func (c *networkPolicyControl) updateAdminPolicyStatus(gvr schema.GroupVersionResource, name string, condition *v1.Condition,
+	setCondition func(u *unstructured.Unstructured) (interface{}, error)) error {
+	client := c.dynamicClient.Resource(gvr)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := client.Get(context.TODO(), name, v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		toUpdate, err := setCondition(current)
+		if err != nil {
+			return err
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(toUpdate)
+		if err != nil {
+			return err
+		}
+		klog.V(2).InfoS("Updating status", "resource", gvr.Resource, "name", name, "condition", condition)
+		_, err = client.UpdateStatus(context.TODO(), &unstructured.Unstructured{Object: content}, v1.UpdateOptions{})
+		return err
+	})
+}
@@ -38,8 +38,10 @@ import (
 
 type fakeNetworkPolicyControl struct {
 	sync.Mutex
-	anpStatus *crdv1alpha1.NetworkPolicyStatus
-	cnpStatus *crdv1alpha1.NetworkPolicyStatus
+	anpStatus        *crdv1alpha1.NetworkPolicyStatus
+	cnpStatus        *crdv1alpha1.NetworkPolicyStatus
+	adminNPCondition *v1.Condition
+	banpCondition    *v1.Condition
 }
 
 func (c *fakeNetworkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1alpha1.NetworkPolicyStatus) error {
@@ -56,6 +58,32 @@ func (c *fakeNetworkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name s
 	return nil
 }
 
+func (c *fakeNetworkPolicyControl) UpdateAdminNetworkPolicyStatus(name string, condition *v1.Condition) error {
+	c.Lock()
+	defer c.Unlock()
+	c.adminNPCondition = condition
+	return nil
+}
+
+func (c *fakeNetworkPolicyControl) UpdateBaselineAdminNetworkPolicyStatus(name string, condition *v1.Condition) error {
+	c.Lock()
+	defer c.Unlock()
+	c.banpCondition = condition
+	return nil
+}
+
+func (c *fakeNetworkPolicyControl) getAdminNetworkPolicyCondition() *v1.Condition {
+	c.Lock()
+	defer c.Unlock()
+	return c.adminNPCondition
+}
+
+func (c *fakeNetworkPolicyControl) getBaselineAdminNetworkPolicyCondition() *v1.Condition {
+	c.Lock()
+	defer c.Unlock()
+	return c.banpCondition
+}
+
 func (c *fakeNetworkPolicyControl) getAntreaNetworkPolicyStatus() *crdv1alpha1.NetworkPolicyStatus {
 	c.Lock()
 	defer c.Unlock()
@@ -325,6 +353,90 @@ func TestDeleteAntreaNetworkPolicy(t *testing.T) {
 	assert.Empty(t, statusController.getNodeStatuses(initialNetworkPolicy.Name))
 }
 
+func TestSyncAdminPolicyStatus(t *testing.T) {
+	adminNPRef := &controlplane.NetworkPolicyReference{Type: controlplane.AdminNetworkPolicy, Name: "adminnp1"}
+	banpRef := &controlplane.NetworkPolicyReference{Type: controlplane.BaselineAdminNetworkPolicy, Name: "default"}
+	pendingAdminNP := newInternalNetworkPolicy("adminnp1", 1, nil, adminNPRef)
+	pendingAdminNP.SpanMeta.NodeNames = nil
+	invalidBANP := newInternalNetworkPolicy("default", 2, []string{"node1"}, banpRef)
+	invalidBANP.SyncError = fmt.Errorf("ingress rule \"r1\": sameLabels is not supported")
+	tests := []struct {
+		name                         string
+		networkPolicy                *types.NetworkPolicy
+		collectedNetworkPolicyStatus []*controlplane.NetworkPolicyStatus
+		expectedAdminNPCondition     *v1.Condition
+		expectedBANPCondition        *v1.Condition
+	}{
+		{
+			name:          "pending",
+			networkPolicy: pendingAdminNP,
+			expectedAdminNPCondition: &v1.Condition{
+				Type:               adminPolicyConditionReady,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             adminPolicyReasonPending,
+				Message:            "The policy has not been processed yet",
+			},
+		},
+		{
+			name:          "partially realized",
+			networkPolicy: newInternalNetworkPolicy("adminnp1", 2, []string{"node1", "node2"}, adminNPRef),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("adminnp1", "node1", 1),
+				newNetworkPolicyStatus("adminnp1", "node2", 2),
+			},
+			expectedAdminNPCondition: &v1.Condition{
+				Type:               adminPolicyConditionReady,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             adminPolicyReasonRealizing,
+				Message:            "The policy has been realized on 1 of 2 Nodes",
+			},
+		},
+		{
+			name:          "entirely realized",
+			networkPolicy: newInternalNetworkPolicy("default", 1, []string{"node1", "node2"}, banpRef),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("default", "node1", 1),
+				newNetworkPolicyStatus("default", "node2", 1),
+			},
+			expectedBANPCondition: &v1.Condition{
+				Type:               adminPolicyConditionReady,
+				Status:             v1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             adminPolicyReasonRealized,
+				Message:            "The policy has been realized on 2 of 2 Nodes",
+			},
+		},
+		{
+			name:          "sync error",
+			networkPolicy: invalidBANP,
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus("default", "node1", 2),
+			},
+			expectedBANPCondition: &v1.Condition{
+				Type:               adminPolicyConditionReady,
+				Status:             v1.ConditionFalse,
+				ObservedGeneration: 2,
+				Reason:             adminPolicyReasonSyncError,
+				Message:            "ingress rule \"r1\": sameLabels is not supported",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusController, _, _, networkPolicyStore, networkPolicyControl := newTestStatusController()
+			networkPolicyStore.Create(tt.networkPolicy)
+			for _, status := range tt.collectedNetworkPolicyStatus {
+				statusController.UpdateStatus(status)
+			}
+			assert.NoError(t, statusController.syncHandler(tt.networkPolicy.Name))
+			assert.Equal(t, tt.expectedAdminNPCondition, networkPolicyControl.getAdminNetworkPolicyCondition())
+			assert.Equal(t, tt.expectedBANPCondition, networkPolicyControl.getBaselineAdminNetworkPolicyCondition())
+		})
+	}
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy spans 1000 Nodes. Its current result is:
 // 70024 ns/op            8338 B/op          8 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
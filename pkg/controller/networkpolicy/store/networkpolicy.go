@@ -122,6 +122,9 @@ func ToNetworkPolicyMsg(in *types.NetworkPolicy, out *controlplane.NetworkPolicy
 	}
 	out.Priority = in.Priority
 	out.TierPriority = in.TierPriority
+	out.EnforcementMode = in.EnforcementMode
+	out.Tier = in.Tier
+	out.InactiveRules = in.InactiveRules
 }
 
 // NetworkPolicyKeyFunc knows how to get the key of a NetworkPolicy.
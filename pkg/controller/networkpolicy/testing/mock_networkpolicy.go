@@ -48,6 +48,21 @@ func (m *MockEndpointQuerier) EXPECT() *MockEndpointQuerierMockRecorder {
 	return m.recorder
 }
 
+// EvaluatePolicies mocks base method
+func (m *MockEndpointQuerier) EvaluatePolicies(arg0 *networkpolicy.PolicyEvaluationRequest) (*networkpolicy.PolicyEvaluationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluatePolicies", arg0)
+	ret0, _ := ret[0].(*networkpolicy.PolicyEvaluationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluatePolicies indicates an expected call of EvaluatePolicies
+func (mr *MockEndpointQuerierMockRecorder) EvaluatePolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluatePolicies", reflect.TypeOf((*MockEndpointQuerier)(nil).EvaluatePolicies), arg0)
+}
+
 // QueryNetworkPolicies mocks base method
 func (m *MockEndpointQuerier) QueryNetworkPolicies(arg0, arg1 string) (*networkpolicy.EndpointQueryResponse, error) {
 	m.ctrl.T.Helper()
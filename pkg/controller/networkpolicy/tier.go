@@ -39,6 +39,14 @@ var (
 	// BaselineTierPriority maintains the priority for the system generated baseline Tier.
 	// This is the tier that will be enforced after K8s NetworkPolicies.
 	BaselineTierPriority = int32(253)
+	// AdminNetworkPolicyTierPriority maintains the priority for the Tier of AdminNetworkPolicies.
+	// This is the tier that will be enforced after all the other tiers and before K8s
+	// NetworkPolicies. Unlike the system generated Tiers, no Tier CR is created for it.
+	AdminNetworkPolicyTierPriority = int32(251)
+	// BaselineAdminNetworkPolicyTierPriority maintains the priority for the Tier of
+	// BaselineAdminNetworkPolicies. This is the tier that will be enforced after K8s
+	// NetworkPolicies and the baseline Tier. No Tier CR is created for it either.
+	BaselineAdminNetworkPolicyTierPriority = int32(254)
 	// defaultTierName maintains the name of the default Tier in Antrea.
 	defaultTierName = "application"
 	// emergencyTierName maintains the name of the Emergency Tier in Antrea.
@@ -47,6 +55,10 @@ var (
 	networkOpsTierName  = "networkops"
 	platformTierName    = "platform"
 	baselineTierName    = "baseline"
+	// adminNetworkPolicyTierName and baselineAdminNetworkPolicyTierName are the names of the
+	// Tiers of AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+	adminNetworkPolicyTierName         = "adminnetworkpolicy"
+	baselineAdminNetworkPolicyTierName = "baselineadminnetworkpolicy"
 	// priorityMap maintains the Tier priority associated with system generated
 	// Tier names.
 	priorityMap = map[string]int32{
@@ -17,6 +17,7 @@ package networkpolicy
 import (
 	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -24,6 +25,7 @@ import (
 
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -36,6 +38,7 @@ import (
 	"antrea.io/antrea/pkg/controller/networkpolicy/store"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/env"
+	npav1alpha1 "antrea.io/antrea/third_party/network-policy-api/apis/v1alpha1"
 )
 
 // validator interface introduces the set of functions that must be implemented
@@ -73,7 +76,8 @@ var (
 	// reservedTierPriorities stores the reserved priority range from 251, 252, 254 and 255.
 	// The priority 250 is reserved for default Tier but not part of this set in order to be
 	// able to create the Tier by Antrea. Same for priority 253 which is reserved for the
-	// baseline tier.
+	// baseline tier. The priorities 251 and 254 are used by the Tiers of AdminNetworkPolicies
+	// and BaselineAdminNetworkPolicies respectively.
 	reservedTierPriorities = sets.NewInt32(int32(251), int32(252), int32(254), int32(255))
 	// reservedTierNames stores the set of Tier names which cannot be deleted
 	// since they are created by Antrea.
@@ -143,7 +147,8 @@ func NewNetworkPolicyValidator(networkPolicyController *NetworkPolicyController)
 	return &vr
 }
 
-// Validate function validates a ClusterGroup, Tier or Antrea Policy object
+// Validate function validates a ClusterGroup, Tier, Antrea Policy, AdminNetworkPolicy or
+// BaselineAdminNetworkPolicy object
 func (v *NetworkPolicyValidator) Validate(ar *admv1.AdmissionReview) *admv1.AdmissionResponse {
 	var result *metav1.Status
 	var msg string
@@ -217,6 +222,26 @@ func (v *NetworkPolicyValidator) Validate(ar *admv1.AdmissionReview) *admv1.Admi
 			}
 		}
 		msg, allowed = v.validateAntreaPolicy(&curANP, &oldANP, op, ui)
+	case "AdminNetworkPolicy":
+		klog.V(2).Info("Validating AdminNetworkPolicy CRD")
+		var curANP npav1alpha1.AdminNetworkPolicy
+		if curRaw != nil {
+			if err := json.Unmarshal(curRaw, &curANP); err != nil {
+				klog.Errorf("Error de-serializing current AdminNetworkPolicy")
+				return GetAdmissionResponseForErr(err)
+			}
+		}
+		msg, allowed = validateAdminNetworkPolicy(&curANP)
+	case "BaselineAdminNetworkPolicy":
+		klog.V(2).Info("Validating BaselineAdminNetworkPolicy CRD")
+		var curBANP npav1alpha1.BaselineAdminNetworkPolicy
+		if curRaw != nil {
+			if err := json.Unmarshal(curRaw, &curBANP); err != nil {
+				klog.Errorf("Error de-serializing current BaselineAdminNetworkPolicy")
+				return GetAdmissionResponseForErr(err)
+			}
+		}
+		msg, allowed = validateBaselineAdminNetworkPolicy(&curBANP)
 	}
 	if msg != "" {
 		result = &metav1.Status{
@@ -229,6 +254,48 @@ func (v *NetworkPolicyValidator) Validate(ar *admv1.AdmissionReview) *admv1.Admi
 	}
 }
 
+// validateAdminNetworkPolicy validates the admission of an AdminNetworkPolicy. The policy is
+// defined by a third-party API, so only the rule peers which cannot be translated by the
+// NetworkPolicyController, e.g. the ones using sameLabels or notSameLabels, are rejected here.
+func validateAdminNetworkPolicy(anp *npav1alpha1.AdminNetworkPolicy) (string, bool) {
+	for _, r := range anp.Spec.Ingress {
+		if reason, allowed := validateAdminPolicyRulePeers("ingress", r.Name, r.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, r := range anp.Spec.Egress {
+		if reason, allowed := validateAdminPolicyRulePeers("egress", r.Name, r.To); !allowed {
+			return reason, allowed
+		}
+	}
+	return "", true
+}
+
+// validateBaselineAdminNetworkPolicy validates the admission of a BaselineAdminNetworkPolicy in
+// the same way as validateAdminNetworkPolicy.
+func validateBaselineAdminNetworkPolicy(banp *npav1alpha1.BaselineAdminNetworkPolicy) (string, bool) {
+	for _, r := range banp.Spec.Ingress {
+		if reason, allowed := validateAdminPolicyRulePeers("ingress", r.Name, r.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, r := range banp.Spec.Egress {
+		if reason, allowed := validateAdminPolicyRulePeers("egress", r.Name, r.To); !allowed {
+			return reason, allowed
+		}
+	}
+	return "", true
+}
+
+// validateAdminPolicyRulePeers validates the peers of a rule of an AdminNetworkPolicy or a
+// BaselineAdminNetworkPolicy, using the same translation as the NetworkPolicyController.
+func validateAdminPolicyRulePeers(direction, ruleName string, peers []npav1alpha1.AdminNetworkPolicyPeer) (string, bool) {
+	if _, err := toNetworkPolicyPeersForAdminPolicy(peers); err != nil {
+		return fmt.Sprintf("%s rule %q: %v", direction, ruleName, err), false
+	}
+	return "", true
+}
+
 // validateAntreaPolicy validates the admission of a Antrea NetworkPolicy CRDs
 func (v *NetworkPolicyValidator) validateAntreaPolicy(curObj, oldObj interface{}, op admv1.Operation, userInfo authenticationv1.UserInfo) (string, bool) {
 	allowed := true
@@ -381,6 +448,7 @@ func (v *antreaPolicyValidator) createValidate(curObj interface{}, userInfo auth
 	var tier string
 	var ingress, egress []crdv1alpha1.Rule
 	var specAppliedTo []crdv1alpha1.NetworkPolicyPeer
+	var namespaced bool
 	switch curObj.(type) {
 	case *crdv1alpha1.ClusterNetworkPolicy:
 		curCNP := curObj.(*crdv1alpha1.ClusterNetworkPolicy)
@@ -394,6 +462,7 @@ func (v *antreaPolicyValidator) createValidate(curObj interface{}, userInfo auth
 		ingress = curANP.Spec.Ingress
 		egress = curANP.Spec.Egress
 		specAppliedTo = curANP.Spec.AppliedTo
+		namespaced = true
 	}
 	reason, allowed := v.validateTierForPolicy(tier)
 	if !allowed {
@@ -410,6 +479,10 @@ func (v *antreaPolicyValidator) createValidate(curObj interface{}, userInfo auth
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateNodeAppliedTo(namespaced, ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
 	reason, allowed = v.validatePeers(ingress, egress)
 	if !allowed {
 		return reason, allowed
@@ -418,6 +491,14 @@ func (v *antreaPolicyValidator) createValidate(curObj interface{}, userInfo auth
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateL7Protocols(ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateRuleSchedules(ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return err.Error(), false
 	}
@@ -472,7 +553,10 @@ func (v *antreaPolicyValidator) validateAppliedTo(ingress, egress []crdv1alpha1.
 			if eachAppliedTo.ServiceAccount != nil && appliedToFieldsNum > 1 {
 				return "serviceAccount cannot be set with other peers in appliedTo", false
 			}
-			if reason, allowed := checkSelectorsLabels(eachAppliedTo.PodSelector, eachAppliedTo.NamespaceSelector, eachAppliedTo.ExternalEntitySelector); !allowed {
+			if eachAppliedTo.NodeSelector != nil && appliedToFieldsNum > 1 {
+				return "nodeSelector cannot be set with other peers in appliedTo", false
+			}
+			if reason, allowed := checkSelectorsLabels(eachAppliedTo.PodSelector, eachAppliedTo.NamespaceSelector, eachAppliedTo.ExternalEntitySelector, eachAppliedTo.NodeSelector); !allowed {
 				return reason, allowed
 			}
 		}
@@ -499,6 +583,73 @@ func (v *antreaPolicyValidator) validateAppliedTo(ingress, egress []crdv1alpha1.
 	return "", true
 }
 
+// validateNodeAppliedTo validates the Antrea-native policies which select Nodes in appliedTo. Such
+// policies must be ClusterNetworkPolicies, cannot select workloads in appliedTo at the same time,
+// and cannot use the rule fields which are only meaningful to workloads.
+func (v *antreaPolicyValidator) validateNodeAppliedTo(namespaced bool, ingress, egress []crdv1alpha1.Rule, specAppliedTo []crdv1alpha1.NetworkPolicyPeer) (string, bool) {
+	selectNodes, selectWorkloads := false, false
+	checkAppliedTo := func(appliedTo []crdv1alpha1.NetworkPolicyPeer) {
+		for _, at := range appliedTo {
+			if at.NodeSelector != nil {
+				selectNodes = true
+			} else {
+				selectWorkloads = true
+			}
+		}
+	}
+	checkAppliedTo(specAppliedTo)
+	for _, rules := range [][]crdv1alpha1.Rule{ingress, egress} {
+		for _, r := range rules {
+			checkAppliedTo(r.AppliedTo)
+		}
+	}
+	if !selectNodes {
+		return "", true
+	}
+	if namespaced {
+		return "nodeSelector can only be set in appliedTo of ClusterNetworkPolicies", false
+	}
+	if !features.DefaultFeatureGate.Enabled(features.NodeNetworkPolicy) {
+		return "nodeSelector can only be set in appliedTo when NodeNetworkPolicy is enabled", false
+	}
+	if selectWorkloads {
+		return "appliedTo of a policy cannot select Nodes and workloads at the same time", false
+	}
+	validate := func(r crdv1alpha1.Rule, peers []crdv1alpha1.NetworkPolicyPeer) (string, bool) {
+		if len(r.ToServices) != 0 {
+			return "toServices cannot be used in policies applied to Nodes", false
+		}
+		if len(r.L7Protocols) != 0 {
+			return "layer 7 protocols cannot be used in policies applied to Nodes", false
+		}
+		for _, port := range r.Ports {
+			if port.Port != nil && port.Port.Type == intstr.String {
+				return "named ports cannot be used in policies applied to Nodes", false
+			}
+		}
+		for _, peer := range peers {
+			if peer.FQDN != "" {
+				return "fqdn cannot be used in policies applied to Nodes", false
+			}
+			if peer.Namespaces != nil && peer.Namespaces.Match == crdv1alpha1.NamespaceMatchSelf {
+				return "namespaces cannot be used in policies applied to Nodes", false
+			}
+		}
+		return "", true
+	}
+	for _, r := range ingress {
+		if reason, allowed := validate(r, r.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, r := range egress {
+		if reason, allowed := validate(r, r.To); !allowed {
+			return reason, allowed
+		}
+	}
+	return "", true
+}
+
 // validatePeers ensures that the NetworkPolicyPeer object set in rules are valid, i.e.
 // currently it ensures that a Group cannot be set with other stand-alone selectors or IPBlock.
 func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1alpha1.Rule) (string, bool) {
@@ -520,6 +671,11 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1alpha1.Rule
 			if reason, allowed := checkSelectorsLabels(peer.PodSelector, peer.NamespaceSelector, peer.ExternalEntitySelector, peer.NodeSelector); !allowed {
 				return reason, allowed
 			}
+			if peer.IPBlock != nil {
+				if reason, allowed := validateIPBlock(peer.IPBlock); !allowed {
+					return reason, allowed
+				}
+			}
 		}
 		return "", true
 	}
@@ -546,6 +702,27 @@ func (v *antreaPolicyValidator) validatePeers(ingress, egress []crdv1alpha1.Rule
 	return "", true
 }
 
+// validateIPBlock validates that the CIDR of an IPBlock is valid, and that all its except CIDRs
+// are strict subsets of it.
+func validateIPBlock(ipBlock *crdv1alpha1.IPBlock) (string, bool) {
+	_, cidr, err := net.ParseCIDR(ipBlock.CIDR)
+	if err != nil {
+		return fmt.Sprintf("invalid ipBlock cidr %s: %v", ipBlock.CIDR, err), false
+	}
+	cidrPrefixLen, cidrBits := cidr.Mask.Size()
+	for _, exc := range ipBlock.Except {
+		_, exceptCIDR, err := net.ParseCIDR(exc)
+		if err != nil {
+			return fmt.Sprintf("invalid ipBlock except %s: %v", exc, err), false
+		}
+		exceptPrefixLen, exceptBits := exceptCIDR.Mask.Size()
+		if exceptBits != cidrBits || exceptPrefixLen <= cidrPrefixLen || !cidr.Contains(exceptCIDR.IP) {
+			return fmt.Sprintf("ipBlock except %s must be a strict subset of cidr %s", exc, ipBlock.CIDR), false
+		}
+	}
+	return "", true
+}
+
 // numFieldsSetInPeer returns the number of fields in use of a peer.
 func numFieldsSetInPeer(peer crdv1alpha1.NetworkPolicyPeer) int {
 	num := 0
@@ -627,11 +804,71 @@ func (v *antreaPolicyValidator) validateFQDNSelectors(egressRules []crdv1alpha1.
 	return "", true
 }
 
+// validateL7Protocols validates the L7Protocols field set in Antrea-native policy
+// rules are valid, and compatible with the ports or protocols fields.
+func (v *antreaPolicyValidator) validateL7Protocols(ingress, egress []crdv1alpha1.Rule) (string, bool) {
+	validate := func(r crdv1alpha1.Rule) (string, bool) {
+		if len(r.L7Protocols) == 0 {
+			return "", true
+		}
+		if !features.DefaultFeatureGate.Enabled(features.L7NetworkPolicy) {
+			return "layer 7 protocols can only be used when L7NetworkPolicy is enabled", false
+		}
+		if r.Action != nil && *r.Action != crdv1alpha1.RuleActionAllow {
+			return "layer 7 protocols only support Allow", false
+		}
+		if len(r.ToServices) != 0 {
+			return "layer 7 protocols can not be used with toServices", false
+		}
+		if len(r.Protocols) != 0 {
+			return "layer 7 protocols can not be used with protocols", false
+		}
+		for _, port := range r.Ports {
+			if port.Protocol != nil && *port.Protocol != v1.ProtocolTCP {
+				return "layer 7 protocols only support TCP ports", false
+			}
+		}
+		for _, l7p := range r.L7Protocols {
+			if l7p.HTTP == nil {
+				return "layer 7 protocol must be specified", false
+			}
+		}
+		return "", true
+	}
+	for _, r := range ingress {
+		if reason, allowed := validate(r); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, r := range egress {
+		if reason, allowed := validate(r); !allowed {
+			return reason, allowed
+		}
+	}
+	return "", true
+}
+
+// validateRuleSchedules validates the schedule field set in Antrea-native policy rules is valid.
+func (v *antreaPolicyValidator) validateRuleSchedules(ingress, egress []crdv1alpha1.Rule) (string, bool) {
+	for _, rules := range [][]crdv1alpha1.Rule{ingress, egress} {
+		for _, r := range rules {
+			if r.Schedule == nil {
+				continue
+			}
+			if _, err := parseRuleSchedule(r.Schedule); err != nil {
+				return fmt.Sprintf("invalid schedule of rule %s: %v", r.Name, err), false
+			}
+		}
+	}
+	return "", true
+}
+
 // updateValidate validates the UPDATE events of Antrea-native policies.
 func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) (string, bool) {
 	var tier string
 	var ingress, egress []crdv1alpha1.Rule
 	var specAppliedTo []crdv1alpha1.NetworkPolicyPeer
+	var namespaced bool
 	switch curObj.(type) {
 	case *crdv1alpha1.ClusterNetworkPolicy:
 		curCNP := curObj.(*crdv1alpha1.ClusterNetworkPolicy)
@@ -645,6 +882,7 @@ func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userI
 		ingress = curANP.Spec.Ingress
 		egress = curANP.Spec.Egress
 		specAppliedTo = curANP.Spec.AppliedTo
+		namespaced = true
 	}
 	reason, allowed := v.validateAppliedTo(ingress, egress, specAppliedTo)
 	if !allowed {
@@ -653,6 +891,10 @@ func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userI
 	if ruleNameUnique := v.validateRuleName(ingress, egress); !ruleNameUnique {
 		return "rules names must be unique within the policy", false
 	}
+	reason, allowed = v.validateNodeAppliedTo(namespaced, ingress, egress, specAppliedTo)
+	if !allowed {
+		return reason, allowed
+	}
 	reason, allowed = v.validatePeers(ingress, egress)
 	if !allowed {
 		return reason, allowed
@@ -661,6 +903,14 @@ func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userI
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateL7Protocols(ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateRuleSchedules(ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return err.Error(), false
 	}
@@ -15,15 +15,22 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 
 	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	"antrea.io/antrea/pkg/features"
+	npav1alpha1 "antrea.io/antrea/third_party/network-policy-api/apis/v1alpha1"
 )
 
 func TestValidateAntreaPolicy(t *testing.T) {
@@ -457,6 +464,99 @@ func TestValidateAntreaPolicy(t *testing.T) {
 			},
 			expectedReason: "group cannot be set with other peers in rules",
 		},
+		{
+			name: "acnp-rule-ipblock-with-except",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-ipblock-with-except",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1alpha1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1alpha1.IPBlock{
+										CIDR:   "10.0.0.0/24",
+										Except: []string{"10.0.0.0/28", "10.0.0.128/25"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-ipblock-except-not-in-cidr",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-ipblock-except-not-in-cidr",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1alpha1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1alpha1.IPBlock{
+										CIDR:   "10.0.0.0/24",
+										Except: []string{"10.0.1.0/28"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "ipBlock except 10.0.1.0/28 must be a strict subset of cidr 10.0.0.0/24",
+		},
+		{
+			name: "acnp-rule-ipblock-except-equal-to-cidr",
+			policy: &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-rule-ipblock-except-equal-to-cidr",
+				},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Action: &allowAction,
+							From: []crdv1alpha1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1alpha1.IPBlock{
+										CIDR:   "10.0.0.0/24",
+										Except: []string{"10.0.0.0/24"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: "ipBlock except 10.0.0.0/24 must be a strict subset of cidr 10.0.0.0/24",
+		},
 		{
 			name: "acnp-rule-group-set-with-nssel",
 			policy: &crdv1alpha1.ClusterNetworkPolicy{
@@ -1069,6 +1169,310 @@ func TestValidateAntreaPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateAntreaPolicyL7Protocols(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	dropAction := crdv1alpha1.RuleActionDrop
+	tcp := v1.ProtocolTCP
+	udp := v1.ProtocolUDP
+	int80 := intstr.FromInt(80)
+	httpProtocols := []crdv1alpha1.L7Protocol{{HTTP: &crdv1alpha1.HTTPProtocol{Method: "GET", Path: "/api/*"}}}
+
+	tests := []struct {
+		name           string
+		l7Enabled      bool
+		rule           crdv1alpha1.Rule
+		expectedReason string
+	}{
+		{
+			name:      "http-allow",
+			l7Enabled: true,
+			rule: crdv1alpha1.Rule{
+				Action:      &allowAction,
+				Ports:       []crdv1alpha1.NetworkPolicyPort{{Protocol: &tcp, Port: &int80}},
+				L7Protocols: httpProtocols,
+			},
+		},
+		{
+			name:      "feature-disabled",
+			l7Enabled: false,
+			rule: crdv1alpha1.Rule{
+				Action:      &allowAction,
+				L7Protocols: httpProtocols,
+			},
+			expectedReason: "layer 7 protocols can only be used when L7NetworkPolicy is enabled",
+		},
+		{
+			name:      "drop-action",
+			l7Enabled: true,
+			rule: crdv1alpha1.Rule{
+				Action:      &dropAction,
+				L7Protocols: httpProtocols,
+			},
+			expectedReason: "layer 7 protocols only support Allow",
+		},
+		{
+			name:      "udp-port",
+			l7Enabled: true,
+			rule: crdv1alpha1.Rule{
+				Action:      &allowAction,
+				Ports:       []crdv1alpha1.NetworkPolicyPort{{Protocol: &udp, Port: &int80}},
+				L7Protocols: httpProtocols,
+			},
+			expectedReason: "layer 7 protocols only support TCP ports",
+		},
+		{
+			name:      "icmp-protocol",
+			l7Enabled: true,
+			rule: crdv1alpha1.Rule{
+				Action:      &allowAction,
+				Protocols:   []crdv1alpha1.NetworkPolicyProtocol{{ICMP: &crdv1alpha1.ICMPProtocol{}}},
+				L7Protocols: httpProtocols,
+			},
+			expectedReason: "layer 7 protocols can not be used with protocols",
+		},
+		{
+			name:      "to-services",
+			l7Enabled: true,
+			rule: crdv1alpha1.Rule{
+				Action:      &allowAction,
+				ToServices:  []crdv1alpha1.NamespacedName{{Name: "foo", Namespace: "bar"}},
+				L7Protocols: httpProtocols,
+			},
+			expectedReason: "layer 7 protocols can not be used with toServices",
+		},
+		{
+			name:      "empty-protocol",
+			l7Enabled: true,
+			rule: crdv1alpha1.Rule{
+				Action:      &allowAction,
+				L7Protocols: []crdv1alpha1.L7Protocol{{}},
+			},
+			expectedReason: "layer 7 protocol must be specified",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.L7NetworkPolicy, tt.l7Enabled)()
+			policy := &crdv1alpha1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "acnp-l7"},
+				Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+					},
+					Egress: []crdv1alpha1.Rule{tt.rule},
+				},
+			}
+			_, c := newController()
+			v := NewNetworkPolicyValidator(c.NetworkPolicyController)
+			actualReason, allowed := v.validateAntreaPolicy(policy, nil, admv1.Create, authenticationv1.UserInfo{})
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
+
+func TestValidateAntreaPolicyRuleSchedules(t *testing.T) {
+	tests := []struct {
+		name           string
+		schedule       *crdv1alpha1.RuleSchedule
+		expectedReason string
+	}{
+		{
+			name: "valid",
+			schedule: &crdv1alpha1.RuleSchedule{
+				TimeZone: "Europe/Paris",
+				Windows: []crdv1alpha1.TimeWindow{
+					{Start: "09:00", End: "17:30", DaysOfWeek: []string{"Monday", "friday"}},
+					{Start: "22:00", End: "06:00"},
+				},
+			},
+		},
+		{
+			name:           "no-window",
+			schedule:       &crdv1alpha1.RuleSchedule{},
+			expectedReason: "invalid schedule of rule rule1: at least one time window must be set",
+		},
+		{
+			name: "invalid-time-zone",
+			schedule: &crdv1alpha1.RuleSchedule{
+				TimeZone: "Mars/Olympus_Mons",
+				Windows:  []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00"}},
+			},
+			expectedReason: "invalid schedule of rule rule1: invalid time zone \"Mars/Olympus_Mons\": unknown time zone Mars/Olympus_Mons",
+		},
+		{
+			name: "invalid-time",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "9am", End: "17:00"}},
+			},
+			expectedReason: "invalid schedule of rule rule1: invalid time \"9am\", it must be in the format of HH:MM",
+		},
+		{
+			name: "out-of-range-time",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "24:00"}},
+			},
+			expectedReason: "invalid schedule of rule rule1: invalid time \"24:00\", it must be in the format of HH:MM",
+		},
+		{
+			name: "invalid-day",
+			schedule: &crdv1alpha1.RuleSchedule{
+				Windows: []crdv1alpha1.TimeWindow{{Start: "09:00", End: "17:00", DaysOfWeek: []string{"Mon"}}},
+			},
+			expectedReason: "invalid schedule of rule rule1: invalid day of week \"Mon\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowAction := crdv1alpha1.RuleActionAllow
+			policy := &crdv1alpha1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "x", Name: "anp-schedule"},
+				Spec: crdv1alpha1.NetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{
+						{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+					},
+					Ingress: []crdv1alpha1.Rule{
+						{
+							Name:     "rule1",
+							Action:   &allowAction,
+							Schedule: tt.schedule,
+						},
+					},
+				},
+			}
+			_, c := newController()
+			v := NewNetworkPolicyValidator(c.NetworkPolicyController)
+			actualReason, allowed := v.validateAntreaPolicy(policy, nil, admv1.Create, authenticationv1.UserInfo{})
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
+
+func TestValidateAntreaPolicyNodeAppliedTo(t *testing.T) {
+	allowAction := crdv1alpha1.RuleActionAllow
+	tcp := v1.ProtocolTCP
+	int22 := intstr.FromInt(22)
+	portSSH := intstr.FromString("ssh")
+	nodeSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}
+	podSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}
+	ipBlock := &crdv1alpha1.IPBlock{CIDR: "10.0.0.0/8"}
+
+	newACNP := func(appliedTo []crdv1alpha1.NetworkPolicyPeer, ingress, egress []crdv1alpha1.Rule) *crdv1alpha1.ClusterNetworkPolicy {
+		return &crdv1alpha1.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "acnp-node"},
+			Spec: crdv1alpha1.ClusterNetworkPolicySpec{
+				AppliedTo: appliedTo,
+				Ingress:   ingress,
+				Egress:    egress,
+			},
+		}
+	}
+	sshRule := crdv1alpha1.Rule{
+		Action: &allowAction,
+		From:   []crdv1alpha1.NetworkPolicyPeer{{IPBlock: ipBlock}},
+		Ports:  []crdv1alpha1.NetworkPolicyPort{{Protocol: &tcp, Port: &int22}},
+	}
+
+	tests := []struct {
+		name              string
+		nodePolicyEnabled bool
+		policy            interface{}
+		expectedReason    string
+	}{
+		{
+			name:              "valid",
+			nodePolicyEnabled: true,
+			policy:            newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}}, []crdv1alpha1.Rule{sshRule}, nil),
+		},
+		{
+			name:              "valid-rule-appliedTo",
+			nodePolicyEnabled: true,
+			policy: newACNP(nil, nil, []crdv1alpha1.Rule{{
+				Action:    &allowAction,
+				AppliedTo: []crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}},
+				To:        []crdv1alpha1.NetworkPolicyPeer{{PodSelector: podSelector}},
+			}}),
+		},
+		{
+			name:              "feature-disabled",
+			nodePolicyEnabled: false,
+			policy:            newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}}, []crdv1alpha1.Rule{sshRule}, nil),
+			expectedReason:    "nodeSelector can only be set in appliedTo when NodeNetworkPolicy is enabled",
+		},
+		{
+			name:              "namespaced-policy",
+			nodePolicyEnabled: true,
+			policy: &crdv1alpha1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "x", Name: "anp-node"},
+				Spec: crdv1alpha1.NetworkPolicySpec{
+					AppliedTo: []crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}},
+					Ingress:   []crdv1alpha1.Rule{sshRule},
+				},
+			},
+			expectedReason: "nodeSelector can only be set in appliedTo of ClusterNetworkPolicies",
+		},
+		{
+			name:              "node-and-pod-selectors-in-same-peer",
+			nodePolicyEnabled: true,
+			policy:            newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector, PodSelector: podSelector}}, []crdv1alpha1.Rule{sshRule}, nil),
+			expectedReason:    "nodeSelector cannot be set with other peers in appliedTo",
+		},
+		{
+			name:              "nodes-and-pods",
+			nodePolicyEnabled: true,
+			policy:            newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}, {PodSelector: podSelector}}, []crdv1alpha1.Rule{sshRule}, nil),
+			expectedReason:    "appliedTo of a policy cannot select Nodes and workloads at the same time",
+		},
+		{
+			name:              "named-port",
+			nodePolicyEnabled: true,
+			policy: newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}}, []crdv1alpha1.Rule{{
+				Action: &allowAction,
+				Ports:  []crdv1alpha1.NetworkPolicyPort{{Protocol: &tcp, Port: &portSSH}},
+			}}, nil),
+			expectedReason: "named ports cannot be used in policies applied to Nodes",
+		},
+		{
+			name:              "to-services",
+			nodePolicyEnabled: true,
+			policy: newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}}, nil, []crdv1alpha1.Rule{{
+				Action:     &allowAction,
+				ToServices: []crdv1alpha1.NamespacedName{{Name: "foo", Namespace: "bar"}},
+			}}),
+			expectedReason: "toServices cannot be used in policies applied to Nodes",
+		},
+		{
+			name:              "fqdn",
+			nodePolicyEnabled: true,
+			policy: newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}}, nil, []crdv1alpha1.Rule{{
+				Action: &allowAction,
+				To:     []crdv1alpha1.NetworkPolicyPeer{{FQDN: "www.example.com"}},
+			}}),
+			expectedReason: "fqdn cannot be used in policies applied to Nodes",
+		},
+		{
+			name:              "per-namespace-rule",
+			nodePolicyEnabled: true,
+			policy: newACNP([]crdv1alpha1.NetworkPolicyPeer{{NodeSelector: nodeSelector}}, []crdv1alpha1.Rule{{
+				Action: &allowAction,
+				From:   []crdv1alpha1.NetworkPolicyPeer{{Namespaces: &crdv1alpha1.PeerNamespaces{Match: crdv1alpha1.NamespaceMatchSelf}}},
+			}}, nil),
+			expectedReason: "namespaces cannot be used in policies applied to Nodes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NodeNetworkPolicy, tt.nodePolicyEnabled)()
+			_, c := newController()
+			v := NewNetworkPolicyValidator(c.NetworkPolicyController)
+			actualReason, allowed := v.validateAntreaPolicy(tt.policy, nil, admv1.Create, authenticationv1.UserInfo{})
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
+
 func TestValidateClusterAntreaGroup(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1118,3 +1522,98 @@ func TestValidateClusterAntreaGroup(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAdminPolicy(t *testing.T) {
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	tests := []struct {
+		name           string
+		kind           string
+		policy         interface{}
+		expectedReason string
+	}{
+		{
+			name: "anp-valid",
+			kind: "AdminNetworkPolicy",
+			policy: &npav1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anp-valid"},
+				Spec: npav1alpha1.AdminNetworkPolicySpec{
+					Subject: npav1alpha1.AdminNetworkPolicySubject{Namespaces: &selectorA},
+					Ingress: []npav1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "allow-from-nsA",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &npav1alpha1.NamespacedPeer{NamespaceSelector: &selectorA}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "anp-same-labels",
+			kind: "AdminNetworkPolicy",
+			policy: &npav1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anp-same-labels"},
+				Spec: npav1alpha1.AdminNetworkPolicySpec{
+					Subject: npav1alpha1.AdminNetworkPolicySubject{Namespaces: &selectorA},
+					Ingress: []npav1alpha1.AdminNetworkPolicyIngressRule{
+						{
+							Name:   "allow-from-same-tenant",
+							Action: npav1alpha1.AdminNetworkPolicyRuleActionAllow,
+							From: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Namespaces: &npav1alpha1.NamespacedPeer{SameLabels: []string{"tenant"}}},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: `ingress rule "allow-from-same-tenant": sameLabels and notSameLabels are not supported`,
+		},
+		{
+			name: "banp-not-same-labels",
+			kind: "BaselineAdminNetworkPolicy",
+			policy: &npav1alpha1.BaselineAdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: npav1alpha1.BaselineAdminNetworkPolicySpec{
+					Subject: npav1alpha1.AdminNetworkPolicySubject{Namespaces: &selectorA},
+					Egress: []npav1alpha1.BaselineAdminNetworkPolicyEgressRule{
+						{
+							Name:   "deny-to-other-tenants",
+							Action: npav1alpha1.BaselineAdminNetworkPolicyRuleActionDeny,
+							To: []npav1alpha1.AdminNetworkPolicyPeer{
+								{Pods: &npav1alpha1.NamespacedPodPeer{
+									Namespaces:  npav1alpha1.NamespacedPeer{NotSameLabels: []string{"tenant"}},
+									PodSelector: selectorA,
+								}},
+							},
+						},
+					},
+				},
+			},
+			expectedReason: `egress rule "deny-to-other-tenants": sameLabels and notSameLabels are not supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController()
+			v := NewNetworkPolicyValidator(c.NetworkPolicyController)
+			raw, err := json.Marshal(tt.policy)
+			assert.NoError(t, err)
+			ar := &admv1.AdmissionReview{
+				Request: &admv1.AdmissionRequest{
+					Kind:      metav1.GroupVersionKind{Group: "policy.networking.k8s.io", Version: "v1alpha1", Kind: tt.kind},
+					Operation: admv1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			}
+			response := v.Validate(ar)
+			assert.Equal(t, tt.expectedReason == "", response.Allowed)
+			if tt.expectedReason == "" {
+				assert.Nil(t, response.Result)
+			} else {
+				assert.Equal(t, tt.expectedReason, response.Result.Message)
+			}
+		})
+	}
+}
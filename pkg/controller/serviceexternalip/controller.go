@@ -24,7 +24,6 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -182,13 +181,9 @@ func (c *ServiceExternalIPController) restoreIPAllocations(services []*corev1.Se
 		}
 		ip := net.ParseIP(svc.Status.LoadBalancer.Ingress[0].IP)
 		allocation := externalippool.IPAllocation{
-			ObjectReference: v1.ObjectReference{
-				Name:      svc.Name,
-				Namespace: svc.Namespace,
-				Kind:      svc.Kind,
-			},
-			IPPoolName: ipPool,
-			IP:         ip,
+			ObjectReference: newIPOwner(svc),
+			IPPoolName:      ipPool,
+			IP:              ip,
 		}
 		previousIPAllocations = append(previousIPAllocations, allocation)
 	}
@@ -276,6 +271,11 @@ func getServiceExternalIP(service *corev1.Service) string {
 	return service.Status.LoadBalancer.Ingress[0].IP
 }
 
+// newIPOwner returns the reference to the Service which is used as the owner of its external IP in ExternalIPPools.
+func newIPOwner(service *corev1.Service) corev1.ObjectReference {
+	return corev1.ObjectReference{Kind: "Service", Namespace: service.Namespace, Name: service.Name}
+}
+
 func (c *ServiceExternalIPController) syncService(key apimachinerytypes.NamespacedName) error {
 	startTime := time.Now()
 	defer func() {
@@ -348,10 +348,10 @@ func (c *ServiceExternalIPController) syncService(key apimachinerytypes.Namespac
 			klog.ErrorS(nil, "IP pool does not contain required external IP", "ipPool", currentIPPool, "ip", newExternalIP)
 			return nil
 		}
-		err = c.externalIPAllocator.UpdateIPAllocation(currentIPPool, newExternalIP)
+		err = c.externalIPAllocator.UpdateIPAllocation(currentIPPool, newExternalIP, newIPOwner(service))
 	} else {
 		// Allocate IP from existing ExternalIPPool.
-		newExternalIP, err = c.externalIPAllocator.AllocateIPFromPool(currentIPPool)
+		newExternalIP, err = c.externalIPAllocator.AllocateIPFromPool(currentIPPool, newIPOwner(service))
 		allocated = true
 	}
 	if err != nil {
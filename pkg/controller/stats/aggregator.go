@@ -21,14 +21,18 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/informers"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
 	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
+	crdv1a2informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha2"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -43,6 +47,9 @@ const (
 // - pkg/apiserver/registry/stats/networkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
+// - pkg/apiserver/registry/stats/egressstats.statsProvider
+// - pkg/apiserver/registry/stats/adminnetworkpolicystats.statsProvider
+// - pkg/apiserver/registry/stats/baselineadminnetworkpolicystats.statsProvider
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -50,6 +57,12 @@ type Aggregator struct {
 	antreaClusterNetworkPolicyStats cache.Indexer
 	// antreaNetworkPolicyStats caches the statistics of Antrea NetworkPolicies collected from the antrea-agents.
 	antreaNetworkPolicyStats cache.Indexer
+	// egressStats caches the statistics of Egresses collected from the antrea-agents.
+	egressStats cache.Indexer
+	// adminNetworkPolicyStats caches the statistics of AdminNetworkPolicies collected from the antrea-agents.
+	adminNetworkPolicyStats cache.Indexer
+	// baselineAdminNetworkPolicyStats caches the statistics of BaselineAdminNetworkPolicies collected from the antrea-agents.
+	baselineAdminNetworkPolicyStats cache.Indexer
 	// dataCh is the channel that buffers the NodeSummaries sent by antrea-agents.
 	dataCh chan *controlplane.NodeStatsSummary
 	// npListerSynced is a function which returns true if the K8s NetworkPolicy shared informer has been synced at least once.
@@ -58,6 +71,12 @@ type Aggregator struct {
 	cnpListerSynced cache.InformerSynced
 	// anpListerSynced is a function which returns true if the Antrea NetworkPolicy shared informer has been synced at least once.
 	anpListerSynced cache.InformerSynced
+	// egressListerSynced is a function which returns true if the Egress shared informer has been synced at least once.
+	egressListerSynced cache.InformerSynced
+	// adminNPListerSynced is a function which returns true if the AdminNetworkPolicy shared informer has been synced at least once.
+	adminNPListerSynced cache.InformerSynced
+	// banpListerSynced is a function which returns true if the BaselineAdminNetworkPolicy shared informer has been synced at least once.
+	banpListerSynced cache.InformerSynced
 }
 
 // uidIndexFunc is an index function that indexes based on an object's UID.
@@ -69,7 +88,12 @@ func uidIndexFunc(obj interface{}) ([]string, error) {
 	return []string{string(meta.GetUID())}, nil
 }
 
-func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, cnpInformer crdinformers.ClusterNetworkPolicyInformer, anpInformer crdinformers.NetworkPolicyInformer) *Aggregator {
+func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer,
+	cnpInformer crdinformers.ClusterNetworkPolicyInformer,
+	anpInformer crdinformers.NetworkPolicyInformer,
+	egressInformer crdv1a2informers.EgressInformer,
+	adminNPInformer informers.GenericInformer,
+	banpInformer informers.GenericInformer) *Aggregator {
 	aggregator := &Aggregator{
 		networkPolicyStats: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
 		dataCh:             make(chan *controlplane.NodeStatsSummary, 1000),
@@ -111,6 +135,47 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 			// Set resyncPeriod to 0 to disable resyncing.
 			0,
 		)
+
+		// The dynamic informers of AdminNetworkPolicies and BaselineAdminNetworkPolicies are only created if the
+		// feature is enabled.
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			aggregator.adminNetworkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
+			aggregator.adminNPListerSynced = adminNPInformer.Informer().HasSynced
+			adminNPInformer.Informer().AddEventHandlerWithResyncPeriod(
+				cache.ResourceEventHandlerFuncs{
+					AddFunc:    aggregator.addAdminNP,
+					DeleteFunc: aggregator.deleteAdminNP,
+				},
+				// Set resyncPeriod to 0 to disable resyncing.
+				0,
+			)
+
+			aggregator.baselineAdminNetworkPolicyStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
+			aggregator.banpListerSynced = banpInformer.Informer().HasSynced
+			banpInformer.Informer().AddEventHandlerWithResyncPeriod(
+				cache.ResourceEventHandlerFuncs{
+					AddFunc:    aggregator.addBANP,
+					DeleteFunc: aggregator.deleteBANP,
+				},
+				// Set resyncPeriod to 0 to disable resyncing.
+				0,
+			)
+		}
+	}
+	// Register Informer and add handlers for Egress events only if the feature is enabled.
+	// They are the source of truth of the EgressStats, i.e., an EgressStats is present only if the corresponding
+	// Egress is present.
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		aggregator.egressStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: uidIndexFunc})
+		aggregator.egressListerSynced = egressInformer.Informer().HasSynced
+		egressInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    aggregator.addEgress,
+				DeleteFunc: aggregator.deleteEgress,
+			},
+			// Set resyncPeriod to 0 to disable resyncing.
+			0,
+		)
 	}
 	return aggregator
 }
@@ -236,6 +301,125 @@ func (a *Aggregator) deleteANP(obj interface{}) {
 	a.antreaNetworkPolicyStats.Delete(stats)
 }
 
+// addEgress handles Egress ADD events and creates corresponding EgressStats objects.
+func (a *Aggregator) addEgress(obj interface{}) {
+	egress := obj.(*crdv1alpha2.Egress)
+	stats := &statsv1alpha1.EgressStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: egress.Name,
+			UID:  egress.UID,
+			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
+			// start, instead of the CreationTimestamp of the Egress.
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+	}
+	a.egressStats.Add(stats)
+}
+
+// deleteEgress handles Egress DELETE events and deletes corresponding EgressStats objects.
+func (a *Aggregator) deleteEgress(obj interface{}) {
+	egress, ok := obj.(*crdv1alpha2.Egress)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Egress, invalid type: %v", obj)
+			return
+		}
+		egress, ok = tombstone.Obj.(*crdv1alpha2.Egress)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Egress, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.EgressStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: egress.Name,
+			UID:  egress.UID,
+		},
+	}
+	a.egressStats.Delete(stats)
+}
+
+// addAdminNP handles AdminNetworkPolicy ADD events and creates corresponding AdminNetworkPolicyStats objects.
+func (a *Aggregator) addAdminNP(obj interface{}) {
+	anp := obj.(*unstructured.Unstructured)
+	stats := &statsv1alpha1.AdminNetworkPolicyStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: anp.GetName(),
+			UID:  anp.GetUID(),
+			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
+			// start, instead of the CreationTimestamp of the AdminNetworkPolicy.
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+	}
+	a.adminNetworkPolicyStats.Add(stats)
+}
+
+// deleteAdminNP handles AdminNetworkPolicy DELETE events and deletes corresponding AdminNetworkPolicyStats objects.
+func (a *Aggregator) deleteAdminNP(obj interface{}) {
+	anp, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting AdminNetworkPolicy, invalid type: %v", obj)
+			return
+		}
+		anp, ok = tombstone.Obj.(*unstructured.Unstructured)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting AdminNetworkPolicy, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.AdminNetworkPolicyStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: anp.GetName(),
+			UID:  anp.GetUID(),
+		},
+	}
+	a.adminNetworkPolicyStats.Delete(stats)
+}
+
+// addBANP handles BaselineAdminNetworkPolicy ADD events and creates corresponding BaselineAdminNetworkPolicyStats
+// objects.
+func (a *Aggregator) addBANP(obj interface{}) {
+	banp := obj.(*unstructured.Unstructured)
+	stats := &statsv1alpha1.BaselineAdminNetworkPolicyStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: banp.GetName(),
+			UID:  banp.GetUID(),
+			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
+			// start, instead of the CreationTimestamp of the BaselineAdminNetworkPolicy.
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+	}
+	a.baselineAdminNetworkPolicyStats.Add(stats)
+}
+
+// deleteBANP handles BaselineAdminNetworkPolicy DELETE events and deletes corresponding
+// BaselineAdminNetworkPolicyStats objects.
+func (a *Aggregator) deleteBANP(obj interface{}) {
+	banp, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting BaselineAdminNetworkPolicy, invalid type: %v", obj)
+			return
+		}
+		banp, ok = tombstone.Obj.(*unstructured.Unstructured)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting BaselineAdminNetworkPolicy, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.BaselineAdminNetworkPolicyStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: banp.GetName(),
+			UID:  banp.GetUID(),
+		},
+	}
+	a.baselineAdminNetworkPolicyStats.Delete(stats)
+}
+
 func (a *Aggregator) ListAntreaClusterNetworkPolicyStats() []statsv1alpha1.AntreaClusterNetworkPolicyStats {
 	objs := a.antreaClusterNetworkPolicyStats.List()
 	stats := make([]statsv1alpha1.AntreaClusterNetworkPolicyStats, len(objs))
@@ -299,6 +483,57 @@ func (a *Aggregator) GetNetworkPolicyStats(namespace, name string) (*statsv1alph
 	return obj.(*statsv1alpha1.NetworkPolicyStats), true
 }
 
+func (a *Aggregator) ListEgressStats() []statsv1alpha1.EgressStats {
+	objs := a.egressStats.List()
+	stats := make([]statsv1alpha1.EgressStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.EgressStats))
+	}
+	return stats
+}
+
+func (a *Aggregator) GetEgressStats(name string) (*statsv1alpha1.EgressStats, bool) {
+	obj, exists, _ := a.egressStats.GetByKey(name)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*statsv1alpha1.EgressStats), true
+}
+
+func (a *Aggregator) ListAdminNetworkPolicyStats() []statsv1alpha1.AdminNetworkPolicyStats {
+	objs := a.adminNetworkPolicyStats.List()
+	stats := make([]statsv1alpha1.AdminNetworkPolicyStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.AdminNetworkPolicyStats))
+	}
+	return stats
+}
+
+func (a *Aggregator) GetAdminNetworkPolicyStats(name string) (*statsv1alpha1.AdminNetworkPolicyStats, bool) {
+	obj, exists, _ := a.adminNetworkPolicyStats.GetByKey(name)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*statsv1alpha1.AdminNetworkPolicyStats), true
+}
+
+func (a *Aggregator) ListBaselineAdminNetworkPolicyStats() []statsv1alpha1.BaselineAdminNetworkPolicyStats {
+	objs := a.baselineAdminNetworkPolicyStats.List()
+	stats := make([]statsv1alpha1.BaselineAdminNetworkPolicyStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.BaselineAdminNetworkPolicyStats))
+	}
+	return stats
+}
+
+func (a *Aggregator) GetBaselineAdminNetworkPolicyStats(name string) (*statsv1alpha1.BaselineAdminNetworkPolicyStats, bool) {
+	obj, exists, _ := a.baselineAdminNetworkPolicyStats.GetByKey(name)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*statsv1alpha1.BaselineAdminNetworkPolicyStats), true
+}
+
 // Collect collects the node summary asynchronously to avoid the competition for the statsLock and to save clients
 // from pending on it.
 func (a *Aggregator) Collect(summary *controlplane.NodeStatsSummary) {
@@ -314,6 +549,12 @@ func (a *Aggregator) Run(stopCh <-chan struct{}) {
 	cacheSyncs := []cache.InformerSynced{a.npListerSynced}
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, a.cnpListerSynced, a.anpListerSynced)
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			cacheSyncs = append(cacheSyncs, a.adminNPListerSynced, a.banpListerSynced)
+		}
+	}
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		cacheSyncs = append(cacheSyncs, a.egressListerSynced)
 	}
 	if !cache.WaitForNamedCacheSync("stats aggregator", stopCh, cacheSyncs...) {
 		return
@@ -373,6 +614,42 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 				a.antreaNetworkPolicyStats.Update(curStats)
 			}
 		}
+
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			for _, stats := range summary.AdminNetworkPolicies {
+				// The policy might have been removed, skip processing it if missing.
+				objs, _ := a.adminNetworkPolicyStats.ByIndex(uidIndex, string(stats.NetworkPolicy.UID))
+				if len(objs) > 0 {
+					// The object returned by cache is supposed to be read only, create a new object and update it.
+					curStats := objs[0].(*statsv1alpha1.AdminNetworkPolicyStats).DeepCopy()
+					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
+					a.adminNetworkPolicyStats.Update(curStats)
+				}
+			}
+
+			for _, stats := range summary.BaselineAdminNetworkPolicies {
+				// The policy might have been removed, skip processing it if missing.
+				objs, _ := a.baselineAdminNetworkPolicyStats.ByIndex(uidIndex, string(stats.NetworkPolicy.UID))
+				if len(objs) > 0 {
+					// The object returned by cache is supposed to be read only, create a new object and update it.
+					curStats := objs[0].(*statsv1alpha1.BaselineAdminNetworkPolicyStats).DeepCopy()
+					addRulesUp(&curStats.RuleTrafficStats, &curStats.TrafficStats, stats.RuleTrafficStats)
+					a.baselineAdminNetworkPolicyStats.Update(curStats)
+				}
+			}
+		}
+	}
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		for _, stats := range summary.Egresses {
+			// The Egress might have been removed, skip processing it if missing.
+			objs, _ := a.egressStats.ByIndex(uidIndex, string(stats.UID))
+			if len(objs) > 0 {
+				// The object returned by cache is supposed to be read only, create a new object and update it.
+				curStats := objs[0].(*statsv1alpha1.EgressStats).DeepCopy()
+				addUp(&curStats.TrafficStats, &stats.TrafficStats)
+				a.egressStats.Update(curStats)
+			}
+		}
 	}
 }
 
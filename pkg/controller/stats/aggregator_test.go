@@ -23,18 +23,25 @@ import (
 	"github.com/stretchr/testify/require"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
 	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
 	"antrea.io/antrea/pkg/features"
+	npav1alpha1 "antrea.io/antrea/third_party/network-policy-api/apis/v1alpha1"
 )
 
 var (
@@ -56,6 +63,9 @@ var (
 	anp2 = &crdv1alpha1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "baz", UID: "uid6"},
 	}
+	egress1 = &crdv1alpha2.Egress{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", UID: "uid7"},
+	}
 )
 
 // runWrapper wraps the Run method of the Aggregator and is used to avoid race conditions in tests.
@@ -69,7 +79,7 @@ func runWrapper(t *testing.T, a *Aggregator, policyCount int, summaries []*contr
 	stopCh := make(chan struct{})
 	doneCh := make(chan struct{})
 	err := wait.PollImmediate(100*time.Millisecond, time.Second, func() (done bool, err error) {
-		count := len(a.ListNetworkPolicyStats("")) + len(a.ListAntreaNetworkPolicyStats("")) + len(a.ListAntreaClusterNetworkPolicyStats())
+		count := len(a.ListNetworkPolicyStats("")) + len(a.ListAntreaNetworkPolicyStats("")) + len(a.ListAntreaClusterNetworkPolicyStats()) + len(a.ListEgressStats())
 		return (count >= policyCount), nil
 	})
 	require.NoError(t, err, "Timeout while waiting for Add events to be processed by Aggregator")
@@ -522,7 +532,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 			informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 			crdClient := fakeversioned.NewSimpleClientset(append(tt.existingAntreaClusterNetworkPolicies, tt.existingAntreaNetworkPolicies...)...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies())
+			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha2().Egresses(), nil, nil)
 			informerFactory.Start(stopCh)
 			crdInformerFactory.Start(stopCh)
 			expectedPolicyCount := len(tt.expectedNetworkPolicyStats) + len(tt.expectedAntreaClusterNetworkPolicyStats) + len(tt.expectedAntreaNetworkPolicyStats)
@@ -561,7 +571,7 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 	crdClient := fakeversioned.NewSimpleClientset(cnp1, anp1)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies())
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha2().Egresses(), nil, nil)
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
 
@@ -627,3 +637,155 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestAggregatorCollectEgressStats(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset(egress1)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha2().Egresses(), nil, nil)
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+
+	summaries := []*controlplane.NodeStatsSummary{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Egresses: []controlplane.EgressStats{
+				{
+					Name:         egress1.Name,
+					UID:          egress1.UID,
+					TrafficStats: statsv1alpha1.TrafficStats{Bytes: 100, Packets: 2},
+				},
+				{
+					// The Egress has been removed, its stats should be ignored.
+					Name:         "baz",
+					UID:          "uid8",
+					TrafficStats: statsv1alpha1.TrafficStats{Bytes: 50, Packets: 1},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			Egresses: []controlplane.EgressStats{
+				{
+					Name:         egress1.Name,
+					UID:          egress1.UID,
+					TrafficStats: statsv1alpha1.TrafficStats{Bytes: 300, Packets: 5},
+				},
+			},
+		},
+	}
+	runWrapper(t, a, 1, summaries)
+
+	require.Equal(t, 1, len(a.ListEgressStats()))
+	actualStats, exists := a.GetEgressStats(egress1.Name)
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 400, Packets: 7}, actualStats.TrafficStats)
+
+	crdClient.CrdV1alpha2().Egresses().Delete(context.TODO(), egress1.Name, metav1.DeleteOptions{})
+	// Event handlers are asynchronous, it's supposed to finish very soon.
+	err := wait.PollImmediate(100*time.Millisecond, time.Second, func() (done bool, err error) {
+		return len(a.ListEgressStats()) == 0, nil
+	})
+	assert.NoError(t, err)
+}
+
+func newAdminPolicy(kind, name string, uid types.UID) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(npav1alpha1.SchemeGroupVersion.String())
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetUID(uid)
+	return obj
+}
+
+func TestAggregatorCollectAdminNetworkPolicyStats(t *testing.T) {
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicy, true)()
+	defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AdminNetworkPolicy, true)()
+
+	adminNPGVR := npav1alpha1.SchemeGroupVersion.WithResource("adminnetworkpolicies")
+	banpGVR := npav1alpha1.SchemeGroupVersion.WithResource("baselineadminnetworkpolicies")
+	adminNP1 := newAdminPolicy("AdminNetworkPolicy", "foo", "uid9")
+	banp1 := newAdminPolicy("BaselineAdminNetworkPolicy", "default", "uid10")
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset()
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		adminNPGVR: "AdminNetworkPolicyList",
+		banpGVR:    "BaselineAdminNetworkPolicyList",
+	}, adminNP1, banp1)
+	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 12*time.Hour)
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1alpha1().NetworkPolicies(), crdInformerFactory.Crd().V1alpha2().Egresses(), dynamicInformerFactory.ForResource(adminNPGVR), dynamicInformerFactory.ForResource(banpGVR))
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+	dynamicInformerFactory.Start(stopCh)
+	// Event handlers are asynchronous, it's supposed to finish very soon.
+	err := wait.PollImmediate(100*time.Millisecond, time.Second, func() (done bool, err error) {
+		return len(a.ListAdminNetworkPolicyStats()) == 1 && len(a.ListBaselineAdminNetworkPolicyStats()) == 1, nil
+	})
+	require.NoError(t, err)
+
+	ruleStats := func(name string, bytes, packets, sessions int64) []statsv1alpha1.RuleTrafficStats {
+		return []statsv1alpha1.RuleTrafficStats{
+			{
+				Name:         name,
+				TrafficStats: statsv1alpha1.TrafficStats{Bytes: bytes, Packets: packets, Sessions: sessions},
+			},
+		}
+	}
+	summaries := []*controlplane.NodeStatsSummary{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			AdminNetworkPolicies: []controlplane.NetworkPolicyStats{
+				{
+					NetworkPolicy:    controlplane.NetworkPolicyReference{UID: adminNP1.GetUID()},
+					RuleTrafficStats: ruleStats("rule1", 10, 1, 1),
+				},
+				{
+					// The AdminNetworkPolicy has been removed, its stats should be ignored.
+					NetworkPolicy:    controlplane.NetworkPolicyReference{UID: "uid11"},
+					RuleTrafficStats: ruleStats("rule1", 10, 1, 1),
+				},
+			},
+			BaselineAdminNetworkPolicies: []controlplane.NetworkPolicyStats{
+				{
+					NetworkPolicy:    controlplane.NetworkPolicyReference{UID: banp1.GetUID()},
+					RuleTrafficStats: ruleStats("rule1", 30, 5, 3),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			AdminNetworkPolicies: []controlplane.NetworkPolicyStats{
+				{
+					NetworkPolicy:    controlplane.NetworkPolicyReference{UID: adminNP1.GetUID()},
+					RuleTrafficStats: ruleStats("rule1", 20, 2, 1),
+				},
+			},
+		},
+	}
+	runWrapper(t, a, 0, summaries)
+
+	actualANPStats, exists := a.GetAdminNetworkPolicyStats(adminNP1.GetName())
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3, Sessions: 2}, actualANPStats.TrafficStats)
+	assert.ElementsMatch(t, ruleStats("rule1", 30, 3, 2), actualANPStats.RuleTrafficStats)
+	actualBANPStats, exists := a.GetBaselineAdminNetworkPolicyStats(banp1.GetName())
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 30, Packets: 5, Sessions: 3}, actualBANPStats.TrafficStats)
+	assert.ElementsMatch(t, ruleStats("rule1", 30, 5, 3), actualBANPStats.RuleTrafficStats)
+
+	dynamicClient.Resource(adminNPGVR).Delete(context.TODO(), adminNP1.GetName(), metav1.DeleteOptions{})
+	dynamicClient.Resource(banpGVR).Delete(context.TODO(), banp1.GetName(), metav1.DeleteOptions{})
+	// Event handlers are asynchronous, it's supposed to finish very soon.
+	err = wait.PollImmediate(100*time.Millisecond, time.Second, func() (done bool, err error) {
+		return len(a.ListAdminNetworkPolicyStats()) == 0 && len(a.ListBaselineAdminNetworkPolicyStats()) == 0, nil
+	})
+	assert.NoError(t, err)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -292,7 +293,20 @@ func (c *Controller) startTraceflow(tf *crdv1alpha1.Traceflow) error {
 // checkTraceflowStatus is only called for Traceflows in the Running phase
 func (c *Controller) checkTraceflowStatus(tf *crdv1alpha1.Traceflow) error {
 	succeeded := false
-	if tf.Spec.LiveTraffic && tf.Spec.DroppedOnly {
+	var tracedPackets int32
+	if isSamplingTraceflow(tf) {
+		tf = tf.DeepCopy()
+		for i := range tf.Status.Samples {
+			for j := range tf.Status.Samples[i].Results {
+				for k := range tf.Status.Samples[i].Results[j].Observations {
+					c.setObservationPod(&tf.Status.Samples[i].Results[j].Observations[k])
+				}
+			}
+		}
+		tf.Status.PacketCounts = getPacketCounts(tf)
+		tracedPackets = tf.Status.PacketCounts.Delivered + tf.Status.PacketCounts.Forwarded + tf.Status.PacketCounts.Dropped
+		succeeded = tracedPackets >= tf.Spec.SampleCount
+	} else if tf.Spec.LiveTraffic && tf.Spec.DroppedOnly {
 		// There should be only one reported NodeResult for droppedOnly
 		// Traceflow.
 		if len(tf.Status.Results) > 0 {
@@ -312,20 +326,7 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1alpha1.Traceflow) error {
 					ob.Action == crdv1alpha1.ActionForwardedOutOfOverlay {
 					receiver = true
 				}
-				if ob.TranslatedDstIP != "" {
-					// Add Pod ns/name to observation if TranslatedDstIP (a.k.a. Service Endpoint address) is Pod IP.
-					pods, err := c.podInformer.Informer().GetIndexer().ByIndex(podIPsIndex, ob.TranslatedDstIP)
-					if err != nil {
-						klog.Infof("Unable to find Pod from IP, error: %+v", err)
-					} else if len(pods) > 0 {
-						pod, ok := pods[0].(*corev1.Pod)
-						if !ok {
-							klog.Warningf("Invalid Pod obj in cache")
-						} else {
-							tf.Status.Results[i].Observations[j].Pod = fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
-						}
-					}
-				}
+				c.setObservationPod(&tf.Status.Results[i].Observations[j])
 			}
 		}
 		// When the Source Pod is specified, the Traceflow should receive
@@ -356,11 +357,87 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1alpha1.Traceflow) error {
 	}
 	if startTime.Add(timeout).Before(time.Now()) {
 		c.deallocateTagForTF(tf)
+		// A sampling Traceflow is considered successful if any packet
+		// has been traced before it times out.
+		if tracedPackets > 0 {
+			return c.updateTraceflowStatus(tf, crdv1alpha1.Succeeded, fmt.Sprintf("%s, traced %d of %d packets", traceflowTimeout, tracedPackets, tf.Spec.SampleCount), 0)
+		}
 		return c.updateTraceflowStatus(tf, crdv1alpha1.Failed, traceflowTimeout, 0)
 	}
 	return nil
 }
 
+// setObservationPod adds Pod ns/name to the observation if TranslatedDstIP (a.k.a. Service Endpoint address) is Pod IP.
+func (c *Controller) setObservationPod(ob *crdv1alpha1.Observation) {
+	if ob.TranslatedDstIP == "" {
+		return
+	}
+	pods, err := c.podInformer.Informer().GetIndexer().ByIndex(podIPsIndex, ob.TranslatedDstIP)
+	if err != nil {
+		klog.Infof("Unable to find Pod from IP, error: %+v", err)
+	} else if len(pods) > 0 {
+		pod, ok := pods[0].(*corev1.Pod)
+		if !ok {
+			klog.Warningf("Invalid Pod obj in cache")
+		} else {
+			ob.Pod = fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+		}
+	}
+}
+
+// isSamplingTraceflow checks whether the Traceflow samples multiple packets of live traffic.
+func isSamplingTraceflow(tf *crdv1alpha1.Traceflow) bool {
+	return tf.Spec.LiveTraffic && tf.Spec.SampleCount > 1
+}
+
+// getPacketCounts aggregates the sampled packets of a Traceflow by their final actions. A sampled packet is not
+// counted in any action until a Node reports the final action of it, i.e. the packet is delivered, dropped, rejected,
+// or forwarded out of the overlay.
+func getPacketCounts(tf *crdv1alpha1.Traceflow) *crdv1alpha1.TraceflowPacketCounts {
+	counts := &crdv1alpha1.TraceflowPacketCounts{Sampled: int32(len(tf.Status.Samples))}
+	policyCounts := map[crdv1alpha1.NetworkPolicyPacketCount]int32{}
+	for _, sample := range tf.Status.Samples {
+		var final *crdv1alpha1.Observation
+		for i := range sample.Results {
+			for j := range sample.Results[i].Observations {
+				ob := &sample.Results[i].Observations[j]
+				if ob.Action == crdv1alpha1.ActionDelivered ||
+					ob.Action == crdv1alpha1.ActionDropped ||
+					ob.Action == crdv1alpha1.ActionRejected ||
+					ob.Action == crdv1alpha1.ActionForwardedOutOfOverlay {
+					final = ob
+				}
+			}
+		}
+		if final == nil {
+			continue
+		}
+		switch final.Action {
+		case crdv1alpha1.ActionDelivered:
+			counts.Delivered++
+		case crdv1alpha1.ActionForwardedOutOfOverlay:
+			counts.Forwarded++
+		default:
+			counts.Dropped++
+			if final.NetworkPolicy != "" {
+				policyCounts[crdv1alpha1.NetworkPolicyPacketCount{NetworkPolicy: final.NetworkPolicy, Action: final.Action}]++
+			}
+		}
+	}
+	for key, count := range policyCounts {
+		key.Count = count
+		counts.NetworkPolicies = append(counts.NetworkPolicies, key)
+	}
+	sort.Slice(counts.NetworkPolicies, func(i, j int) bool {
+		a, b := counts.NetworkPolicies[i], counts.NetworkPolicies[j]
+		if a.NetworkPolicy != b.NetworkPolicy {
+			return a.NetworkPolicy < b.NetworkPolicy
+		}
+		return a.Action < b.Action
+	})
+	return counts
+}
+
 func (c *Controller) updateTraceflowStatus(tf *crdv1alpha1.Traceflow, phase crdv1alpha1.TraceflowPhase, reason string, dataPlaneTag uint8) error {
 	update := tf.DeepCopy()
 	update.Status.Phase = phase
@@ -435,6 +512,23 @@ func (c *Controller) deallocateTag(name string, tag uint8) {
 }
 
 func (c *Controller) validateTraceflow(tf *crdv1alpha1.Traceflow) error {
+	if tf.Spec.SampleCount > 1 && !tf.Spec.LiveTraffic {
+		return fmt.Errorf("sampling multiple packets is supported only for live-traffic Traceflow")
+	}
+	if tf.Spec.Source.Node != "" && (tf.Spec.LiveTraffic || tf.Spec.Source.IP == "" || tf.Spec.Source.Pod != "") {
+		return fmt.Errorf("source Node can be specified only with source IP in non-live-traffic Traceflow")
+	}
+	if !tf.Spec.LiveTraffic && tf.Spec.Source.IP != "" {
+		// The packet from an external client is injected on the Node it
+		// enters the cluster from.
+		if tf.Spec.Source.Node == "" {
+			return fmt.Errorf("source Node must be specified with source IP in non-live-traffic Traceflow")
+		}
+		if tf.Spec.Destination.Pod == "" && tf.Spec.Destination.Service == "" && tf.Spec.Destination.IP == "" {
+			return fmt.Errorf("destination must be specified with source IP in non-live-traffic Traceflow")
+		}
+		return nil
+	}
 	if !tf.Spec.LiveTraffic {
 		srcPod, err := c.podLister.Pods(tf.Spec.Source.Namespace).Get(tf.Spec.Source.Pod)
 		if err != nil {
@@ -176,6 +176,84 @@ func TestTraceflow(t *testing.T) {
 		assert.Equal(t, numRunningTraceflows(), 0)
 	})
 
+	t.Run("samplingTraceflow", func(t *testing.T) {
+		tf3 := crdv1alpha1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf3", UID: "uid3"},
+			Spec: crdv1alpha1.TraceflowSpec{
+				Source:      crdv1alpha1.Source{Namespace: "ns1", Pod: "pod1"},
+				Destination: crdv1alpha1.Destination{Namespace: "ns2", Pod: "pod2"},
+				LiveTraffic: true,
+				SampleCount: 3,
+				Timeout:     2, // 2 seconds timeout
+			},
+		}
+		tfc.client.CrdV1alpha1().Traceflows().Create(context.TODO(), &tf3, metav1.CreateOptions{})
+		res, _ := tfc.waitForTraceflow("tf3", crdv1alpha1.Running, time.Second)
+		require.NotNil(t, res)
+
+		sender := crdv1alpha1.NodeResult{Observations: []crdv1alpha1.Observation{{Component: crdv1alpha1.ComponentSpoofGuard}}}
+		res.Status.Samples = []crdv1alpha1.TraceflowSample{
+			{Results: []crdv1alpha1.NodeResult{sender, {Observations: []crdv1alpha1.Observation{{Action: crdv1alpha1.ActionDelivered}}}}},
+			{Results: []crdv1alpha1.NodeResult{sender, {Observations: []crdv1alpha1.Observation{{Action: crdv1alpha1.ActionDropped, NetworkPolicy: "AntreaNetworkPolicy:ns2/deny"}}}}},
+		}
+		tfc.client.CrdV1alpha1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		// Only 2 of 3 packets are traced, the Traceflow should succeed after it times out.
+		res, _ = tfc.waitForTraceflow("tf3", crdv1alpha1.Succeeded, defaultTimeoutDuration*2)
+		require.NotNil(t, res)
+		assert.Equal(t, "Traceflow timeout, traced 2 of 3 packets", res.Status.Reason)
+		assert.Equal(t, &crdv1alpha1.TraceflowPacketCounts{
+			Sampled:   2,
+			Delivered: 1,
+			Dropped:   1,
+			NetworkPolicies: []crdv1alpha1.NetworkPolicyPacketCount{
+				{NetworkPolicy: "AntreaNetworkPolicy:ns2/deny", Action: crdv1alpha1.ActionDropped, Count: 1},
+			},
+		}, res.Status.PacketCounts)
+		assert.Equal(t, numRunningTraceflows(), 0)
+	})
+
+	t.Run("externalSourceTraceflow", func(t *testing.T) {
+		tf4 := crdv1alpha1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf4", UID: "uid4"},
+			Spec: crdv1alpha1.TraceflowSpec{
+				Source:      crdv1alpha1.Source{IP: "10.0.0.1", Node: "node1"},
+				Destination: crdv1alpha1.Destination{Namespace: "ns2", Service: "svc1"},
+				Timeout:     2, // 2 seconds timeout
+			},
+		}
+		tfc.client.CrdV1alpha1().Traceflows().Create(context.TODO(), &tf4, metav1.CreateOptions{})
+		res, _ := tfc.waitForTraceflow("tf4", crdv1alpha1.Running, time.Second)
+		require.NotNil(t, res)
+
+		// Only the ingress Node and the receiver report the results.
+		res.Status.Results = []crdv1alpha1.NodeResult{
+			{
+				Observations: []crdv1alpha1.Observation{{Component: crdv1alpha1.ComponentLB, Action: crdv1alpha1.ActionForwarded}},
+			},
+			{
+				Observations: []crdv1alpha1.Observation{{Action: crdv1alpha1.ActionDelivered}},
+			},
+		}
+		tfc.client.CrdV1alpha1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("tf4", crdv1alpha1.Succeeded, time.Second)
+		require.NotNil(t, res)
+		assert.Equal(t, numRunningTraceflows(), 0)
+	})
+
+	t.Run("externalSourceTraceflowWithoutNode", func(t *testing.T) {
+		tf5 := crdv1alpha1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf5", UID: "uid5"},
+			Spec: crdv1alpha1.TraceflowSpec{
+				Source:      crdv1alpha1.Source{IP: "10.0.0.1"},
+				Destination: crdv1alpha1.Destination{Namespace: "ns2", Service: "svc1"},
+			},
+		}
+		tfc.client.CrdV1alpha1().Traceflows().Create(context.TODO(), &tf5, metav1.CreateOptions{})
+		res, _ := tfc.waitForTraceflow("tf5", crdv1alpha1.Failed, time.Second)
+		require.NotNil(t, res)
+		assert.True(t, res.Status.DataplaneTag == 0)
+	})
+
 	close(stopCh)
 }
 
@@ -0,0 +1,565 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trafficcontrol
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	antreaclientset "antrea.io/antrea/pkg/client/clientset/versioned"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha2"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha2"
+)
+
+const (
+	statusControllerName = "TrafficControlStatusController"
+	// How long to wait before retrying the processing of a TrafficControl status.
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+	// Default number of workers processing a TrafficControl status.
+	defaultWorkers = 4
+	// Disable resyncing.
+	resyncPeriod time.Duration = 0
+
+	// Reasons of the TrafficControl conditions.
+	reasonAllNodesReady   = "AllNodesReady"
+	reasonNodesNotReady   = "NodesNotReady"
+	reasonTargetReachable = "TargetReachable"
+	reasonTargetNotFound  = "TargetNotFound"
+)
+
+// StatusController is responsible for synchronizing the status of TrafficControls. It aggregates the realization
+// statuses reported by antrea-agents and calculates the desired Nodes of a TrafficControl based on the Pods it
+// selects.
+type StatusController struct {
+	// tcControlInterface knows how to update TrafficControl status.
+	tcControlInterface trafficControlControlInterface
+
+	// queue maintains the names of the TrafficControl objects that need to be synced.
+	queue workqueue.RateLimitingInterface
+
+	// statuses is a nested map that keeps the realization statuses reported by antrea-agents.
+	// The outer map's keys are the TrafficControl names. The inner map's keys are the Node names. The inner map's
+	// values are statuses reported by each Node for a TrafficControl.
+	statuses     map[string]map[string]*controlplane.TrafficControlNodeStatus
+	statusesLock sync.RWMutex
+
+	tcLister       crdlisters.TrafficControlLister
+	tcListerSynced cache.InformerSynced
+
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+
+	namespaceLister       corelisters.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+}
+
+func NewStatusController(antreaClient antreaclientset.Interface,
+	tcInformer crdinformers.TrafficControlInformer,
+	podInformer coreinformers.PodInformer,
+	namespaceInformer coreinformers.NamespaceInformer) *StatusController {
+	c := &StatusController{
+		tcControlInterface: &trafficControlControl{
+			antreaClient: antreaClient,
+			tcLister:     tcInformer.Lister(),
+		},
+		queue:                 workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "trafficControlStatus"),
+		statuses:              map[string]map[string]*controlplane.TrafficControlNodeStatus{},
+		tcLister:              tcInformer.Lister(),
+		tcListerSynced:        tcInformer.Informer().HasSynced,
+		podLister:             podInformer.Lister(),
+		podListerSynced:       podInformer.Informer().HasSynced,
+		namespaceLister:       namespaceInformer.Lister(),
+		namespaceListerSynced: namespaceInformer.Informer().HasSynced,
+	}
+	// Like the NetworkPolicy StatusController, the cache of the Lister is treated as the state of kube-apiserver, so
+	// a TrafficControl is resynced if its status is updated.
+	tcInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addTC,
+			UpdateFunc: c.updateTC,
+			DeleteFunc: c.deleteTC,
+		},
+		resyncPeriod,
+	)
+	podInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.addPod,
+			UpdateFunc: c.updatePod,
+			DeleteFunc: c.deletePod,
+		},
+		resyncPeriod,
+	)
+	namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: c.updateNamespace,
+		},
+		resyncPeriod,
+	)
+	return c
+}
+
+func (c *StatusController) addTC(obj interface{}) {
+	tc := obj.(*crdv1alpha2.TrafficControl)
+	c.queue.Add(tc.Name)
+}
+
+func (c *StatusController) updateTC(old, cur interface{}) {
+	oldTC := old.(*crdv1alpha2.TrafficControl)
+	curTC := cur.(*crdv1alpha2.TrafficControl)
+	if oldTC.Generation == curTC.Generation && reflect.DeepEqual(oldTC.Status, curTC.Status) {
+		return
+	}
+	c.queue.Add(curTC.Name)
+}
+
+func (c *StatusController) deleteTC(obj interface{}) {
+	tc, ok := obj.(*crdv1alpha2.TrafficControl)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		tc, ok = deletedState.Obj.(*crdv1alpha2.TrafficControl)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-TrafficControl object: %v", deletedState.Obj)
+			return
+		}
+	}
+	c.queue.Add(tc.Name)
+}
+
+func (c *StatusController) addPod(obj interface{}) {
+	pod := obj.(*corev1.Pod)
+	if pod.Spec.HostNetwork || pod.Spec.NodeName == "" {
+		return
+	}
+	c.enqueueTCsByPod(pod, nil)
+}
+
+func (c *StatusController) updatePod(old, cur interface{}) {
+	oldPod := old.(*corev1.Pod)
+	curPod := cur.(*corev1.Pod)
+	if curPod.Spec.HostNetwork {
+		return
+	}
+	// Only the label changes and the scheduling of a Pod can affect the status of the TrafficControls selecting it.
+	if oldPod.Spec.NodeName == curPod.Spec.NodeName && reflect.DeepEqual(oldPod.Labels, curPod.Labels) {
+		return
+	}
+	c.enqueueTCsByPod(curPod, oldPod.Labels)
+}
+
+func (c *StatusController) deletePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-Pod object: %v", deletedState.Obj)
+			return
+		}
+	}
+	if pod.Spec.HostNetwork || pod.Spec.NodeName == "" {
+		return
+	}
+	c.enqueueTCsByPod(pod, nil)
+}
+
+func (c *StatusController) updateNamespace(old, cur interface{}) {
+	oldNS := old.(*corev1.Namespace)
+	curNS := cur.(*corev1.Namespace)
+	if reflect.DeepEqual(oldNS.Labels, curNS.Labels) {
+		return
+	}
+	tcs, _ := c.tcLister.List(labels.Everything())
+	for _, tc := range tcs {
+		if appliedToMatchesNamespace(&tc.Spec.AppliedTo, curNS.Labels) ||
+			appliedToMatchesNamespace(&tc.Spec.AppliedTo, oldNS.Labels) {
+			c.queue.Add(tc.Name)
+		}
+	}
+}
+
+// enqueueTCsByPod enqueues the TrafficControls that select the Pod with its current labels or with its previous
+// labels (if oldLabels is not nil).
+func (c *StatusController) enqueueTCsByPod(pod *corev1.Pod, oldLabels map[string]string) {
+	ns, err := c.namespaceLister.Get(pod.Namespace)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get Namespace of Pod", "pod", klog.KObj(pod))
+		return
+	}
+	tcs, _ := c.tcLister.List(labels.Everything())
+	for _, tc := range tcs {
+		if !appliedToMatchesNamespace(&tc.Spec.AppliedTo, ns.Labels) {
+			continue
+		}
+		if appliedToMatchesPod(&tc.Spec.AppliedTo, pod.Labels) ||
+			(oldLabels != nil && appliedToMatchesPod(&tc.Spec.AppliedTo, oldLabels)) {
+			c.queue.Add(tc.Name)
+		}
+	}
+}
+
+// appliedToMatchesNamespace returns whether Pods in a Namespace with the provided labels can be selected by the
+// AppliedTo.
+func appliedToMatchesNamespace(appliedTo *crdv1alpha2.AppliedTo, nsLabels map[string]string) bool {
+	if appliedTo.NamespaceSelector == nil {
+		// No Pod will be selected if neither of the selectors is set.
+		return appliedTo.PodSelector != nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(appliedTo.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(nsLabels))
+}
+
+// appliedToMatchesPod returns whether a Pod with the provided labels can be selected by the AppliedTo, assuming its
+// Namespace is matched.
+func appliedToMatchesPod(appliedTo *crdv1alpha2.AppliedTo, podLabels map[string]string) bool {
+	if appliedTo.PodSelector == nil {
+		return appliedTo.NamespaceSelector != nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(appliedTo.PodSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(podLabels))
+}
+
+// UpdateStatus is called by the API handler when an antrea-agent reports the realization status of a TrafficControl.
+func (c *StatusController) UpdateStatus(status *controlplane.TrafficControlStatus) error {
+	key := status.Name
+	if _, err := c.tcLister.Get(key); err != nil {
+		klog.InfoS("TrafficControl has been deleted, skip updating its status", "trafficControl", key)
+		return nil
+	}
+	func() {
+		c.statusesLock.Lock()
+		defer c.statusesLock.Unlock()
+		statusPerNode, exists := c.statuses[key]
+		if !exists {
+			statusPerNode = map[string]*controlplane.TrafficControlNodeStatus{}
+			c.statuses[key] = statusPerNode
+		}
+		for i := range status.Nodes {
+			statusPerNode[status.Nodes[i].NodeName] = &status.Nodes[i]
+		}
+	}()
+	c.queue.Add(key)
+	return nil
+}
+
+func (c *StatusController) getNodeStatuses(key string) []*controlplane.TrafficControlNodeStatus {
+	c.statusesLock.RLock()
+	defer c.statusesLock.RUnlock()
+	statusPerNode, exists := c.statuses[key]
+	if !exists {
+		return nil
+	}
+	statuses := make([]*controlplane.TrafficControlNodeStatus, 0, len(statusPerNode))
+	for _, status := range statusPerNode {
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+func (c *StatusController) clearStatuses(key string) {
+	c.statusesLock.Lock()
+	defer c.statusesLock.Unlock()
+	delete(c.statuses, key)
+}
+
+func (c *StatusController) deleteNodeStatus(key string, nodeName string) {
+	c.statusesLock.Lock()
+	defer c.statusesLock.Unlock()
+	statusPerNode, exists := c.statuses[key]
+	if !exists {
+		return
+	}
+	delete(statusPerNode, nodeName)
+}
+
+// Run begins watching and syncing of a StatusController.
+func (c *StatusController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.Infof("Starting %s", statusControllerName)
+	defer klog.Infof("Shutting down %s", statusControllerName)
+
+	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, c.tcListerSynced, c.podListerSynced, c.namespaceListerSynced) {
+		return
+	}
+
+	for i := 0; i < defaultWorkers; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *StatusController) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+// processNextWorkItem deals with one key off the queue. It returns false when it's time to quit.
+func (c *StatusController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncHandler(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	klog.ErrorS(err, "Failed to sync TrafficControl status", "trafficControl", key)
+	c.queue.AddRateLimited(key)
+
+	return true
+}
+
+// filterPods returns the Pods selected by the AppliedTo. The Pods that are using the host network or haven't been
+// scheduled are excluded as no Node will realize the TrafficControl for them.
+func (c *StatusController) filterPods(appliedTo *crdv1alpha2.AppliedTo) ([]*corev1.Pod, error) {
+	// If both selectors are nil, no Pod should be selected.
+	if appliedTo.PodSelector == nil && appliedTo.NamespaceSelector == nil {
+		return nil, nil
+	}
+	podSelector := labels.Everything()
+	if appliedTo.PodSelector != nil {
+		var err error
+		podSelector, err = metav1.LabelSelectorAsSelector(appliedTo.PodSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var pods []*corev1.Pod
+	if appliedTo.NamespaceSelector == nil {
+		// If Namespace selector is nil, Pods are selected from all Namespaces.
+		var err error
+		if pods, err = c.podLister.List(podSelector); err != nil {
+			return nil, err
+		}
+	} else {
+		nsSelector, err := metav1.LabelSelectorAsSelector(appliedTo.NamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		namespaces, err := c.namespaceLister.List(nsSelector)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range namespaces {
+			nsPods, err := c.podLister.Pods(ns.Name).List(podSelector)
+			if err != nil {
+				return nil, err
+			}
+			pods = append(pods, nsPods...)
+		}
+	}
+	selectedPods := make([]*corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || pod.Spec.NodeName == "" {
+			continue
+		}
+		selectedPods = append(selectedPods, pod)
+	}
+	return selectedPods, nil
+}
+
+// syncHandler calculates the TrafficControl status based on the desired Nodes derived from the selected Pods and the
+// actual state reported by antrea-agents, and syncs it with the Kubernetes API.
+func (c *StatusController) syncHandler(key string) error {
+	klog.V(2).InfoS("Syncing TrafficControl status", "trafficControl", key)
+	tc, err := c.tcLister.Get(key)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// It has been deleted, cleaning its statuses.
+			c.clearStatuses(key)
+			return nil
+		}
+		return err
+	}
+
+	pods, err := c.filterPods(&tc.Spec.AppliedTo)
+	if err != nil {
+		return err
+	}
+	desiredNodes := sets.NewString()
+	for _, pod := range pods {
+		desiredNodes.Insert(pod.Spec.NodeName)
+	}
+
+	var currentNodes, portCreatedNodes, flowsInstalledNodes int
+	var unreachableMessages []string
+	for _, status := range c.getNodeStatuses(key) {
+		// The Node is no longer running any selected Pod, delete its status.
+		if !desiredNodes.Has(status.NodeName) {
+			c.deleteNodeStatus(key, status.NodeName)
+			continue
+		}
+		if status.Generation != tc.Generation {
+			continue
+		}
+		if status.PortCreated {
+			portCreatedNodes++
+		}
+		if status.FlowsInstalled {
+			flowsInstalledNodes++
+		}
+		if status.PortCreated && status.FlowsInstalled {
+			currentNodes++
+		}
+		if status.TargetUnreachable {
+			unreachableMessages = append(unreachableMessages, fmt.Sprintf("%s: %s", status.NodeName, status.Message))
+		}
+	}
+	sort.Strings(unreachableMessages)
+
+	conditions := []crdv1alpha2.TrafficControlCondition{
+		newNodesCondition(crdv1alpha2.TrafficControlPortCreated, portCreatedNodes, desiredNodes.Len()),
+		newNodesCondition(crdv1alpha2.TrafficControlFlowsInstalled, flowsInstalledNodes, desiredNodes.Len()),
+	}
+	if len(unreachableMessages) > 0 {
+		conditions = append(conditions, crdv1alpha2.TrafficControlCondition{
+			Type:    crdv1alpha2.TrafficControlTargetUnreachable,
+			Status:  corev1.ConditionTrue,
+			Reason:  reasonTargetNotFound,
+			Message: strings.Join(unreachableMessages, "; "),
+		})
+	} else {
+		conditions = append(conditions, crdv1alpha2.TrafficControlCondition{
+			Type:   crdv1alpha2.TrafficControlTargetUnreachable,
+			Status: corev1.ConditionFalse,
+			Reason: reasonTargetReachable,
+		})
+	}
+
+	status := &crdv1alpha2.TrafficControlStatus{
+		ObservedGeneration:   tc.Generation,
+		CurrentNodesRealized: int32(currentNodes),
+		DesiredNodesRealized: int32(desiredNodes.Len()),
+		SelectedPods:         int32(len(pods)),
+		Conditions:           mergeConditions(tc.Status.Conditions, conditions),
+	}
+	klog.V(2).InfoS("Updating TrafficControl status", "trafficControl", key, "status", status)
+	return c.tcControlInterface.UpdateTrafficControlStatus(key, status)
+}
+
+// newNodesCondition returns a condition which is true only when all desired Nodes have reached the expected state.
+func newNodesCondition(conditionType crdv1alpha2.TrafficControlConditionType, readyNodes, desiredNodes int) crdv1alpha2.TrafficControlCondition {
+	if readyNodes == desiredNodes {
+		return crdv1alpha2.TrafficControlCondition{
+			Type:   conditionType,
+			Status: corev1.ConditionTrue,
+			Reason: reasonAllNodesReady,
+		}
+	}
+	return crdv1alpha2.TrafficControlCondition{
+		Type:    conditionType,
+		Status:  corev1.ConditionFalse,
+		Reason:  reasonNodesNotReady,
+		Message: fmt.Sprintf("%d/%d Nodes are ready", readyNodes, desiredNodes),
+	}
+}
+
+// mergeConditions sets the LastTransitionTime of the new conditions. The time of a condition is kept if its status
+// doesn't change, otherwise it's set to the current time.
+func mergeConditions(oldConditions, newConditions []crdv1alpha2.TrafficControlCondition) []crdv1alpha2.TrafficControlCondition {
+	now := metav1.Now()
+	for i := range newConditions {
+		newConditions[i].LastTransitionTime = now
+		for _, oldCondition := range oldConditions {
+			if oldCondition.Type == newConditions[i].Type && oldCondition.Status == newConditions[i].Status {
+				newConditions[i].LastTransitionTime = oldCondition.LastTransitionTime
+				break
+			}
+		}
+	}
+	return newConditions
+}
+
+// trafficControlControlInterface is an interface that knows how to update TrafficControl status.
+// It's created as an interface to allow testing.
+type trafficControlControlInterface interface {
+	UpdateTrafficControlStatus(name string, status *crdv1alpha2.TrafficControlStatus) error
+}
+
+type trafficControlControl struct {
+	antreaClient antreaclientset.Interface
+	tcLister     crdlisters.TrafficControlLister
+}
+
+func (c *trafficControlControl) UpdateTrafficControlStatus(name string, status *crdv1alpha2.TrafficControlStatus) error {
+	tc, err := c.tcLister.Get(name)
+	if err != nil {
+		klog.InfoS("Didn't find the original TrafficControl, skip updating status", "trafficControl", name)
+		return nil
+	}
+	// If the current status equals to the desired status, no need to update.
+	if reflect.DeepEqual(tc.Status, *status) {
+		return nil
+	}
+
+	toUpdate := tc.DeepCopy()
+
+	var updateErr, getErr error
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		toUpdate.Status = *status
+		klog.V(2).InfoS("Updating TrafficControl", "trafficControl", klog.KObj(toUpdate))
+		_, updateErr = c.antreaClient.CrdV1alpha2().TrafficControls().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.antreaClient.CrdV1alpha2().TrafficControls().Get(context.TODO(), name, metav1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated TrafficControl", "trafficControl", klog.KObj(toUpdate))
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trafficcontrol
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	antreafakeclientset "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+	antreainformers "antrea.io/antrea/pkg/client/informers/externalversions"
+)
+
+type fakeTrafficControlControl struct {
+	sync.Mutex
+	status *crdv1alpha2.TrafficControlStatus
+}
+
+func (c *fakeTrafficControlControl) UpdateTrafficControlStatus(name string, status *crdv1alpha2.TrafficControlStatus) error {
+	c.Lock()
+	defer c.Unlock()
+	c.status = status
+	return nil
+}
+
+func (c *fakeTrafficControlControl) getTrafficControlStatus() *crdv1alpha2.TrafficControlStatus {
+	c.Lock()
+	defer c.Unlock()
+	return c.status
+}
+
+func newTestStatusController(t *testing.T, objects []runtime.Object, crdObjects []runtime.Object) (*StatusController, *fakeTrafficControlControl) {
+	k8sClient := fake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(k8sClient, 0)
+	antreaClientset := antreafakeclientset.NewSimpleClientset(crdObjects...)
+	antreaInformerFactory := antreainformers.NewSharedInformerFactory(antreaClientset, 0)
+
+	c := NewStatusController(antreaClientset,
+		antreaInformerFactory.Crd().V1alpha2().TrafficControls(),
+		informerFactory.Core().V1().Pods(),
+		informerFactory.Core().V1().Namespaces())
+	tcControl := &fakeTrafficControlControl{}
+	c.tcControlInterface = tcControl
+
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	informerFactory.Start(stopCh)
+	antreaInformerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	antreaInformerFactory.WaitForCacheSync(stopCh)
+	return c, tcControl
+}
+
+func newPod(namespace, name, nodeName string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func newTrafficControlStatus(name string, nodeStatuses ...controlplane.TrafficControlNodeStatus) *controlplane.TrafficControlStatus {
+	return &controlplane.TrafficControlStatus{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Nodes:      nodeStatuses,
+	}
+}
+
+func getCondition(status *crdv1alpha2.TrafficControlStatus, conditionType crdv1alpha2.TrafficControlConditionType) *crdv1alpha2.TrafficControlCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestTrafficControlStatusSync(t *testing.T) {
+	labels := map[string]string{"app": "foo"}
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}
+	pod1 := newPod("ns1", "pod1", "node1", labels)
+	pod2 := newPod("ns1", "pod2", "node2", labels)
+	// pod3 is not scheduled yet.
+	pod3 := newPod("ns1", "pod3", "", labels)
+	// pod4 is not selected.
+	pod4 := newPod("ns1", "pod4", "node3", nil)
+	tc := &crdv1alpha2.TrafficControl{
+		ObjectMeta: metav1.ObjectMeta{Name: "tc1", Generation: 1},
+		Spec: crdv1alpha2.TrafficControlSpec{
+			AppliedTo: crdv1alpha2.AppliedTo{PodSelector: &metav1.LabelSelector{MatchLabels: labels}},
+		},
+	}
+	c, tcControl := newTestStatusController(t, []runtime.Object{ns, pod1, pod2, pod3, pod4}, []runtime.Object{tc})
+
+	tests := []struct {
+		name                   string
+		nodeStatuses           []controlplane.TrafficControlNodeStatus
+		expectedCurrentNodes   int32
+		expectedPortCreated    corev1.ConditionStatus
+		expectedFlowsInstalled corev1.ConditionStatus
+		expectedUnreachable    corev1.ConditionStatus
+		expectedMessage        string
+	}{
+		{
+			name:                   "no status reported",
+			expectedCurrentNodes:   0,
+			expectedPortCreated:    corev1.ConditionFalse,
+			expectedFlowsInstalled: corev1.ConditionFalse,
+			expectedUnreachable:    corev1.ConditionFalse,
+		},
+		{
+			name: "target unreachable on one Node",
+			nodeStatuses: []controlplane.TrafficControlNodeStatus{
+				{NodeName: "node1", Generation: 1, PortCreated: true, FlowsInstalled: true},
+				{NodeName: "node2", Generation: 1, TargetUnreachable: true, Message: "device eth1 not found"},
+				// node3 doesn't run any selected Pod, its status should be ignored.
+				{NodeName: "node3", Generation: 1, TargetUnreachable: true, Message: "device eth1 not found"},
+			},
+			expectedCurrentNodes:   1,
+			expectedPortCreated:    corev1.ConditionFalse,
+			expectedFlowsInstalled: corev1.ConditionFalse,
+			expectedUnreachable:    corev1.ConditionTrue,
+			expectedMessage:        "node2: device eth1 not found",
+		},
+		{
+			name: "realized on all Nodes",
+			nodeStatuses: []controlplane.TrafficControlNodeStatus{
+				{NodeName: "node2", Generation: 1, PortCreated: true, FlowsInstalled: true},
+			},
+			expectedCurrentNodes:   2,
+			expectedPortCreated:    corev1.ConditionTrue,
+			expectedFlowsInstalled: corev1.ConditionTrue,
+			expectedUnreachable:    corev1.ConditionFalse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.nodeStatuses) > 0 {
+				require.NoError(t, c.UpdateStatus(newTrafficControlStatus("tc1", tt.nodeStatuses...)))
+			}
+			require.NoError(t, c.syncHandler("tc1"))
+			status := tcControl.getTrafficControlStatus()
+			require.NotNil(t, status)
+			assert.Equal(t, int64(1), status.ObservedGeneration)
+			assert.Equal(t, int32(2), status.DesiredNodesRealized)
+			assert.Equal(t, int32(2), status.SelectedPods)
+			assert.Equal(t, tt.expectedCurrentNodes, status.CurrentNodesRealized)
+			assert.Equal(t, tt.expectedPortCreated, getCondition(status, crdv1alpha2.TrafficControlPortCreated).Status)
+			assert.Equal(t, tt.expectedFlowsInstalled, getCondition(status, crdv1alpha2.TrafficControlFlowsInstalled).Status)
+			unreachableCondition := getCondition(status, crdv1alpha2.TrafficControlTargetUnreachable)
+			assert.Equal(t, tt.expectedUnreachable, unreachableCondition.Status)
+			assert.Equal(t, tt.expectedMessage, unreachableCondition.Message)
+		})
+	}
+	assert.NotContains(t, c.statuses["tc1"], "node3")
+}
+
+func TestMergeConditions(t *testing.T) {
+	oldTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	oldConditions := []crdv1alpha2.TrafficControlCondition{
+		{Type: crdv1alpha2.TrafficControlPortCreated, Status: corev1.ConditionTrue, LastTransitionTime: oldTime},
+		{Type: crdv1alpha2.TrafficControlFlowsInstalled, Status: corev1.ConditionFalse, LastTransitionTime: oldTime},
+	}
+	newConditions := mergeConditions(oldConditions, []crdv1alpha2.TrafficControlCondition{
+		{Type: crdv1alpha2.TrafficControlPortCreated, Status: corev1.ConditionTrue},
+		{Type: crdv1alpha2.TrafficControlFlowsInstalled, Status: corev1.ConditionTrue},
+	})
+	assert.Equal(t, oldTime, newConditions[0].LastTransitionTime)
+	assert.True(t, newConditions[1].LastTransitionTime.After(oldTime.Time))
+}
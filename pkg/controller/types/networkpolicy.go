@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 )
 
 // SpanMeta describes the span information of an object.
@@ -103,4 +104,16 @@ type NetworkPolicy struct {
 	// to re-calculate affected Namespaces.
 	// It is set only for AntreaClusterNetworkPolicies with per-namespace rules.
 	PerNamespaceSelectors []labels.Selector
+	// EnforcementMode specifies whether the Drop and Reject rules of this policy are enforced
+	// or only audited. Empty for K8s NetworkPolicy.
+	EnforcementMode crdv1alpha1.EnforcementMode
+	// Tier is the name of the Tier associated with this Network Policy.
+	Tier string
+	// InactiveRules is a list of names of the rules which are not included in Rules because
+	// the current time is outside of their schedules.
+	InactiveRules []string
+	// SyncError is the error encountered when translating the original Network Policy, e.g. because
+	// of an unsupported field. The rules which cannot be translated are ignored. It is only set for
+	// AdminNetworkPolicies and BaselineAdminNetworkPolicies.
+	SyncError error
 }
@@ -95,6 +95,24 @@ const (
 	// alpha: v1.7
 	// Enable mirroring or redirecting the traffic Pods send or receive.
 	TrafficControl featuregate.Feature = "TrafficControl"
+
+	// alpha: v1.7
+	// Enable limiting the bandwidth of Egress traffic.
+	EgressTrafficShaping featuregate.Feature = "EgressTrafficShaping"
+
+	// alpha: v1.7
+	// Enable layer 7 NetworkPolicy.
+	L7NetworkPolicy featuregate.Feature = "L7NetworkPolicy"
+
+	// alpha: v1.7
+	// Enable support for the AdminNetworkPolicy and BaselineAdminNetworkPolicy APIs of
+	// policy.networking.k8s.io. AntreaPolicy must be enabled as well.
+	AdminNetworkPolicy featuregate.Feature = "AdminNetworkPolicy"
+
+	// alpha: v1.7
+	// Enable applying Antrea ClusterNetworkPolicies to the host network traffic of Nodes.
+	// AntreaPolicy must be enabled as well.
+	NodeNetworkPolicy featuregate.Feature = "NodeNetworkPolicy"
 )
 
 var (
@@ -109,20 +127,24 @@ var (
 	// To add a new feature, define a key for it above and add it here. The features will be
 	// available throughout Antrea binaries.
 	DefaultAntreaFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-		AntreaPolicy:       {Default: true, PreRelease: featuregate.Beta},
-		AntreaProxy:        {Default: true, PreRelease: featuregate.Beta},
-		Egress:             {Default: true, PreRelease: featuregate.Beta},
-		EndpointSlice:      {Default: false, PreRelease: featuregate.Alpha},
-		Traceflow:          {Default: true, PreRelease: featuregate.Beta},
-		AntreaIPAM:         {Default: false, PreRelease: featuregate.Alpha},
-		FlowExporter:       {Default: false, PreRelease: featuregate.Alpha},
-		NetworkPolicyStats: {Default: true, PreRelease: featuregate.Beta},
-		NodePortLocal:      {Default: true, PreRelease: featuregate.Beta},
-		NodeIPAM:           {Default: false, PreRelease: featuregate.Alpha},
-		Multicast:          {Default: false, PreRelease: featuregate.Alpha},
-		SecondaryNetwork:   {Default: false, PreRelease: featuregate.Alpha},
-		ServiceExternalIP:  {Default: false, PreRelease: featuregate.Alpha},
-		TrafficControl:     {Default: false, PreRelease: featuregate.Alpha},
+		AntreaPolicy:         {Default: true, PreRelease: featuregate.Beta},
+		AntreaProxy:          {Default: true, PreRelease: featuregate.Beta},
+		Egress:               {Default: true, PreRelease: featuregate.Beta},
+		EndpointSlice:        {Default: false, PreRelease: featuregate.Alpha},
+		Traceflow:            {Default: true, PreRelease: featuregate.Beta},
+		AntreaIPAM:           {Default: false, PreRelease: featuregate.Alpha},
+		FlowExporter:         {Default: false, PreRelease: featuregate.Alpha},
+		NetworkPolicyStats:   {Default: true, PreRelease: featuregate.Beta},
+		NodePortLocal:        {Default: true, PreRelease: featuregate.Beta},
+		NodeIPAM:             {Default: false, PreRelease: featuregate.Alpha},
+		Multicast:            {Default: false, PreRelease: featuregate.Alpha},
+		SecondaryNetwork:     {Default: false, PreRelease: featuregate.Alpha},
+		ServiceExternalIP:    {Default: false, PreRelease: featuregate.Alpha},
+		TrafficControl:       {Default: false, PreRelease: featuregate.Alpha},
+		EgressTrafficShaping: {Default: false, PreRelease: featuregate.Alpha},
+		L7NetworkPolicy:      {Default: false, PreRelease: featuregate.Alpha},
+		AdminNetworkPolicy:   {Default: false, PreRelease: featuregate.Alpha},
+		NodeNetworkPolicy:    {Default: false, PreRelease: featuregate.Alpha},
 	}
 
 	// UnsupportedFeaturesOnWindows records the features not supported on
@@ -136,12 +158,15 @@ var (
 	// can have different FeatureSpecs between Linux and Windows, we should
 	// still define a separate defaultAntreaFeatureGates map for Windows.
 	unsupportedFeaturesOnWindows = map[featuregate.Feature]struct{}{
-		NodePortLocal:     {},
-		Egress:            {},
-		AntreaIPAM:        {},
-		Multicast:         {},
-		SecondaryNetwork:  {},
-		ServiceExternalIP: {},
+		NodePortLocal:        {},
+		Egress:               {},
+		EgressTrafficShaping: {},
+		AntreaIPAM:           {},
+		Multicast:            {},
+		SecondaryNetwork:     {},
+		ServiceExternalIP:    {},
+		L7NetworkPolicy:      {},
+		NodeNetworkPolicy:    {},
 	}
 )
 
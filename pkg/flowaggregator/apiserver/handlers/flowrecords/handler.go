@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
 
@@ -82,7 +83,7 @@ func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
 }
 
 func (r Response) GetTableHeader() []string {
-	return []string{"SRC_IP", "DST_IP", "SPORT", "DPORT", "PROTO", "SRC_POD", "DST_POD", "SRC_NS", "DST_NS", "SERVICE"}
+	return []string{"SRC_IP", "DST_IP", "SPORT", "DPORT", "PROTO", "SRC_POD", "DST_POD", "SRC_NS", "DST_NS", "SERVICE", "TCP_SRTT", "TCP_RETRANS"}
 }
 
 func (r Response) GetTableRow(maxColumnLength int) []string {
@@ -105,9 +106,28 @@ func (r Response) GetTableRow(maxColumnLength int) []string {
 		fmt.Sprintf("%v", r["sourcePodNamespace"]),
 		fmt.Sprintf("%v", r["destinationPodNamespace"]),
 		fmt.Sprintf("%v", r["destinationServicePortName"]),
+		formatTCPSmoothedRTT(r["tcpSmoothedRTT"]),
+		fmt.Sprintf("%v", r["tcpRetransmissions"]),
 	}
 }
 
+// formatTCPSmoothedRTT formats the smoothed RTT of a TCP connection, which is reported in
+// microseconds. An empty string is returned when the RTT is unknown.
+func formatTCPSmoothedRTT(value interface{}) string {
+	var rtt float64
+	switch v := value.(type) {
+	case float64:
+		// Numbers are decoded as float64 by antctl.
+		rtt = v
+	case uint32:
+		rtt = float64(v)
+	}
+	if rtt == 0 {
+		return ""
+	}
+	return (time.Duration(rtt) * time.Microsecond).String()
+}
+
 func (r Response) SortRows() bool {
 	return false
 }
@@ -41,6 +41,11 @@ const (
 	ClientSecretNamespace = "flow-aggregator"
 	// #nosec G101: false positive triggered by variable name which includes "Secret"
 	ClientSecretName = "flow-aggregator-client-tls"
+	// CASecretName is the name of the Secret storing the CA certificate and key shared by all
+	// the replicas of the Flow Aggregator when high availability is enabled.
+	// #nosec G101: false positive triggered by variable name which includes "Secret"
+	CASecretName      = "flow-aggregator-ca"
+	CASecretNamespace = "flow-aggregator"
 )
 
 var (
@@ -80,7 +85,9 @@ func generateCACertKey() (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
 	return cert, caKey, caPEM.Bytes(), err
 }
 
-func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string) ([]byte, []byte, error) {
+// generateCertKey generates a certificate signed by the CA. For a server certificate, the
+// certificate is valid for flowAggregatorAddress and for the provided extra IP addresses.
+func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string, extraIPs ...net.IP) ([]byte, []byte, error) {
 	var cert *x509.Certificate
 	if isServer {
 		cert = &x509.Certificate{
@@ -104,6 +111,7 @@ func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer b
 			}
 			cert.IPAddresses = flowAggregatorIPs
 		}
+		cert.IPAddresses = append(cert.IPAddresses, extraIPs...)
 	} else {
 		cert = &x509.Certificate{
 			SerialNumber: big.NewInt(3),
@@ -205,3 +213,73 @@ func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kuberne
 	}
 	return nil
 }
+
+// getOrCreateCASecret returns the CA certificate and key stored in the CA Secret, after creating
+// the Secret with a new CA if it does not exist yet.
+func getOrCreateCASecret(k8sClient kubernetes.Interface) (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
+	secret, err := k8sClient.CoreV1().Secrets(CASecretNamespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+	if err == nil {
+		return parseCASecret(secret)
+	}
+	if !errors.IsNotFound(err) {
+		return nil, nil, nil, fmt.Errorf("error getting Secret %s: %v", CASecretName, err)
+	}
+	caCert, caKey, caPEM, err := generateCACertKey()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error when generating CA certificate: %v", err)
+	}
+	caKeyPEM := new(bytes.Buffer)
+	pem.Encode(caKeyPEM, &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(caKey),
+	})
+	secret = &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CASecretName,
+			Namespace: CASecretNamespace,
+			Labels: map[string]string{
+				"app": "flow-aggregator",
+			},
+		},
+		Data: map[string][]byte{
+			v1.TLSCertKey:       caPEM,
+			v1.TLSPrivateKeyKey: caKeyPEM.Bytes(),
+		},
+		Type: v1.SecretTypeTLS,
+	}
+	if _, err := k8sClient.CoreV1().Secrets(CASecretNamespace).Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create Secret %s: %v", CASecretName, err)
+	}
+	klog.InfoS("Created CA Secret", "secret", klog.KObj(secret))
+	return caCert, caKey, caPEM, nil
+}
+
+// getCASecret returns the CA certificate and key stored in the CA Secret.
+func getCASecret(k8sClient kubernetes.Interface) (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
+	secret, err := k8sClient.CoreV1().Secrets(CASecretNamespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting Secret %s: %v", CASecretName, err)
+	}
+	return parseCASecret(secret)
+}
+
+func parseCASecret(secret *v1.Secret) (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
+	caPEM := secret.Data[v1.TLSCertKey]
+	certBlock, _ := pem.Decode(caPEM)
+	if certBlock == nil {
+		return nil, nil, nil, fmt.Errorf("no PEM-encoded certificate in Secret %s", secret.Name)
+	}
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid certificate in Secret %s: %v", secret.Name, err)
+	}
+	keyBlock, _ := pem.Decode(secret.Data[v1.TLSPrivateKeyKey])
+	if keyBlock == nil {
+		return nil, nil, nil, fmt.Errorf("no PEM-encoded key in Secret %s", secret.Name)
+	}
+	caKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid key in Secret %s: %v", secret.Name, err)
+	}
+	return caCert, caKey, caPEM, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"os"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// ClickHouseExporter exports flow records to a ClickHouse database, in batches.
+type ClickHouseExporter struct {
+	chInput           clickhouseclient.ClickHouseInput
+	clickHouseProcess *clickhouseclient.ClickHouseExportProcess
+	started           bool
+}
+
+// newClickHouseClient can be overridden in tests.
+var newClickHouseClient = clickhouseclient.NewClickHouseClient
+
+func buildClickHouseInput(opt *options.Options) clickhouseclient.ClickHouseInput {
+	return clickhouseclient.ClickHouseInput{
+		Username:       os.Getenv("CH_USERNAME"),
+		Password:       os.Getenv("CH_PASSWORD"),
+		Database:       opt.Config.ClickHouse.Database,
+		DatabaseURL:    opt.Config.ClickHouse.DatabaseURL,
+		Debug:          opt.Config.ClickHouse.Debug,
+		Compress:       opt.Config.ClickHouse.Compress,
+		CommitInterval: opt.ClickHouseCommitInterval,
+	}
+}
+
+func NewClickHouseExporter(opt *options.Options) (*ClickHouseExporter, error) {
+	chInput := buildClickHouseInput(opt)
+	klog.InfoS("ClickHouse configuration", "database", chInput.Database, "databaseURL", chInput.DatabaseURL, "debug", chInput.Debug, "compress", *chInput.Compress, "commitInterval", chInput.CommitInterval)
+	chExportProcess, err := newClickHouseClient(chInput)
+	if err != nil {
+		return nil, err
+	}
+	return &ClickHouseExporter{
+		chInput:           chInput,
+		clickHouseProcess: chExportProcess,
+	}, nil
+}
+
+func (e *ClickHouseExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
+	e.clickHouseProcess.CacheRecord(record)
+	return nil
+}
+
+func (e *ClickHouseExporter) Start() {
+	go e.clickHouseProcess.Start()
+	e.started = true
+}
+
+func (e *ClickHouseExporter) Stop() {
+	if e.started {
+		e.clickHouseProcess.Stop()
+		e.started = false
+	}
+}
+
+func (e *ClickHouseExporter) UpdateOptions(opt *options.Options) {
+	chInput := buildClickHouseInput(opt)
+	if chInput.Database == e.chInput.Database &&
+		chInput.DatabaseURL == e.chInput.DatabaseURL &&
+		chInput.Username == e.chInput.Username &&
+		chInput.Password == e.chInput.Password &&
+		chInput.Debug == e.chInput.Debug &&
+		*chInput.Compress == *e.chInput.Compress &&
+		chInput.CommitInterval == e.chInput.CommitInterval {
+		return
+	}
+	chExportProcess, err := newClickHouseClient(chInput)
+	if err != nil {
+		klog.ErrorS(err, "Error when applying new ClickHouse configuration, keeping the current one")
+		return
+	}
+	// The records cached by the current process are committed when it is stopped.
+	wasStarted := e.started
+	e.Stop()
+	e.chInput = chInput
+	e.clickHouseProcess = chExportProcess
+	if wasStarted {
+		e.Start()
+	}
+	klog.InfoS("New ClickHouse configuration", "database", chInput.Database, "databaseURL", chInput.DatabaseURL, "debug", chInput.Debug, "compress", *chInput.Compress, "commitInterval", chInput.CommitInterval)
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// Interface is the interface that all the flow record exporters (sinks) of the Flow Aggregator
+// must implement. All the methods are called from the same goroutine.
+type Interface interface {
+	// Start starts the exporter, including any background routine it may need.
+	Start()
+	// Stop stops the exporter. Records which have been buffered by the exporter are flushed
+	// on a best-effort basis.
+	Stop()
+	// AddRecord exports an aggregated flow record, or buffers it until the next export. The
+	// record may be modified after AddRecord returns, so it must not be retained.
+	AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error
+	// UpdateOptions applies a new configuration to a running exporter.
+	UpdateOptions(opt *options.Options)
+}
@@ -0,0 +1,408 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/google/uuid"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"github.com/vmware/go-ipfix/pkg/exporter"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/clusteridentity"
+	"antrea.io/antrea/pkg/flowaggregator/infoelements"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/ipfix"
+)
+
+// IPFIXExporter exports flow records to an external flow collector, using the IPFIX or the JSON
+// format.
+type IPFIXExporter struct {
+	externalFlowCollectorAddr  string
+	externalFlowCollectorProto string
+	exportingProcess           ipfix.IPFIXExportingProcess
+	sendJSONRecord             bool
+	includePodLabels           bool
+	includeDestinationFQDN     bool
+	includeTCPMetrics          bool
+	observationDomainID        uint32
+	templateIDv4               uint16
+	templateIDv6               uint16
+	registry                   ipfix.IPFIXRegistry
+	set                        ipfixentities.Set
+	// elementsListv4 and elementsListv6 are the elements of the templates sent to the
+	// collector. They are used to order the elements of the exported data records.
+	elementsListv4 []ipfixentities.InfoElementWithValue
+	elementsListv6 []ipfixentities.InfoElementWithValue
+}
+
+// genObservationDomainID generates an IPFIX Observation Domain ID when one is not provided by the
+// user through the flow aggregator configuration. It will first try to generate one
+// deterministically based on the cluster UUID (if available, with a timeout of 10s). Otherwise, it
+// will generate a random one. The cluster UUID should be available if Antrea is deployed to the
+// cluster ahead of the flow aggregator, which is the expectation since when deploying flow
+// aggregator as a Pod, networking needs to be configured by the CNI plugin.
+func genObservationDomainID(k8sClient kubernetes.Interface) uint32 {
+	const retryInterval = time.Second
+	const timeout = 10 * time.Second
+	const defaultAntreaNamespace = "kube-system"
+
+	clusterIdentityProvider := clusteridentity.NewClusterIdentityProvider(
+		defaultAntreaNamespace,
+		clusteridentity.DefaultClusterIdentityConfigMapName,
+		k8sClient,
+	)
+	var clusterUUID uuid.UUID
+	if err := wait.PollImmediate(retryInterval, timeout, func() (bool, error) {
+		clusterIdentity, _, err := clusterIdentityProvider.Get()
+		if err != nil {
+			return false, nil
+		}
+		clusterUUID = clusterIdentity.UUID
+		return true, nil
+	}); err != nil {
+		klog.Warningf(
+			"Unable to retrieve cluster UUID after %v (does ConfigMap '%s/%s' exist?); will generate a random observation domain ID",
+			timeout, defaultAntreaNamespace, clusteridentity.DefaultClusterIdentityConfigMapName,
+		)
+		clusterUUID = uuid.New()
+	}
+	h := fnv.New32()
+	h.Write(clusterUUID[:])
+	observationDomainID := h.Sum32()
+	return observationDomainID
+}
+
+func NewIPFIXExporter(k8sClient kubernetes.Interface, opt *options.Options, registry ipfix.IPFIXRegistry) *IPFIXExporter {
+	var observationDomainID uint32
+	if opt.Config.FlowCollector.ObservationDomainID != nil {
+		observationDomainID = *opt.Config.FlowCollector.ObservationDomainID
+	} else {
+		observationDomainID = genObservationDomainID(k8sClient)
+	}
+	klog.InfoS("Flow aggregator Observation Domain ID", "domainID", observationDomainID)
+
+	return &IPFIXExporter{
+		externalFlowCollectorAddr:  opt.ExternalFlowCollectorAddr,
+		externalFlowCollectorProto: opt.ExternalFlowCollectorProto,
+		sendJSONRecord:             opt.Config.FlowCollector.RecordFormat == "JSON",
+		includePodLabels:           opt.Config.RecordContents.PodLabels,
+		includeDestinationFQDN:     opt.Config.FlowCollector.IncludeDestinationFQDN,
+		includeTCPMetrics:          opt.Config.FlowCollector.IncludeTCPMetrics,
+		observationDomainID:        observationDomainID,
+		registry:                   registry,
+		set:                        ipfixentities.NewSet(false),
+	}
+}
+
+// Start is a no-op: the connection to the flow collector is established when the first record is
+// exported.
+func (e *IPFIXExporter) Start() {}
+
+func (e *IPFIXExporter) Stop() {
+	if e.exportingProcess != nil {
+		e.exportingProcess.CloseConnToCollector()
+		e.exportingProcess = nil
+	}
+}
+
+func (e *IPFIXExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
+	if err := e.sendRecord(record, isRecordIPv6); err != nil {
+		// If there is an error when sending flow records because of intermittent connectivity,
+		// we reset the connection to the IPFIX collector. The connection will be
+		// re-initialized when the next record is exported.
+		if e.exportingProcess != nil {
+			e.exportingProcess.CloseConnToCollector()
+			e.exportingProcess = nil
+		}
+		return fmt.Errorf("error when sending IPFIX record: %v", err)
+	}
+	return nil
+}
+
+func (e *IPFIXExporter) UpdateOptions(opt *options.Options) {
+	sendJSONRecord := opt.Config.FlowCollector.RecordFormat == "JSON"
+	if opt.ExternalFlowCollectorAddr == e.externalFlowCollectorAddr &&
+		opt.ExternalFlowCollectorProto == e.externalFlowCollectorProto &&
+		sendJSONRecord == e.sendJSONRecord &&
+		opt.Config.RecordContents.PodLabels == e.includePodLabels &&
+		opt.Config.FlowCollector.IncludeDestinationFQDN == e.includeDestinationFQDN &&
+		opt.Config.FlowCollector.IncludeTCPMetrics == e.includeTCPMetrics {
+		return
+	}
+	e.externalFlowCollectorAddr = opt.ExternalFlowCollectorAddr
+	e.externalFlowCollectorProto = opt.ExternalFlowCollectorProto
+	e.sendJSONRecord = sendJSONRecord
+	e.includePodLabels = opt.Config.RecordContents.PodLabels
+	e.includeDestinationFQDN = opt.Config.FlowCollector.IncludeDestinationFQDN
+	e.includeTCPMetrics = opt.Config.FlowCollector.IncludeTCPMetrics
+	// The connection (and the templates) will be re-initialized when the next record is
+	// exported.
+	e.Stop()
+	klog.InfoS("New IPFIXExporter configuration", "collectorAddress", e.externalFlowCollectorAddr, "collectorProtocol", e.externalFlowCollectorProto, "sendJSON", e.sendJSONRecord)
+}
+
+func (e *IPFIXExporter) sendRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
+	if e.exportingProcess == nil {
+		if err := e.initExportingProcess(); err != nil {
+			return err
+		}
+	}
+	templateID := e.templateIDv4
+	templateElements := e.elementsListv4
+	if isRecordIPv6 {
+		templateID = e.templateIDv6
+		templateElements = e.elementsListv6
+	}
+	// TODO: more records per data set will be supported when go-ipfix supports size check when adding records
+	e.set.ResetSet()
+	if err := e.set.PrepareSet(ipfixentities.Data, templateID); err != nil {
+		return err
+	}
+	if err := e.set.AddRecord(getTemplateOrderedElements(record, templateElements), templateID); err != nil {
+		return err
+	}
+	sentBytes, err := e.exportingProcess.SendSet(e.set)
+	if err != nil {
+		return err
+	}
+	klog.V(4).InfoS("Data set sent successfully", "bytes sent", sentBytes)
+	return nil
+}
+
+// getTemplateOrderedElements returns the elements of the record in the order of the template
+// elements. The records received from the Flow Exporters may not match the template, e.g. when
+// the Flow Exporters run a different version of Antrea, or when some elements are excluded by the
+// configuration: the elements missing from the record are exported with their zero value, and
+// the elements missing from the template are not exported.
+func getTemplateOrderedElements(record ipfixentities.Record, templateElements []ipfixentities.InfoElementWithValue) []ipfixentities.InfoElementWithValue {
+	recordElements := record.GetOrderedElementList()
+	elementsByName := make(map[string]ipfixentities.InfoElementWithValue, len(recordElements))
+	for _, element := range recordElements {
+		elementsByName[element.GetName()] = element
+	}
+	elements := make([]ipfixentities.InfoElementWithValue, 0, len(templateElements))
+	for _, templateElement := range templateElements {
+		if element, ok := elementsByName[templateElement.GetName()]; ok {
+			elements = append(elements, element)
+		} else {
+			elements = append(elements, templateElement)
+		}
+	}
+	return elements
+}
+
+func (e *IPFIXExporter) initExportingProcess() error {
+	// TODO: This code can be further simplified by changing the go-ipfix API to accept
+	// externalFlowCollectorAddr and externalFlowCollectorProto instead of net.Addr input.
+	var expInput exporter.ExporterInput
+	if e.externalFlowCollectorProto == "tcp" {
+		// TCP transport does not need any tempRefTimeout, so sending 0.
+		expInput = exporter.ExporterInput{
+			CollectorAddress:    e.externalFlowCollectorAddr,
+			CollectorProtocol:   e.externalFlowCollectorProto,
+			ObservationDomainID: e.observationDomainID,
+			TempRefTimeout:      0,
+			IsEncrypted:         false,
+			SendJSONRecord:      e.sendJSONRecord,
+		}
+	} else {
+		// For UDP transport, hardcoding tempRefTimeout value as 1800s. So we will send out template every 30 minutes.
+		expInput = exporter.ExporterInput{
+			CollectorAddress:    e.externalFlowCollectorAddr,
+			CollectorProtocol:   e.externalFlowCollectorProto,
+			ObservationDomainID: e.observationDomainID,
+			TempRefTimeout:      1800,
+			IsEncrypted:         false,
+			SendJSONRecord:      e.sendJSONRecord,
+		}
+	}
+	ep, err := ipfix.NewIPFIXExportingProcess(expInput)
+	if err != nil {
+		return fmt.Errorf("got error when initializing IPFIX exporting process: %v", err)
+	}
+	e.exportingProcess = ep
+	// Currently, we send two templates for IPv4 and IPv6 regardless of the IP families supported by cluster
+	if err = e.createAndSendTemplate(false); err != nil {
+		return err
+	}
+	if err = e.createAndSendTemplate(true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (e *IPFIXExporter) createAndSendTemplate(isRecordIPv6 bool) error {
+	templateID := e.exportingProcess.NewTemplateID()
+	recordIPFamily := "IPv4"
+	if isRecordIPv6 {
+		recordIPFamily = "IPv6"
+	}
+	if isRecordIPv6 {
+		e.templateIDv6 = templateID
+	} else {
+		e.templateIDv4 = templateID
+	}
+	bytesSent, err := e.sendTemplateSet(isRecordIPv6)
+	if err != nil {
+		e.exportingProcess.CloseConnToCollector()
+		e.exportingProcess = nil
+		e.set.ResetSet()
+		return fmt.Errorf("sending %s template set failed, err: %v", recordIPFamily, err)
+	}
+	klog.V(2).InfoS("Exporting process initialized", "bytesSent", bytesSent, "templateSetIPFamily", recordIPFamily)
+	return nil
+}
+
+func (e *IPFIXExporter) sendTemplateSet(isIPv6 bool) (int, error) {
+	elements := make([]ipfixentities.InfoElementWithValue, 0)
+	ianaInfoElements := infoelements.IANAInfoElementsIPv4
+	antreaInfoElements := infoelements.AntreaInfoElementsIPv4
+	templateID := e.templateIDv4
+	if isIPv6 {
+		ianaInfoElements = infoelements.IANAInfoElementsIPv6
+		antreaInfoElements = infoelements.AntreaInfoElementsIPv6
+		templateID = e.templateIDv6
+	}
+	for _, ie := range ianaInfoElements {
+		ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.IANAEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+	}
+	for _, ie := range infoelements.IANAReverseInfoElements {
+		ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.IANAReversedEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+	}
+	for _, ie := range antreaInfoElements {
+		ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+	}
+	// The order of source and destination stats elements needs to match the order specified in
+	// addFieldsForStatsAggregation method in go-ipfix aggregation process.
+	for i := range infoelements.StatsElementList {
+		// Add Antrea source stats fields
+		ieName := infoelements.AntreaSourceStatsElementList[i]
+		ie, err := e.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+		// Add Antrea destination stats fields
+		ieName = infoelements.AntreaDestinationStatsElementList[i]
+		ie, err = e.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+	}
+	for _, ie := range infoelements.AntreaFlowEndSecondsElementList {
+		ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+	}
+	for i := range infoelements.AntreaThroughputElementList {
+		// Add common throughput fields
+		ieName := infoelements.AntreaThroughputElementList[i]
+		ie, err := e.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+		// Add source node specific throughput fields
+		ieName = infoelements.AntreaSourceThroughputElementList[i]
+		ie, err = e.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+		// Add destination node specific throughput fields
+		ieName = infoelements.AntreaDestinationThroughputElementList[i]
+		ie, err = e.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
+		if err != nil {
+			return 0, err
+		}
+		elements = append(elements, ie)
+	}
+	if e.includePodLabels {
+		for _, ie := range infoelements.AntreaLabelsElementList {
+			ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
+			if err != nil {
+				return 0, err
+			}
+			elements = append(elements, ie)
+		}
+	}
+	if e.includeDestinationFQDN {
+		for _, ie := range infoelements.AntreaDestinationFQDNElementList {
+			ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
+			if err != nil {
+				return 0, err
+			}
+			elements = append(elements, ie)
+		}
+	}
+	if e.includeTCPMetrics {
+		for _, ie := range infoelements.AntreaTCPMetricsElementList {
+			ie, err := e.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
+			if err != nil {
+				return 0, err
+			}
+			elements = append(elements, ie)
+		}
+	}
+	e.set.ResetSet()
+	if err := e.set.PrepareSet(ipfixentities.Template, templateID); err != nil {
+		return 0, err
+	}
+	err := e.set.AddRecord(elements, templateID)
+	if err != nil {
+		return 0, fmt.Errorf("error when adding record to set, error: %v", err)
+	}
+	if isIPv6 {
+		e.elementsListv6 = elements
+	} else {
+		e.elementsListv4 = elements
+	}
+	bytesSent, err := e.exportingProcess.SendSet(e.set)
+	return bytesSent, err
+}
+
+func (e *IPFIXExporter) createInfoElementForTemplateSet(ieName string, enterpriseID uint32) (ipfixentities.InfoElementWithValue, error) {
+	element, err := e.registry.GetInfoElement(ieName, enterpriseID)
+	if err != nil {
+		return nil, fmt.Errorf("%s not present. returned error: %v", ieName, err)
+	}
+	ie, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ie, nil
+}
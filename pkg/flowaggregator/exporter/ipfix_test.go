@@ -0,0 +1,267 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixentitiestesting "github.com/vmware/go-ipfix/pkg/entities/testing"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/infoelements"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/ipfix"
+	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
+)
+
+const (
+	testTemplateIDv4        = uint16(256)
+	testTemplateIDv6        = uint16(257)
+	testObservationDomainID = 0xabcd
+)
+
+func init() {
+	ipfix.NewIPFIXRegistry().LoadRegistry()
+}
+
+func createElement(name string, enterpriseID uint32) ipfixentities.InfoElementWithValue {
+	element, _ := ipfixregistry.GetInfoElement(name, enterpriseID)
+	ieWithValue, _ := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+	return ieWithValue
+}
+
+func TestIPFIXExporter_sendTemplateSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
+	mockIPFIXRegistry := ipfixtest.NewMockIPFIXRegistry(ctrl)
+	mockTempSet := ipfixentitiestesting.NewMockSet(ctrl)
+
+	newIPFIXExporter := func(includePodLabels, includeDestinationFQDN, includeTCPMetrics bool) *IPFIXExporter {
+		return &IPFIXExporter{
+			externalFlowCollectorAddr:  "",
+			externalFlowCollectorProto: "",
+			exportingProcess:           mockIPFIXExpProc,
+			templateIDv4:               testTemplateIDv4,
+			templateIDv6:               testTemplateIDv6,
+			registry:                   mockIPFIXRegistry,
+			set:                        mockTempSet,
+			includePodLabels:           includePodLabels,
+			includeDestinationFQDN:     includeDestinationFQDN,
+			includeTCPMetrics:          includeTCPMetrics,
+			observationDomainID:        testObservationDomainID,
+		}
+	}
+
+	testcases := []struct {
+		isIPv6                 bool
+		includePodLabels       bool
+		includeDestinationFQDN bool
+		includeTCPMetrics      bool
+	}{
+		{false, true, false, false},
+		{true, true, false, false},
+		{false, false, false, false},
+		{true, false, false, false},
+		{false, true, true, false},
+		{true, false, true, false},
+		{false, false, false, true},
+		{true, true, true, true},
+	}
+
+	for _, tc := range testcases {
+		e := newIPFIXExporter(tc.includePodLabels, tc.includeDestinationFQDN, tc.includeTCPMetrics)
+		ianaInfoElements := infoelements.IANAInfoElementsIPv4
+		antreaInfoElements := infoelements.AntreaInfoElementsIPv4
+		testTemplateID := e.templateIDv4
+		if tc.isIPv6 {
+			ianaInfoElements = infoelements.IANAInfoElementsIPv6
+			antreaInfoElements = infoelements.AntreaInfoElementsIPv6
+			testTemplateID = e.templateIDv6
+		}
+		// Following consists of all elements that are in ianaInfoElements and antreaInfoElements (globals)
+		// Only the element name is needed, other arguments have dummy values.
+		elemList := make([]ipfixentities.InfoElementWithValue, 0)
+		for _, ie := range ianaInfoElements {
+			elemList = append(elemList, createElement(ie, ipfixregistry.IANAEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.IANAEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+		}
+		for _, ie := range infoelements.IANAReverseInfoElements {
+			elemList = append(elemList, createElement(ie, ipfixregistry.IANAReversedEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.IANAReversedEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+		}
+		for _, ie := range antreaInfoElements {
+			elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+		}
+		for i := range infoelements.StatsElementList {
+			elemList = append(elemList, createElement(infoelements.AntreaSourceStatsElementList[i], ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(infoelements.AntreaSourceStatsElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+			elemList = append(elemList, createElement(infoelements.AntreaDestinationStatsElementList[i], ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(infoelements.AntreaDestinationStatsElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+		}
+		for _, ie := range infoelements.AntreaFlowEndSecondsElementList {
+			elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+		}
+		for i := range infoelements.AntreaThroughputElementList {
+			elemList = append(elemList, createElement(infoelements.AntreaThroughputElementList[i], ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(infoelements.AntreaThroughputElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+			elemList = append(elemList, createElement(infoelements.AntreaSourceThroughputElementList[i], ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(infoelements.AntreaSourceThroughputElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+			elemList = append(elemList, createElement(infoelements.AntreaDestinationThroughputElementList[i], ipfixregistry.AntreaEnterpriseID))
+			mockIPFIXRegistry.EXPECT().GetInfoElement(infoelements.AntreaDestinationThroughputElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+		}
+		if tc.includePodLabels {
+			for _, ie := range infoelements.AntreaLabelsElementList {
+				elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
+				mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+			}
+		}
+		if tc.includeDestinationFQDN {
+			for _, ie := range infoelements.AntreaDestinationFQDNElementList {
+				elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
+				mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+			}
+		}
+		if tc.includeTCPMetrics {
+			for _, ie := range infoelements.AntreaTCPMetricsElementList {
+				elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
+				mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
+			}
+		}
+		mockTempSet.EXPECT().ResetSet()
+		mockTempSet.EXPECT().PrepareSet(ipfixentities.Template, testTemplateID).Return(nil)
+		mockTempSet.EXPECT().AddRecord(elemList, testTemplateID).Return(nil)
+		// Passing 0 for sentBytes as it is not used anywhere in the test. If this not a call to mock, the actual sentBytes
+		// above elements: ianaInfoElements, ianaReverseInfoElements and antreaInfoElements.
+		mockIPFIXExpProc.EXPECT().SendSet(mockTempSet).Return(0, nil)
+
+		_, err := e.sendTemplateSet(tc.isIPv6)
+		assert.NoErrorf(t, err, "Error in sending template record: %v, isIPv6: %v", err, tc.isIPv6)
+		templateElements := e.elementsListv4
+		if tc.isIPv6 {
+			templateElements = e.elementsListv6
+		}
+		assert.Equal(t, elemList, templateElements)
+	}
+}
+
+func TestIPFIXExporter_AddRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
+	mockDataSet := ipfixentitiestesting.NewMockSet(ctrl)
+	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
+
+	e := &IPFIXExporter{
+		exportingProcess:    mockIPFIXExpProc,
+		templateIDv4:        testTemplateIDv4,
+		templateIDv6:        testTemplateIDv6,
+		set:                 mockDataSet,
+		observationDomainID: testObservationDomainID,
+	}
+
+	for _, isIPv6 := range []bool{false, true} {
+		templateID := testTemplateIDv4
+		if isIPv6 {
+			templateID = testTemplateIDv6
+		}
+		elementList := make([]ipfixentities.InfoElementWithValue, 0)
+		mockDataSet.EXPECT().ResetSet()
+		mockDataSet.EXPECT().PrepareSet(ipfixentities.Data, templateID).Return(nil)
+		mockRecord.EXPECT().GetOrderedElementList().Return(elementList)
+		mockDataSet.EXPECT().AddRecord(elementList, templateID).Return(nil)
+		mockIPFIXExpProc.EXPECT().SendSet(mockDataSet).Return(0, nil)
+		assert.NoError(t, e.AddRecord(mockRecord, isIPv6))
+	}
+
+	// The connection to the collector is reset when the record cannot be sent.
+	elementList := make([]ipfixentities.InfoElementWithValue, 0)
+	mockDataSet.EXPECT().ResetSet()
+	mockDataSet.EXPECT().PrepareSet(ipfixentities.Data, testTemplateIDv4).Return(nil)
+	mockRecord.EXPECT().GetOrderedElementList().Return(elementList)
+	mockDataSet.EXPECT().AddRecord(elementList, testTemplateIDv4).Return(nil)
+	mockIPFIXExpProc.EXPECT().SendSet(mockDataSet).Return(0, fmt.Errorf("connection reset"))
+	mockIPFIXExpProc.EXPECT().CloseConnToCollector()
+	assert.Error(t, e.AddRecord(mockRecord, false))
+	assert.Nil(t, e.exportingProcess)
+}
+
+func TestGetTemplateOrderedElements(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	templateElements := []ipfixentities.InfoElementWithValue{
+		createElement("sourcePodName", ipfixregistry.AntreaEnterpriseID),
+		createElement("destinationFQDN", ipfixregistry.AntreaEnterpriseID),
+		createElement("flowType", ipfixregistry.AntreaEnterpriseID),
+	}
+	flowTypeElem := createElement("flowType", ipfixregistry.AntreaEnterpriseID)
+	flowTypeElem.SetUnsigned8Value(2)
+	sourcePodNameElem := createElement("sourcePodName", ipfixregistry.AntreaEnterpriseID)
+	sourcePodNameElem.SetStringValue("pod1")
+	tcpStateElem := createElement("tcpState", ipfixregistry.AntreaEnterpriseID)
+	tcpStateElem.SetStringValue("ESTABLISHED")
+	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
+	mockRecord.EXPECT().GetOrderedElementList().Return([]ipfixentities.InfoElementWithValue{flowTypeElem, sourcePodNameElem, tcpStateElem})
+
+	// The elements are reordered to match the template, the elements missing from the record are
+	// exported with their zero value and the elements missing from the template are dropped.
+	elements := getTemplateOrderedElements(mockRecord, templateElements)
+	assert.Equal(t, []ipfixentities.InfoElementWithValue{sourcePodNameElem, templateElements[1], flowTypeElem}, elements)
+}
+
+func TestIPFIXExporter_UpdateOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
+	opt := &options.Options{
+		Config: &flowaggregatorconfig.FlowAggregatorConfig{
+			FlowCollector: flowaggregatorconfig.FlowCollectorConfig{
+				Enable:       true,
+				Address:      "10.0.0.1:4739:tcp",
+				RecordFormat: "IPFIX",
+			},
+		},
+		ExternalFlowCollectorAddr:  "10.0.0.1:4739",
+		ExternalFlowCollectorProto: "tcp",
+	}
+	e := &IPFIXExporter{
+		externalFlowCollectorAddr:  opt.ExternalFlowCollectorAddr,
+		externalFlowCollectorProto: opt.ExternalFlowCollectorProto,
+		exportingProcess:           mockIPFIXExpProc,
+	}
+
+	// The connection is kept when the configuration does not change.
+	e.UpdateOptions(opt)
+	assert.Equal(t, mockIPFIXExpProc, e.exportingProcess)
+
+	opt.Config.FlowCollector.RecordFormat = "JSON"
+	opt.ExternalFlowCollectorAddr = "10.0.0.2:4739"
+	mockIPFIXExpProc.EXPECT().CloseConnToCollector()
+	e.UpdateOptions(opt)
+	assert.Nil(t, e.exportingProcess)
+	assert.Equal(t, "10.0.0.2:4739", e.externalFlowCollectorAddr)
+	assert.True(t, e.sendJSONRecord)
+}
@@ -0,0 +1,436 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Shopify/sarama"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"github.com/xdg-go/scram"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/klog/v2"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	flowrecordpb "antrea.io/antrea/pkg/flowaggregator/flowrecord/protobuf"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	// maxKafkaBufferedRecords is the maximum number of records buffered by the Kafka exporter
+	// while the Kafka brokers are unavailable. The oldest records are dropped first.
+	maxKafkaBufferedRecords = 100000
+	kafkaRequestTimeout     = 10 * time.Second
+	kafkaClientID           = "antrea-flow-aggregator"
+)
+
+// newKafkaProducer can be overridden in tests.
+var newKafkaProducer = sarama.NewSyncProducer
+
+// kafkaSettings are the settings of the Kafka exporter which require a new producer when they are
+// updated.
+type kafkaSettings struct {
+	brokers      []string
+	requiredAcks int16
+	tls          flowaggregatorconfig.KafkaTLSConfig
+	sasl         flowaggregatorconfig.KafkaSASLConfig
+}
+
+// KafkaExporter exports flow records to a Kafka topic, with one JSON or Protobuf encoded record per
+// message. Records are buffered and produced in batches by a background goroutine, either when the
+// flush interval expires or when a full batch is available.
+type KafkaExporter struct {
+	settings      kafkaSettings
+	topic         string
+	recordFormat  string
+	maxBatchSize  int
+	flushInterval time.Duration
+	producerCfg   *sarama.Config
+	// producer is created by the background goroutine, and recreated after it failed to
+	// produce records. It is nil when the brokers could not be reached.
+	producer sarama.SyncProducer
+
+	// mutex protects buffer, which is shared with the background goroutine.
+	mutex   sync.Mutex
+	buffer  [][]byte
+	flushCh chan struct{}
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+}
+
+func buildKafkaSettings(opt *options.Options) kafkaSettings {
+	return kafkaSettings{
+		brokers:      opt.Config.Kafka.Brokers,
+		requiredAcks: *opt.Config.Kafka.RequiredAcks,
+		tls:          opt.Config.Kafka.TLS,
+		sasl:         opt.Config.Kafka.SASL,
+	}
+}
+
+// buildProducerConfig returns the configuration of the Kafka producer. The SASL credentials are
+// read from the environment, as they are provided through a Secret.
+func buildProducerConfig(settings kafkaSettings) (*sarama.Config, error) {
+	cfg := sarama.NewConfig()
+	cfg.ClientID = kafkaClientID
+	cfg.Net.DialTimeout = kafkaRequestTimeout
+	cfg.Net.ReadTimeout = kafkaRequestTimeout
+	cfg.Net.WriteTimeout = kafkaRequestTimeout
+	cfg.Producer.RequiredAcks = sarama.RequiredAcks(settings.requiredAcks)
+	cfg.Producer.Timeout = kafkaRequestTimeout
+	// Required by the SyncProducer. Failed records are buffered by the exporter and retried
+	// during the next flush.
+	cfg.Producer.Return.Successes = true
+	if settings.tls.Enable {
+		tlsConfig, err := buildKafkaTLSConfig(settings.tls)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Net.TLS.Enable = true
+		cfg.Net.TLS.Config = tlsConfig
+	}
+	if settings.sasl.Enable {
+		cfg.Net.SASL.Enable = true
+		cfg.Net.SASL.User = os.Getenv("KAFKA_SASL_USERNAME")
+		cfg.Net.SASL.Password = os.Getenv("KAFKA_SASL_PASSWORD")
+		cfg.Net.SASL.Mechanism = sarama.SASLMechanism(settings.sasl.Mechanism)
+		switch cfg.Net.SASL.Mechanism {
+		case sarama.SASLTypeSCRAMSHA256:
+			cfg.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGenerator: scram.SHA256}
+			}
+		case sarama.SASLTypeSCRAMSHA512:
+			cfg.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &scramClient{hashGenerator: scram.SHA512}
+			}
+		}
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func buildKafkaTLSConfig(c flowaggregatorconfig.KafkaTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402: only applicable for testing purpose, must be set explicitly.
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CACertPath != "" {
+		caCert, err := ioutil.ReadFile(c.CACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading Kafka CA certificate: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificate found in %s", c.CACertPath)
+		}
+	}
+	if c.CertPath != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertPath, c.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when loading Kafka client certificate: %v", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func NewKafkaExporter(opt *options.Options) (*KafkaExporter, error) {
+	settings := buildKafkaSettings(opt)
+	producerCfg, err := buildProducerConfig(settings)
+	if err != nil {
+		return nil, fmt.Errorf("error when building Kafka producer configuration: %v", err)
+	}
+	e := &KafkaExporter{
+		settings:    settings,
+		producerCfg: producerCfg,
+		flushCh:     make(chan struct{}, 1),
+	}
+	e.setOptions(opt)
+	klog.InfoS("Kafka configuration", "brokers", e.settings.brokers, "topic", e.topic, "recordFormat", e.recordFormat, "requiredAcks", e.settings.requiredAcks, "tls", e.settings.tls.Enable, "sasl", e.settings.sasl.Enable, "maxBatchSize", e.maxBatchSize, "flushInterval", e.flushInterval)
+	return e, nil
+}
+
+func (e *KafkaExporter) setOptions(opt *options.Options) {
+	e.topic = opt.Config.Kafka.Topic
+	e.recordFormat = opt.Config.Kafka.RecordFormat
+	e.maxBatchSize = opt.Config.Kafka.MaxBatchSize
+	e.flushInterval = opt.KafkaFlushInterval
+}
+
+func (e *KafkaExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
+	msg, err := e.encodeRecord(flowrecord.GetFlowRecord(record))
+	if err != nil {
+		return err
+	}
+	e.mutex.Lock()
+	e.buffer = append(e.buffer, msg)
+	e.dropOldestRecordsLocked()
+	batchReady := len(e.buffer) >= e.maxBatchSize
+	e.mutex.Unlock()
+	if batchReady {
+		select {
+		case e.flushCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *KafkaExporter) encodeRecord(r *flowrecord.FlowRecord) ([]byte, error) {
+	if e.recordFormat == "Protobuf" {
+		return proto.Marshal(flowRecordToProtobuf(r))
+	}
+	return json.Marshal(r)
+}
+
+func (e *KafkaExporter) Start() {
+	if e.stopCh != nil {
+		return
+	}
+	e.stopCh = make(chan struct{})
+	e.doneCh = make(chan struct{})
+	go e.run(e.stopCh, e.doneCh)
+}
+
+// Stop stops the background goroutine, after a last attempt to produce the buffered records.
+// Records which could not be produced are kept in the buffer, in case the exporter is restarted.
+func (e *KafkaExporter) Stop() {
+	if e.stopCh == nil {
+		return
+	}
+	close(e.stopCh)
+	<-e.doneCh
+	e.stopCh = nil
+	e.doneCh = nil
+	e.closeProducer()
+}
+
+func (e *KafkaExporter) UpdateOptions(opt *options.Options) {
+	settings := buildKafkaSettings(opt)
+	settingsChanged := !reflect.DeepEqual(settings, e.settings)
+	if !settingsChanged &&
+		opt.Config.Kafka.Topic == e.topic &&
+		opt.Config.Kafka.RecordFormat == e.recordFormat &&
+		opt.Config.Kafka.MaxBatchSize == e.maxBatchSize &&
+		opt.KafkaFlushInterval == e.flushInterval {
+		return
+	}
+	var producerCfg *sarama.Config
+	if settingsChanged {
+		var err error
+		if producerCfg, err = buildProducerConfig(settings); err != nil {
+			klog.ErrorS(err, "Error when applying new Kafka configuration, keeping the current one")
+			return
+		}
+	}
+	// Stopping the exporter produces the buffered records with the current configuration.
+	wasStarted := e.stopCh != nil
+	e.Stop()
+	if producerCfg != nil {
+		e.settings = settings
+		e.producerCfg = producerCfg
+	}
+	e.setOptions(opt)
+	if wasStarted {
+		e.Start()
+	}
+	klog.InfoS("New Kafka configuration", "brokers", e.settings.brokers, "topic", e.topic, "recordFormat", e.recordFormat, "requiredAcks", e.settings.requiredAcks, "tls", e.settings.tls.Enable, "sasl", e.settings.sasl.Enable, "maxBatchSize", e.maxBatchSize, "flushInterval", e.flushInterval)
+}
+
+func (e *KafkaExporter) run(stopCh <-chan struct{}, doneCh chan<- struct{}) {
+	defer close(doneCh)
+	flushTicker := time.NewTicker(e.flushInterval)
+	defer flushTicker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			e.flush()
+			return
+		case <-flushTicker.C:
+			e.flush()
+		case <-e.flushCh:
+			e.flush()
+		}
+	}
+}
+
+// flush produces all the buffered records, in batches of maxBatchSize records. If some records of
+// a batch cannot be produced, they are put back at the front of the buffer and will be retried
+// during the next flush.
+func (e *KafkaExporter) flush() {
+	for {
+		e.mutex.Lock()
+		n := len(e.buffer)
+		if n > e.maxBatchSize {
+			n = e.maxBatchSize
+		}
+		batch := e.buffer[:n]
+		e.buffer = e.buffer[n:]
+		e.mutex.Unlock()
+		if n == 0 {
+			return
+		}
+		failed, err := e.produce(batch)
+		if err != nil {
+			klog.ErrorS(err, "Error when producing flow records to Kafka", "topic", e.topic, "count", n, "failed", len(failed))
+			e.mutex.Lock()
+			buffer := make([][]byte, 0, len(failed)+len(e.buffer))
+			e.buffer = append(append(buffer, failed...), e.buffer...)
+			e.dropOldestRecordsLocked()
+			e.mutex.Unlock()
+			// The metadata of the producer may be stale, e.g. because the leader of a
+			// partition changed. A new producer is created for the next flush.
+			e.closeProducer()
+			return
+		}
+		klog.V(4).InfoS("Produced flow records to Kafka", "topic", e.topic, "count", n)
+	}
+}
+
+// produce produces a batch of records and returns the records which could not be produced.
+func (e *KafkaExporter) produce(batch [][]byte) ([][]byte, error) {
+	if e.producer == nil {
+		producer, err := newKafkaProducer(e.settings.brokers, e.producerCfg)
+		if err != nil {
+			return batch, err
+		}
+		e.producer = producer
+	}
+	msgs := make([]*sarama.ProducerMessage, len(batch))
+	for i := range batch {
+		msgs[i] = &sarama.ProducerMessage{Topic: e.topic, Value: sarama.ByteEncoder(batch[i])}
+	}
+	err := e.producer.SendMessages(msgs)
+	if err == nil {
+		return nil, nil
+	}
+	var producerErrs sarama.ProducerErrors
+	if !errors.As(err, &producerErrs) {
+		return batch, err
+	}
+	failed := make([][]byte, 0, len(producerErrs))
+	for _, producerErr := range producerErrs {
+		failed = append(failed, producerErr.Msg.Value.(sarama.ByteEncoder))
+	}
+	return failed, producerErrs[0].Err
+}
+
+func (e *KafkaExporter) closeProducer() {
+	if e.producer == nil {
+		return
+	}
+	if err := e.producer.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing Kafka producer")
+	}
+	e.producer = nil
+}
+
+func (e *KafkaExporter) dropOldestRecordsLocked() {
+	if numDropped := len(e.buffer) - maxKafkaBufferedRecords; numDropped > 0 {
+		klog.V(2).InfoS("Kafka buffer is full, dropping oldest flow records", "count", numDropped)
+		e.buffer = e.buffer[numDropped:]
+	}
+}
+
+func flowRecordToProtobuf(r *flowrecord.FlowRecord) *flowrecordpb.FlowRecord {
+	return &flowrecordpb.FlowRecord{
+		FlowStartSeconds:                     timestamppb.New(r.FlowStartSeconds),
+		FlowEndSeconds:                       timestamppb.New(r.FlowEndSeconds),
+		FlowEndSecondsFromSourceNode:         timestamppb.New(r.FlowEndSecondsFromSourceNode),
+		FlowEndSecondsFromDestinationNode:    timestamppb.New(r.FlowEndSecondsFromDestinationNode),
+		FlowEndReason:                        uint32(r.FlowEndReason),
+		SourceIp:                             r.SourceIP,
+		DestinationIp:                        r.DestinationIP,
+		SourceTransportPort:                  uint32(r.SourceTransportPort),
+		DestinationTransportPort:             uint32(r.DestinationTransportPort),
+		ProtocolIdentifier:                   uint32(r.ProtocolIdentifier),
+		PacketTotalCount:                     r.PacketTotalCount,
+		OctetTotalCount:                      r.OctetTotalCount,
+		PacketDeltaCount:                     r.PacketDeltaCount,
+		OctetDeltaCount:                      r.OctetDeltaCount,
+		ReversePacketTotalCount:              r.ReversePacketTotalCount,
+		ReverseOctetTotalCount:               r.ReverseOctetTotalCount,
+		ReversePacketDeltaCount:              r.ReversePacketDeltaCount,
+		ReverseOctetDeltaCount:               r.ReverseOctetDeltaCount,
+		SourcePodName:                        r.SourcePodName,
+		SourcePodNamespace:                   r.SourcePodNamespace,
+		SourceNodeName:                       r.SourceNodeName,
+		DestinationPodName:                   r.DestinationPodName,
+		DestinationPodNamespace:              r.DestinationPodNamespace,
+		DestinationNodeName:                  r.DestinationNodeName,
+		DestinationClusterIp:                 r.DestinationClusterIP,
+		DestinationServicePort:               uint32(r.DestinationServicePort),
+		DestinationServicePortName:           r.DestinationServicePortName,
+		IngressNetworkPolicyName:             r.IngressNetworkPolicyName,
+		IngressNetworkPolicyNamespace:        r.IngressNetworkPolicyNamespace,
+		IngressNetworkPolicyRuleName:         r.IngressNetworkPolicyRuleName,
+		IngressNetworkPolicyRuleAction:       uint32(r.IngressNetworkPolicyRuleAction),
+		IngressNetworkPolicyType:             uint32(r.IngressNetworkPolicyType),
+		EgressNetworkPolicyName:              r.EgressNetworkPolicyName,
+		EgressNetworkPolicyNamespace:         r.EgressNetworkPolicyNamespace,
+		EgressNetworkPolicyRuleName:          r.EgressNetworkPolicyRuleName,
+		EgressNetworkPolicyRuleAction:        uint32(r.EgressNetworkPolicyRuleAction),
+		EgressNetworkPolicyType:              uint32(r.EgressNetworkPolicyType),
+		TcpState:                             r.TCPState,
+		FlowType:                             uint32(r.FlowType),
+		SourcePodLabels:                      r.SourcePodLabels,
+		DestinationPodLabels:                 r.DestinationPodLabels,
+		Throughput:                           r.Throughput,
+		ReverseThroughput:                    r.ReverseThroughput,
+		ThroughputFromSourceNode:             r.ThroughputFromSourceNode,
+		ThroughputFromDestinationNode:        r.ThroughputFromDestinationNode,
+		ReverseThroughputFromSourceNode:      r.ReverseThroughputFromSourceNode,
+		ReverseThroughputFromDestinationNode: r.ReverseThroughputFromDestinationNode,
+		DestinationFqdn:                      r.DestinationFQDN,
+		TcpSmoothedRtt:                       r.TCPSmoothedRTT,
+		TcpRetransmissions:                   r.TCPRetransmissions,
+	}
+}
+
+// scramClient implements sarama.SCRAMClient with the xdg-go/scram library.
+type scramClient struct {
+	hashGenerator scram.HashGeneratorFcn
+	conversation  *scram.ClientConversation
+}
+
+func (c *scramClient) Begin(userName, password, authzID string) error {
+	client, err := c.hashGenerator.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.conversation = client.NewConversation()
+	return nil
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.conversation.Step(challenge)
+}
+
+func (c *scramClient) Done() bool {
+	return c.conversation.Done()
+}
@@ -0,0 +1,272 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentitiestesting "github.com/vmware/go-ipfix/pkg/entities/testing"
+	"google.golang.org/protobuf/proto"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	flowrecordpb "antrea.io/antrea/pkg/flowaggregator/flowrecord/protobuf"
+	flowrecordtesting "antrea.io/antrea/pkg/flowaggregator/flowrecord/testing"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// fakeKafkaProducers records the producers created by the Kafka exporter. Each producer produces
+// the values of its messages to the values channel.
+type fakeKafkaProducers struct {
+	t         *testing.T
+	brokers   [][]string
+	configs   []*sarama.Config
+	producers []*mocks.SyncProducer
+	// err is returned when creating a producer, if not nil.
+	err    error
+	values chan []byte
+}
+
+func (p *fakeKafkaProducers) newProducer(brokers []string, config *sarama.Config) (sarama.SyncProducer, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	producer := mocks.NewSyncProducer(p.t, config)
+	p.brokers = append(p.brokers, brokers)
+	p.configs = append(p.configs, config)
+	p.producers = append(p.producers, producer)
+	return producer, nil
+}
+
+// expectMessages sets the expectations for the next n messages sent by the last producer.
+func (p *fakeKafkaProducers) expectMessages(n int) {
+	producer := p.producers[len(p.producers)-1]
+	for i := 0; i < n; i++ {
+		producer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(val []byte) error {
+			p.values <- val
+			return nil
+		})
+	}
+}
+
+func newTestKafkaExporter(t *testing.T, config string) (*KafkaExporter, *fakeKafkaProducers) {
+	producers := &fakeKafkaProducers{t: t, values: make(chan []byte, 10)}
+	origNewKafkaProducer := newKafkaProducer
+	t.Cleanup(func() { newKafkaProducer = origNewKafkaProducer })
+	newKafkaProducer = producers.newProducer
+	opt, err := options.LoadConfig([]byte(config))
+	require.NoError(t, err)
+	e, err := NewKafkaExporter(opt)
+	require.NoError(t, err)
+	return e, producers
+}
+
+func addTestRecord(t *testing.T, e Interface, ctrl *gomock.Controller) {
+	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
+	flowrecordtesting.PrepareMockIpfixRecord(mockRecord, true)
+	require.NoError(t, e.AddRecord(mockRecord, false))
+}
+
+func TestKafkaExporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, producers := newTestKafkaExporter(t, `
+kafka:
+  enable: true
+  brokers: ["kafka-0:9092", "kafka-1:9092"]
+  topic: antrea-flows
+  maxBatchSize: 2
+  flushInterval: 1h
+`)
+	// Create the producer before the records are added, to set the expectations.
+	_, err := e.produce(nil)
+	require.NoError(t, err)
+	require.Len(t, producers.producers, 1)
+	assert.Equal(t, []string{"kafka-0:9092", "kafka-1:9092"}, producers.brokers[0])
+	assert.Equal(t, sarama.WaitForLocal, producers.configs[0].Producer.RequiredAcks)
+	producers.expectMessages(3)
+	e.Start()
+
+	// A full batch is produced right away.
+	addTestRecord(t, e, ctrl)
+	addTestRecord(t, e, ctrl)
+	addTestRecord(t, e, ctrl)
+	var record flowrecord.FlowRecord
+	require.NoError(t, json.Unmarshal(<-producers.values, &record))
+	assert.Equal(t, uint16(44752), record.SourceTransportPort)
+	assert.Equal(t, "10.10.0.79", record.SourceIP)
+	<-producers.values
+
+	// The remaining records are produced when the exporter is stopped.
+	e.Stop()
+	assert.Len(t, producers.values, 1)
+	assert.Nil(t, e.producer)
+}
+
+func TestKafkaExporterProtobuf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, producers := newTestKafkaExporter(t, `
+kafka:
+  enable: true
+  brokers: ["kafka-0:9092"]
+  recordFormat: Protobuf
+  flushInterval: 1h
+`)
+	_, err := e.produce(nil)
+	require.NoError(t, err)
+	producers.expectMessages(1)
+	addTestRecord(t, e, ctrl)
+	e.flush()
+
+	var record flowrecordpb.FlowRecord
+	require.NoError(t, proto.Unmarshal(<-producers.values, &record))
+	assert.Equal(t, uint32(44752), record.SourceTransportPort)
+	assert.Equal(t, "10.10.0.79", record.SourceIp)
+	assert.Equal(t, int64(1637706961), record.FlowStartSeconds.Seconds)
+	assert.Equal(t, uint32(1520), record.TcpSmoothedRtt)
+}
+
+func TestKafkaExporterProduceError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, producers := newTestKafkaExporter(t, `
+kafka:
+  enable: true
+  brokers: ["kafka-0:9092"]
+  flushInterval: 1h
+`)
+	producers.err = fmt.Errorf("brokers not available")
+	addTestRecord(t, e, ctrl)
+	addTestRecord(t, e, ctrl)
+	e.flush()
+	// The records are kept until they can be produced.
+	assert.Len(t, e.buffer, 2)
+	assert.Empty(t, producers.producers)
+
+	producers.err = nil
+	_, err := e.produce(nil)
+	require.NoError(t, err)
+	// The mock producer stops at the first failed message.
+	producers.producers[0].ExpectSendMessageAndFail(sarama.ErrNotLeaderForPartition)
+	producers.producers[0].ExpectSendMessageAndSucceed()
+	e.flush()
+	assert.Len(t, e.buffer, 2)
+	// A new producer is created after an error.
+	assert.Nil(t, e.producer)
+
+	_, err = e.produce(nil)
+	require.NoError(t, err)
+	producers.expectMessages(2)
+	e.flush()
+	assert.Len(t, producers.values, 2)
+	assert.Empty(t, e.buffer)
+}
+
+func TestKafkaExporterUpdateOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, producers := newTestKafkaExporter(t, `
+kafka:
+  enable: true
+  brokers: ["kafka-0:9092"]
+  flushInterval: 1h
+`)
+	_, err := e.produce(nil)
+	require.NoError(t, err)
+	producers.expectMessages(1)
+	e.Start()
+	defer e.Stop()
+	addTestRecord(t, e, ctrl)
+
+	opt, err := options.LoadConfig([]byte(`
+kafka:
+  enable: true
+  brokers: ["kafka-0:9092"]
+  flushInterval: 1h
+`))
+	require.NoError(t, err)
+	e.UpdateOptions(opt)
+	assert.Len(t, producers.values, 0)
+
+	opt, err = options.LoadConfig([]byte(`
+kafka:
+  enable: true
+  brokers: ["kafka-1:9092"]
+  requiredAcks: -1
+  flushInterval: 10s
+`))
+	require.NoError(t, err)
+	e.UpdateOptions(opt)
+	// The buffered record is produced with the previous configuration.
+	assert.Len(t, producers.values, 1)
+	assert.Equal(t, []string{"kafka-1:9092"}, e.settings.brokers)
+	assert.Equal(t, sarama.WaitForAll, e.producerCfg.Producer.RequiredAcks)
+	assert.Equal(t, 10*time.Second, e.flushInterval)
+
+	// An invalid configuration is ignored.
+	opt, err = options.LoadConfig([]byte(`
+kafka:
+  enable: true
+  brokers: ["kafka-2:9092"]
+  tls:
+    enable: true
+    caCertPath: /does/not/exist
+`))
+	require.NoError(t, err)
+	e.UpdateOptions(opt)
+	assert.Equal(t, []string{"kafka-1:9092"}, e.settings.brokers)
+}
+
+func TestBuildProducerConfig(t *testing.T) {
+	t.Setenv("KAFKA_SASL_USERNAME", "antrea")
+	t.Setenv("KAFKA_SASL_PASSWORD", "secret")
+	opt, err := options.LoadConfig([]byte(`
+kafka:
+  enable: true
+  brokers: ["kafka-0:9093"]
+  tls:
+    enable: true
+    insecureSkipVerify: true
+  sasl:
+    enable: true
+    mechanism: SCRAM-SHA-512
+`))
+	require.NoError(t, err)
+	cfg, err := buildProducerConfig(buildKafkaSettings(opt))
+	require.NoError(t, err)
+	assert.True(t, cfg.Net.TLS.Enable)
+	assert.True(t, cfg.Net.TLS.Config.InsecureSkipVerify)
+	assert.True(t, cfg.Net.SASL.Enable)
+	assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), cfg.Net.SASL.Mechanism)
+	assert.Equal(t, "antrea", cfg.Net.SASL.User)
+	assert.Equal(t, "secret", cfg.Net.SASL.Password)
+	client := cfg.Net.SASL.SCRAMClientGeneratorFunc()
+	require.NoError(t, client.Begin("antrea", "secret", ""))
+	firstMessage, err := client.Step("")
+	require.NoError(t, err)
+	assert.Contains(t, firstMessage, "n=antrea")
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"gopkg.in/natefinch/lumberjack.v2"
+	"k8s.io/klog/v2"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// LogExporter writes flow records to a local file, with one JSON-encoded record per line. The
+// file is rotated based on its size.
+type LogExporter struct {
+	config flowaggregatorconfig.FlowLoggerConfig
+	logger *lumberjack.Logger
+}
+
+func newLumberjackLogger(config flowaggregatorconfig.FlowLoggerConfig) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   config.Path,
+		MaxSize:    int(config.MaxSize),
+		MaxBackups: int(*config.MaxBackups),
+		MaxAge:     int(config.MaxAge),
+		Compress:   *config.Compress,
+	}
+}
+
+func NewLogExporter(opt *options.Options) *LogExporter {
+	config := opt.Config.FlowLogger
+	klog.InfoS("FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", *config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress)
+	return &LogExporter{
+		config: config,
+		logger: newLumberjackLogger(config),
+	}
+}
+
+func (e *LogExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
+	line, err := json.Marshal(flowrecord.GetFlowRecord(record))
+	if err != nil {
+		return err
+	}
+	if _, err := e.logger.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("error when writing flow record to %s: %v", e.config.Path, err)
+	}
+	return nil
+}
+
+// Start is a no-op: the file is opened when the first record is written.
+func (e *LogExporter) Start() {}
+
+func (e *LogExporter) Stop() {
+	if err := e.logger.Close(); err != nil {
+		klog.ErrorS(err, "Error when closing flow log file", "path", e.config.Path)
+	}
+}
+
+func (e *LogExporter) UpdateOptions(opt *options.Options) {
+	config := opt.Config.FlowLogger
+	if config.Path == e.config.Path &&
+		config.MaxSize == e.config.MaxSize &&
+		*config.MaxBackups == *e.config.MaxBackups &&
+		config.MaxAge == e.config.MaxAge &&
+		*config.Compress == *e.config.Compress {
+		return
+	}
+	e.Stop()
+	e.config = config
+	e.logger = newLumberjackLogger(config)
+	klog.InfoS("New FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", *config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress)
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+func TestLogExporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "flows.log")
+	opt, err := options.LoadConfig([]byte(fmt.Sprintf(`
+flowLogger:
+  enable: true
+  path: %s
+`, path)))
+	require.NoError(t, err)
+	e := NewLogExporter(opt)
+	e.Start()
+	addTestRecord(t, e, ctrl)
+	addTestRecord(t, e, ctrl)
+
+	// Records are written to the new file after the path is updated.
+	newPath := filepath.Join(dir, "flows-new.log")
+	newOpt, err := options.LoadConfig([]byte(fmt.Sprintf(`
+flowLogger:
+  enable: true
+  path: %s
+`, newPath)))
+	require.NoError(t, err)
+	e.UpdateOptions(newOpt)
+	addTestRecord(t, e, ctrl)
+	e.Stop()
+
+	for p, numRecords := range map[string]int{path: 2, newPath: 1} {
+		data, err := ioutil.ReadFile(p)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		require.Len(t, lines, numRecords)
+		for _, line := range lines {
+			var record flowrecord.FlowRecord
+			require.NoError(t, json.Unmarshal([]byte(line), &record))
+			assert.Equal(t, uint16(5201), record.DestinationTransportPort)
+		}
+	}
+}
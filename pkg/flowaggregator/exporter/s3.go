@@ -0,0 +1,498 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"github.com/xitongsys/parquet-go/parquet"
+	parquetwriter "github.com/xitongsys/parquet-go/writer"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+const (
+	// maxS3PendingFiles is the maximum number of files waiting to be uploaded while the object
+	// storage is unavailable. The oldest files are dropped first.
+	maxS3PendingFiles = 5
+	s3UploadTimeout   = 5 * time.Minute
+
+	s3RecordFormatCSV     = "CSV"
+	s3RecordFormatParquet = "Parquet"
+)
+
+type objectUploader interface {
+	Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error)
+}
+
+// s3ClientSettings contains the configuration parameters used to create the S3 client.
+type s3ClientSettings struct {
+	endpoint       string
+	region         string
+	forcePathStyle bool
+	caCertPath     string
+}
+
+// newS3Uploader can be overridden in tests. The credentials are retrieved with the default
+// credential chain of the AWS SDK, e.g. from the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+// environment variables.
+var newS3Uploader = func(settings s3ClientSettings) (objectUploader, error) {
+	loadOptions := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(settings.region)}
+	if settings.caCertPath != "" {
+		caCert, err := os.ReadFile(settings.caCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading S3 CA certificate: %v", err)
+		}
+		loadOptions = append(loadOptions, awsconfig.WithCustomCABundle(bytes.NewReader(caCert)))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(context.TODO(), loadOptions...)
+	if err != nil {
+		return nil, err
+	}
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = settings.forcePathStyle
+		if settings.endpoint != "" {
+			o.EndpointResolver = s3.EndpointResolverFromURL(settings.endpoint)
+		}
+	})
+	return manager.NewUploader(client), nil
+}
+
+// s3RecordWriter writes flow records to a file in a given format.
+type s3RecordWriter interface {
+	write(r *flowrecord.FlowRecord) error
+	// close writes the buffered data and the footer of the file, if any.
+	close() error
+}
+
+// s3File is a file being written or waiting to be uploaded.
+type s3File struct {
+	buf             bytes.Buffer
+	writer          s3RecordWriter
+	numRecords      int32
+	key             string
+	contentType     string
+	contentEncoding string
+}
+
+// S3Exporter exports flow records to AWS S3 or to an S3-compatible object storage, as CSV files
+// which are optionally gzip-compressed, or as Parquet files. A file is uploaded by a background
+// goroutine when the upload interval expires, or as soon as it contains maxRecordsPerFile
+// records.
+type S3Exporter struct {
+	clientSettings    s3ClientSettings
+	bucketName        string
+	bucketPrefix      string
+	recordFormat      string
+	compress          bool
+	maxRecordsPerFile int32
+	uploadInterval    time.Duration
+	uploader          objectUploader
+
+	// mutex protects current and pending, which are shared with the background goroutine.
+	mutex    sync.Mutex
+	current  *s3File
+	pending  []*s3File
+	uploadCh chan struct{}
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+func buildS3ClientSettings(opt *options.Options) s3ClientSettings {
+	return s3ClientSettings{
+		endpoint:       opt.Config.S3Uploader.Endpoint,
+		region:         opt.Config.S3Uploader.Region,
+		forcePathStyle: opt.Config.S3Uploader.ForcePathStyle,
+		caCertPath:     opt.Config.S3Uploader.CACertPath,
+	}
+}
+
+func NewS3Exporter(opt *options.Options) (*S3Exporter, error) {
+	clientSettings := buildS3ClientSettings(opt)
+	uploader, err := newS3Uploader(clientSettings)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating S3 client: %v", err)
+	}
+	e := &S3Exporter{
+		clientSettings: clientSettings,
+		uploader:       uploader,
+		uploadCh:       make(chan struct{}, 1),
+	}
+	e.setOptions(opt)
+	klog.InfoS("S3Uploader configuration", "bucketName", e.bucketName, "bucketPrefix", e.bucketPrefix, "region", clientSettings.region, "recordFormat", e.recordFormat, "compress", e.compress, "maxRecordsPerFile", e.maxRecordsPerFile, "uploadInterval", e.uploadInterval)
+	return e, nil
+}
+
+func (e *S3Exporter) setOptions(opt *options.Options) {
+	e.bucketName = opt.Config.S3Uploader.BucketName
+	e.bucketPrefix = opt.Config.S3Uploader.BucketPrefix
+	e.recordFormat = opt.Config.S3Uploader.RecordFormat
+	e.compress = *opt.Config.S3Uploader.Compress
+	e.maxRecordsPerFile = opt.Config.S3Uploader.MaxRecordsPerFile
+	e.uploadInterval = opt.S3UploadInterval
+}
+
+func (e *S3Exporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
+	r := flowrecord.GetFlowRecord(record)
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.current == nil {
+		f, err := e.newFile()
+		if err != nil {
+			return err
+		}
+		e.current = f
+	}
+	if err := e.current.writer.write(r); err != nil {
+		return err
+	}
+	e.current.numRecords++
+	if e.current.numRecords >= e.maxRecordsPerFile {
+		if err := e.finishCurrentFileLocked(); err != nil {
+			return err
+		}
+		select {
+		case e.uploadCh <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (e *S3Exporter) Start() {
+	if e.stopCh != nil {
+		return
+	}
+	e.stopCh = make(chan struct{})
+	e.doneCh = make(chan struct{})
+	go e.run(e.stopCh, e.doneCh)
+}
+
+// Stop stops the background goroutine, after a last attempt to upload the buffered records.
+// Files which could not be uploaded are kept, in case the exporter is restarted.
+func (e *S3Exporter) Stop() {
+	if e.stopCh == nil {
+		return
+	}
+	close(e.stopCh)
+	<-e.doneCh
+	e.stopCh = nil
+	e.doneCh = nil
+}
+
+func (e *S3Exporter) UpdateOptions(opt *options.Options) {
+	clientSettings := buildS3ClientSettings(opt)
+	if clientSettings == e.clientSettings &&
+		opt.Config.S3Uploader.BucketName == e.bucketName &&
+		opt.Config.S3Uploader.BucketPrefix == e.bucketPrefix &&
+		opt.Config.S3Uploader.RecordFormat == e.recordFormat &&
+		*opt.Config.S3Uploader.Compress == e.compress &&
+		opt.Config.S3Uploader.MaxRecordsPerFile == e.maxRecordsPerFile &&
+		opt.S3UploadInterval == e.uploadInterval {
+		return
+	}
+	var uploader objectUploader
+	if clientSettings != e.clientSettings {
+		var err error
+		if uploader, err = newS3Uploader(clientSettings); err != nil {
+			klog.ErrorS(err, "Error when applying new S3Uploader configuration, keeping the current one")
+			return
+		}
+	}
+	// Stopping the exporter uploads the current file with the current configuration.
+	wasStarted := e.stopCh != nil
+	e.Stop()
+	if uploader != nil {
+		e.clientSettings = clientSettings
+		e.uploader = uploader
+	}
+	e.setOptions(opt)
+	if wasStarted {
+		e.Start()
+	}
+	klog.InfoS("New S3Uploader configuration", "bucketName", e.bucketName, "bucketPrefix", e.bucketPrefix, "region", e.clientSettings.region, "recordFormat", e.recordFormat, "compress", e.compress, "maxRecordsPerFile", e.maxRecordsPerFile, "uploadInterval", e.uploadInterval)
+}
+
+func (e *S3Exporter) run(stopCh <-chan struct{}, doneCh chan<- struct{}) {
+	defer close(doneCh)
+	uploadTicker := time.NewTicker(e.uploadInterval)
+	defer uploadTicker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			e.upload(true)
+			return
+		case <-uploadTicker.C:
+			e.upload(true)
+		case <-e.uploadCh:
+			e.upload(false)
+		}
+	}
+}
+
+// upload uploads the pending files, as well as the current file if includeCurrent is true. Files
+// which cannot be uploaded are retried during the next upload.
+func (e *S3Exporter) upload(includeCurrent bool) {
+	e.mutex.Lock()
+	if includeCurrent {
+		if err := e.finishCurrentFileLocked(); err != nil {
+			klog.ErrorS(err, "Error when writing flow records file")
+		}
+	}
+	pending := e.pending
+	e.pending = nil
+	e.mutex.Unlock()
+
+	for i, f := range pending {
+		if err := e.uploadFile(f); err != nil {
+			klog.ErrorS(err, "Error when uploading flow records to S3", "bucketName", e.bucketName, "key", f.key, "count", f.numRecords)
+			e.mutex.Lock()
+			remaining := make([]*s3File, 0, len(pending)-i+len(e.pending))
+			e.pending = append(append(remaining, pending[i:]...), e.pending...)
+			e.dropOldestFilesLocked()
+			e.mutex.Unlock()
+			return
+		}
+		klog.V(4).InfoS("Uploaded flow records to S3", "bucketName", e.bucketName, "key", f.key, "count", f.numRecords)
+	}
+}
+
+func (e *S3Exporter) uploadFile(f *s3File) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3UploadTimeout)
+	defer cancel()
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(e.bucketName),
+		Key:         aws.String(f.key),
+		Body:        bytes.NewReader(f.buf.Bytes()),
+		ContentType: aws.String(f.contentType),
+	}
+	if f.contentEncoding != "" {
+		input.ContentEncoding = aws.String(f.contentEncoding)
+	}
+	_, err := e.uploader.Upload(ctx, input)
+	return err
+}
+
+func (e *S3Exporter) newFile() (*s3File, error) {
+	f := &s3File{}
+	switch e.recordFormat {
+	case s3RecordFormatParquet:
+		w, err := newParquetRecordWriter(&f.buf, e.compress)
+		if err != nil {
+			return nil, err
+		}
+		f.writer = w
+		f.contentType = "application/vnd.apache.parquet"
+	default:
+		f.writer = newCSVRecordWriter(&f.buf, e.compress)
+		f.contentType = "text/csv"
+		if e.compress {
+			f.contentEncoding = "gzip"
+		}
+	}
+	return f, nil
+}
+
+// finishCurrentFileLocked closes the current file if it contains records, and adds it to the
+// files waiting to be uploaded.
+func (e *S3Exporter) finishCurrentFileLocked() error {
+	f := e.current
+	if f == nil || f.numRecords == 0 {
+		return nil
+	}
+	e.current = nil
+	if err := f.writer.close(); err != nil {
+		return err
+	}
+	key := fmt.Sprintf("records-%s-%s", time.Now().UTC().Format("20060102T150405Z"), utilrand.String(8))
+	switch {
+	case e.recordFormat == s3RecordFormatParquet:
+		key += ".parquet"
+	case f.contentEncoding == "gzip":
+		key += ".csv.gz"
+	default:
+		key += ".csv"
+	}
+	f.key = path.Join(e.bucketPrefix, key)
+	e.pending = append(e.pending, f)
+	e.dropOldestFilesLocked()
+	return nil
+}
+
+func (e *S3Exporter) dropOldestFilesLocked() {
+	if numDropped := len(e.pending) - maxS3PendingFiles; numDropped > 0 {
+		for _, f := range e.pending[:numDropped] {
+			klog.InfoS("Too many files pending upload to S3, dropping flow records", "key", f.key, "count", f.numRecords)
+		}
+		e.pending = e.pending[numDropped:]
+	}
+}
+
+type csvRecordWriter struct {
+	gzipWriter *gzip.Writer
+	csvWriter  *csv.Writer
+}
+
+func newCSVRecordWriter(w io.Writer, compress bool) *csvRecordWriter {
+	rw := &csvRecordWriter{}
+	if compress {
+		rw.gzipWriter = gzip.NewWriter(w)
+		w = rw.gzipWriter
+	}
+	rw.csvWriter = csv.NewWriter(w)
+	// Writing to a bytes.Buffer cannot fail, the error is returned when the file is closed.
+	rw.csvWriter.Write(csvHeader)
+	return rw
+}
+
+func (w *csvRecordWriter) write(r *flowrecord.FlowRecord) error {
+	return w.csvWriter.Write(csvValues(r))
+}
+
+func (w *csvRecordWriter) close() error {
+	w.csvWriter.Flush()
+	if err := w.csvWriter.Error(); err != nil {
+		return err
+	}
+	if w.gzipWriter != nil {
+		return w.gzipWriter.Close()
+	}
+	return nil
+}
+
+type parquetRecordWriter struct {
+	writer *parquetwriter.CSVWriter
+}
+
+func newParquetRecordWriter(w io.Writer, compress bool) (*parquetRecordWriter, error) {
+	writer, err := parquetwriter.NewCSVWriterFromWriter(parquetSchema, w, 1)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating Parquet writer: %v", err)
+	}
+	if compress {
+		writer.CompressionType = parquet.CompressionCodec_SNAPPY
+	} else {
+		writer.CompressionType = parquet.CompressionCodec_UNCOMPRESSED
+	}
+	return &parquetRecordWriter{writer: writer}, nil
+}
+
+func (w *parquetRecordWriter) write(r *flowrecord.FlowRecord) error {
+	return w.writer.Write(parquetValues(r))
+}
+
+func (w *parquetRecordWriter) close() error {
+	return w.writer.WriteStop()
+}
+
+// fieldNames returns the JSON names of the FlowRecord fields, which are used as the names of
+// the CSV and Parquet columns.
+func fieldNames() []string {
+	t := reflect.TypeOf(flowrecord.FlowRecord{})
+	names := make([]string, t.NumField())
+	for i := range names {
+		names[i] = strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+	}
+	return names
+}
+
+// csvHeader contains the names of the CSV columns.
+var csvHeader = fieldNames()
+
+// csvValues returns the values of the FlowRecord fields, in the order of the CSV columns.
+// Timestamps are represented as seconds since the Unix epoch.
+func csvValues(r *flowrecord.FlowRecord) []string {
+	v := reflect.ValueOf(r).Elem()
+	values := make([]string, v.NumField())
+	for i := range values {
+		switch field := v.Field(i).Interface().(type) {
+		case time.Time:
+			values[i] = strconv.FormatInt(field.Unix(), 10)
+		default:
+			values[i] = fmt.Sprint(field)
+		}
+	}
+	return values
+}
+
+// parquetSchema contains the metadata of the Parquet columns, in the format expected by the
+// parquet-go CSV writer. Timestamps are stored with millisecond precision, and unsigned integers
+// are annotated with their logical type.
+var parquetSchema = func() []string {
+	t := reflect.TypeOf(flowrecord.FlowRecord{})
+	names := fieldNames()
+	schema := make([]string, len(names))
+	for i, name := range names {
+		var columnType string
+		switch t.Field(i).Type.Kind() {
+		case reflect.String:
+			columnType = "type=BYTE_ARRAY, convertedtype=UTF8"
+		case reflect.Uint8:
+			columnType = "type=INT32, convertedtype=UINT_8"
+		case reflect.Uint16:
+			columnType = "type=INT32, convertedtype=UINT_16"
+		case reflect.Uint32:
+			columnType = "type=INT32, convertedtype=UINT_32"
+		case reflect.Uint64:
+			columnType = "type=INT64, convertedtype=UINT_64"
+		case reflect.Struct:
+			// time.Time is the only struct type in FlowRecord.
+			columnType = "type=INT64, convertedtype=TIMESTAMP_MILLIS"
+		}
+		schema[i] = fmt.Sprintf("name=%s, %s", name, columnType)
+	}
+	return schema
+}()
+
+// parquetValues returns the values of the FlowRecord fields, in the order of the Parquet
+// columns, with the Go types corresponding to the Parquet physical types.
+func parquetValues(r *flowrecord.FlowRecord) []interface{} {
+	v := reflect.ValueOf(r).Elem()
+	values := make([]interface{}, v.NumField())
+	for i := range values {
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.String:
+			values[i] = field.String()
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			values[i] = int32(field.Uint())
+		case reflect.Uint64:
+			values[i] = int64(field.Uint())
+		case reflect.Struct:
+			values[i] = field.Interface().(time.Time).UnixNano() / int64(time.Millisecond)
+		}
+	}
+	return values
+}
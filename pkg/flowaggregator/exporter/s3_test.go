@@ -0,0 +1,276 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	parquetreader "github.com/xitongsys/parquet-go/reader"
+
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+type uploadedObject struct {
+	bucket          string
+	key             string
+	body            []byte
+	contentType     string
+	contentEncoding string
+}
+
+type fakeUploader struct {
+	mutex   sync.Mutex
+	err     error
+	objects chan uploadedObject
+}
+
+func (u *fakeUploader) Upload(ctx context.Context, input *s3.PutObjectInput, opts ...func(*manager.Uploader)) (*manager.UploadOutput, error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	if u.err != nil {
+		return nil, u.err
+	}
+	body, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+	u.objects <- uploadedObject{
+		bucket:          aws.ToString(input.Bucket),
+		key:             aws.ToString(input.Key),
+		body:            body,
+		contentType:     aws.ToString(input.ContentType),
+		contentEncoding: aws.ToString(input.ContentEncoding),
+	}
+	return &manager.UploadOutput{}, nil
+}
+
+func (u *fakeUploader) setErr(err error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	u.err = err
+}
+
+func newTestS3Exporter(t *testing.T, config string) (*S3Exporter, *fakeUploader) {
+	uploader := &fakeUploader{objects: make(chan uploadedObject, 10)}
+	origNewS3Uploader := newS3Uploader
+	t.Cleanup(func() { newS3Uploader = origNewS3Uploader })
+	newS3Uploader = func(settings s3ClientSettings) (objectUploader, error) {
+		return uploader, nil
+	}
+	opt, err := options.LoadConfig([]byte(config))
+	require.NoError(t, err)
+	e, err := NewS3Exporter(opt)
+	require.NoError(t, err)
+	return e, uploader
+}
+
+func readCSV(t *testing.T, obj uploadedObject) [][]string {
+	body := obj.body
+	if obj.contentEncoding == "gzip" {
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		require.NoError(t, err)
+		body, err = ioutil.ReadAll(r)
+		require.NoError(t, err)
+	}
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	require.NoError(t, err)
+	return records
+}
+
+// readParquet returns the rows of a Parquet file, as structs whose fields are named after the
+// capitalized column names.
+func readParquet(t *testing.T, obj uploadedObject) []reflect.Value {
+	f, err := buffer.NewBufferFile(obj.body)
+	require.NoError(t, err)
+	r, err := parquetreader.NewParquetReader(f, nil, 1)
+	require.NoError(t, err)
+	defer r.ReadStop()
+	rows, err := r.ReadByNumber(int(r.GetNumRows()))
+	require.NoError(t, err)
+	values := make([]reflect.Value, len(rows))
+	for i := range rows {
+		values[i] = reflect.ValueOf(rows[i])
+	}
+	return values
+}
+
+func TestS3Exporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, uploader := newTestS3Exporter(t, `
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+  bucketPrefix: cluster-a
+  maxRecordsPerFile: 2
+  uploadInterval: 1h
+`)
+	e.Start()
+
+	// A full file is uploaded right away.
+	addTestRecord(t, e, ctrl)
+	addTestRecord(t, e, ctrl)
+	addTestRecord(t, e, ctrl)
+	obj := <-uploader.objects
+	assert.Equal(t, "antrea-flows", obj.bucket)
+	assert.Regexp(t, `^cluster-a/records-\d{8}T\d{6}Z-\w{8}\.csv\.gz$`, obj.key)
+	assert.Equal(t, "text/csv", obj.contentType)
+	records := readCSV(t, obj)
+	require.Len(t, records, 3)
+	assert.Equal(t, csvHeader, records[0])
+	assert.Equal(t, "flowStartSeconds", records[0][0])
+	assert.Equal(t, "1637706961", records[1][0])
+
+	// The current file is uploaded when the exporter is stopped.
+	e.Stop()
+	obj = <-uploader.objects
+	assert.Len(t, readCSV(t, obj), 2)
+}
+
+func TestS3ExporterUploadError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, uploader := newTestS3Exporter(t, `
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+  compress: false
+  uploadInterval: 1h
+`)
+	uploader.setErr(fmt.Errorf("service unavailable"))
+	e.Start()
+	addTestRecord(t, e, ctrl)
+	e.Stop()
+	// The file is kept until it can be uploaded.
+	require.Len(t, e.pending, 1)
+
+	uploader.setErr(nil)
+	e.Start()
+	addTestRecord(t, e, ctrl)
+	e.Stop()
+	obj1, obj2 := <-uploader.objects, <-uploader.objects
+	assert.Regexp(t, `^records-.*\.csv$`, obj1.key)
+	assert.Empty(t, obj1.contentEncoding)
+	assert.Len(t, readCSV(t, obj1), 2)
+	assert.Len(t, readCSV(t, obj2), 2)
+	assert.Empty(t, e.pending)
+}
+
+func TestS3ExporterMaxPendingFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, uploader := newTestS3Exporter(t, `
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+  maxRecordsPerFile: 1
+  uploadInterval: 1h
+`)
+	uploader.setErr(fmt.Errorf("service unavailable"))
+	// The exporter is not started, so that files are not uploaded.
+	for i := 0; i < maxS3PendingFiles+2; i++ {
+		addTestRecord(t, e, ctrl)
+	}
+	assert.Len(t, e.pending, maxS3PendingFiles)
+}
+
+func TestS3ExporterParquet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, compress := range []bool{true, false} {
+		t.Run(fmt.Sprintf("compress=%t", compress), func(t *testing.T) {
+			e, uploader := newTestS3Exporter(t, fmt.Sprintf(`
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+  recordFormat: Parquet
+  compress: %t
+  uploadInterval: 1h
+`, compress))
+			e.Start()
+			addTestRecord(t, e, ctrl)
+			addTestRecord(t, e, ctrl)
+			e.Stop()
+			obj := <-uploader.objects
+			assert.Regexp(t, `^records-\d{8}T\d{6}Z-\w{8}\.parquet$`, obj.key)
+			assert.Equal(t, "application/vnd.apache.parquet", obj.contentType)
+			assert.Empty(t, obj.contentEncoding)
+			rows := readParquet(t, obj)
+			require.Len(t, rows, 2)
+			assert.Equal(t, int64(1637706961000), rows[0].FieldByName("FlowStartSeconds").Interface())
+			assert.Equal(t, "10.10.0.79", rows[0].FieldByName("SourceIP").Interface())
+			assert.Equal(t, int32(44752), rows[0].FieldByName("SourceTransportPort").Interface())
+			assert.Equal(t, int32(1520), rows[1].FieldByName("TcpSmoothedRTT").Interface())
+		})
+	}
+}
+
+func TestNewS3Uploader(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret-key")
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	uploader, err := newS3Uploader(s3ClientSettings{
+		endpoint:       server.URL,
+		region:         "us-east-1",
+		forcePathStyle: true,
+	})
+	require.NoError(t, err)
+	_, err = uploader.Upload(context.Background(), &s3.PutObjectInput{
+		Bucket:      aws.String("antrea-flows"),
+		Key:         aws.String("cluster-a/records.csv"),
+		Body:        strings.NewReader("flowStartSeconds\n"),
+		ContentType: aws.String("text/csv"),
+	})
+	require.NoError(t, err)
+	r := <-requests
+	assert.Equal(t, http.MethodPut, r.Method)
+	assert.Equal(t, "/antrea-flows/cluster-a/records.csv", r.URL.Path)
+	assert.Equal(t, "text/csv", r.Header.Get("Content-Type"))
+	assert.Contains(t, r.Header.Get("Authorization"), "Credential=access-key/")
+	assert.Equal(t, "flowStartSeconds\n", string(<-bodies))
+
+	_, err = newS3Uploader(s3ClientSettings{region: "us-east-1", caCertPath: "/does/not/exist"})
+	assert.Error(t, err)
+}
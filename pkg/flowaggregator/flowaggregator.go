@@ -16,14 +16,21 @@ package flowaggregator
 
 import (
 	"bytes"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
 	"sync"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/vmware/go-ipfix/pkg/collector"
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
-	"github.com/vmware/go-ipfix/pkg/exporter"
 	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
 	corev1 "k8s.io/api/core/v1"
@@ -32,121 +39,24 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 
-	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/exporter"
+	"antrea.io/antrea/pkg/flowaggregator/infoelements"
+	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 	"antrea.io/antrea/pkg/ipfix"
 )
 
 var (
-	ianaInfoElementsCommon = []string{
-		"flowStartSeconds",
-		"flowEndSeconds",
-		"flowEndReason",
-		"sourceTransportPort",
-		"destinationTransportPort",
-		"protocolIdentifier",
-		"packetTotalCount",
-		"octetTotalCount",
-		"packetDeltaCount",
-		"octetDeltaCount",
-	}
-	ianaInfoElementsIPv4    = append(ianaInfoElementsCommon, []string{"sourceIPv4Address", "destinationIPv4Address"}...)
-	ianaInfoElementsIPv6    = append(ianaInfoElementsCommon, []string{"sourceIPv6Address", "destinationIPv6Address"}...)
-	ianaReverseInfoElements = []string{
-		"reversePacketTotalCount",
-		"reverseOctetTotalCount",
-		"reversePacketDeltaCount",
-		"reverseOctetDeltaCount",
-	}
-	antreaInfoElementsCommon = []string{
-		"sourcePodName",
-		"sourcePodNamespace",
-		"sourceNodeName",
-		"destinationPodName",
-		"destinationPodNamespace",
-		"destinationNodeName",
-		"destinationServicePort",
-		"destinationServicePortName",
-		"ingressNetworkPolicyName",
-		"ingressNetworkPolicyNamespace",
-		"ingressNetworkPolicyType",
-		"ingressNetworkPolicyRuleName",
-		"ingressNetworkPolicyRuleAction",
-		"egressNetworkPolicyName",
-		"egressNetworkPolicyNamespace",
-		"egressNetworkPolicyType",
-		"egressNetworkPolicyRuleName",
-		"egressNetworkPolicyRuleAction",
-		"tcpState",
-		"flowType",
-	}
-	antreaInfoElementsIPv4 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv4"}...)
-	antreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
-
-	nonStatsElementList = []string{
-		"flowEndSeconds",
-		"flowEndReason",
-		"tcpState",
-	}
-	statsElementList = []string{
-		"octetDeltaCount",
-		"octetTotalCount",
-		"packetDeltaCount",
-		"packetTotalCount",
-		"reverseOctetDeltaCount",
-		"reverseOctetTotalCount",
-		"reversePacketDeltaCount",
-		"reversePacketTotalCount",
-	}
-	antreaSourceStatsElementList = []string{
-		"octetDeltaCountFromSourceNode",
-		"octetTotalCountFromSourceNode",
-		"packetDeltaCountFromSourceNode",
-		"packetTotalCountFromSourceNode",
-		"reverseOctetDeltaCountFromSourceNode",
-		"reverseOctetTotalCountFromSourceNode",
-		"reversePacketDeltaCountFromSourceNode",
-		"reversePacketTotalCountFromSourceNode",
-	}
-	antreaDestinationStatsElementList = []string{
-		"octetDeltaCountFromDestinationNode",
-		"octetTotalCountFromDestinationNode",
-		"packetDeltaCountFromDestinationNode",
-		"packetTotalCountFromDestinationNode",
-		"reverseOctetDeltaCountFromDestinationNode",
-		"reverseOctetTotalCountFromDestinationNode",
-		"reversePacketDeltaCountFromDestinationNode",
-		"reversePacketTotalCountFromDestinationNode",
-	}
-	antreaLabelsElementList = []string{
-		"sourcePodLabels",
-		"destinationPodLabels",
-	}
-	antreaFlowEndSecondsElementList = []string{
-		"flowEndSecondsFromSourceNode",
-		"flowEndSecondsFromDestinationNode",
-	}
-	antreaThroughputElementList = []string{
-		"throughput",
-		"reverseThroughput",
-	}
-	antreaSourceThroughputElementList = []string{
-		"throughputFromSourceNode",
-		"reverseThroughputFromSourceNode",
-	}
-	antreaDestinationThroughputElementList = []string{
-		"throughputFromDestinationNode",
-		"reverseThroughputFromDestinationNode",
-	}
 	aggregationElements = &ipfixintermediate.AggregationElements{
-		NonStatsElements:                   nonStatsElementList,
-		StatsElements:                      statsElementList,
-		AggregatedSourceStatsElements:      antreaSourceStatsElementList,
-		AggregatedDestinationStatsElements: antreaDestinationStatsElementList,
-		AntreaFlowEndSecondsElements:       antreaFlowEndSecondsElementList,
-		ThroughputElements:                 antreaThroughputElementList,
-		SourceThroughputElements:           antreaSourceThroughputElementList,
-		DestinationThroughputElements:      antreaDestinationThroughputElementList,
+		NonStatsElements:                   infoelements.NonStatsElementList,
+		StatsElements:                      infoelements.StatsElementList,
+		AggregatedSourceStatsElements:      infoelements.AntreaSourceStatsElementList,
+		AggregatedDestinationStatsElements: infoelements.AntreaDestinationStatsElementList,
+		AntreaFlowEndSecondsElements:       infoelements.AntreaFlowEndSecondsElementList,
+		ThroughputElements:                 infoelements.AntreaThroughputElementList,
+		SourceThroughputElements:           infoelements.AntreaSourceThroughputElementList,
+		DestinationThroughputElements:      infoelements.AntreaDestinationThroughputElementList,
 	}
 
 	correlateFields = []string{
@@ -170,6 +80,7 @@ var (
 		"egressNetworkPolicyRuleAction",
 		"egressNetworkPolicyType",
 		"egressNetworkPolicyRuleName",
+		"destinationFQDN",
 	}
 )
 
@@ -181,72 +92,142 @@ const (
 
 	// PodInfo index name for Pod cache.
 	podInfoIndex = "podInfo"
-)
-
-type AggregatorTransportProtocol string
 
-const (
-	AggregatorTransportProtocolTCP AggregatorTransportProtocol = "TCP"
-	AggregatorTransportProtocolTLS AggregatorTransportProtocol = "TLS"
-	AggregatorTransportProtocolUDP AggregatorTransportProtocol = "UDP"
+	// podIPEnvKey is the environment variable set to the IP address of the Flow Aggregator Pod,
+	// which is added to the server certificate when high availability is enabled.
+	podIPEnvKey = "POD_IP"
 )
 
 type flowAggregator struct {
-	externalFlowCollectorAddr   string
-	externalFlowCollectorProto  string
-	aggregatorTransportProtocol AggregatorTransportProtocol
+	aggregatorTransportProtocol flowaggregatorconfig.AggregatorTransportProtocol
 	collectingProcess           ipfix.IPFIXCollectingProcess
 	aggregationProcess          ipfix.IPFIXAggregationProcess
-	dbExportProcess             *clickhouseclient.ClickHouseExportProcess
 	activeFlowRecordTimeout     time.Duration
 	inactiveFlowRecordTimeout   time.Duration
-	exportingProcess            ipfix.IPFIXExportingProcess
-	templateIDv4                uint16
-	templateIDv6                uint16
 	registry                    ipfix.IPFIXRegistry
-	set                         ipfixentities.Set
 	flowAggregatorAddress       string
+	highAvailability            bool
 	includePodLabels            bool
 	k8sClient                   kubernetes.Interface
-	observationDomainID         uint32
 	podInformer                 coreinformers.PodInformer
-	sendJSONRecord              bool
 	numRecordsExported          int64
 	numRecordsReceived          int64
+	ipfixExporter               exporter.Interface
+	clickHouseExporter          exporter.Interface
+	kafkaExporter               exporter.Interface
+	s3Exporter                  exporter.Interface
+	logExporter                 exporter.Interface
+	// aggregationMsgCh receives the messages of the collecting process, once the TCP metrics
+	// of their records have been saved in tcpMetrics.
+	aggregationMsgCh chan *ipfixentities.Message
+	tcpMetrics       *tcpMetricsStore
+	// configFile is watched for changes, which are applied to the exporters without
+	// restarting the Flow Aggregator.
+	configFile    string
+	configWatcher *fsnotify.Watcher
+	// configOpt holds the last loaded options. It is only accessed by watchConfiguration.
+	configOpt *options.Options
+	updateCh  chan *options.Options
 }
 
+// The constructors of the exporters can be overridden in tests.
+var (
+	newIPFIXExporter = func(k8sClient kubernetes.Interface, opt *options.Options, registry ipfix.IPFIXRegistry) exporter.Interface {
+		return exporter.NewIPFIXExporter(k8sClient, opt, registry)
+	}
+	newClickHouseExporter = func(opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewClickHouseExporter(opt)
+	}
+	newKafkaExporter = func(opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewKafkaExporter(opt)
+	}
+	newS3Exporter = func(opt *options.Options) (exporter.Interface, error) {
+		return exporter.NewS3Exporter(opt)
+	}
+	newLogExporter = func(opt *options.Options) exporter.Interface {
+		return exporter.NewLogExporter(opt)
+	}
+)
+
+// NewFlowAggregator creates a Flow Aggregator with the options loaded from configFile. If configFile
+// is not empty, it is watched for changes once the Flow Aggregator is running.
 func NewFlowAggregator(
-	externalFlowCollectorAddr string,
-	externalFlowCollectorProto string,
-	activeFlowRecTimeout time.Duration,
-	inactiveFlowRecTimeout time.Duration,
-	aggregatorTransportProtocol AggregatorTransportProtocol,
-	flowAggregatorAddress string,
-	includePodLabels bool,
 	k8sClient kubernetes.Interface,
-	observationDomainID uint32,
 	podInformer coreinformers.PodInformer,
-	sendJSONRecord bool,
-) *flowAggregator {
+	configFile string,
+	opt *options.Options,
+) (*flowAggregator, error) {
 	registry := ipfix.NewIPFIXRegistry()
 	registry.LoadRegistry()
 	fa := &flowAggregator{
-		externalFlowCollectorAddr:   externalFlowCollectorAddr,
-		externalFlowCollectorProto:  externalFlowCollectorProto,
-		aggregatorTransportProtocol: aggregatorTransportProtocol,
-		activeFlowRecordTimeout:     activeFlowRecTimeout,
-		inactiveFlowRecordTimeout:   inactiveFlowRecTimeout,
+		aggregatorTransportProtocol: opt.AggregatorTransportProtocol,
+		activeFlowRecordTimeout:     opt.ActiveFlowRecordTimeout,
+		inactiveFlowRecordTimeout:   opt.InactiveFlowRecordTimeout,
 		registry:                    registry,
-		set:                         ipfixentities.NewSet(false),
-		flowAggregatorAddress:       flowAggregatorAddress,
-		includePodLabels:            includePodLabels,
+		flowAggregatorAddress:       opt.Config.FlowAggregatorAddress,
+		highAvailability:            opt.Config.HighAvailability.Enable,
+		includePodLabels:            opt.Config.RecordContents.PodLabels,
 		k8sClient:                   k8sClient,
-		observationDomainID:         observationDomainID,
 		podInformer:                 podInformer,
-		sendJSONRecord:              sendJSONRecord,
+		configFile:                  configFile,
+		configOpt:                   opt,
+		updateCh:                    make(chan *options.Options),
+		aggregationMsgCh:            make(chan *ipfixentities.Message),
+		tcpMetrics:                  newTCPMetricsStore(),
+	}
+	if opt.Config.FlowCollector.Enable {
+		fa.ipfixExporter = newIPFIXExporter(k8sClient, opt, registry)
+	}
+	if opt.Config.ClickHouse.Enable {
+		var err error
+		fa.clickHouseExporter, err = newClickHouseExporter(opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating ClickHouse export process: %v", err)
+		}
+	}
+	if opt.Config.Kafka.Enable {
+		var err error
+		fa.kafkaExporter, err = newKafkaExporter(opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating Kafka export process: %v", err)
+		}
+	}
+	if opt.Config.S3Uploader.Enable {
+		var err error
+		fa.s3Exporter, err = newS3Exporter(opt)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating S3 export process: %v", err)
+		}
+	}
+	if opt.Config.FlowLogger.Enable {
+		fa.logExporter = newLogExporter(opt)
+	}
+	if configFile != "" {
+		configWatcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return nil, fmt.Errorf("error when creating file watcher for configuration file: %v", err)
+		}
+		// When the ConfigMap is updated, kubelet atomically replaces a symlink in the
+		// directory instead of writing to the file, so we watch the parent directory.
+		if err := configWatcher.Add(filepath.Dir(configFile)); err != nil {
+			configWatcher.Close()
+			return nil, fmt.Errorf("error when watching directory of configuration file: %v", err)
+		}
+		fa.configWatcher = configWatcher
 	}
 	podInformer.Informer().AddIndexers(cache.Indexers{podInfoIndex: podInfoIndexFunc})
-	return fa
+	return fa, nil
+}
+
+// exporters returns the exporters which are enabled.
+func (fa *flowAggregator) exporters() []exporter.Interface {
+	var exporters []exporter.Interface
+	for _, e := range []exporter.Interface{fa.ipfixExporter, fa.clickHouseExporter, fa.kafkaExporter, fa.s3Exporter, fa.logExporter} {
+		if e != nil {
+			exporters = append(exporters, e)
+		}
+	}
+	return exporters
 }
 
 func podInfoIndexFunc(obj interface{}) ([]string, error) {
@@ -266,23 +247,45 @@ func podInfoIndexFunc(obj interface{}) ([]string, error) {
 
 func (fa *flowAggregator) InitCollectingProcess() error {
 	var cpInput collector.CollectorInput
-	if fa.aggregatorTransportProtocol == AggregatorTransportProtocolTLS {
-		parentCert, privateKey, caCert, err := generateCACertKey()
-		if err != nil {
-			return fmt.Errorf("error when generating CA certificate: %v", err)
-		}
-		serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress)
-		if err != nil {
-			return fmt.Errorf("error when creating server certificate: %v", err)
-		}
+	if fa.aggregatorTransportProtocol == flowaggregatorconfig.AggregatorTransportProtocolTLS {
+		var parentCert *x509.Certificate
+		var privateKey *rsa.PrivateKey
+		var caCert, serverCert, serverKey []byte
+		var err error
+		if fa.highAvailability {
+			// The CA is shared by all the replicas and managed by the leader, see
+			// RunLeaderElection. The Flow Exporters connect to each replica using its Pod
+			// IP, which must be included in the server certificate.
+			parentCert, privateKey, caCert, err = fa.waitForCA()
+			if err != nil {
+				return fmt.Errorf("error when waiting for CA certificate: %v", err)
+			}
+			podIP := net.ParseIP(os.Getenv(podIPEnvKey))
+			if podIP == nil {
+				return fmt.Errorf("invalid Pod IP %q in environment variable %s", os.Getenv(podIPEnvKey), podIPEnvKey)
+			}
+			serverCert, serverKey, err = generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress, podIP)
+			if err != nil {
+				return fmt.Errorf("error when creating server certificate: %v", err)
+			}
+		} else {
+			parentCert, privateKey, caCert, err = generateCACertKey()
+			if err != nil {
+				return fmt.Errorf("error when generating CA certificate: %v", err)
+			}
+			serverCert, serverKey, err = generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress)
+			if err != nil {
+				return fmt.Errorf("error when creating server certificate: %v", err)
+			}
 
-		clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "")
-		if err != nil {
-			return fmt.Errorf("error when creating client certificate: %v", err)
-		}
-		err = syncCAAndClientCert(caCert, clientCert, clientKey, fa.k8sClient)
-		if err != nil {
-			return fmt.Errorf("error when synchronizing client certificate: %v", err)
+			clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "")
+			if err != nil {
+				return fmt.Errorf("error when creating client certificate: %v", err)
+			}
+			err = syncCAAndClientCert(caCert, clientCert, clientKey, fa.k8sClient)
+			if err != nil {
+				return fmt.Errorf("error when synchronizing client certificate: %v", err)
+			}
 		}
 		cpInput = collector.CollectorInput{
 			Address:       collectorAddress,
@@ -294,7 +297,7 @@ func (fa *flowAggregator) InitCollectingProcess() error {
 			ServerKey:     serverKey,
 			ServerCert:    serverCert,
 		}
-	} else if fa.aggregatorTransportProtocol == AggregatorTransportProtocolTCP {
+	} else if fa.aggregatorTransportProtocol == flowaggregatorconfig.AggregatorTransportProtocolTCP {
 		cpInput = collector.CollectorInput{
 			Address:       collectorAddress,
 			Protocol:      tcpTransport,
@@ -311,8 +314,8 @@ func (fa *flowAggregator) InitCollectingProcess() error {
 			IsEncrypted:   false,
 		}
 	}
-	cpInput.NumExtraElements = len(antreaSourceStatsElementList) + len(antreaDestinationStatsElementList) + len(antreaLabelsElementList) +
-		len(antreaFlowEndSecondsElementList) + len(antreaThroughputElementList) + len(antreaSourceThroughputElementList) + len(antreaDestinationThroughputElementList)
+	cpInput.NumExtraElements = len(infoelements.AntreaSourceStatsElementList) + len(infoelements.AntreaDestinationStatsElementList) + len(infoelements.AntreaLabelsElementList) +
+		len(infoelements.AntreaFlowEndSecondsElementList) + len(infoelements.AntreaThroughputElementList) + len(infoelements.AntreaSourceThroughputElementList) + len(infoelements.AntreaDestinationThroughputElementList)
 	var err error
 	fa.collectingProcess, err = ipfix.NewIPFIXCollectingProcess(cpInput)
 	return err
@@ -321,7 +324,7 @@ func (fa *flowAggregator) InitCollectingProcess() error {
 func (fa *flowAggregator) InitAggregationProcess() error {
 	var err error
 	apInput := ipfixintermediate.AggregationInput{
-		MessageChan:           fa.collectingProcess.GetMsgChan(),
+		MessageChan:           fa.aggregationMsgCh,
 		WorkerNum:             aggregationWorkerNum,
 		CorrelateFields:       correlateFields,
 		ActiveExpiryTimeout:   fa.activeFlowRecordTimeout,
@@ -332,88 +335,29 @@ func (fa *flowAggregator) InitAggregationProcess() error {
 	return err
 }
 
-func (fa *flowAggregator) InitDBExportProcess(chInput clickhouseclient.ClickHouseInput) error {
-	var err error
-	fa.dbExportProcess, err = clickhouseclient.NewClickHouseClient(chInput)
-	return err
-}
-
-func (fa *flowAggregator) createAndSendTemplate(isRecordIPv6 bool) error {
-	templateID := fa.exportingProcess.NewTemplateID()
-	recordIPFamily := "IPv4"
-	if isRecordIPv6 {
-		recordIPFamily = "IPv6"
-	}
-	if isRecordIPv6 {
-		fa.templateIDv6 = templateID
-	} else {
-		fa.templateIDv4 = templateID
-	}
-	bytesSent, err := fa.sendTemplateSet(isRecordIPv6)
-	if err != nil {
-		fa.exportingProcess.CloseConnToCollector()
-		fa.exportingProcess = nil
-		fa.set.ResetSet()
-		return fmt.Errorf("sending %s template set failed, err: %v", recordIPFamily, err)
-	}
-	klog.V(2).InfoS("Exporting process initialized", "bytesSent", bytesSent, "templateSetIPFamily", recordIPFamily)
-	return nil
-}
-
-func (fa *flowAggregator) initExportingProcess() error {
-	// TODO: This code can be further simplified by changing the go-ipfix API to accept
-	// externalFlowCollectorAddr and externalFlowCollectorProto instead of net.Addr input.
-	var expInput exporter.ExporterInput
-	if fa.externalFlowCollectorProto == "tcp" {
-		// TCP transport does not need any tempRefTimeout, so sending 0.
-		expInput = exporter.ExporterInput{
-			CollectorAddress:    fa.externalFlowCollectorAddr,
-			CollectorProtocol:   fa.externalFlowCollectorProto,
-			ObservationDomainID: fa.observationDomainID,
-			TempRefTimeout:      0,
-			IsEncrypted:         false,
-			SendJSONRecord:      fa.sendJSONRecord,
-		}
-	} else {
-		// For UDP transport, hardcoding tempRefTimeout value as 1800s. So we will send out template every 30 minutes.
-		expInput = exporter.ExporterInput{
-			CollectorAddress:    fa.externalFlowCollectorAddr,
-			CollectorProtocol:   fa.externalFlowCollectorProto,
-			ObservationDomainID: fa.observationDomainID,
-			TempRefTimeout:      1800,
-			IsEncrypted:         false,
-			SendJSONRecord:      fa.sendJSONRecord,
-		}
-	}
-	ep, err := ipfix.NewIPFIXExportingProcess(expInput)
-	if err != nil {
-		return fmt.Errorf("got error when initializing IPFIX exporting process: %v", err)
-	}
-	fa.exportingProcess = ep
-	// Currently, we send two templates for IPv4 and IPv6 regardless of the IP families supported by cluster
-	if err = fa.createAndSendTemplate(false); err != nil {
-		return err
-	}
-	if err = fa.createAndSendTemplate(true); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (fa *flowAggregator) Run(stopCh <-chan struct{}, wg *sync.WaitGroup) {
 	defer wg.Done()
 	go fa.collectingProcess.Start()
 	defer fa.collectingProcess.Stop()
 	go fa.aggregationProcess.Start()
 	defer fa.aggregationProcess.Stop()
-	if fa.dbExportProcess != nil {
-		go fa.dbExportProcess.Start()
-		defer fa.dbExportProcess.Stop()
+	go fa.forwardMessages(stopCh)
+	for _, e := range fa.exporters() {
+		e.Start()
 	}
-	go fa.flowRecordExpiryCheck(stopCh)
+	if fa.configWatcher != nil {
+		go fa.watchConfiguration(stopCh)
+	}
+	var expiryWg sync.WaitGroup
+	expiryWg.Add(1)
+	go func() {
+		defer expiryWg.Done()
+		fa.flowRecordExpiryCheck(stopCh)
+	}()
 
 	<-stopCh
+	// The exporters are stopped by flowRecordExpiryCheck, since they are not thread-safe.
+	expiryWg.Wait()
 }
 
 func (fa *flowAggregator) flowRecordExpiryCheck(stopCh <-chan struct{}) {
@@ -422,31 +366,21 @@ func (fa *flowAggregator) flowRecordExpiryCheck(stopCh <-chan struct{}) {
 	for {
 		select {
 		case <-stopCh:
-			if fa.exportingProcess != nil {
-				fa.exportingProcess.CloseConnToCollector()
+			fa.drain()
+			for _, e := range fa.exporters() {
+				e.Stop()
 			}
 			expireTimer.Stop()
+			logTicker.Stop()
 			return
 		case <-expireTimer.C:
-			if fa.externalFlowCollectorAddr != "" && fa.exportingProcess == nil {
-				err := fa.initExportingProcess()
-				if err != nil {
-					klog.Errorf("Error when initializing exporting process: %v, will retry in %s", err, fa.activeFlowRecordTimeout)
-					// Initializing exporting process fails, will retry in next cycle.
-					expireTimer.Reset(fa.activeFlowRecordTimeout)
-					continue
-				}
-			}
 			// Pop the flow record item from expire priority queue in the Aggregation
 			// Process and send the flow records.
 			if err := fa.aggregationProcess.ForAllExpiredFlowRecordsDo(fa.sendFlowKeyRecord); err != nil {
 				klog.Errorf("Error when sending expired flow records: %v", err)
-				// If there is an error when sending flow records because of intermittent connectivity, we reset the connection
-				// to IPFIX collector and retry in the next export cycle to reinitialize the connection and send flow records.
-				if fa.exportingProcess != nil {
-					fa.exportingProcess.CloseConnToCollector()
-					fa.exportingProcess = nil
-				}
+				// If there is an error when sending flow records because of intermittent
+				// connectivity, the exporter has reset its connection and we retry in the
+				// next export cycle.
 				expireTimer.Reset(fa.activeFlowRecordTimeout)
 				continue
 			}
@@ -458,21 +392,173 @@ func (fa *flowAggregator) flowRecordExpiryCheck(stopCh <-chan struct{}) {
 			klog.V(4).InfoS("Total number of records exported", "count", fa.numRecordsExported)
 			klog.V(4).InfoS("Total number of flows stored in Flow Aggregator", "count", fa.aggregationProcess.GetNumFlows())
 			klog.V(4).InfoS("Number of exporters connected with Flow Aggregator", "count", fa.collectingProcess.GetNumConnToCollector())
+		case opt := <-fa.updateCh:
+			fa.updateFlowAggregator(opt)
 		}
 	}
 }
 
-func (fa *flowAggregator) sendFlowKeyRecord(key ipfixintermediate.FlowKey, record *ipfixintermediate.AggregationFlowRecord) error {
-	isRecordIPv4 := fa.aggregationProcess.IsAggregatedRecordIPv4(*record)
-	templateID := fa.templateIDv4
-	if !isRecordIPv4 {
-		templateID = fa.templateIDv6
+// forwardMessages saves the TCP metrics of the records received by the collecting process, before
+// passing them to the aggregation process. The metrics which are no longer reported are deleted
+// after the inactive flow record timeout.
+func (fa *flowAggregator) forwardMessages(stopCh <-chan struct{}) {
+	msgCh := fa.collectingProcess.GetMsgChan()
+	staleTicker := time.NewTicker(fa.inactiveFlowRecordTimeout)
+	defer staleTicker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-staleTicker.C:
+			fa.tcpMetrics.deleteStale(time.Now().Add(-fa.inactiveFlowRecordTimeout))
+		case msg := <-msgCh:
+			if set := msg.GetSet(); set.GetSetType() == ipfixentities.Data {
+				now := time.Now()
+				for _, record := range set.GetRecords() {
+					fa.tcpMetrics.update(record, now)
+				}
+			}
+			select {
+			case fa.aggregationMsgCh <- msg:
+			case <-stopCh:
+				return
+			}
+		}
 	}
-	// TODO: more records per data set will be supported when go-ipfix supports size check when adding records
-	fa.set.ResetSet()
-	if err := fa.set.PrepareSet(ipfixentities.Data, templateID); err != nil {
-		return err
+}
+
+// drain sends all the flow records held by the aggregation process when the Flow Aggregator is
+// stopped, instead of waiting for them to expire, so that they are not lost when the Flow
+// Aggregator is restarted or upgraded. This includes the records which have not been correlated
+// yet, e.g. the last record of a connection which ended before the record from the other Node was
+// received, for which the missing Kubernetes metadata is filled from the Pod cache when possible.
+func (fa *flowAggregator) drain() {
+	numRecordsExported := fa.numRecordsExported
+	numRecordsUncorrelated := 0
+	if err := fa.aggregationProcess.ForAllRecordsDo(func(key ipfixintermediate.FlowKey, record *ipfixintermediate.AggregationFlowRecord) error {
+		if !record.ReadyToSend {
+			numRecordsUncorrelated++
+		}
+		return fa.sendFlowKeyRecord(key, record)
+	}); err != nil {
+		klog.ErrorS(err, "Error when sending flow records before stopping")
+	}
+	klog.InfoS("Sent flow records before stopping", "count", fa.numRecordsExported-numRecordsExported, "uncorrelated", numRecordsUncorrelated)
+}
+
+func (fa *flowAggregator) watchConfiguration(stopCh <-chan struct{}) {
+	klog.InfoS("Watching for changes to the configuration file", "file", fa.configFile)
+	defer fa.configWatcher.Close()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case event, ok := <-fa.configWatcher.Events:
+			if !ok {
+				return
+			}
+			klog.V(4).InfoS("Event received in configuration directory", "event", event)
+			fa.reloadConfiguration(stopCh)
+		case err, ok := <-fa.configWatcher.Errors:
+			if !ok {
+				return
+			}
+			klog.ErrorS(err, "Error when watching configuration file", "file", fa.configFile)
+		}
+	}
+}
+
+// reloadConfiguration loads the configuration file and, if the options have changed, sends them
+// to flowRecordExpiryCheck, which applies them. Invalid configurations are ignored.
+func (fa *flowAggregator) reloadConfiguration(stopCh <-chan struct{}) {
+	data, err := ioutil.ReadFile(fa.configFile)
+	if err != nil {
+		klog.ErrorS(err, "Error when reading configuration file", "file", fa.configFile)
+		return
+	}
+	opt, err := options.LoadConfig(data)
+	if err != nil {
+		klog.ErrorS(err, "Invalid configuration file, keeping the current configuration", "file", fa.configFile)
+		return
+	}
+	// These parameters are used by the collecting and aggregation processes, by the leader
+	// election and by the API server, which cannot be reconfigured without dropping the aggregated flow records.
+	// The current values are kept until the Flow Aggregator is restarted, so that the running configuration
+	// reflects the values actually in use.
+	if opt.AggregatorTransportProtocol != fa.configOpt.AggregatorTransportProtocol ||
+		opt.Config.FlowAggregatorAddress != fa.configOpt.Config.FlowAggregatorAddress ||
+		opt.ActiveFlowRecordTimeout != fa.configOpt.ActiveFlowRecordTimeout ||
+		opt.InactiveFlowRecordTimeout != fa.configOpt.InactiveFlowRecordTimeout ||
+		opt.Config.HighAvailability != fa.configOpt.Config.HighAvailability ||
+		!reflect.DeepEqual(opt.Config.APIServer, fa.configOpt.Config.APIServer) {
+		klog.InfoS("Changes to aggregatorTransportProtocol, flowAggregatorAddress, activeFlowRecordTimeout, inactiveFlowRecordTimeout, highAvailability and apiServer require restarting the Flow Aggregator, keeping the current values")
+		opt.AggregatorTransportProtocol = fa.configOpt.AggregatorTransportProtocol
+		opt.Config.AggregatorTransportProtocol = fa.configOpt.Config.AggregatorTransportProtocol
+		opt.Config.FlowAggregatorAddress = fa.configOpt.Config.FlowAggregatorAddress
+		opt.ActiveFlowRecordTimeout = fa.configOpt.ActiveFlowRecordTimeout
+		opt.Config.ActiveFlowRecordTimeout = fa.configOpt.Config.ActiveFlowRecordTimeout
+		opt.InactiveFlowRecordTimeout = fa.configOpt.InactiveFlowRecordTimeout
+		opt.Config.InactiveFlowRecordTimeout = fa.configOpt.Config.InactiveFlowRecordTimeout
+		opt.Config.HighAvailability = fa.configOpt.Config.HighAvailability
+		opt.Config.APIServer = fa.configOpt.Config.APIServer
+	}
+	if reflect.DeepEqual(opt, fa.configOpt) {
+		return
+	}
+	klog.InfoS("Configuration file changed, updating Flow Aggregator", "file", fa.configFile)
+	fa.configOpt = opt
+	select {
+	case fa.updateCh <- opt:
+	case <-stopCh:
+	}
+}
+
+// updateFlowAggregator applies the new options to the exporters, which are created, updated or
+// stopped based on the new configuration. The flow records held by the aggregation process are
+// not affected.
+func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
+	fa.includePodLabels = opt.Config.RecordContents.PodLabels
+	updateExporter("IPFIX", &fa.ipfixExporter, opt.Config.FlowCollector.Enable, opt, func() (exporter.Interface, error) {
+		return newIPFIXExporter(fa.k8sClient, opt, fa.registry), nil
+	})
+	updateExporter("ClickHouse", &fa.clickHouseExporter, opt.Config.ClickHouse.Enable, opt, func() (exporter.Interface, error) {
+		return newClickHouseExporter(opt)
+	})
+	updateExporter("Kafka", &fa.kafkaExporter, opt.Config.Kafka.Enable, opt, func() (exporter.Interface, error) {
+		return newKafkaExporter(opt)
+	})
+	updateExporter("S3", &fa.s3Exporter, opt.Config.S3Uploader.Enable, opt, func() (exporter.Interface, error) {
+		return newS3Exporter(opt)
+	})
+	updateExporter("FlowLogger", &fa.logExporter, opt.Config.FlowLogger.Enable, opt, func() (exporter.Interface, error) {
+		return newLogExporter(opt), nil
+	})
+}
+
+// updateExporter creates and starts, updates, or stops and removes the exporter pointed to by e,
+// depending on whether it is enabled in the new configuration.
+func updateExporter(name string, e *exporter.Interface, enable bool, opt *options.Options, create func() (exporter.Interface, error)) {
+	switch {
+	case enable && *e == nil:
+		newExporter, err := create()
+		if err != nil {
+			klog.ErrorS(err, "Error when enabling exporter", "exporter", name)
+			return
+		}
+		newExporter.Start()
+		*e = newExporter
+		klog.InfoS("Enabled exporter", "exporter", name)
+	case enable:
+		(*e).UpdateOptions(opt)
+	case *e != nil:
+		(*e).Stop()
+		*e = nil
+		klog.InfoS("Disabled exporter", "exporter", name)
 	}
+}
+
+func (fa *flowAggregator) sendFlowKeyRecord(key ipfixintermediate.FlowKey, record *ipfixintermediate.AggregationFlowRecord) error {
+	isRecordIPv4 := fa.aggregationProcess.IsAggregatedRecordIPv4(*record)
 	if !fa.aggregationProcess.AreCorrelatedFieldsFilled(*record) {
 		fa.fillK8sMetadata(key, record.Record)
 		fa.aggregationProcess.SetCorrelatedFieldsFilled(record)
@@ -481,18 +567,13 @@ func (fa *flowAggregator) sendFlowKeyRecord(key ipfixintermediate.FlowKey, recor
 		fa.fillPodLabels(key, record.Record)
 		fa.aggregationProcess.SetExternalFieldsFilled(record)
 	}
-	if err := fa.set.AddRecord(record.Record.GetOrderedElementList(), templateID); err != nil {
-		return err
+	if fa.tcpMetrics != nil {
+		fa.tcpMetrics.fill(key, record.Record)
 	}
-	if fa.exportingProcess != nil {
-		sentBytes, err := fa.exportingProcess.SendSet(fa.set)
-		if err != nil {
+	for _, e := range fa.exporters() {
+		if err := e.AddRecord(record.Record, !isRecordIPv4); err != nil {
 			return err
 		}
-		klog.V(4).Infof("Data set sent successfully: %d Bytes sent", sentBytes)
-	}
-	if fa.dbExportProcess != nil {
-		fa.dbExportProcess.CacheSet(fa.set)
 	}
 	if err := fa.aggregationProcess.ResetStatAndThroughputElementsInRecord(record.Record); err != nil {
 		return err
@@ -501,106 +582,6 @@ func (fa *flowAggregator) sendFlowKeyRecord(key ipfixintermediate.FlowKey, recor
 	return nil
 }
 
-func (fa *flowAggregator) sendTemplateSet(isIPv6 bool) (int, error) {
-	elements := make([]ipfixentities.InfoElementWithValue, 0)
-	ianaInfoElements := ianaInfoElementsIPv4
-	antreaInfoElements := antreaInfoElementsIPv4
-	templateID := fa.templateIDv4
-	if isIPv6 {
-		ianaInfoElements = ianaInfoElementsIPv6
-		antreaInfoElements = antreaInfoElementsIPv6
-		templateID = fa.templateIDv6
-	}
-	for _, ie := range ianaInfoElements {
-		ie, err := fa.createInfoElementForTemplateSet(ie, ipfixregistry.IANAEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-	}
-	for _, ie := range ianaReverseInfoElements {
-		ie, err := fa.createInfoElementForTemplateSet(ie, ipfixregistry.IANAReversedEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-	}
-	for _, ie := range antreaInfoElements {
-		ie, err := fa.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-	}
-	// The order of source and destination stats elements needs to match the order specified in
-	// addFieldsForStatsAggregation method in go-ipfix aggregation process.
-	for i := range statsElementList {
-		// Add Antrea source stats fields
-		ieName := antreaSourceStatsElementList[i]
-		ie, err := fa.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-		// Add Antrea destination stats fields
-		ieName = antreaDestinationStatsElementList[i]
-		ie, err = fa.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-	}
-	for _, ie := range antreaFlowEndSecondsElementList {
-		ie, err := fa.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-	}
-	for i := range antreaThroughputElementList {
-		// Add common throughput fields
-		ieName := antreaThroughputElementList[i]
-		ie, err := fa.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-		// Add source node specific throughput fields
-		ieName = antreaSourceThroughputElementList[i]
-		ie, err = fa.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-		// Add destination node specific throughput fields
-		ieName = antreaDestinationThroughputElementList[i]
-		ie, err = fa.createInfoElementForTemplateSet(ieName, ipfixregistry.AntreaEnterpriseID)
-		if err != nil {
-			return 0, err
-		}
-		elements = append(elements, ie)
-	}
-	if fa.includePodLabels {
-		for _, ie := range antreaLabelsElementList {
-			ie, err := fa.createInfoElementForTemplateSet(ie, ipfixregistry.AntreaEnterpriseID)
-			if err != nil {
-				return 0, err
-			}
-			elements = append(elements, ie)
-		}
-	}
-	fa.set.ResetSet()
-	if err := fa.set.PrepareSet(ipfixentities.Template, templateID); err != nil {
-		return 0, err
-	}
-	err := fa.set.AddRecord(elements, templateID)
-	if err != nil {
-		return 0, fmt.Errorf("error when adding record to set, error: %v", err)
-	}
-	bytesSent, err := fa.exportingProcess.SendSet(fa.set)
-	return bytesSent, err
-}
-
 // fillK8sMetadata fills Pod name, Pod namespace and Node name for inter-Node flows
 // that have incomplete info due to deny network policy.
 func (fa *flowAggregator) fillK8sMetadata(key ipfixintermediate.FlowKey, record ipfixentities.Record) {
@@ -712,15 +693,3 @@ func (fa *flowAggregator) GetRecordMetrics() querier.Metrics {
 		NumConnToCollector: fa.collectingProcess.GetNumConnToCollector(),
 	}
 }
-
-func (fa *flowAggregator) createInfoElementForTemplateSet(ieName string, enterpriseID uint32) (ipfixentities.InfoElementWithValue, error) {
-	element, err := fa.registry.GetInfoElement(ieName, enterpriseID)
-	if err != nil {
-		return nil, fmt.Errorf("%s not present. returned error: %v", ieName, err)
-	}
-	ie, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
-	if err != nil {
-		return nil, err
-	}
-	return ie, nil
-}
@@ -16,41 +16,51 @@ package flowaggregator
 
 import (
 	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	ipfixentitiestesting "github.com/vmware/go-ipfix/pkg/entities/testing"
 	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 
+	"antrea.io/antrea/pkg/flowaggregator/exporter"
+	exportertesting "antrea.io/antrea/pkg/flowaggregator/exporter/testing"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/ipfix"
 	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
 )
 
 const (
-	testTemplateIDv4        = uint16(256)
-	testTemplateIDv6        = uint16(257)
-	testActiveTimeout       = 60 * time.Second
-	testInactiveTimeout     = 180 * time.Second
-	testObservationDomainID = 0xabcd
-	informerDefaultResync   = 12 * time.Hour
+	testActiveTimeout     = 60 * time.Second
+	testInactiveTimeout   = 180 * time.Second
+	informerDefaultResync = 12 * time.Hour
 )
 
 func init() {
-	ipfixregistry.LoadRegistry()
+	ipfix.NewIPFIXRegistry().LoadRegistry()
 }
 
 func TestFlowAggregator_sendFlowKeyRecord(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
+	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
+	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
 	mockIPFIXRegistry := ipfixtest.NewMockIPFIXRegistry(ctrl)
-	mockDataSet := ipfixentitiestesting.NewMockSet(ctrl)
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	mockAggregationProcess := ipfixtest.NewMockIPFIXAggregationProcess(ctrl)
 
@@ -59,20 +69,15 @@ func TestFlowAggregator_sendFlowKeyRecord(t *testing.T) {
 
 	newFlowAggregator := func(includePodLabels bool) *flowAggregator {
 		return &flowAggregator{
-			externalFlowCollectorAddr:   "",
-			externalFlowCollectorProto:  "",
 			aggregatorTransportProtocol: "tcp",
 			aggregationProcess:          mockAggregationProcess,
 			activeFlowRecordTimeout:     testActiveTimeout,
 			inactiveFlowRecordTimeout:   testInactiveTimeout,
-			exportingProcess:            mockIPFIXExpProc,
-			templateIDv4:                testTemplateIDv4,
-			templateIDv6:                testTemplateIDv6,
+			ipfixExporter:               mockIPFIXExporter,
+			clickHouseExporter:          mockClickHouseExporter,
 			registry:                    mockIPFIXRegistry,
-			set:                         mockDataSet,
 			flowAggregatorAddress:       "",
 			includePodLabels:            includePodLabels,
-			observationDomainID:         testObservationDomainID,
 			podInformer:                 informerFactory.Core().V1().Pods(),
 		}
 	}
@@ -137,16 +142,8 @@ func TestFlowAggregator_sendFlowKeyRecord(t *testing.T) {
 
 	for _, tc := range testcases {
 		fa := newFlowAggregator(tc.includePodLabels)
-		templateID := fa.templateIDv4
-		if tc.isIPv6 {
-			templateID = fa.templateIDv6
-		}
-		mockDataSet.EXPECT().ResetSet()
-		mockDataSet.EXPECT().PrepareSet(ipfixentities.Data, templateID).Return(nil)
-		elementList := make([]ipfixentities.InfoElementWithValue, 0)
-		mockRecord.EXPECT().GetOrderedElementList().Return(elementList)
-		mockDataSet.EXPECT().AddRecord(elementList, templateID).Return(nil)
-		mockIPFIXExpProc.EXPECT().SendSet(mockDataSet).Return(0, nil)
+		mockIPFIXExporter.EXPECT().AddRecord(mockRecord, tc.isIPv6)
+		mockClickHouseExporter.EXPECT().AddRecord(mockRecord, tc.isIPv6)
 		mockAggregationProcess.EXPECT().ResetStatAndThroughputElementsInRecord(mockRecord).Return(nil)
 		mockAggregationProcess.EXPECT().AreCorrelatedFieldsFilled(*tc.flowRecord).Return(false)
 		emptyStr := make([]byte, 0)
@@ -174,107 +171,254 @@ func TestFlowAggregator_sendFlowKeyRecord(t *testing.T) {
 	}
 }
 
-func TestFlowAggregator_sendTemplateSet(t *testing.T) {
+func TestNewFlowAggregator(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
-	mockIPFIXRegistry := ipfixtest.NewMockIPFIXRegistry(ctrl)
-	mockTempSet := ipfixentitiestesting.NewMockSet(ctrl)
+	origNewIPFIXExporter, origNewClickHouseExporter, origNewKafkaExporter, origNewS3Exporter, origNewLogExporter := newIPFIXExporter, newClickHouseExporter, newKafkaExporter, newS3Exporter, newLogExporter
+	defer func() {
+		newIPFIXExporter, newClickHouseExporter, newKafkaExporter, newS3Exporter, newLogExporter = origNewIPFIXExporter, origNewClickHouseExporter, origNewKafkaExporter, origNewS3Exporter, origNewLogExporter
+	}()
+	newIPFIXExporter = func(kubernetes.Interface, *options.Options, ipfix.IPFIXRegistry) exporter.Interface {
+		return exportertesting.NewMockInterface(ctrl)
+	}
+	newClickHouseExporter = func(*options.Options) (exporter.Interface, error) {
+		return exportertesting.NewMockInterface(ctrl), nil
+	}
+	newKafkaExporter = func(*options.Options) (exporter.Interface, error) {
+		return exportertesting.NewMockInterface(ctrl), nil
+	}
+	newS3Exporter = func(*options.Options) (exporter.Interface, error) {
+		return nil, fmt.Errorf("missing credentials")
+	}
+	newLogExporter = func(*options.Options) exporter.Interface {
+		return exportertesting.NewMockInterface(ctrl)
+	}
 
-	newFlowAggregator := func(includePodLabels bool) *flowAggregator {
-		return &flowAggregator{
-			externalFlowCollectorAddr:   "",
-			externalFlowCollectorProto:  "",
-			aggregatorTransportProtocol: "tcp",
-			collectingProcess:           nil,
-			aggregationProcess:          nil,
-			activeFlowRecordTimeout:     testActiveTimeout,
-			exportingProcess:            mockIPFIXExpProc,
-			templateIDv4:                testTemplateIDv4,
-			templateIDv6:                testTemplateIDv6,
-			registry:                    mockIPFIXRegistry,
-			set:                         mockTempSet,
-			flowAggregatorAddress:       "",
-			includePodLabels:            includePodLabels,
-			k8sClient:                   nil,
-			observationDomainID:         testObservationDomainID,
-		}
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, informerDefaultResync)
+
+	opt, err := options.LoadConfig([]byte(`
+flowCollector:
+  enable: true
+  address: 10.0.0.1:4739
+kafka:
+  enable: true
+  brokers: ["kafka:9092"]
+flowLogger:
+  enable: true
+`))
+	require.NoError(t, err)
+	fa, err := NewFlowAggregator(client, informerFactory.Core().V1().Pods(), "", opt)
+	require.NoError(t, err)
+	assert.NotNil(t, fa.ipfixExporter)
+	assert.Nil(t, fa.clickHouseExporter)
+	assert.NotNil(t, fa.kafkaExporter)
+	assert.Nil(t, fa.s3Exporter)
+	assert.NotNil(t, fa.logExporter)
+	assert.Len(t, fa.exporters(), 3)
+	assert.Equal(t, opt.ActiveFlowRecordTimeout, fa.activeFlowRecordTimeout)
+	assert.Equal(t, opt.InactiveFlowRecordTimeout, fa.inactiveFlowRecordTimeout)
+
+	opt, err = options.LoadConfig([]byte(`
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+`))
+	require.NoError(t, err)
+	_, err = NewFlowAggregator(client, informerFactory.Core().V1().Pods(), "", opt)
+	assert.Error(t, err)
+}
+
+func TestFlowAggregator_updateFlowAggregator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
+	mockClickHouseExporter := exportertesting.NewMockInterface(ctrl)
+	mockKafkaExporter := exportertesting.NewMockInterface(ctrl)
+
+	origNewKafkaExporter, origNewS3Exporter := newKafkaExporter, newS3Exporter
+	defer func() {
+		newKafkaExporter, newS3Exporter = origNewKafkaExporter, origNewS3Exporter
+	}()
+	newKafkaExporter = func(*options.Options) (exporter.Interface, error) {
+		return mockKafkaExporter, nil
+	}
+	newS3Exporter = func(*options.Options) (exporter.Interface, error) {
+		return nil, fmt.Errorf("missing credentials")
 	}
 
-	testcases := []struct {
-		isIPv6           bool
-		includePodLabels bool
-	}{
-		{false, true},
-		{true, true},
-		{false, false},
-		{true, false},
+	fa := &flowAggregator{
+		ipfixExporter:      mockIPFIXExporter,
+		clickHouseExporter: mockClickHouseExporter,
 	}
+	opt, err := options.LoadConfig([]byte(`
+flowCollector:
+  enable: true
+  address: 10.0.0.2:4739
+kafka:
+  enable: true
+  brokers: ["kafka:9092"]
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+recordContents:
+  podLabels: true
+`))
+	require.NoError(t, err)
+	mockIPFIXExporter.EXPECT().UpdateOptions(opt)
+	mockClickHouseExporter.EXPECT().Stop()
+	mockKafkaExporter.EXPECT().Start()
+	fa.updateFlowAggregator(opt)
+	assert.True(t, fa.includePodLabels)
+	assert.Equal(t, mockIPFIXExporter, fa.ipfixExporter)
+	assert.Nil(t, fa.clickHouseExporter)
+	assert.Equal(t, mockKafkaExporter, fa.kafkaExporter)
+	assert.Nil(t, fa.s3Exporter)
+	assert.Nil(t, fa.logExporter)
+}
 
-	for _, tc := range testcases {
-		fa := newFlowAggregator(tc.includePodLabels)
-		ianaInfoElements := ianaInfoElementsIPv4
-		antreaInfoElements := antreaInfoElementsIPv4
-		testTemplateID := fa.templateIDv4
-		if tc.isIPv6 {
-			ianaInfoElements = ianaInfoElementsIPv6
-			antreaInfoElements = antreaInfoElementsIPv6
-			testTemplateID = fa.templateIDv6
-		}
-		// Following consists of all elements that are in ianaInfoElements and antreaInfoElements (globals)
-		// Only the element name is needed, other arguments have dummy values.
-		elemList := make([]ipfixentities.InfoElementWithValue, 0)
-		for _, ie := range ianaInfoElements {
-			elemList = append(elemList, createElement(ie, ipfixregistry.IANAEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.IANAEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-		}
-		for _, ie := range ianaReverseInfoElements {
-			elemList = append(elemList, createElement(ie, ipfixregistry.IANAReversedEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.IANAReversedEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-		}
-		for _, ie := range antreaInfoElements {
-			elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-		}
-		for i := range statsElementList {
-			elemList = append(elemList, createElement(antreaSourceStatsElementList[i], ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(antreaSourceStatsElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-			elemList = append(elemList, createElement(antreaDestinationStatsElementList[i], ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(antreaDestinationStatsElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-		}
-		for _, ie := range antreaFlowEndSecondsElementList {
-			elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-		}
-		for i := range antreaThroughputElementList {
-			elemList = append(elemList, createElement(antreaThroughputElementList[i], ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(antreaThroughputElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-			elemList = append(elemList, createElement(antreaSourceThroughputElementList[i], ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(antreaSourceThroughputElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-			elemList = append(elemList, createElement(antreaDestinationThroughputElementList[i], ipfixregistry.AntreaEnterpriseID))
-			mockIPFIXRegistry.EXPECT().GetInfoElement(antreaDestinationThroughputElementList[i], ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-		}
-		if tc.includePodLabels {
-			for _, ie := range antreaLabelsElementList {
-				elemList = append(elemList, createElement(ie, ipfixregistry.AntreaEnterpriseID))
-				mockIPFIXRegistry.EXPECT().GetInfoElement(ie, ipfixregistry.AntreaEnterpriseID).Return(elemList[len(elemList)-1].GetInfoElement(), nil)
-			}
-		}
-		mockTempSet.EXPECT().ResetSet()
-		mockTempSet.EXPECT().PrepareSet(ipfixentities.Template, testTemplateID).Return(nil)
-		mockTempSet.EXPECT().AddRecord(elemList, testTemplateID).Return(nil)
-		// Passing 0 for sentBytes as it is not used anywhere in the test. If this not a call to mock, the actual sentBytes
-		// above elements: ianaInfoElements, ianaReverseInfoElements and antreaInfoElements.
-		mockIPFIXExpProc.EXPECT().SendSet(mockTempSet).Return(0, nil)
-
-		_, err := fa.sendTemplateSet(tc.isIPv6)
-		assert.NoErrorf(t, err, "Error in sending template record: %v, isIPv6: %v", err, tc.isIPv6)
+func TestFlowAggregator_watchConfiguration(t *testing.T) {
+	configDir, err := ioutil.TempDir("", "flow-aggregator")
+	require.NoError(t, err)
+	defer os.RemoveAll(configDir)
+	configFile := filepath.Join(configDir, "flow-aggregator.conf")
+	config := fmt.Sprintf("flowLogger:\n  enable: true\n  path: %s\n", filepath.Join(configDir, "flows.log"))
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(config), 0644))
+	opt, err := options.LoadConfig([]byte(config))
+	require.NoError(t, err)
+
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, informerDefaultResync)
+	fa, err := NewFlowAggregator(client, informerFactory.Core().V1().Pods(), configFile, opt)
+	require.NoError(t, err)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go fa.watchConfiguration(stopCh)
+
+	// An invalid configuration is ignored.
+	require.NoError(t, ioutil.WriteFile(configFile, []byte("activeFlowRecordTimeout: foo"), 0644))
+	select {
+	case <-fa.updateCh:
+		t.Fatalf("Invalid configuration should be ignored")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(config+"recordContents:\n  podLabels: true\n"), 0644))
+	select {
+	case newOpt := <-fa.updateCh:
+		assert.True(t, newOpt.Config.RecordContents.PodLabels)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timeout when waiting for the configuration update")
 	}
+
+	// Parameters which require a restart keep their current values, the other parameters are updated.
+	restartOnlyConfig := "activeFlowRecordTimeout: 120s\naggregatorTransportProtocol: UDP\nflowAggregatorAddress: flow-aggregator.example.com\n"
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(config+restartOnlyConfig), 0644))
+	select {
+	case newOpt := <-fa.updateCh:
+		assert.False(t, newOpt.Config.RecordContents.PodLabels)
+		assert.Equal(t, opt.ActiveFlowRecordTimeout, newOpt.ActiveFlowRecordTimeout)
+		assert.Equal(t, opt.Config.ActiveFlowRecordTimeout, newOpt.Config.ActiveFlowRecordTimeout)
+		assert.Equal(t, opt.AggregatorTransportProtocol, newOpt.AggregatorTransportProtocol)
+		assert.Equal(t, opt.Config.FlowAggregatorAddress, newOpt.Config.FlowAggregatorAddress)
+		assert.Equal(t, newOpt, fa.configOpt)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timeout when waiting for the configuration update")
+	}
+
+	// Changes to parameters which require a restart only are not sent.
+	require.NoError(t, ioutil.WriteFile(configFile, []byte(config+"inactiveFlowRecordTimeout: 150s\n"), 0644))
+	select {
+	case <-fa.updateCh:
+		t.Fatalf("Changes to parameters which require a restart should not be sent")
+	case <-time.After(500 * time.Millisecond):
+	}
+	assert.Equal(t, opt.InactiveFlowRecordTimeout, fa.configOpt.InactiveFlowRecordTimeout)
 }
 
-func createElement(name string, enterpriseID uint32) ipfixentities.InfoElementWithValue {
-	element, _ := ipfixregistry.GetInfoElement(name, enterpriseID)
-	ieWithValue, _ := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
-	return ieWithValue
+func TestFlowAggregator_flowRecordExpiryCheck_drain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
+	mockAggregationProcess := ipfixtest.NewMockIPFIXAggregationProcess(ctrl)
+	fa := &flowAggregator{
+		aggregationProcess:      mockAggregationProcess,
+		activeFlowRecordTimeout: testActiveTimeout,
+		ipfixExporter:           mockIPFIXExporter,
+	}
+
+	// The flow records are sent before the exporters are stopped.
+	gomock.InOrder(
+		mockAggregationProcess.EXPECT().ForAllRecordsDo(gomock.Any()).Return(nil),
+		mockIPFIXExporter.EXPECT().Stop(),
+	)
+	stopCh := make(chan struct{})
+	close(stopCh)
+	fa.flowRecordExpiryCheck(stopCh)
+}
+
+func TestFlowAggregator_drain(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockIPFIXExporter := exportertesting.NewMockInterface(ctrl)
+	mockAggregationProcess := ipfixtest.NewMockIPFIXAggregationProcess(ctrl)
+	client := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(client, informerDefaultResync)
+	podInformer := informerFactory.Core().V1().Pods()
+	require.NoError(t, podInformer.Informer().AddIndexers(cache.Indexers{podInfoIndex: podInfoIndexFunc}))
+	require.NoError(t, podInformer.Informer().GetIndexer().Add(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "ns"},
+		Spec:       corev1.PodSpec{NodeName: "node2"},
+		Status:     corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: "10.0.1.1"}}},
+	}))
+	fa := &flowAggregator{
+		aggregationProcess: mockAggregationProcess,
+		ipfixExporter:      mockIPFIXExporter,
+		podInformer:        podInformer,
+	}
+
+	newStringElement := func(name, value string) ipfixentities.InfoElementWithValue {
+		element, err := ipfixregistry.GetInfoElement(name, ipfixregistry.AntreaEnterpriseID)
+		require.NoError(t, err)
+		return ipfixentities.NewStringInfoElement(element, value)
+	}
+	readyRecord := &ipfixintermediate.AggregationFlowRecord{
+		Record:      ipfixentitiestesting.NewMockRecord(ctrl),
+		ReadyToSend: true,
+	}
+	// The record of an inter-Node connection which ended before the record from the destination
+	// Node was received, which is exported with the metadata of the destination Pod.
+	mockEndedRecord := ipfixentitiestesting.NewMockRecord(ctrl)
+	endedRecord := &ipfixintermediate.AggregationFlowRecord{
+		Record: mockEndedRecord,
+	}
+	destinationPodName := newStringElement("destinationPodName", "")
+	destinationPodNamespace := newStringElement("destinationPodNamespace", "")
+	destinationNodeName := newStringElement("destinationNodeName", "")
+	mockEndedRecord.EXPECT().GetInfoElementWithValue("sourcePodName").Return(newStringElement("sourcePodName", "client"), 0, true)
+	mockEndedRecord.EXPECT().GetInfoElementWithValue("destinationPodName").Return(destinationPodName, 0, true)
+	mockEndedRecord.EXPECT().GetInfoElementWithValue("destinationPodNamespace").Return(destinationPodNamespace, 0, true)
+	mockEndedRecord.EXPECT().GetInfoElementWithValue("destinationNodeName").Return(destinationNodeName, 0, true)
+
+	mockAggregationProcess.EXPECT().ForAllRecordsDo(gomock.Any()).DoAndReturn(func(callback ipfixintermediate.FlowKeyRecordMapCallBack) error {
+		require.NoError(t, callback(ipfixintermediate.FlowKey{SourceAddress: "10.0.0.1", DestinationAddress: "10.0.1.1"}, endedRecord))
+		return callback(ipfixintermediate.FlowKey{SourceAddress: "10.0.0.2", DestinationAddress: "10.0.1.2"}, readyRecord)
+	})
+	for _, record := range []*ipfixintermediate.AggregationFlowRecord{endedRecord, readyRecord} {
+		mockAggregationProcess.EXPECT().IsAggregatedRecordIPv4(*record).Return(true)
+		mockIPFIXExporter.EXPECT().AddRecord(record.Record, false)
+		mockAggregationProcess.EXPECT().ResetStatAndThroughputElementsInRecord(record.Record).Return(nil)
+	}
+	mockAggregationProcess.EXPECT().AreCorrelatedFieldsFilled(*endedRecord).Return(false)
+	mockAggregationProcess.EXPECT().SetCorrelatedFieldsFilled(endedRecord)
+	mockAggregationProcess.EXPECT().AreCorrelatedFieldsFilled(*readyRecord).Return(true)
+	fa.drain()
+	assert.Equal(t, int64(2), fa.numRecordsExported)
+	assert.Equal(t, "server", destinationPodName.GetStringValue())
+	assert.Equal(t, "ns", destinationPodNamespace.GetStringValue())
+	assert.Equal(t, "node2", destinationNodeName.GetStringValue())
 }
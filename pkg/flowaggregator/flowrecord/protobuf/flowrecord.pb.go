@@ -0,0 +1,744 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: pkg/flowaggregator/flowrecord/protobuf/flowrecord.proto
+
+package protobuf
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FlowRecord is an aggregated flow record exported by the Flow Aggregator. The fields match the
+// fields of flowrecord.FlowRecord, and new fields must be appended with new field numbers so that
+// consumers built with a previous version of this schema can still decode the records.
+type FlowRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlowStartSeconds                     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=flow_start_seconds,json=flowStartSeconds,proto3" json:"flow_start_seconds,omitempty"`
+	FlowEndSeconds                       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=flow_end_seconds,json=flowEndSeconds,proto3" json:"flow_end_seconds,omitempty"`
+	FlowEndSecondsFromSourceNode         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=flow_end_seconds_from_source_node,json=flowEndSecondsFromSourceNode,proto3" json:"flow_end_seconds_from_source_node,omitempty"`
+	FlowEndSecondsFromDestinationNode    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=flow_end_seconds_from_destination_node,json=flowEndSecondsFromDestinationNode,proto3" json:"flow_end_seconds_from_destination_node,omitempty"`
+	FlowEndReason                        uint32                 `protobuf:"varint,5,opt,name=flow_end_reason,json=flowEndReason,proto3" json:"flow_end_reason,omitempty"`
+	SourceIp                             string                 `protobuf:"bytes,6,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	DestinationIp                        string                 `protobuf:"bytes,7,opt,name=destination_ip,json=destinationIp,proto3" json:"destination_ip,omitempty"`
+	SourceTransportPort                  uint32                 `protobuf:"varint,8,opt,name=source_transport_port,json=sourceTransportPort,proto3" json:"source_transport_port,omitempty"`
+	DestinationTransportPort             uint32                 `protobuf:"varint,9,opt,name=destination_transport_port,json=destinationTransportPort,proto3" json:"destination_transport_port,omitempty"`
+	ProtocolIdentifier                   uint32                 `protobuf:"varint,10,opt,name=protocol_identifier,json=protocolIdentifier,proto3" json:"protocol_identifier,omitempty"`
+	PacketTotalCount                     uint64                 `protobuf:"varint,11,opt,name=packet_total_count,json=packetTotalCount,proto3" json:"packet_total_count,omitempty"`
+	OctetTotalCount                      uint64                 `protobuf:"varint,12,opt,name=octet_total_count,json=octetTotalCount,proto3" json:"octet_total_count,omitempty"`
+	PacketDeltaCount                     uint64                 `protobuf:"varint,13,opt,name=packet_delta_count,json=packetDeltaCount,proto3" json:"packet_delta_count,omitempty"`
+	OctetDeltaCount                      uint64                 `protobuf:"varint,14,opt,name=octet_delta_count,json=octetDeltaCount,proto3" json:"octet_delta_count,omitempty"`
+	ReversePacketTotalCount              uint64                 `protobuf:"varint,15,opt,name=reverse_packet_total_count,json=reversePacketTotalCount,proto3" json:"reverse_packet_total_count,omitempty"`
+	ReverseOctetTotalCount               uint64                 `protobuf:"varint,16,opt,name=reverse_octet_total_count,json=reverseOctetTotalCount,proto3" json:"reverse_octet_total_count,omitempty"`
+	ReversePacketDeltaCount              uint64                 `protobuf:"varint,17,opt,name=reverse_packet_delta_count,json=reversePacketDeltaCount,proto3" json:"reverse_packet_delta_count,omitempty"`
+	ReverseOctetDeltaCount               uint64                 `protobuf:"varint,18,opt,name=reverse_octet_delta_count,json=reverseOctetDeltaCount,proto3" json:"reverse_octet_delta_count,omitempty"`
+	SourcePodName                        string                 `protobuf:"bytes,19,opt,name=source_pod_name,json=sourcePodName,proto3" json:"source_pod_name,omitempty"`
+	SourcePodNamespace                   string                 `protobuf:"bytes,20,opt,name=source_pod_namespace,json=sourcePodNamespace,proto3" json:"source_pod_namespace,omitempty"`
+	SourceNodeName                       string                 `protobuf:"bytes,21,opt,name=source_node_name,json=sourceNodeName,proto3" json:"source_node_name,omitempty"`
+	DestinationPodName                   string                 `protobuf:"bytes,22,opt,name=destination_pod_name,json=destinationPodName,proto3" json:"destination_pod_name,omitempty"`
+	DestinationPodNamespace              string                 `protobuf:"bytes,23,opt,name=destination_pod_namespace,json=destinationPodNamespace,proto3" json:"destination_pod_namespace,omitempty"`
+	DestinationNodeName                  string                 `protobuf:"bytes,24,opt,name=destination_node_name,json=destinationNodeName,proto3" json:"destination_node_name,omitempty"`
+	DestinationClusterIp                 string                 `protobuf:"bytes,25,opt,name=destination_cluster_ip,json=destinationClusterIp,proto3" json:"destination_cluster_ip,omitempty"`
+	DestinationServicePort               uint32                 `protobuf:"varint,26,opt,name=destination_service_port,json=destinationServicePort,proto3" json:"destination_service_port,omitempty"`
+	DestinationServicePortName           string                 `protobuf:"bytes,27,opt,name=destination_service_port_name,json=destinationServicePortName,proto3" json:"destination_service_port_name,omitempty"`
+	IngressNetworkPolicyName             string                 `protobuf:"bytes,28,opt,name=ingress_network_policy_name,json=ingressNetworkPolicyName,proto3" json:"ingress_network_policy_name,omitempty"`
+	IngressNetworkPolicyNamespace        string                 `protobuf:"bytes,29,opt,name=ingress_network_policy_namespace,json=ingressNetworkPolicyNamespace,proto3" json:"ingress_network_policy_namespace,omitempty"`
+	IngressNetworkPolicyRuleName         string                 `protobuf:"bytes,30,opt,name=ingress_network_policy_rule_name,json=ingressNetworkPolicyRuleName,proto3" json:"ingress_network_policy_rule_name,omitempty"`
+	IngressNetworkPolicyRuleAction       uint32                 `protobuf:"varint,31,opt,name=ingress_network_policy_rule_action,json=ingressNetworkPolicyRuleAction,proto3" json:"ingress_network_policy_rule_action,omitempty"`
+	IngressNetworkPolicyType             uint32                 `protobuf:"varint,32,opt,name=ingress_network_policy_type,json=ingressNetworkPolicyType,proto3" json:"ingress_network_policy_type,omitempty"`
+	EgressNetworkPolicyName              string                 `protobuf:"bytes,33,opt,name=egress_network_policy_name,json=egressNetworkPolicyName,proto3" json:"egress_network_policy_name,omitempty"`
+	EgressNetworkPolicyNamespace         string                 `protobuf:"bytes,34,opt,name=egress_network_policy_namespace,json=egressNetworkPolicyNamespace,proto3" json:"egress_network_policy_namespace,omitempty"`
+	EgressNetworkPolicyRuleName          string                 `protobuf:"bytes,35,opt,name=egress_network_policy_rule_name,json=egressNetworkPolicyRuleName,proto3" json:"egress_network_policy_rule_name,omitempty"`
+	EgressNetworkPolicyRuleAction        uint32                 `protobuf:"varint,36,opt,name=egress_network_policy_rule_action,json=egressNetworkPolicyRuleAction,proto3" json:"egress_network_policy_rule_action,omitempty"`
+	EgressNetworkPolicyType              uint32                 `protobuf:"varint,37,opt,name=egress_network_policy_type,json=egressNetworkPolicyType,proto3" json:"egress_network_policy_type,omitempty"`
+	TcpState                             string                 `protobuf:"bytes,38,opt,name=tcp_state,json=tcpState,proto3" json:"tcp_state,omitempty"`
+	FlowType                             uint32                 `protobuf:"varint,39,opt,name=flow_type,json=flowType,proto3" json:"flow_type,omitempty"`
+	SourcePodLabels                      string                 `protobuf:"bytes,40,opt,name=source_pod_labels,json=sourcePodLabels,proto3" json:"source_pod_labels,omitempty"`
+	DestinationPodLabels                 string                 `protobuf:"bytes,41,opt,name=destination_pod_labels,json=destinationPodLabels,proto3" json:"destination_pod_labels,omitempty"`
+	Throughput                           uint64                 `protobuf:"varint,42,opt,name=throughput,proto3" json:"throughput,omitempty"`
+	ReverseThroughput                    uint64                 `protobuf:"varint,43,opt,name=reverse_throughput,json=reverseThroughput,proto3" json:"reverse_throughput,omitempty"`
+	ThroughputFromSourceNode             uint64                 `protobuf:"varint,44,opt,name=throughput_from_source_node,json=throughputFromSourceNode,proto3" json:"throughput_from_source_node,omitempty"`
+	ThroughputFromDestinationNode        uint64                 `protobuf:"varint,45,opt,name=throughput_from_destination_node,json=throughputFromDestinationNode,proto3" json:"throughput_from_destination_node,omitempty"`
+	ReverseThroughputFromSourceNode      uint64                 `protobuf:"varint,46,opt,name=reverse_throughput_from_source_node,json=reverseThroughputFromSourceNode,proto3" json:"reverse_throughput_from_source_node,omitempty"`
+	ReverseThroughputFromDestinationNode uint64                 `protobuf:"varint,47,opt,name=reverse_throughput_from_destination_node,json=reverseThroughputFromDestinationNode,proto3" json:"reverse_throughput_from_destination_node,omitempty"`
+	DestinationFqdn                      string                 `protobuf:"bytes,48,opt,name=destination_fqdn,json=destinationFqdn,proto3" json:"destination_fqdn,omitempty"`
+	TcpSmoothedRtt                       uint32                 `protobuf:"varint,49,opt,name=tcp_smoothed_rtt,json=tcpSmoothedRtt,proto3" json:"tcp_smoothed_rtt,omitempty"`
+	TcpRetransmissions                   uint32                 `protobuf:"varint,50,opt,name=tcp_retransmissions,json=tcpRetransmissions,proto3" json:"tcp_retransmissions,omitempty"`
+}
+
+func (x *FlowRecord) Reset() {
+	*x = FlowRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowRecord) ProtoMessage() {}
+
+func (x *FlowRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowRecord.ProtoReflect.Descriptor instead.
+func (*FlowRecord) Descriptor() ([]byte, []int) {
+	return file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FlowRecord) GetFlowStartSeconds() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FlowStartSeconds
+	}
+	return nil
+}
+
+func (x *FlowRecord) GetFlowEndSeconds() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FlowEndSeconds
+	}
+	return nil
+}
+
+func (x *FlowRecord) GetFlowEndSecondsFromSourceNode() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FlowEndSecondsFromSourceNode
+	}
+	return nil
+}
+
+func (x *FlowRecord) GetFlowEndSecondsFromDestinationNode() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FlowEndSecondsFromDestinationNode
+	}
+	return nil
+}
+
+func (x *FlowRecord) GetFlowEndReason() uint32 {
+	if x != nil {
+		return x.FlowEndReason
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationIp() string {
+	if x != nil {
+		return x.DestinationIp
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetSourceTransportPort() uint32 {
+	if x != nil {
+		return x.SourceTransportPort
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetDestinationTransportPort() uint32 {
+	if x != nil {
+		return x.DestinationTransportPort
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetProtocolIdentifier() uint32 {
+	if x != nil {
+		return x.ProtocolIdentifier
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetPacketTotalCount() uint64 {
+	if x != nil {
+		return x.PacketTotalCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetOctetTotalCount() uint64 {
+	if x != nil {
+		return x.OctetTotalCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetPacketDeltaCount() uint64 {
+	if x != nil {
+		return x.PacketDeltaCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetOctetDeltaCount() uint64 {
+	if x != nil {
+		return x.OctetDeltaCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReversePacketTotalCount() uint64 {
+	if x != nil {
+		return x.ReversePacketTotalCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReverseOctetTotalCount() uint64 {
+	if x != nil {
+		return x.ReverseOctetTotalCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReversePacketDeltaCount() uint64 {
+	if x != nil {
+		return x.ReversePacketDeltaCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReverseOctetDeltaCount() uint64 {
+	if x != nil {
+		return x.ReverseOctetDeltaCount
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetSourcePodName() string {
+	if x != nil {
+		return x.SourcePodName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetSourcePodNamespace() string {
+	if x != nil {
+		return x.SourcePodNamespace
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetSourceNodeName() string {
+	if x != nil {
+		return x.SourceNodeName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationPodName() string {
+	if x != nil {
+		return x.DestinationPodName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationPodNamespace() string {
+	if x != nil {
+		return x.DestinationPodNamespace
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationNodeName() string {
+	if x != nil {
+		return x.DestinationNodeName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationClusterIp() string {
+	if x != nil {
+		return x.DestinationClusterIp
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationServicePort() uint32 {
+	if x != nil {
+		return x.DestinationServicePort
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetDestinationServicePortName() string {
+	if x != nil {
+		return x.DestinationServicePortName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetIngressNetworkPolicyName() string {
+	if x != nil {
+		return x.IngressNetworkPolicyName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetIngressNetworkPolicyNamespace() string {
+	if x != nil {
+		return x.IngressNetworkPolicyNamespace
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetIngressNetworkPolicyRuleName() string {
+	if x != nil {
+		return x.IngressNetworkPolicyRuleName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetIngressNetworkPolicyRuleAction() uint32 {
+	if x != nil {
+		return x.IngressNetworkPolicyRuleAction
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetIngressNetworkPolicyType() uint32 {
+	if x != nil {
+		return x.IngressNetworkPolicyType
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetEgressNetworkPolicyName() string {
+	if x != nil {
+		return x.EgressNetworkPolicyName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetEgressNetworkPolicyNamespace() string {
+	if x != nil {
+		return x.EgressNetworkPolicyNamespace
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetEgressNetworkPolicyRuleName() string {
+	if x != nil {
+		return x.EgressNetworkPolicyRuleName
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetEgressNetworkPolicyRuleAction() uint32 {
+	if x != nil {
+		return x.EgressNetworkPolicyRuleAction
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetEgressNetworkPolicyType() uint32 {
+	if x != nil {
+		return x.EgressNetworkPolicyType
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetTcpState() string {
+	if x != nil {
+		return x.TcpState
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetFlowType() uint32 {
+	if x != nil {
+		return x.FlowType
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetSourcePodLabels() string {
+	if x != nil {
+		return x.SourcePodLabels
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetDestinationPodLabels() string {
+	if x != nil {
+		return x.DestinationPodLabels
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetThroughput() uint64 {
+	if x != nil {
+		return x.Throughput
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReverseThroughput() uint64 {
+	if x != nil {
+		return x.ReverseThroughput
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetThroughputFromSourceNode() uint64 {
+	if x != nil {
+		return x.ThroughputFromSourceNode
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetThroughputFromDestinationNode() uint64 {
+	if x != nil {
+		return x.ThroughputFromDestinationNode
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReverseThroughputFromSourceNode() uint64 {
+	if x != nil {
+		return x.ReverseThroughputFromSourceNode
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetReverseThroughputFromDestinationNode() uint64 {
+	if x != nil {
+		return x.ReverseThroughputFromDestinationNode
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetDestinationFqdn() string {
+	if x != nil {
+		return x.DestinationFqdn
+	}
+	return ""
+}
+
+func (x *FlowRecord) GetTcpSmoothedRtt() uint32 {
+	if x != nil {
+		return x.TcpSmoothedRtt
+	}
+	return 0
+}
+
+func (x *FlowRecord) GetTcpRetransmissions() uint32 {
+	if x != nil {
+		return x.TcpRetransmissions
+	}
+	return 0
+}
+
+var File_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto protoreflect.FileDescriptor
+
+var file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDesc = []byte{
+	0x0a, 0x37, 0x70, 0x6b, 0x67, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x6f, 0x72, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x37, 0x61, 0x6e, 0x74, 0x72, 0x65,
+	0x61, 0x5f, 0x69, 0x6f, 0x2e, 0x61, 0x6e, 0x74, 0x72, 0x65, 0x61, 0x2e, 0x70, 0x6b, 0x67, 0x2e,
+	0x66, 0x6c, 0x6f, 0x77, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x66,
+	0x6c, 0x6f, 0x77, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x16, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x48, 0x0a, 0x12, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x66, 0x6c, 0x6f, 0x77,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x44, 0x0a, 0x10,
+	0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x63, 0x0a, 0x21, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x1c, 0x66, 0x6c, 0x6f, 0x77, 0x45,
+	0x6e, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x6d, 0x0a, 0x26, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x21, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65,
+	0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0d, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x70, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x13, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x6f, 0x63, 0x74, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x19, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x16, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x64, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3a, 0x0a, 0x19, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x70, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x41, 0x0a, 0x1d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x47, 0x0a, 0x20, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x20, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x1b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x18, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x1a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x17, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x1f, 0x65, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1c, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x44, 0x0a, 0x1f, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x65, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x21, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x63, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x66, 0x6c, 0x6f, 0x77, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x29,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x3d, 0x0a, 0x1b, 0x74, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x20, 0x74, 0x68, 0x72, 0x6f,
+	0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x1d, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x46, 0x72,
+	0x6f, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x4c, 0x0a, 0x23, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x74, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1f,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x56, 0x0a, 0x28, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x24, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x70, 0x75, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x30, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x71,
+	0x64, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68,
+	0x65, 0x64, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x63,
+	0x70, 0x53, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x65, 0x64, 0x52, 0x74, 0x74, 0x12, 0x2f, 0x0a, 0x13,
+	0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x63, 0x70, 0x52, 0x65,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x28, 0x5a,
+	0x26, 0x70, 0x6b, 0x67, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x6f, 0x72, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescOnce sync.Once
+	file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescData = file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDesc
+)
+
+func file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescGZIP() []byte {
+	file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescOnce.Do(func() {
+		file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescData)
+	})
+	return file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDescData
+}
+
+var file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_goTypes = []interface{}{
+	(*FlowRecord)(nil),            // 0: antrea_io.antrea.pkg.flowaggregator.flowrecord.protobuf.FlowRecord
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_depIdxs = []int32{
+	1, // 0: antrea_io.antrea.pkg.flowaggregator.flowrecord.protobuf.FlowRecord.flow_start_seconds:type_name -> google.protobuf.Timestamp
+	1, // 1: antrea_io.antrea.pkg.flowaggregator.flowrecord.protobuf.FlowRecord.flow_end_seconds:type_name -> google.protobuf.Timestamp
+	1, // 2: antrea_io.antrea.pkg.flowaggregator.flowrecord.protobuf.FlowRecord.flow_end_seconds_from_source_node:type_name -> google.protobuf.Timestamp
+	1, // 3: antrea_io.antrea.pkg.flowaggregator.flowrecord.protobuf.FlowRecord.flow_end_seconds_from_destination_node:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_init() }
+func file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_init() {
+	if File_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_goTypes,
+		DependencyIndexes: file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_depIdxs,
+		MessageInfos:      file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_msgTypes,
+	}.Build()
+	File_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto = out.File
+	file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_rawDesc = nil
+	file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_goTypes = nil
+	file_pkg_flowaggregator_flowrecord_protobuf_flowrecord_proto_depIdxs = nil
+}
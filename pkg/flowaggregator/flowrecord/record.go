@@ -0,0 +1,239 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowrecord
+
+import (
+	"time"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+)
+
+// FlowRecord is the representation of an aggregated flow record which is shared by the exporters
+// which do not export IPFIX records. The JSON field names match the names of the corresponding
+// IPFIX Information Elements.
+type FlowRecord struct {
+	FlowStartSeconds                     time.Time `json:"flowStartSeconds"`
+	FlowEndSeconds                       time.Time `json:"flowEndSeconds"`
+	FlowEndSecondsFromSourceNode         time.Time `json:"flowEndSecondsFromSourceNode"`
+	FlowEndSecondsFromDestinationNode    time.Time `json:"flowEndSecondsFromDestinationNode"`
+	FlowEndReason                        uint8     `json:"flowEndReason"`
+	SourceIP                             string    `json:"sourceIP"`
+	DestinationIP                        string    `json:"destinationIP"`
+	SourceTransportPort                  uint16    `json:"sourceTransportPort"`
+	DestinationTransportPort             uint16    `json:"destinationTransportPort"`
+	ProtocolIdentifier                   uint8     `json:"protocolIdentifier"`
+	PacketTotalCount                     uint64    `json:"packetTotalCount"`
+	OctetTotalCount                      uint64    `json:"octetTotalCount"`
+	PacketDeltaCount                     uint64    `json:"packetDeltaCount"`
+	OctetDeltaCount                      uint64    `json:"octetDeltaCount"`
+	ReversePacketTotalCount              uint64    `json:"reversePacketTotalCount"`
+	ReverseOctetTotalCount               uint64    `json:"reverseOctetTotalCount"`
+	ReversePacketDeltaCount              uint64    `json:"reversePacketDeltaCount"`
+	ReverseOctetDeltaCount               uint64    `json:"reverseOctetDeltaCount"`
+	SourcePodName                        string    `json:"sourcePodName"`
+	SourcePodNamespace                   string    `json:"sourcePodNamespace"`
+	SourceNodeName                       string    `json:"sourceNodeName"`
+	DestinationPodName                   string    `json:"destinationPodName"`
+	DestinationPodNamespace              string    `json:"destinationPodNamespace"`
+	DestinationNodeName                  string    `json:"destinationNodeName"`
+	DestinationClusterIP                 string    `json:"destinationClusterIP"`
+	DestinationServicePort               uint16    `json:"destinationServicePort"`
+	DestinationServicePortName           string    `json:"destinationServicePortName"`
+	IngressNetworkPolicyName             string    `json:"ingressNetworkPolicyName"`
+	IngressNetworkPolicyNamespace        string    `json:"ingressNetworkPolicyNamespace"`
+	IngressNetworkPolicyRuleName         string    `json:"ingressNetworkPolicyRuleName"`
+	IngressNetworkPolicyRuleAction       uint8     `json:"ingressNetworkPolicyRuleAction"`
+	IngressNetworkPolicyType             uint8     `json:"ingressNetworkPolicyType"`
+	EgressNetworkPolicyName              string    `json:"egressNetworkPolicyName"`
+	EgressNetworkPolicyNamespace         string    `json:"egressNetworkPolicyNamespace"`
+	EgressNetworkPolicyRuleName          string    `json:"egressNetworkPolicyRuleName"`
+	EgressNetworkPolicyRuleAction        uint8     `json:"egressNetworkPolicyRuleAction"`
+	EgressNetworkPolicyType              uint8     `json:"egressNetworkPolicyType"`
+	TCPState                             string    `json:"tcpState"`
+	FlowType                             uint8     `json:"flowType"`
+	SourcePodLabels                      string    `json:"sourcePodLabels"`
+	DestinationPodLabels                 string    `json:"destinationPodLabels"`
+	Throughput                           uint64    `json:"throughput"`
+	ReverseThroughput                    uint64    `json:"reverseThroughput"`
+	ThroughputFromSourceNode             uint64    `json:"throughputFromSourceNode"`
+	ThroughputFromDestinationNode        uint64    `json:"throughputFromDestinationNode"`
+	ReverseThroughputFromSourceNode      uint64    `json:"reverseThroughputFromSourceNode"`
+	ReverseThroughputFromDestinationNode uint64    `json:"reverseThroughputFromDestinationNode"`
+	DestinationFQDN                      string    `json:"destinationFQDN"`
+	TCPSmoothedRTT                       uint32    `json:"tcpSmoothedRTT"`
+	TCPRetransmissions                   uint32    `json:"tcpRetransmissions"`
+}
+
+// GetFlowRecord converts an aggregated IPFIX record to a FlowRecord.
+func GetFlowRecord(record ipfixentities.Record) *FlowRecord {
+	r := &FlowRecord{}
+	if flowStartSeconds, _, ok := record.GetInfoElementWithValue("flowStartSeconds"); ok {
+		r.FlowStartSeconds = time.Unix(int64(flowStartSeconds.GetUnsigned32Value()), 0)
+	}
+	if flowEndSeconds, _, ok := record.GetInfoElementWithValue("flowEndSeconds"); ok {
+		r.FlowEndSeconds = time.Unix(int64(flowEndSeconds.GetUnsigned32Value()), 0)
+	}
+	if flowEndSecFromSrcNode, _, ok := record.GetInfoElementWithValue("flowEndSecondsFromSourceNode"); ok {
+		r.FlowEndSecondsFromSourceNode = time.Unix(int64(flowEndSecFromSrcNode.GetUnsigned32Value()), 0)
+	}
+	if flowEndSecFromDstNode, _, ok := record.GetInfoElementWithValue("flowEndSecondsFromDestinationNode"); ok {
+		r.FlowEndSecondsFromDestinationNode = time.Unix(int64(flowEndSecFromDstNode.GetUnsigned32Value()), 0)
+	}
+	if flowEndReason, _, ok := record.GetInfoElementWithValue("flowEndReason"); ok {
+		r.FlowEndReason = flowEndReason.GetUnsigned8Value()
+	}
+	if sourceIPv4, _, ok := record.GetInfoElementWithValue("sourceIPv4Address"); ok {
+		r.SourceIP = sourceIPv4.GetIPAddressValue().String()
+	} else if sourceIPv6, _, ok := record.GetInfoElementWithValue("sourceIPv6Address"); ok {
+		r.SourceIP = sourceIPv6.GetIPAddressValue().String()
+	}
+	if destinationIPv4, _, ok := record.GetInfoElementWithValue("destinationIPv4Address"); ok {
+		r.DestinationIP = destinationIPv4.GetIPAddressValue().String()
+	} else if destinationIPv6, _, ok := record.GetInfoElementWithValue("destinationIPv6Address"); ok {
+		r.DestinationIP = destinationIPv6.GetIPAddressValue().String()
+	}
+	if sourcePort, _, ok := record.GetInfoElementWithValue("sourceTransportPort"); ok {
+		r.SourceTransportPort = sourcePort.GetUnsigned16Value()
+	}
+	if destinationPort, _, ok := record.GetInfoElementWithValue("destinationTransportPort"); ok {
+		r.DestinationTransportPort = destinationPort.GetUnsigned16Value()
+	}
+	if protocolIdentifier, _, ok := record.GetInfoElementWithValue("protocolIdentifier"); ok {
+		r.ProtocolIdentifier = protocolIdentifier.GetUnsigned8Value()
+	}
+	if packetTotalCount, _, ok := record.GetInfoElementWithValue("packetTotalCount"); ok {
+		r.PacketTotalCount = packetTotalCount.GetUnsigned64Value()
+	}
+	if octetTotalCount, _, ok := record.GetInfoElementWithValue("octetTotalCount"); ok {
+		r.OctetTotalCount = octetTotalCount.GetUnsigned64Value()
+	}
+	if packetDeltaCount, _, ok := record.GetInfoElementWithValue("packetDeltaCount"); ok {
+		r.PacketDeltaCount = packetDeltaCount.GetUnsigned64Value()
+	}
+	if octetDeltaCount, _, ok := record.GetInfoElementWithValue("octetDeltaCount"); ok {
+		r.OctetDeltaCount = octetDeltaCount.GetUnsigned64Value()
+	}
+	if reversePacketTotalCount, _, ok := record.GetInfoElementWithValue("reversePacketTotalCount"); ok {
+		r.ReversePacketTotalCount = reversePacketTotalCount.GetUnsigned64Value()
+	}
+	if reverseOctetTotalCount, _, ok := record.GetInfoElementWithValue("reverseOctetTotalCount"); ok {
+		r.ReverseOctetTotalCount = reverseOctetTotalCount.GetUnsigned64Value()
+	}
+	if reversePacketDeltaCount, _, ok := record.GetInfoElementWithValue("reversePacketDeltaCount"); ok {
+		r.ReversePacketDeltaCount = reversePacketDeltaCount.GetUnsigned64Value()
+	}
+	if reverseOctetDeltaCount, _, ok := record.GetInfoElementWithValue("reverseOctetDeltaCount"); ok {
+		r.ReverseOctetDeltaCount = reverseOctetDeltaCount.GetUnsigned64Value()
+	}
+	if sourcePodName, _, ok := record.GetInfoElementWithValue("sourcePodName"); ok {
+		r.SourcePodName = sourcePodName.GetStringValue()
+	}
+	if sourcePodNamespace, _, ok := record.GetInfoElementWithValue("sourcePodNamespace"); ok {
+		r.SourcePodNamespace = sourcePodNamespace.GetStringValue()
+	}
+	if sourceNodeName, _, ok := record.GetInfoElementWithValue("sourceNodeName"); ok {
+		r.SourceNodeName = sourceNodeName.GetStringValue()
+	}
+	if destinationPodName, _, ok := record.GetInfoElementWithValue("destinationPodName"); ok {
+		r.DestinationPodName = destinationPodName.GetStringValue()
+	}
+	if destinationPodNamespace, _, ok := record.GetInfoElementWithValue("destinationPodNamespace"); ok {
+		r.DestinationPodNamespace = destinationPodNamespace.GetStringValue()
+	}
+	if destinationNodeName, _, ok := record.GetInfoElementWithValue("destinationNodeName"); ok {
+		r.DestinationNodeName = destinationNodeName.GetStringValue()
+	}
+	if destinationClusterIPv4, _, ok := record.GetInfoElementWithValue("destinationClusterIPv4"); ok {
+		r.DestinationClusterIP = destinationClusterIPv4.GetIPAddressValue().String()
+	} else if destinationClusterIPv6, _, ok := record.GetInfoElementWithValue("destinationClusterIPv6"); ok {
+		r.DestinationClusterIP = destinationClusterIPv6.GetIPAddressValue().String()
+	}
+	if destinationServicePort, _, ok := record.GetInfoElementWithValue("destinationServicePort"); ok {
+		r.DestinationServicePort = destinationServicePort.GetUnsigned16Value()
+	}
+	if destinationServicePortName, _, ok := record.GetInfoElementWithValue("destinationServicePortName"); ok {
+		r.DestinationServicePortName = destinationServicePortName.GetStringValue()
+	}
+	if ingressNPName, _, ok := record.GetInfoElementWithValue("ingressNetworkPolicyName"); ok {
+		r.IngressNetworkPolicyName = ingressNPName.GetStringValue()
+	}
+	if ingressNPNamespace, _, ok := record.GetInfoElementWithValue("ingressNetworkPolicyNamespace"); ok {
+		r.IngressNetworkPolicyNamespace = ingressNPNamespace.GetStringValue()
+	}
+	if ingressNPRuleName, _, ok := record.GetInfoElementWithValue("ingressNetworkPolicyRuleName"); ok {
+		r.IngressNetworkPolicyRuleName = ingressNPRuleName.GetStringValue()
+	}
+	if ingressNPType, _, ok := record.GetInfoElementWithValue("ingressNetworkPolicyType"); ok {
+		r.IngressNetworkPolicyType = ingressNPType.GetUnsigned8Value()
+	}
+	if ingressNPRuleAction, _, ok := record.GetInfoElementWithValue("ingressNetworkPolicyRuleAction"); ok {
+		r.IngressNetworkPolicyRuleAction = ingressNPRuleAction.GetUnsigned8Value()
+	}
+	if egressNPName, _, ok := record.GetInfoElementWithValue("egressNetworkPolicyName"); ok {
+		r.EgressNetworkPolicyName = egressNPName.GetStringValue()
+	}
+	if egressNPNamespace, _, ok := record.GetInfoElementWithValue("egressNetworkPolicyNamespace"); ok {
+		r.EgressNetworkPolicyNamespace = egressNPNamespace.GetStringValue()
+	}
+	if egressNPRuleName, _, ok := record.GetInfoElementWithValue("egressNetworkPolicyRuleName"); ok {
+		r.EgressNetworkPolicyRuleName = egressNPRuleName.GetStringValue()
+	}
+	if egressNPType, _, ok := record.GetInfoElementWithValue("egressNetworkPolicyType"); ok {
+		r.EgressNetworkPolicyType = egressNPType.GetUnsigned8Value()
+	}
+	if egressNPRuleAction, _, ok := record.GetInfoElementWithValue("egressNetworkPolicyRuleAction"); ok {
+		r.EgressNetworkPolicyRuleAction = egressNPRuleAction.GetUnsigned8Value()
+	}
+	if tcpState, _, ok := record.GetInfoElementWithValue("tcpState"); ok {
+		r.TCPState = tcpState.GetStringValue()
+	}
+	if flowType, _, ok := record.GetInfoElementWithValue("flowType"); ok {
+		r.FlowType = flowType.GetUnsigned8Value()
+	}
+	if sourcePodLabels, _, ok := record.GetInfoElementWithValue("sourcePodLabels"); ok {
+		r.SourcePodLabels = sourcePodLabels.GetStringValue()
+	}
+	if destinationPodLabels, _, ok := record.GetInfoElementWithValue("destinationPodLabels"); ok {
+		r.DestinationPodLabels = destinationPodLabels.GetStringValue()
+	}
+	if throughput, _, ok := record.GetInfoElementWithValue("throughput"); ok {
+		r.Throughput = throughput.GetUnsigned64Value()
+	}
+	if reverseThroughput, _, ok := record.GetInfoElementWithValue("reverseThroughput"); ok {
+		r.ReverseThroughput = reverseThroughput.GetUnsigned64Value()
+	}
+	if throughputFromSrcNode, _, ok := record.GetInfoElementWithValue("throughputFromSourceNode"); ok {
+		r.ThroughputFromSourceNode = throughputFromSrcNode.GetUnsigned64Value()
+	}
+	if throughputFromDstNode, _, ok := record.GetInfoElementWithValue("throughputFromDestinationNode"); ok {
+		r.ThroughputFromDestinationNode = throughputFromDstNode.GetUnsigned64Value()
+	}
+	if revTputFromSrcNode, _, ok := record.GetInfoElementWithValue("reverseThroughputFromSourceNode"); ok {
+		r.ReverseThroughputFromSourceNode = revTputFromSrcNode.GetUnsigned64Value()
+	}
+	if revTputFromDstNode, _, ok := record.GetInfoElementWithValue("reverseThroughputFromDestinationNode"); ok {
+		r.ReverseThroughputFromDestinationNode = revTputFromDstNode.GetUnsigned64Value()
+	}
+	if destinationFQDN, _, ok := record.GetInfoElementWithValue("destinationFQDN"); ok {
+		r.DestinationFQDN = destinationFQDN.GetStringValue()
+	}
+	if tcpSmoothedRTT, _, ok := record.GetInfoElementWithValue("tcpSmoothedRTT"); ok {
+		r.TCPSmoothedRTT = tcpSmoothedRTT.GetUnsigned32Value()
+	}
+	if tcpRetransmissions, _, ok := record.GetInfoElementWithValue("tcpRetransmissions"); ok {
+		r.TCPRetransmissions = tcpRetransmissions.GetUnsigned32Value()
+	}
+	return r
+}
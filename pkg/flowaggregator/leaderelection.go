@@ -0,0 +1,136 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/klog/v2"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/util/env"
+)
+
+const (
+	leaderElectionLeaseName      = "flow-aggregator"
+	leaderElectionLeaseNamespace = "flow-aggregator"
+	leaderElectionLeaseDuration  = 15 * time.Second
+	leaderElectionRenewDeadline  = 10 * time.Second
+	leaderElectionRetryPeriod    = 2 * time.Second
+
+	// caSecretPollInterval and caSecretTimeout determine how long a replica waits for the
+	// leader to create the CA Secret.
+	caSecretPollInterval = 2 * time.Second
+	caSecretTimeout      = 5 * time.Minute
+)
+
+// RunLeaderElection elects, among all the replicas of the Flow Aggregator, the replica which
+// manages the TLS credentials shared by all the replicas and by the Flow Exporters: the CA
+// certificate and key, stored in a Secret and used by each replica to sign its own server
+// certificate, and the client certificate used by the Flow Exporters. It only runs when high
+// availability is enabled with the TLS transport protocol, and blocks until stopCh is closed.
+func (fa *flowAggregator) RunLeaderElection(stopCh <-chan struct{}) {
+	if !fa.highAvailability || fa.aggregatorTransportProtocol != flowaggregatorconfig.AggregatorTransportProtocolTLS {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	identity := env.GetPodName()
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      leaderElectionLeaseName,
+			Namespace: leaderElectionLeaseNamespace,
+		},
+		Client: fa.k8sClient.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: identity,
+		},
+	}
+	config := leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		LeaseDuration:   leaderElectionLeaseDuration,
+		RenewDeadline:   leaderElectionRenewDeadline,
+		RetryPeriod:     leaderElectionRetryPeriod,
+		ReleaseOnCancel: true,
+		Name:            leaderElectionLeaseName,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: fa.syncTLSCredentials,
+			OnStoppedLeading: func() {
+				klog.InfoS("Stopped leading", "identity", identity)
+			},
+			OnNewLeader: func(leader string) {
+				klog.InfoS("New leader elected", "identity", leader)
+			},
+		},
+	}
+	// RunOrDie returns when the leadership is lost, in which case the replica joins the election
+	// again.
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		leaderelection.RunOrDie(ctx, config)
+	}, leaderElectionRetryPeriod)
+}
+
+// syncTLSCredentials is called by the leader. It creates the CA Secret if it does not exist
+// yet, and distributes the CA certificate and a client certificate signed by the CA to the Flow
+// Exporters. The existing CA is kept, as the server certificates of the other replicas are
+// signed by it.
+func (fa *flowAggregator) syncTLSCredentials(ctx context.Context) {
+	wait.PollImmediateUntil(caSecretPollInterval, func() (bool, error) {
+		caCert, caKey, caPEM, err := getOrCreateCASecret(fa.k8sClient)
+		if err != nil {
+			klog.ErrorS(err, "Error when getting CA certificate")
+			return false, nil
+		}
+		clientCert, clientKey, err := generateCertKey(caCert, caKey, false, "")
+		if err != nil {
+			klog.ErrorS(err, "Error when creating client certificate")
+			return false, nil
+		}
+		if err := syncCAAndClientCert(caPEM, clientCert, clientKey, fa.k8sClient); err != nil {
+			klog.ErrorS(err, "Error when synchronizing client certificate")
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done())
+}
+
+// waitForCA waits for the leader to create the CA Secret, and returns the CA certificate and key.
+func (fa *flowAggregator) waitForCA() (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
+	var caCert *x509.Certificate
+	var caKey *rsa.PrivateKey
+	var caPEM []byte
+	err := wait.PollImmediate(caSecretPollInterval, caSecretTimeout, func() (bool, error) {
+		var err error
+		caCert, caKey, caPEM, err = getCASecret(fa.k8sClient)
+		if err != nil {
+			klog.V(2).InfoS("Waiting for CA Secret", "err", err)
+			return false, nil
+		}
+		return true, nil
+	})
+	return caCert, caKey, caPEM, err
+}
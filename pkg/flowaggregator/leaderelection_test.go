@@ -0,0 +1,71 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSyncTLSCredentials(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	fa := &flowAggregator{k8sClient: client}
+
+	fa.syncTLSCredentials(context.Background())
+	caCert, caKey, caPEM, err := fa.waitForCA()
+	require.NoError(t, err)
+	caConfigMap, err := client.CoreV1().ConfigMaps(CAConfigMapNamespace).Get(context.TODO(), CAConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(caPEM), caConfigMap.Data[CAConfigMapKey])
+	clientSecret, err := client.CoreV1().Secrets(ClientSecretNamespace).Get(context.TODO(), ClientSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caPEM))
+	verifyCert := func(certPEM []byte, opts x509.VerifyOptions) {
+		block, _ := pem.Decode(certPEM)
+		require.NotNil(t, block)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		opts.Roots = roots
+		_, err = cert.Verify(opts)
+		assert.NoError(t, err)
+	}
+	verifyCert(clientSecret.Data["tls.crt"], x509.VerifyOptions{KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+
+	// The server certificate of each replica is signed by the shared CA and is valid for its
+	// Pod IP.
+	serverCert, serverKey, err := generateCertKey(caCert, caKey, true, "127.0.0.1", net.ParseIP("10.10.0.5"))
+	require.NoError(t, err)
+	_, err = tls.X509KeyPair(serverCert, serverKey)
+	require.NoError(t, err)
+	verifyCert(serverCert, x509.VerifyOptions{DNSName: "10.10.0.5"})
+	verifyCert(serverCert, x509.VerifyOptions{DNSName: "127.0.0.1"})
+
+	// A new leader keeps the existing CA.
+	fa.syncTLSCredentials(context.Background())
+	_, _, newCAPEM, err := getCASecret(client)
+	require.NoError(t, err)
+	assert.Equal(t, caPEM, newCAPEM)
+}
@@ -0,0 +1,312 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"antrea.io/antrea/pkg/apis"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/util/flowexport"
+)
+
+const (
+	defaultExternalFlowCollectorTransport = "tcp"
+	defaultExternalFlowCollectorPort      = "4739"
+	defaultActiveFlowRecordTimeout        = "60s"
+	defaultInactiveFlowRecordTimeout      = "90s"
+	defaultAggregatorTransportProtocol    = "TLS"
+	defaultFlowAggregatorAddress          = "flow-aggregator.flow-aggregator.svc"
+	defaultRecordFormat                   = "IPFIX"
+	defaultClickHouseDatabase             = "default"
+	defaultClickHouseCommitInterval       = "8s"
+	minClickHouseCommitInterval           = 1 * time.Second
+	defaultClickHouseDatabaseUrl          = "tcp://clickhouse-clickhouse.flow-visibility.svc:9000"
+	defaultKafkaTopic                     = "flows"
+	defaultKafkaRecordFormat              = "JSON"
+	defaultKafkaRequiredAcks              = int16(1)
+	defaultKafkaMaxBatchSize              = 1000
+	defaultKafkaFlushInterval             = "1s"
+	defaultKafkaSASLMechanism             = "PLAIN"
+	defaultS3Region                       = "us-west-2"
+	defaultS3RecordFormat                 = "CSV"
+	defaultS3MaxRecordsPerFile            = 1000000
+	defaultS3UploadInterval               = "60s"
+	minS3UploadInterval                   = 1 * time.Second
+	defaultFlowLoggerPath                 = "/tmp/antrea-flows.log"
+	defaultFlowLoggerMaxSize              = 100
+	defaultFlowLoggerMaxBackups           = 3
+)
+
+// Options stores the flow aggregator configuration, as well as the values parsed from it.
+type Options struct {
+	// The configuration object
+	Config *flowaggregatorconfig.FlowAggregatorConfig
+	// Expiration timeout for active flow records in the flow aggregator
+	ActiveFlowRecordTimeout time.Duration
+	// Expiration timeout for inactive flow records in the flow aggregator
+	InactiveFlowRecordTimeout time.Duration
+	// Transport protocol over which the aggregator collects IPFIX records from all Agents
+	AggregatorTransportProtocol flowaggregatorconfig.AggregatorTransportProtocol
+	// IPFIX flow collector address
+	ExternalFlowCollectorAddr string
+	// IPFIX flow collector transport protocol
+	ExternalFlowCollectorProto string
+	// clickHouseCommitInterval flow records batch commit interval to clickhouse in the flow aggregator
+	ClickHouseCommitInterval time.Duration
+	// Maximum interval between two produce requests to Kafka
+	KafkaFlushInterval time.Duration
+	// Interval between two uploads of flow records to S3
+	S3UploadInterval time.Duration
+}
+
+// LoadConfig parses the flow aggregator configuration, sets the default values of the missing
+// parameters and validates it.
+func LoadConfig(configBytes []byte) (*Options, error) {
+	var opt Options
+	if err := yaml.UnmarshalStrict(configBytes, &opt.Config); err != nil {
+		return nil, err
+	}
+	if opt.Config == nil {
+		opt.Config = &flowaggregatorconfig.FlowAggregatorConfig{}
+	}
+	opt.setDefaults()
+	if err := opt.validate(); err != nil {
+		return nil, err
+	}
+	return &opt, nil
+}
+
+func (o *Options) validate() error {
+	var err error
+	if o.Config.FlowCollector.Enable && o.Config.FlowCollector.Address == "" {
+		return fmt.Errorf("external flow collector enabled without providing address")
+	}
+	if !o.isFlowCollectorSet() && !o.Config.ClickHouse.Enable && !o.Config.Kafka.Enable && !o.Config.S3Uploader.Enable && !o.Config.FlowLogger.Enable {
+		return fmt.Errorf("external flow collector, ClickHouse, Kafka, S3 uploader or flow logger should be configured")
+	}
+
+	// Validate common parameters
+	o.ActiveFlowRecordTimeout, err = time.ParseDuration(o.Config.ActiveFlowRecordTimeout)
+	if err != nil {
+		return err
+	}
+	o.InactiveFlowRecordTimeout, err = time.ParseDuration(o.Config.InactiveFlowRecordTimeout)
+	if err != nil {
+		return err
+	}
+	transportProtocol, err := flowexport.ParseTransportProtocol(o.Config.AggregatorTransportProtocol)
+	if err != nil {
+		return err
+	}
+	o.AggregatorTransportProtocol = transportProtocol
+
+	// Validate flow collector specific parameters
+	if o.isFlowCollectorSet() {
+		host, port, proto, err := flowexport.ParseFlowCollectorAddr(
+			o.Config.FlowCollector.Address, defaultExternalFlowCollectorPort,
+			defaultExternalFlowCollectorTransport)
+		if err != nil {
+			return err
+		}
+		o.ExternalFlowCollectorAddr = net.JoinHostPort(host, port)
+		o.ExternalFlowCollectorProto = proto
+
+		if o.Config.FlowCollector.RecordFormat != "IPFIX" &&
+			o.Config.FlowCollector.RecordFormat != "JSON" {
+			return fmt.Errorf("record format %s is not supported", o.Config.FlowCollector.RecordFormat)
+		}
+	}
+
+	// Validate clickhouse specific parameters
+	if o.Config.ClickHouse.Enable {
+		o.ClickHouseCommitInterval, err = time.ParseDuration(o.Config.ClickHouse.CommitInterval)
+		if err != nil {
+			return err
+		}
+		if o.ClickHouseCommitInterval < minClickHouseCommitInterval {
+			return fmt.Errorf("commitInterval %s is too small: shortest supported interval is %s",
+				o.Config.ClickHouse.CommitInterval, minClickHouseCommitInterval)
+		}
+	}
+
+	// Validate Kafka specific parameters
+	if o.Config.Kafka.Enable {
+		if len(o.Config.Kafka.Brokers) == 0 {
+			return fmt.Errorf("Kafka enabled without providing brokers")
+		}
+		for _, broker := range o.Config.Kafka.Brokers {
+			if _, _, err := net.SplitHostPort(broker); err != nil {
+				return fmt.Errorf("invalid Kafka broker address %s: %v", broker, err)
+			}
+		}
+		if o.Config.Kafka.RecordFormat != "JSON" && o.Config.Kafka.RecordFormat != "Protobuf" {
+			return fmt.Errorf("Kafka record format %s is not supported", o.Config.Kafka.RecordFormat)
+		}
+		if acks := *o.Config.Kafka.RequiredAcks; acks != 0 && acks != 1 && acks != -1 {
+			return fmt.Errorf("Kafka requiredAcks %d is not supported: must be 0, 1 or -1", acks)
+		}
+		if o.Config.Kafka.MaxBatchSize < 0 {
+			return fmt.Errorf("Kafka maxBatchSize %d must not be negative", o.Config.Kafka.MaxBatchSize)
+		}
+		o.KafkaFlushInterval, err = time.ParseDuration(o.Config.Kafka.FlushInterval)
+		if err != nil {
+			return err
+		}
+		if o.KafkaFlushInterval <= 0 {
+			return fmt.Errorf("Kafka flushInterval %s must be positive", o.Config.Kafka.FlushInterval)
+		}
+		if tls := o.Config.Kafka.TLS; tls.Enable && (tls.CertPath == "") != (tls.KeyPath == "") {
+			return fmt.Errorf("Kafka TLS certPath and keyPath must be provided together")
+		}
+		if o.Config.Kafka.SASL.Enable {
+			switch o.Config.Kafka.SASL.Mechanism {
+			case "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+			default:
+				return fmt.Errorf("Kafka SASL mechanism %s is not supported", o.Config.Kafka.SASL.Mechanism)
+			}
+		}
+	}
+
+	// Validate S3 uploader specific parameters
+	if o.Config.S3Uploader.Enable {
+		if o.Config.S3Uploader.BucketName == "" {
+			return fmt.Errorf("S3 uploader enabled without providing bucketName")
+		}
+		if o.Config.S3Uploader.RecordFormat != "CSV" && o.Config.S3Uploader.RecordFormat != "Parquet" {
+			return fmt.Errorf("S3 uploader record format %s is not supported", o.Config.S3Uploader.RecordFormat)
+		}
+		if o.Config.S3Uploader.MaxRecordsPerFile < 0 {
+			return fmt.Errorf("S3 uploader maxRecordsPerFile %d must not be negative", o.Config.S3Uploader.MaxRecordsPerFile)
+		}
+		o.S3UploadInterval, err = time.ParseDuration(o.Config.S3Uploader.UploadInterval)
+		if err != nil {
+			return err
+		}
+		if o.S3UploadInterval < minS3UploadInterval {
+			return fmt.Errorf("uploadInterval %s is too small: shortest supported interval is %s",
+				o.Config.S3Uploader.UploadInterval, minS3UploadInterval)
+		}
+	}
+
+	// Validate flow logger specific parameters
+	if o.Config.FlowLogger.Enable {
+		if o.Config.FlowLogger.MaxSize < 0 || *o.Config.FlowLogger.MaxBackups < 0 || o.Config.FlowLogger.MaxAge < 0 {
+			return fmt.Errorf("flow logger maxSize, maxBackups and maxAge must not be negative")
+		}
+	}
+	return nil
+}
+
+func (o *Options) isFlowCollectorSet() bool {
+	return o.Config.FlowCollector.Enable && len(o.Config.FlowCollector.Address) > 0
+}
+
+func (o *Options) setDefaults() {
+	if o.Config.ActiveFlowRecordTimeout == "" {
+		o.Config.ActiveFlowRecordTimeout = defaultActiveFlowRecordTimeout
+	}
+	if o.Config.InactiveFlowRecordTimeout == "" {
+		o.Config.InactiveFlowRecordTimeout = defaultInactiveFlowRecordTimeout
+	}
+	if o.Config.AggregatorTransportProtocol == "" {
+		o.Config.AggregatorTransportProtocol = defaultAggregatorTransportProtocol
+	}
+	if o.Config.FlowAggregatorAddress == "" {
+		o.Config.FlowAggregatorAddress = defaultFlowAggregatorAddress
+	}
+	if o.Config.APIServer.APIPort == 0 {
+		o.Config.APIServer.APIPort = apis.FlowAggregatorAPIPort
+	}
+	if o.isFlowCollectorSet() {
+		if o.Config.FlowCollector.RecordFormat == "" {
+			o.Config.FlowCollector.RecordFormat = defaultRecordFormat
+		}
+	}
+	if o.Config.ClickHouse.Enable {
+		if o.Config.ClickHouse.Database == "" {
+			o.Config.ClickHouse.Database = defaultClickHouseDatabase
+		}
+		if o.Config.ClickHouse.DatabaseURL == "" {
+			o.Config.ClickHouse.DatabaseURL = defaultClickHouseDatabaseUrl
+		}
+		if o.Config.ClickHouse.Compress == nil {
+			o.Config.ClickHouse.Compress = new(bool)
+			*o.Config.ClickHouse.Compress = true
+		}
+		if o.Config.ClickHouse.CommitInterval == "" {
+			o.Config.ClickHouse.CommitInterval = defaultClickHouseCommitInterval
+		}
+	}
+	if o.Config.Kafka.Enable {
+		if o.Config.Kafka.Topic == "" {
+			o.Config.Kafka.Topic = defaultKafkaTopic
+		}
+		if o.Config.Kafka.RecordFormat == "" {
+			o.Config.Kafka.RecordFormat = defaultKafkaRecordFormat
+		}
+		if o.Config.Kafka.RequiredAcks == nil {
+			o.Config.Kafka.RequiredAcks = new(int16)
+			*o.Config.Kafka.RequiredAcks = defaultKafkaRequiredAcks
+		}
+		if o.Config.Kafka.MaxBatchSize == 0 {
+			o.Config.Kafka.MaxBatchSize = defaultKafkaMaxBatchSize
+		}
+		if o.Config.Kafka.FlushInterval == "" {
+			o.Config.Kafka.FlushInterval = defaultKafkaFlushInterval
+		}
+		if o.Config.Kafka.SASL.Enable && o.Config.Kafka.SASL.Mechanism == "" {
+			o.Config.Kafka.SASL.Mechanism = defaultKafkaSASLMechanism
+		}
+	}
+	if o.Config.S3Uploader.Enable {
+		if o.Config.S3Uploader.Region == "" {
+			o.Config.S3Uploader.Region = defaultS3Region
+		}
+		if o.Config.S3Uploader.RecordFormat == "" {
+			o.Config.S3Uploader.RecordFormat = defaultS3RecordFormat
+		}
+		if o.Config.S3Uploader.Compress == nil {
+			o.Config.S3Uploader.Compress = new(bool)
+			*o.Config.S3Uploader.Compress = true
+		}
+		if o.Config.S3Uploader.MaxRecordsPerFile == 0 {
+			o.Config.S3Uploader.MaxRecordsPerFile = defaultS3MaxRecordsPerFile
+		}
+		if o.Config.S3Uploader.UploadInterval == "" {
+			o.Config.S3Uploader.UploadInterval = defaultS3UploadInterval
+		}
+	}
+	if o.Config.FlowLogger.Enable {
+		if o.Config.FlowLogger.Path == "" {
+			o.Config.FlowLogger.Path = defaultFlowLoggerPath
+		}
+		if o.Config.FlowLogger.MaxSize == 0 {
+			o.Config.FlowLogger.MaxSize = defaultFlowLoggerMaxSize
+		}
+		if o.Config.FlowLogger.MaxBackups == nil {
+			o.Config.FlowLogger.MaxBackups = new(int32)
+			*o.Config.FlowLogger.MaxBackups = defaultFlowLoggerMaxBackups
+		}
+		if o.Config.FlowLogger.Compress == nil {
+			o.Config.FlowLogger.Compress = new(bool)
+			*o.Config.FlowLogger.Compress = true
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+)
+
+func TestLoadConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		config      string
+		expectedErr string
+		check       func(t *testing.T, opt *Options)
+	}{
+		{
+			name:        "no exporter",
+			config:      "",
+			expectedErr: "external flow collector, ClickHouse, Kafka, S3 uploader or flow logger should be configured",
+		},
+		{
+			name: "flow collector",
+			config: `
+activeFlowRecordTimeout: 30s
+inactiveFlowRecordTimeout: 45s
+flowCollector:
+  enable: true
+  address: 10.0.0.1:4739:udp
+`,
+			check: func(t *testing.T, opt *Options) {
+				assert.Equal(t, 30*time.Second, opt.ActiveFlowRecordTimeout)
+				assert.Equal(t, 45*time.Second, opt.InactiveFlowRecordTimeout)
+				assert.Equal(t, flowaggregatorconfig.AggregatorTransportProtocolTLS, opt.AggregatorTransportProtocol)
+				assert.Equal(t, "10.0.0.1:4739", opt.ExternalFlowCollectorAddr)
+				assert.Equal(t, "udp", opt.ExternalFlowCollectorProto)
+				assert.Equal(t, "IPFIX", opt.Config.FlowCollector.RecordFormat)
+			},
+		},
+		{
+			name: "Kafka defaults",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka:9092"]
+`,
+			check: func(t *testing.T, opt *Options) {
+				assert.Equal(t, "flows", opt.Config.Kafka.Topic)
+				assert.Equal(t, "JSON", opt.Config.Kafka.RecordFormat)
+				assert.Equal(t, int16(1), *opt.Config.Kafka.RequiredAcks)
+				assert.Equal(t, 1000, opt.Config.Kafka.MaxBatchSize)
+				assert.Equal(t, time.Second, opt.KafkaFlushInterval)
+			},
+		},
+		{
+			name: "Kafka invalid broker",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka"]
+`,
+			expectedErr: "invalid Kafka broker address",
+		},
+		{
+			name: "Kafka unsupported format",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka:9092"]
+  recordFormat: Avro
+`,
+			expectedErr: "Kafka record format Avro is not supported",
+		},
+		{
+			name: "Kafka TLS and SASL",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka:9093"]
+  recordFormat: Protobuf
+  tls:
+    enable: true
+    caCertPath: /etc/kafka/ca.crt
+  sasl:
+    enable: true
+`,
+			check: func(t *testing.T, opt *Options) {
+				assert.Equal(t, "Protobuf", opt.Config.Kafka.RecordFormat)
+				assert.Equal(t, "/etc/kafka/ca.crt", opt.Config.Kafka.TLS.CACertPath)
+				assert.Equal(t, "PLAIN", opt.Config.Kafka.SASL.Mechanism)
+			},
+		},
+		{
+			name: "Kafka TLS key without certificate",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka:9093"]
+  tls:
+    enable: true
+    keyPath: /etc/kafka/tls.key
+`,
+			expectedErr: "Kafka TLS certPath and keyPath must be provided together",
+		},
+		{
+			name: "Kafka unsupported SASL mechanism",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka:9093"]
+  sasl:
+    enable: true
+    mechanism: GSSAPI
+`,
+			expectedErr: "Kafka SASL mechanism GSSAPI is not supported",
+		},
+		{
+			name: "Kafka invalid requiredAcks",
+			config: `
+kafka:
+  enable: true
+  brokers: ["kafka-0.kafka:9092"]
+  requiredAcks: 2
+`,
+			expectedErr: "Kafka requiredAcks 2 is not supported",
+		},
+		{
+			name: "S3 uploader defaults",
+			config: `
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+`,
+			check: func(t *testing.T, opt *Options) {
+				assert.Equal(t, "us-west-2", opt.Config.S3Uploader.Region)
+				assert.Equal(t, "CSV", opt.Config.S3Uploader.RecordFormat)
+				assert.True(t, *opt.Config.S3Uploader.Compress)
+				assert.Equal(t, int32(1000000), opt.Config.S3Uploader.MaxRecordsPerFile)
+				assert.Equal(t, 60*time.Second, opt.S3UploadInterval)
+			},
+		},
+		{
+			name: "S3 uploader without bucket",
+			config: `
+s3Uploader:
+  enable: true
+`,
+			expectedErr: "S3 uploader enabled without providing bucketName",
+		},
+		{
+			name: "S3 uploader unsupported format",
+			config: `
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+  recordFormat: ORC
+`,
+			expectedErr: "S3 uploader record format ORC is not supported",
+		},
+		{
+			name: "S3 uploader upload interval too small",
+			config: `
+s3Uploader:
+  enable: true
+  bucketName: antrea-flows
+  uploadInterval: 500ms
+`,
+			expectedErr: "uploadInterval 500ms is too small",
+		},
+		{
+			name: "flow logger defaults",
+			config: `
+flowLogger:
+  enable: true
+`,
+			check: func(t *testing.T, opt *Options) {
+				assert.Equal(t, "/tmp/antrea-flows.log", opt.Config.FlowLogger.Path)
+				assert.Equal(t, int32(100), opt.Config.FlowLogger.MaxSize)
+				assert.Equal(t, int32(3), *opt.Config.FlowLogger.MaxBackups)
+				assert.True(t, *opt.Config.FlowLogger.Compress)
+			},
+		},
+		{
+			name: "unknown field",
+			config: `
+flowLogger:
+  enable: true
+  unknown: true
+`,
+			expectedErr: "field unknown not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			opt, err := LoadConfig([]byte(tc.config))
+			if tc.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, opt)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"sync"
+	"time"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
+)
+
+// tcpMetrics are the TCP metrics last reported by the Agents for a connection.
+type tcpMetrics struct {
+	smoothedRTT     uint32
+	retransmissions uint32
+	// fromSourceNode is true if the metrics were measured on the Node of the source Pod.
+	fromSourceNode bool
+	updateTime     time.Time
+}
+
+// tcpMetricsStore keeps the latest TCP metrics reported for each connection. The aggregation
+// process only keeps the TCP metrics of the first record received for a connection, so the
+// metrics are saved when the records are received, and set in the aggregated records when they
+// are exported.
+type tcpMetricsStore struct {
+	mutex   sync.Mutex
+	metrics map[ipfixintermediate.FlowKey]*tcpMetrics
+}
+
+func newTCPMetricsStore() *tcpMetricsStore {
+	return &tcpMetricsStore{
+		metrics: make(map[ipfixintermediate.FlowKey]*tcpMetrics),
+	}
+}
+
+// update saves the TCP metrics of a data record received from an Agent. For inter-Node flows, the
+// metrics measured on the Node of the source Pod are preferred, as the client is the side which
+// measures the latency of the requests.
+func (s *tcpMetricsStore) update(record ipfixentities.Record, updateTime time.Time) {
+	rttElement, _, exist := record.GetInfoElementWithValue("tcpSmoothedRTT")
+	if !exist || rttElement.GetUnsigned32Value() == 0 {
+		return
+	}
+	key, ok := getFlowKey(record)
+	if !ok {
+		return
+	}
+	fromSourceNode := false
+	if sourcePodName, _, exist := record.GetInfoElementWithValue("sourcePodName"); exist {
+		fromSourceNode = sourcePodName.GetStringValue() != ""
+	}
+	var retransmissions uint32
+	if retransmissionsElement, _, exist := record.GetInfoElementWithValue("tcpRetransmissions"); exist {
+		retransmissions = retransmissionsElement.GetUnsigned32Value()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	metrics, exist := s.metrics[key]
+	if !exist {
+		metrics = &tcpMetrics{}
+		s.metrics[key] = metrics
+	} else if metrics.fromSourceNode && !fromSourceNode {
+		metrics.updateTime = updateTime
+		return
+	}
+	metrics.smoothedRTT = rttElement.GetUnsigned32Value()
+	metrics.retransmissions = retransmissions
+	metrics.fromSourceNode = fromSourceNode
+	metrics.updateTime = updateTime
+}
+
+// fill sets the latest TCP metrics of the connection in an aggregated record.
+func (s *tcpMetricsStore) fill(key ipfixintermediate.FlowKey, record ipfixentities.Record) {
+	s.mutex.Lock()
+	metrics, exist := s.metrics[key]
+	s.mutex.Unlock()
+	if !exist {
+		return
+	}
+	if rttElement, _, exist := record.GetInfoElementWithValue("tcpSmoothedRTT"); exist {
+		rttElement.SetUnsigned32Value(metrics.smoothedRTT)
+	}
+	if retransmissionsElement, _, exist := record.GetInfoElementWithValue("tcpRetransmissions"); exist {
+		retransmissionsElement.SetUnsigned32Value(metrics.retransmissions)
+	}
+}
+
+// deleteStale deletes the metrics of the connections which have not been reported since the given
+// time. The aggregated records of these connections have expired as well.
+func (s *tcpMetricsStore) deleteStale(before time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for key, metrics := range s.metrics {
+		if metrics.updateTime.Before(before) {
+			delete(s.metrics, key)
+		}
+	}
+}
+
+// getFlowKey returns the flow key of a data record, as computed by the aggregation process.
+func getFlowKey(record ipfixentities.Record) (ipfixintermediate.FlowKey, bool) {
+	var key ipfixintermediate.FlowKey
+	sourceAddress, _, exist := record.GetInfoElementWithValue("sourceIPv4Address")
+	if !exist {
+		sourceAddress, _, exist = record.GetInfoElementWithValue("sourceIPv6Address")
+	}
+	if !exist {
+		return key, false
+	}
+	destinationAddress, _, exist := record.GetInfoElementWithValue("destinationIPv4Address")
+	if !exist {
+		destinationAddress, _, exist = record.GetInfoElementWithValue("destinationIPv6Address")
+	}
+	if !exist {
+		return key, false
+	}
+	protocol, _, exist := record.GetInfoElementWithValue("protocolIdentifier")
+	if !exist {
+		return key, false
+	}
+	sourcePort, _, exist := record.GetInfoElementWithValue("sourceTransportPort")
+	if !exist {
+		return key, false
+	}
+	destinationPort, _, exist := record.GetInfoElementWithValue("destinationTransportPort")
+	if !exist {
+		return key, false
+	}
+	key.SourceAddress = sourceAddress.GetIPAddressValue().String()
+	key.DestinationAddress = destinationAddress.GetIPAddressValue().String()
+	key.Protocol = protocol.GetUnsigned8Value()
+	key.SourcePort = sourcePort.GetUnsigned16Value()
+	key.DestinationPort = destinationPort.GetUnsigned16Value()
+	return key, true
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+)
+
+func createTCPMetricsRecord(t *testing.T, sourcePodName string, smoothedRTT, retransmissions uint32) ipfixentities.Record {
+	record := ipfixentities.NewDataRecord(256, 0, 0, true)
+	addElement := func(name string, enterpriseID uint32, setValue func(ipfixentities.InfoElementWithValue)) {
+		element, err := ipfixregistry.GetInfoElement(name, enterpriseID)
+		require.NoError(t, err)
+		ie, err := ipfixentities.DecodeAndCreateInfoElementWithValue(element, nil)
+		require.NoError(t, err)
+		setValue(ie)
+		require.NoError(t, record.AddInfoElement(ie))
+	}
+	addElement("sourceIPv4Address", ipfixregistry.IANAEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetIPAddressValue(net.ParseIP("10.10.0.1").To4())
+	})
+	addElement("destinationIPv4Address", ipfixregistry.IANAEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetIPAddressValue(net.ParseIP("10.10.1.1").To4())
+	})
+	addElement("protocolIdentifier", ipfixregistry.IANAEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetUnsigned8Value(6)
+	})
+	addElement("sourceTransportPort", ipfixregistry.IANAEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetUnsigned16Value(40000)
+	})
+	addElement("destinationTransportPort", ipfixregistry.IANAEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetUnsigned16Value(80)
+	})
+	addElement("sourcePodName", ipfixregistry.AntreaEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetStringValue(sourcePodName)
+	})
+	addElement("tcpSmoothedRTT", ipfixregistry.AntreaEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetUnsigned32Value(smoothedRTT)
+	})
+	addElement("tcpRetransmissions", ipfixregistry.AntreaEnterpriseID, func(ie ipfixentities.InfoElementWithValue) {
+		ie.SetUnsigned32Value(retransmissions)
+	})
+	return record
+}
+
+func getTCPMetrics(record ipfixentities.Record) (uint32, uint32) {
+	rtt, _, _ := record.GetInfoElementWithValue("tcpSmoothedRTT")
+	retransmissions, _, _ := record.GetInfoElementWithValue("tcpRetransmissions")
+	return rtt.GetUnsigned32Value(), retransmissions.GetUnsigned32Value()
+}
+
+func TestTCPMetricsStore(t *testing.T) {
+	key := ipfixintermediate.FlowKey{
+		SourceAddress:      "10.10.0.1",
+		DestinationAddress: "10.10.1.1",
+		Protocol:           6,
+		SourcePort:         40000,
+		DestinationPort:    80,
+	}
+	refTime := time.Now()
+	store := newTCPMetricsStore()
+	aggregatedRecord := createTCPMetricsRecord(t, "", 0, 0)
+
+	// The metrics measured on the destination Node are used until the source Node reports some.
+	store.update(createTCPMetricsRecord(t, "", 400, 1), refTime)
+	store.fill(key, aggregatedRecord)
+	rtt, retransmissions := getTCPMetrics(aggregatedRecord)
+	assert.Equal(t, uint32(400), rtt)
+	assert.Equal(t, uint32(1), retransmissions)
+
+	store.update(createTCPMetricsRecord(t, "client", 1500, 2), refTime.Add(time.Second))
+	store.update(createTCPMetricsRecord(t, "", 500, 4), refTime.Add(2*time.Second))
+	// Records without metrics are ignored.
+	store.update(createTCPMetricsRecord(t, "client", 0, 0), refTime.Add(2*time.Second))
+	store.fill(key, aggregatedRecord)
+	rtt, retransmissions = getTCPMetrics(aggregatedRecord)
+	assert.Equal(t, uint32(1500), rtt)
+	assert.Equal(t, uint32(2), retransmissions)
+
+	store.update(createTCPMetricsRecord(t, "client", 1200, 5), refTime.Add(3*time.Second))
+	store.fill(key, aggregatedRecord)
+	rtt, retransmissions = getTCPMetrics(aggregatedRecord)
+	assert.Equal(t, uint32(1200), rtt)
+	assert.Equal(t, uint32(5), retransmissions)
+
+	store.deleteStale(refTime.Add(3 * time.Second))
+	assert.Len(t, store.metrics, 1)
+	store.deleteStale(refTime.Add(4 * time.Second))
+	assert.Empty(t, store.metrics)
+}
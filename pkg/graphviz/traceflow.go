@@ -154,7 +154,9 @@ func getSrcNodeName(tf *crdv1alpha1.Traceflow) string {
 		if len(tf.Spec.Source.IP) > 0 {
 			return getWrappedStr(tf.Spec.Source.IP)
 		}
-		return getWrappedStr(tf.Status.CapturedPacket.SrcIP)
+		if tf.Status.CapturedPacket != nil {
+			return getWrappedStr(tf.Status.CapturedPacket.SrcIP)
+		}
 	}
 	return ""
 }
@@ -170,7 +172,7 @@ func getDstNodeName(tf *crdv1alpha1.Traceflow) string {
 	if len(tf.Spec.Destination.Namespace) > 0 && len(tf.Spec.Destination.Pod) > 0 {
 		return getWrappedStr(tf.Spec.Destination.Namespace + "/" + tf.Spec.Destination.Pod)
 	}
-	if tf.Spec.LiveTraffic {
+	if tf.Spec.LiveTraffic && tf.Status.CapturedPacket != nil {
 		return getWrappedStr(tf.Status.CapturedPacket.DstIP)
 	}
 	return ""
@@ -332,6 +334,19 @@ func genSubGraph(graph *gographviz.Graph, cluster *gographviz.SubGraph, result *
 	return nodes, nil
 }
 
+// getFirstSampleTraceflow returns a copy of a sampling live-traffic Traceflow with the results and the captured packet
+// of its first sample, so that it can be rendered like a Traceflow capturing a single packet.
+func getFirstSampleTraceflow(tf *crdv1alpha1.Traceflow) *crdv1alpha1.Traceflow {
+	sampleTF := tf.DeepCopy()
+	sample := &sampleTF.Status.Samples[0]
+	sampleTF.Status.Results = sample.Results
+	sampleTF.Status.CapturedPacket = &sample.Packet
+	sampleTF.Status.Samples = nil
+	return sampleTF
+}
+
+// GenGraph generates the graph of a Traceflow in DOT language. For a sampling live-traffic Traceflow, the first
+// sampled packet is rendered.
 func GenGraph(tf *crdv1alpha1.Traceflow) (string, error) {
 	g, _ := gographviz.ParseString(`digraph G {}`)
 	graph := gographviz.NewGraph()
@@ -340,6 +355,10 @@ func GenGraph(tf *crdv1alpha1.Traceflow) (string, error) {
 	}
 	graph.Attrs[gographviz.Center] = "true"
 	graph.Attrs[gographviz.Label] = getWrappedStr(tf.Name)
+	if numSamples := len(tf.Status.Samples); numSamples > 0 {
+		graph.Attrs[gographviz.Label] = getWrappedStr(fmt.Sprintf("%s (sample 1 of %d)", tf.Name, numSamples))
+		tf = getFirstSampleTraceflow(tf)
+	}
 	graph.Attrs[gographviz.LabelLOC] = "t"
 	err := graph.SetDir(true)
 	if err != nil {
@@ -362,7 +381,7 @@ func GenGraph(tf *crdv1alpha1.Traceflow) (string, error) {
 			srcCluster.Attrs[gographviz.Label] = "source"
 			srcCluster.Attrs[gographviz.LabelJust] = "l"
 			// For live traffic data, we only know src IP from capturedPacket
-			node, err := createEndpointNodeWithDefaultStyle(graph, srcCluster.Name, getWrappedStr(tf.Status.CapturedPacket.SrcIP))
+			node, err := createEndpointNodeWithDefaultStyle(graph, srcCluster.Name, getSrcNodeName(tf))
 			if err != nil {
 				return "", err
 			}
@@ -534,6 +553,9 @@ func getCapturedPacketLabel(tf *crdv1alpha1.Traceflow) string {
 }
 
 func createCapturedPacketNode(graph *gographviz.Graph, parentGraph string, tf *crdv1alpha1.Traceflow) error {
+	if tf.Status.CapturedPacket == nil {
+		return nil
+	}
 	err := graph.AddNode(parentGraph, "capturedPacket", map[string]string{
 		"shape": "note",
 		"style": `"rounded,filled,solid"`,
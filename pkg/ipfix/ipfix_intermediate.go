@@ -29,6 +29,7 @@ type IPFIXAggregationProcess interface {
 	Start()
 	Stop()
 	ForAllExpiredFlowRecordsDo(callback ipfixintermediate.FlowKeyRecordMapCallBack) error
+	ForAllRecordsDo(callback ipfixintermediate.FlowKeyRecordMapCallBack) error
 	GetExpiryFromExpirePriorityQueue() time.Duration
 	GetRecords(flowKey *ipfixintermediate.FlowKey) []map[string]interface{}
 	ResetStatAndThroughputElementsInRecord(record ipfixentities.Record) error
@@ -68,6 +69,10 @@ func (ap *ipfixAggregationProcess) ForAllExpiredFlowRecordsDo(callback ipfixinte
 	return err
 }
 
+func (ap *ipfixAggregationProcess) ForAllRecordsDo(callback ipfixintermediate.FlowKeyRecordMapCallBack) error {
+	return ap.AggregationProcess.ForAllRecordsDo(callback)
+}
+
 func (ap *ipfixAggregationProcess) GetExpiryFromExpirePriorityQueue() time.Duration {
 	return ap.AggregationProcess.GetExpiryFromExpirePriorityQueue()
 }
@@ -17,10 +17,34 @@ package ipfix
 import (
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"k8s.io/klog/v2"
 )
 
 var _ IPFIXRegistry = new(ipfixRegistry)
 
+// antreaInfoElements are the Information Elements of the Antrea registry which are not defined by
+// the go-ipfix library yet. They are registered when loading the registry, so that they can be
+// exported by the Flow Exporter and decoded by the Flow Aggregator.
+var antreaInfoElements = []*ipfixentities.InfoElement{
+	// destinationFQDN is the comma-separated list of FQDNs which were resolved to the
+	// destination IP by DNS responses intercepted by the Antrea Agent.
+	ipfixentities.NewInfoElement("destinationFQDN", 153, ipfixentities.String, ipfixregistry.AntreaEnterpriseID, ipfixentities.VariableLength),
+	// tcpSmoothedRTT is the smoothed round-trip time of a TCP connection in microseconds, as
+	// measured by the TCP stack of the local Pod. 0 means that it is unknown.
+	ipfixentities.NewInfoElement("tcpSmoothedRTT", 154, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+	// tcpRetransmissions is the total number of segments retransmitted by the TCP stack of the
+	// local Pod for a TCP connection.
+	ipfixentities.NewInfoElement("tcpRetransmissions", 155, ipfixentities.Unsigned32, ipfixregistry.AntreaEnterpriseID, 4),
+}
+
+// Values of the ingressNetworkPolicyType and egressNetworkPolicyType Information Elements which are
+// not defined by the go-ipfix library yet, in addition to registry.PolicyTypeK8sNetworkPolicy,
+// registry.PolicyTypeAntreaNetworkPolicy and registry.PolicyTypeAntreaClusterNetworkPolicy.
+const (
+	PolicyTypeAdminNetworkPolicy         = uint8(4)
+	PolicyTypeBaselineAdminNetworkPolicy = uint8(5)
+)
+
 // IPFIXRegistry interface is added to facilitate unit testing without involving the code from go-ipfix library.
 type IPFIXRegistry interface {
 	LoadRegistry()
@@ -35,6 +59,13 @@ func NewIPFIXRegistry() *ipfixRegistry {
 
 func (reg *ipfixRegistry) LoadRegistry() {
 	ipfixregistry.LoadRegistry()
+	for _, ie := range antreaInfoElements {
+		// This fails if the Information Element has been added to the go-ipfix registry,
+		// in which case it should be removed from antreaInfoElements.
+		if err := ipfixregistry.PutInfoElement(*ie, ie.EnterpriseId); err != nil {
+			klog.ErrorS(err, "Failed to register Information Element", "name", ie.Name)
+		}
+	}
 }
 
 func (reg *ipfixRegistry) GetInfoElement(name string, enterpriseID uint32) (*ipfixentities.InfoElement, error) {
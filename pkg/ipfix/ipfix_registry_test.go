@@ -0,0 +1,47 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipfix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+)
+
+func TestLoadRegistry(t *testing.T) {
+	registry := NewIPFIXRegistry()
+	// Loading the registry twice must not fail to register the Antrea Information Elements.
+	registry.LoadRegistry()
+	registry.LoadRegistry()
+
+	element, err := registry.GetInfoElement("destinationFQDN", ipfixregistry.AntreaEnterpriseID)
+	require.NoError(t, err)
+	assert.Equal(t, ipfixentities.String, element.DataType)
+	// The collecting process of go-ipfix decodes templates using the element ID.
+	elementFromID, err := ipfixregistry.GetInfoElementFromID(element.ElementId, ipfixregistry.AntreaEnterpriseID)
+	require.NoError(t, err)
+	assert.Equal(t, "destinationFQDN", elementFromID.Name)
+	for _, name := range []string{"tcpSmoothedRTT", "tcpRetransmissions"} {
+		element, err := registry.GetInfoElement(name, ipfixregistry.AntreaEnterpriseID)
+		require.NoError(t, err)
+		assert.Equal(t, ipfixentities.Unsigned32, element.DataType)
+	}
+	// The Information Elements defined by go-ipfix are still available.
+	_, err = registry.GetInfoElement("flowEndSecondsFromDestinationNode", ipfixregistry.AntreaEnterpriseID)
+	assert.NoError(t, err)
+}
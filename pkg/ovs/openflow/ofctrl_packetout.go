@@ -290,9 +290,13 @@ func (b *ofPacketOutBuilder) SetOutport(outport uint32) PacketOutBuilder {
 }
 
 // SetL4Packet sets the L4 packet of the packetOut message. It provides a generic function to create a packet
-// of protocol other than TCP/UDP/ICMP.
+// of protocol other than TCP/UDP/ICMP, or to send an L4 packet which is already serialized.
 func (b *ofPacketOutBuilder) SetL4Packet(packet util.Message) PacketOutBuilder {
-	b.pktOut.IPHeader.Data = packet
+	if b.pktOut.IPv6Header != nil {
+		b.pktOut.IPv6Header.Data = packet
+	} else {
+		b.pktOut.IPHeader.Data = packet
+	}
 	return b
 }
 
@@ -352,6 +356,8 @@ func (b *ofPacketOutBuilder) Done() *ofctrl.PacketOut {
 				igmpv3Report.Checksum = b.igmpHeaderChecksum()
 			}
 			b.pktOut.IPHeader.Length = 20 + b.pktOut.IPHeader.Data.Len()
+		} else if b.pktOut.IPHeader.Data != nil {
+			b.pktOut.IPHeader.Length = 20 + b.pktOut.IPHeader.Data.Len()
 		}
 		if b.pktOut.IPHeader.Id == 0 {
 			// #nosec G404: random number generator not used for security purposes
@@ -381,6 +387,8 @@ func (b *ofPacketOutBuilder) Done() *ofctrl.PacketOut {
 			b.pktOut.UDPHeader.Length = b.pktOut.UDPHeader.Len()
 			b.pktOut.UDPHeader.Checksum = b.udpHeaderChecksum()
 			b.pktOut.IPv6Header.Length = b.pktOut.UDPHeader.Len()
+		} else if b.pktOut.IPv6Header.Data != nil {
+			b.pktOut.IPv6Header.Length = b.pktOut.IPv6Header.Data.Len()
 		}
 		// Set IPv6 version in the IP Header.
 		b.pktOut.IPv6Header.Version = 0x6
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"antrea.io/libOpenflow/protocol"
+	"antrea.io/libOpenflow/util"
 	"antrea.io/ofnet/ofctrl"
 )
 
@@ -609,6 +610,8 @@ func Test_ofPacketOutBuilder_Done(t *testing.T) {
 	}
 	icmpID := uint16(1)
 	icmpSeq := uint16(2)
+	l4Packet := new(util.Buffer)
+	l4Packet.UnmarshalBinary([]byte{0x0, 0x35, 0x27, 0x10, 0x0, 0x1, 0x0, 0x2})
 	tests := []struct {
 		name   string
 		fields fields
@@ -779,6 +782,25 @@ func Test_ofPacketOutBuilder_Done(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "IPv6 L4 packet",
+			fields: fields{
+				pktOut: &ofctrl.PacketOut{
+					IPv6Header: &protocol.IPv6{
+						NextHeader: protocol.Type_TCP,
+						Data:       l4Packet,
+					},
+				},
+			},
+			want: &ofctrl.PacketOut{
+				IPv6Header: &protocol.IPv6{
+					Version:    0x6,
+					Length:     8,
+					NextHeader: protocol.Type_TCP,
+					Data:       l4Packet,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
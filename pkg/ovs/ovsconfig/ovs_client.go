@@ -373,7 +373,7 @@ func (br *OVSBridge) CreateInternalPort(name string, ofPortRequest int32, extern
 // the bridge.
 // If ofPortRequest is not zero, it will be passed to the OVS port creation.
 func (br *OVSBridge) CreateTunnelPort(name string, tunnelType TunnelType, ofPortRequest int32) (string, Error) {
-	return br.createTunnelPort(name, tunnelType, ofPortRequest, false, "", "", "", nil)
+	return br.createTunnelPort(name, tunnelType, ofPortRequest, false, "", "", "", nil, nil)
 }
 
 // CreateTunnelPortExt creates a tunnel port with the specified name and type
@@ -384,6 +384,8 @@ func (br *OVSBridge) CreateTunnelPort(name string, tunnelType TunnelType, ofPort
 // psk is for the pre-shared key of IPsec ESP tunnel. If it is not empty, it
 // will be set to the tunnel port interface options. Flow based IPsec tunnel is
 // not supported, so remoteIP must be provided too when psk is not empty.
+// If extraOptions is not nil, the options in it will be added to the tunnel
+// port interface options, e.g. "key" and "dst_port".
 // If externalIDs is not nill, the IDs in it will be added to the port's
 // external_ids.
 func (br *OVSBridge) CreateTunnelPortExt(
@@ -394,11 +396,12 @@ func (br *OVSBridge) CreateTunnelPortExt(
 	localIP string,
 	remoteIP string,
 	psk string,
+	extraOptions map[string]interface{},
 	externalIDs map[string]interface{}) (string, Error) {
 	if psk != "" && remoteIP == "" {
 		return "", newInvalidArgumentsError("IPsec tunnel can not be flow based. remoteIP must be set")
 	}
-	return br.createTunnelPort(name, tunnelType, ofPortRequest, csum, localIP, remoteIP, psk, externalIDs)
+	return br.createTunnelPort(name, tunnelType, ofPortRequest, csum, localIP, remoteIP, psk, extraOptions, externalIDs)
 }
 
 func (br *OVSBridge) createTunnelPort(
@@ -409,16 +412,20 @@ func (br *OVSBridge) createTunnelPort(
 	localIP string,
 	remoteIP string,
 	psk string,
+	extraOptions map[string]interface{},
 	externalIDs map[string]interface{}) (string, Error) {
 
-	if tunnelType != VXLANTunnel && tunnelType != GeneveTunnel && tunnelType != GRETunnel && tunnelType != STTTunnel {
+	if tunnelType != VXLANTunnel && tunnelType != GeneveTunnel && tunnelType != GRETunnel && tunnelType != STTTunnel && tunnelType != ERSPANTunnel {
 		return "", newInvalidArgumentsError("unsupported tunnel type: " + string(tunnelType))
 	}
 	if ofPortRequest < 0 || ofPortRequest > ofPortRequestMax {
 		return "", newInvalidArgumentsError(fmt.Sprint("invalid ofPortRequest value: ", ofPortRequest))
 	}
 
-	options := make(map[string]interface{}, 3)
+	options := make(map[string]interface{}, len(extraOptions)+3)
+	for k, v := range extraOptions {
+		options[k] = v
+	}
 	if remoteIP != "" {
 		options["remote_ip"] = remoteIP
 	} else {
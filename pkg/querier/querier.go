@@ -15,7 +15,11 @@
 package querier
 
 import (
+	"net"
+	"time"
+
 	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"antrea.io/antrea/pkg/agent/types"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -38,6 +42,41 @@ type AgentNetworkPolicyInfoQuerier interface {
 	GetAppliedNetworkPolicies(pod, namespace string, npFilter *NetworkPolicyQueryFilter) []cpv1beta.NetworkPolicy
 	GetNetworkPolicyByRuleFlowID(ruleFlowID uint32) *cpv1beta.NetworkPolicyReference
 	GetRuleByFlowID(ruleFlowID uint32) *types.PolicyRule
+	// GetFQDNCache returns the FQDNs tracked for FQDN policy rules which match the provided
+	// domain, which can contain wildcards like the FQDN peers of policies. An empty domain
+	// matches all FQDNs.
+	GetFQDNCache(domain string) []FQDNCacheEntry
+	// FlushFQDNCache removes the IPs cached for the FQDNs which match the provided domain, and
+	// returns the number of FQDNs flushed. The FQDNs selected by name are resolved again.
+	FlushFQDNCache(domain string) int
+	// GetFQDNsForIP returns the FQDNs tracked for FQDN policy rules which were resolved to the
+	// provided IP, in alphabetical order.
+	GetFQDNsForIP(ip net.IP) []string
+}
+
+// AgentEgressQuerier queries the Egresses realized by antrea-agent.
+type AgentEgressQuerier interface {
+	// GetEgressByOFPort returns the name and UID of the Egress whose SNAT flow is installed for the provided OpenFlow
+	// port of a local Pod.
+	GetEgressByOFPort(ofPort uint32) (string, k8stypes.UID, bool)
+	// GetEgress returns the name of the effective Egress of a local Pod, the Egress IP the Pod's traffic is SNAT'd
+	// to, and the name of the Node that holds the Egress IP.
+	GetEgress(podNamespace, podName string) (string, string, string, error)
+}
+
+// FQDNCacheEntry describes a FQDN tracked by the Antrea Agent for FQDN policy rules, with the IPs it
+// was last resolved to.
+type FQDNCacheEntry struct {
+	FQDN string   `json:"fqdn"`
+	IPs  []string `json:"ips,omitempty"`
+	// ExpirationTime is the time at which the IPs will be resolved again, derived from the
+	// lowest TTL of the DNS records. It is nil if the FQDN has not been resolved yet.
+	ExpirationTime *time.Time `json:"expirationTime,omitempty"`
+	// Selectors are the FQDN peers of policy rules that select the FQDN.
+	Selectors []string `json:"selectors,omitempty"`
+	// Rules are the policy rules that select the FQDN, in the format of
+	// <PolicyType>:[<Namespace>/]<PolicyName>/<RuleName>.
+	Rules []string `json:"rules,omitempty"`
 }
 
 type ControllerNetworkPolicyInfoQuerier interface {
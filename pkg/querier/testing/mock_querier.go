@@ -14,7 +14,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier)
+// Source: antrea.io/antrea/pkg/querier (interfaces: AgentEgressQuerier,AgentNetworkPolicyInfoQuerier)
 
 // Package testing is a generated GoMock package.
 package testing
@@ -24,9 +24,67 @@ import (
 	v1beta2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	querier "antrea.io/antrea/pkg/querier"
 	gomock "github.com/golang/mock/gomock"
+	types0 "k8s.io/apimachinery/pkg/types"
+	net "net"
 	reflect "reflect"
 )
 
+// MockAgentEgressQuerier is a mock of AgentEgressQuerier interface
+type MockAgentEgressQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgentEgressQuerierMockRecorder
+}
+
+// MockAgentEgressQuerierMockRecorder is the mock recorder for MockAgentEgressQuerier
+type MockAgentEgressQuerierMockRecorder struct {
+	mock *MockAgentEgressQuerier
+}
+
+// NewMockAgentEgressQuerier creates a new mock instance
+func NewMockAgentEgressQuerier(ctrl *gomock.Controller) *MockAgentEgressQuerier {
+	mock := &MockAgentEgressQuerier{ctrl: ctrl}
+	mock.recorder = &MockAgentEgressQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAgentEgressQuerier) EXPECT() *MockAgentEgressQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetEgress mocks base method
+func (m *MockAgentEgressQuerier) GetEgress(arg0, arg1 string) (string, string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgress", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetEgress indicates an expected call of GetEgress
+func (mr *MockAgentEgressQuerierMockRecorder) GetEgress(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgress", reflect.TypeOf((*MockAgentEgressQuerier)(nil).GetEgress), arg0, arg1)
+}
+
+// GetEgressByOFPort mocks base method
+func (m *MockAgentEgressQuerier) GetEgressByOFPort(arg0 uint32) (string, types0.UID, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEgressByOFPort", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(types0.UID)
+	ret2, _ := ret[2].(bool)
+	return ret0, ret1, ret2
+}
+
+// GetEgressByOFPort indicates an expected call of GetEgressByOFPort
+func (mr *MockAgentEgressQuerierMockRecorder) GetEgressByOFPort(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressByOFPort", reflect.TypeOf((*MockAgentEgressQuerier)(nil).GetEgressByOFPort), arg0)
+}
+
 // MockAgentNetworkPolicyInfoQuerier is a mock of AgentNetworkPolicyInfoQuerier interface
 type MockAgentNetworkPolicyInfoQuerier struct {
 	ctrl     *gomock.Controller
@@ -50,6 +108,20 @@ func (m *MockAgentNetworkPolicyInfoQuerier) EXPECT() *MockAgentNetworkPolicyInfo
 	return m.recorder
 }
 
+// FlushFQDNCache mocks base method
+func (m *MockAgentNetworkPolicyInfoQuerier) FlushFQDNCache(arg0 string) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlushFQDNCache", arg0)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// FlushFQDNCache indicates an expected call of FlushFQDNCache
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) FlushFQDNCache(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlushFQDNCache", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).FlushFQDNCache), arg0)
+}
+
 // GetAddressGroupNum mocks base method
 func (m *MockAgentNetworkPolicyInfoQuerier) GetAddressGroupNum() int {
 	m.ctrl.T.Helper()
@@ -134,6 +206,34 @@ func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetControllerConnection
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerConnectionStatus", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetControllerConnectionStatus))
 }
 
+// GetFQDNCache mocks base method
+func (m *MockAgentNetworkPolicyInfoQuerier) GetFQDNCache(arg0 string) []querier.FQDNCacheEntry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFQDNCache", arg0)
+	ret0, _ := ret[0].([]querier.FQDNCacheEntry)
+	return ret0
+}
+
+// GetFQDNCache indicates an expected call of GetFQDNCache
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetFQDNCache(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFQDNCache", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetFQDNCache), arg0)
+}
+
+// GetFQDNsForIP mocks base method
+func (m *MockAgentNetworkPolicyInfoQuerier) GetFQDNsForIP(arg0 net.IP) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFQDNsForIP", arg0)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// GetFQDNsForIP indicates an expected call of GetFQDNsForIP
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetFQDNsForIP(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFQDNsForIP", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetFQDNsForIP), arg0)
+}
+
 // GetNetworkPolicies mocks base method
 func (m *MockAgentNetworkPolicyInfoQuerier) GetNetworkPolicies(arg0 *querier.NetworkPolicyQueryFilter) []v1beta2.NetworkPolicy {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ package k8s
 
 import (
 	apiextensionclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -73,6 +74,16 @@ func CreateNetworkAttachDefClient(config componentbaseconfig.ClientConnectionCon
 
 }
 
+// CreateDynamicClient creates a dynamic client from the given config. It is used to access the
+// resources of APIs whose typed clients are not available, e.g. AdminNetworkPolicies.
+func CreateDynamicClient(config componentbaseconfig.ClientConnectionConfiguration, kubeAPIServerOverride string) (dynamic.Interface, error) {
+	kubeConfig, err := createRestConfig(config, kubeAPIServerOverride)
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(kubeConfig)
+}
+
 func createRestConfig(config componentbaseconfig.ClientConnectionConfiguration, kubeAPIServerOverride string) (*rest.Config, error) {
 	var kubeConfig *rest.Config
 	var err error
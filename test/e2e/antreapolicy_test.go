@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	v1net "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -3448,7 +3449,7 @@ func checkANPStatus(t *testing.T, data *TestData, anp *crdv1alpha1.NetworkPolicy
 		if err != nil {
 			return false, err
 		}
-		return anp.Status == expectedStatus, nil
+		return equality.Semantic.DeepEqual(anp.Status, expectedStatus), nil
 	})
 	assert.NoError(t, err, "Antrea NetworkPolicy failed to reach expected status")
 	return anp
@@ -3461,7 +3462,7 @@ func checkACNPStatus(t *testing.T, data *TestData, acnp *crdv1alpha1.ClusterNetw
 		if err != nil {
 			return false, err
 		}
-		return acnp.Status == expectedStatus, nil
+		return equality.Semantic.DeepEqual(acnp.Status, expectedStatus), nil
 	})
 	assert.NoError(t, err, "Antrea ClusterNetworkPolicy failed to reach expected status")
 	return acnp